@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"strconv"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/core/history"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/gin-gonic/gin"
 )
@@ -15,6 +18,11 @@ import (
 type FacadeHandler interface {
 	GetBalance(address string) (*big.Int, error)
 	GetAccount(address string) (*state.Account, error)
+	EncodeAddress(address []byte) (string, error)
+	GetTransactionHistory(address string, from uint64, limit int) (*history.Page, error)
+	GetAccountStorageValue(address string, key []byte) ([]byte, error)
+	GetAccountStorage(address string, startKey []byte, limit int) ([]data.TrieLeaf, error)
+	GetAccountProof(address string, rootHash []byte) ([][]byte, error)
 	IsInterfaceNil() bool
 }
 
@@ -27,10 +35,38 @@ type accountResponse struct {
 	RootHash []byte `json:"rootHash"`
 }
 
+type historyRecordResponse struct {
+	Nonce      uint64 `json:"nonce"`
+	Hash       string `json:"hash"`
+	BlockNonce uint64 `json:"blockNonce"`
+	Direction  string `json:"direction"`
+}
+
+type storagePairResponse struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// defaultHistoryLimit is the number of transaction history records returned when no limit is requested
+const defaultHistoryLimit = 20
+
+// maxHistoryLimit is the maximum number of transaction history records returned by a request
+const maxHistoryLimit = 100
+
+// defaultStorageLimit is the number of account storage pairs returned when no limit is requested
+const defaultStorageLimit = 100
+
+// maxStorageLimit is the maximum number of account storage pairs returned by a request
+const maxStorageLimit = 1000
+
 // Routes defines address related routes
 func Routes(router *gin.RouterGroup) {
 	router.GET("/:address", GetAccount)
 	router.GET("/:address/balance", GetBalance)
+	router.GET("/:address/transactions", GetTransactionHistory)
+	router.GET("/:address/key/:hexkey", GetStorageValue)
+	router.GET("/:address/keys", GetStorage)
+	router.GET("/:address/proof", GetProof)
 }
 
 // GetAccount returns an accountResponse containing information
@@ -48,6 +84,15 @@ func GetAccount(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrCouldNotGetAccount.Error(), err.Error())})
 		return
 	}
+
+	if acc.AddressContainer() != nil {
+		addr, err = ef.EncodeAddress(acc.AddressContainer().Bytes())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrCouldNotGetAccount.Error(), err.Error())})
+			return
+		}
+	}
+
 	c.JSON(http.StatusOK, gin.H{"account": accountResponseFromBaseAccount(addr, acc)})
 }
 
@@ -74,6 +119,159 @@ func GetBalance(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"balance": balance})
 }
 
+// GetTransactionHistory returns a page of the transactions of the address parameter, from the newest to the oldest.
+// The from query parameter is the cursor returned as nextFrom by the previous page, a nextFrom value of 0 meaning
+// that there are no older transactions
+func GetTransactionHistory(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	from := uint64(0)
+	var err error
+	if fromStr := c.Query("from"); fromStr != "" {
+		from, err = strconv.ParseUint(fromStr, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrInvalidTransactionHistoryCursor.Error(), err.Error())})
+			return
+		}
+	}
+
+	limit := defaultHistoryLimit
+	if limitStr := c.Query("limit"); limitStr != "" {
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit < 1 || limit > maxHistoryLimit {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: it must be between 1 and %d", errors.ErrInvalidTransactionHistoryLimit.Error(), maxHistoryLimit)})
+			return
+		}
+	}
+
+	page, err := ef.GetTransactionHistory(c.Param("address"), from, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetTransactionHistory.Error(), err.Error())})
+		return
+	}
+
+	records := make([]historyRecordResponse, 0, len(page.Records))
+	for _, record := range page.Records {
+		records = append(records, historyRecordResponse{
+			Nonce:      record.Nonce,
+			Hash:       hex.EncodeToString(record.TxHash),
+			BlockNonce: record.BlockNonce,
+			Direction:  record.Direction,
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{"transactions": records, "nextFrom": page.NextFrom})
+}
+
+// GetStorageValue returns the value stored under the hexkey parameter in the data trie of the address parameter
+func GetStorageValue(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	key, err := hex.DecodeString(c.Param("hexkey"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrInvalidStorageKeyHex.Error(), err.Error())})
+		return
+	}
+
+	value, err := ef.GetAccountStorageValue(c.Param("address"), key)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetAccountStorage.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"value": hex.EncodeToString(value)})
+}
+
+// GetStorage returns a page of the key/value pairs stored in the data trie of the address parameter. The from query
+// parameter is the hex encoded cursor returned as nextFrom by the previous page, an empty nextFrom value meaning
+// that there are no more pairs
+func GetStorage(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	var startKey []byte
+	var err error
+	if fromStr := c.Query("from"); fromStr != "" {
+		startKey, err = hex.DecodeString(fromStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrInvalidStorageKeyHex.Error(), err.Error())})
+			return
+		}
+	}
+
+	limit := defaultStorageLimit
+	if limitStr := c.Query("limit"); limitStr != "" {
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit < 1 || limit > maxStorageLimit {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: it must be between 1 and %d", errors.ErrInvalidAccountStorageLimit.Error(), maxStorageLimit)})
+			return
+		}
+	}
+
+	// one more pair is requested in order to find out if there is a next page
+	leaves, err := ef.GetAccountStorage(c.Param("address"), startKey, limit+1)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetAccountStorage.Error(), err.Error())})
+		return
+	}
+
+	nextFrom := ""
+	if len(leaves) > limit {
+		leaves = leaves[:limit]
+		nextFrom = hex.EncodeToString(leaves[limit-1].Key)
+	}
+
+	pairs := make([]storagePairResponse, 0, len(leaves))
+	for _, leaf := range leaves {
+		pairs = append(pairs, storagePairResponse{
+			Key:   hex.EncodeToString(leaf.Key),
+			Value: hex.EncodeToString(leaf.Value),
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{"pairs": pairs, "nextFrom": nextFrom})
+}
+
+// GetProof returns the Merkle proof of the address parameter in the accounts trie committed under the hex encoded
+// rootHash query parameter. The proof is the list of the hex encoded trie nodes, from the root to the account leaf
+func GetProof(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	rootHash, err := hex.DecodeString(c.Query("rootHash"))
+	if err != nil || len(rootHash) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": errors.ErrInvalidRootHashHex.Error()})
+		return
+	}
+
+	proof, err := ef.GetAccountProof(c.Param("address"), rootHash)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetAccountProof.Error(), err.Error())})
+		return
+	}
+
+	encodedProof := make([]string, 0, len(proof))
+	for _, proofNode := range proof {
+		encodedProof = append(encodedProof, hex.EncodeToString(proofNode))
+	}
+
+	c.JSON(http.StatusOK, gin.H{"proof": encodedProof})
+}
+
 func accountResponseFromBaseAccount(address string, account *state.Account) accountResponse {
 	return accountResponse{
 		Address:  address,
@@ -1,6 +1,7 @@
 package address_test
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -15,6 +16,8 @@ import (
 	errors2 "github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/core/history"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -233,6 +236,316 @@ func TestGetAccount_ReturnsSuccessfully(t *testing.T) {
 	assert.Empty(t, accountResponse.Error)
 }
 
+func TestGetAccount_ShouldReturnAddressInFacadeFormat(t *testing.T) {
+	t.Parallel()
+
+	addressBytes := []byte("address")
+	facade := mock.Facade{
+		GetAccountHandler: func(address string) (*state.Account, error) {
+			acc, _ := state.NewAccount(state.NewAddress(addressBytes), &mock.AccountTrackerStub{})
+			acc.Nonce = 1
+			return acc, nil
+		},
+		EncodeAddressHandler: func(address []byte) (string, error) {
+			return "erd1" + string(address), nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/0x"+hex.EncodeToString(addressBytes), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	accountResponse := AccountResponse{}
+	loadResponse(resp.Body, &accountResponse)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "erd1address", accountResponse.Account.Address)
+	assert.Equal(t, uint64(1), accountResponse.Account.Nonce)
+	assert.Empty(t, accountResponse.Error)
+}
+
+type TransactionHistoryResponse struct {
+	GeneralResponse
+	Transactions []struct {
+		Nonce      uint64 `json:"nonce"`
+		Hash       string `json:"hash"`
+		BlockNonce uint64 `json:"blockNonce"`
+		Direction  string `json:"direction"`
+	} `json:"transactions"`
+	NextFrom uint64 `json:"nextFrom"`
+}
+
+func TestGetTransactionHistory_InvalidQueryParametersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+
+	req, _ := http.NewRequest("GET", "/address/test/transactions?from=-1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := TransactionHistoryResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, errors2.ErrInvalidTransactionHistoryCursor.Error())
+
+	req, _ = http.NewRequest("GET", "/address/test/transactions?limit=101", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response = TransactionHistoryResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, errors2.ErrInvalidTransactionHistoryLimit.Error())
+}
+
+func TestGetTransactionHistory_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("expected error")
+	ws := startNodeServer(&mock.Facade{
+		GetTransactionHistoryHandler: func(address string, from uint64, limit int) (*history.Page, error) {
+			return nil, errExpected
+		},
+	})
+
+	req, _ := http.NewRequest("GET", "/address/test/transactions", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := TransactionHistoryResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, errors2.ErrGetTransactionHistory.Error())
+	assert.Contains(t, response.Error, errExpected.Error())
+}
+
+func TestGetTransactionHistory_ShouldReturnThePage(t *testing.T) {
+	t.Parallel()
+
+	var requestedFrom uint64
+	var requestedLimit int
+	ws := startNodeServer(&mock.Facade{
+		GetTransactionHistoryHandler: func(address string, from uint64, limit int) (*history.Page, error) {
+			requestedFrom = from
+			requestedLimit = limit
+			return &history.Page{
+				Records: []*history.Record{
+					{Nonce: 4, TxHash: []byte("hash"), BlockNonce: 9, Direction: history.DirectionOut},
+				},
+				NextFrom: 6,
+			}, nil
+		},
+	})
+
+	req, _ := http.NewRequest("GET", "/address/test/transactions?from=7", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := TransactionHistoryResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, uint64(7), requestedFrom)
+	assert.Equal(t, 20, requestedLimit)
+	assert.Equal(t, uint64(6), response.NextFrom)
+	assert.Equal(t, 1, len(response.Transactions))
+	assert.Equal(t, hex.EncodeToString([]byte("hash")), response.Transactions[0].Hash)
+	assert.Equal(t, uint64(9), response.Transactions[0].BlockNonce)
+	assert.Equal(t, history.DirectionOut, response.Transactions[0].Direction)
+}
+
+type StorageValueResponse struct {
+	GeneralResponse
+	Value string `json:"value"`
+}
+
+type StorageResponse struct {
+	GeneralResponse
+	Pairs []struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	} `json:"pairs"`
+	NextFrom string `json:"nextFrom"`
+}
+
+func TestGetStorageValue_InvalidHexKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+
+	req, _ := http.NewRequest("GET", "/address/test/key/zz", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := StorageValueResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, errors2.ErrInvalidStorageKeyHex.Error())
+}
+
+func TestGetStorageValue_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("expected error")
+	ws := startNodeServer(&mock.Facade{
+		GetAccountStorageValueHandler: func(address string, key []byte) ([]byte, error) {
+			return nil, errExpected
+		},
+	})
+
+	req, _ := http.NewRequest("GET", "/address/test/key/0a", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := StorageValueResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, errors2.ErrGetAccountStorage.Error())
+	assert.Contains(t, response.Error, errExpected.Error())
+}
+
+func TestGetStorageValue_ShouldReturnTheHexValue(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{
+		GetAccountStorageValueHandler: func(address string, key []byte) ([]byte, error) {
+			return append([]byte(address), key...), nil
+		},
+	})
+
+	req, _ := http.NewRequest("GET", "/address/test/key/0a0b", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := StorageValueResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, hex.EncodeToString(append([]byte("test"), 10, 11)), response.Value)
+}
+
+func TestGetStorage_InvalidQueryParametersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+
+	req, _ := http.NewRequest("GET", "/address/test/keys?from=zz", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := StorageResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, errors2.ErrInvalidStorageKeyHex.Error())
+
+	req, _ = http.NewRequest("GET", "/address/test/keys?limit=0", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response = StorageResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, errors2.ErrInvalidAccountStorageLimit.Error())
+}
+
+func TestGetStorage_ShouldReturnThePageAndTheNextCursor(t *testing.T) {
+	t.Parallel()
+
+	var requestedStartKey []byte
+	ws := startNodeServer(&mock.Facade{
+		GetAccountStorageHandler: func(address string, startKey []byte, limit int) ([]data.TrieLeaf, error) {
+			requestedStartKey = startKey
+			leaves := make([]data.TrieLeaf, 0)
+			for i := 0; i < limit && i < 3; i++ {
+				leaves = append(leaves, data.TrieLeaf{Key: []byte{byte(i)}, Value: []byte{byte(i + 10)}})
+			}
+			return leaves, nil
+		},
+	})
+
+	req, _ := http.NewRequest("GET", "/address/test/keys?from=ff&limit=2", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := StorageResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, []byte{255}, requestedStartKey)
+	assert.Equal(t, 2, len(response.Pairs))
+	assert.Equal(t, "00", response.Pairs[0].Key)
+	assert.Equal(t, "0a", response.Pairs[0].Value)
+	assert.Equal(t, "01", response.NextFrom)
+
+	req, _ = http.NewRequest("GET", "/address/test/keys", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response = StorageResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, 3, len(response.Pairs))
+	assert.Equal(t, "", response.NextFrom)
+}
+
+type ProofResponse struct {
+	GeneralResponse
+	Proof []string `json:"proof"`
+}
+
+func TestGetProof_InvalidRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+
+	for _, query := range []string{"", "?rootHash=", "?rootHash=zz"} {
+		req, _ := http.NewRequest("GET", "/address/test/proof"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := ProofResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, errors2.ErrInvalidRootHashHex.Error())
+	}
+}
+
+func TestGetProof_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("expected error")
+	ws := startNodeServer(&mock.Facade{
+		GetAccountProofHandler: func(address string, rootHash []byte) ([][]byte, error) {
+			return nil, errExpected
+		},
+	})
+
+	req, _ := http.NewRequest("GET", "/address/test/proof?rootHash=0a", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := ProofResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, errors2.ErrGetAccountProof.Error())
+	assert.Contains(t, response.Error, errExpected.Error())
+}
+
+func TestGetProof_ShouldReturnTheHexProof(t *testing.T) {
+	t.Parallel()
+
+	var requestedRootHash []byte
+	ws := startNodeServer(&mock.Facade{
+		GetAccountProofHandler: func(address string, rootHash []byte) ([][]byte, error) {
+			requestedRootHash = rootHash
+			return [][]byte{[]byte(address), {10, 11}}, nil
+		},
+	})
+
+	req, _ := http.NewRequest("GET", "/address/test/proof?rootHash=0a0b", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := ProofResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, []byte{10, 11}, requestedRootHash)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("test")), "0a0b"}, response.Proof)
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
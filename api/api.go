@@ -7,10 +7,14 @@ import (
 	"reflect"
 
 	"github.com/ElrondNetwork/elrond-go/api/address"
+	"github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/api/jsonrpc"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/node"
+	"github.com/ElrondNetwork/elrond-go/api/subscriptions"
 	"github.com/ElrondNetwork/elrond-go/api/transaction"
 	"github.com/ElrondNetwork/elrond-go/api/vmValues"
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-contrib/pprof"
 	"github.com/gin-gonic/gin"
@@ -25,6 +29,28 @@ type validatorInput struct {
 	Validator validator.Func
 }
 
+// routeGroup holds the routes of a group. The optional readOnlyRoutes are registered instead of the routes when the
+// group is read-only, for the groups which serve read requests with other methods than GET
+type routeGroup struct {
+	name           string
+	routes         func(router *gin.RouterGroup)
+	readOnlyRoutes func(router *gin.RouterGroup)
+}
+
+// routeGroups lists the route groups of the API, in the order they are registered
+var routeGroups = []routeGroup{
+	{name: "node", routes: node.Routes},
+	{name: "address", routes: address.Routes},
+	{name: "transaction", routes: transaction.Routes},
+	{name: "vm-values", routes: vmValues.Routes},
+	{name: "block", routes: block.Routes},
+	{name: "metablock", routes: block.MetaBlockRoutes},
+	{name: "subscriptions", routes: subscriptions.Routes},
+}
+
+// rpcRouteGroup is the JSON-RPC endpoint, served on the REST API port or on a separate one
+var rpcRouteGroup = routeGroup{name: "rpc", routes: jsonrpc.Routes, readOnlyRoutes: jsonrpc.ReadOnlyRoutes}
+
 type prometheus struct {
 	NodePort  string
 	NetworkID string
@@ -38,27 +64,24 @@ type MainApiHandler interface {
 	PrometheusMonitoring() bool
 	PrometheusJoinURL() string
 	PrometheusNetworkID() string
+	ApiConfig() config.ApiConfig
 	IsInterfaceNil() bool
 }
 
 // Start will boot up the api and appropriate routes, handlers and validators
 func Start(elrondFacade MainApiHandler) error {
-	var ws *gin.Engine
-	if elrondFacade.RestAPIServerDebugMode() {
-		ws = gin.Default()
-	} else {
-		ws = gin.New()
-		ws.Use(gin.Recovery())
-		gin.SetMode(gin.ReleaseMode)
-	}
-	ws.Use(cors.Default())
+	ws := createEngine(elrondFacade.RestAPIServerDebugMode())
 
 	err := registerValidators()
 	if err != nil {
 		return err
 	}
 
-	registerRoutes(ws, elrondFacade)
+	apiConfig := elrondFacade.ApiConfig()
+	err = registerRoutes(ws, elrondFacade, apiConfig)
+	if err != nil {
+		return err
+	}
 
 	if elrondFacade.PrometheusMonitoring() {
 		err = joinMonitoringSystem(elrondFacade)
@@ -67,7 +90,41 @@ func Start(elrondFacade MainApiHandler) error {
 		}
 	}
 
-	return ws.Run(fmt.Sprintf(":%s", elrondFacade.RestApiPort()))
+	if !apiConfig.JsonRpc.Enabled || apiConfig.JsonRpc.Port == "" {
+		return ws.Run(fmt.Sprintf(":%s", elrondFacade.RestApiPort()))
+	}
+
+	rpcWs := createEngine(elrondFacade.RestAPIServerDebugMode())
+	_, err = registerRouteGroups(rpcWs, elrondFacade, apiConfig, []routeGroup{rpcRouteGroup})
+	if err != nil {
+		return err
+	}
+
+	chErr := make(chan error, 2)
+	go func() {
+		chErr <- ws.Run(fmt.Sprintf(":%s", elrondFacade.RestApiPort()))
+	}()
+	go func() {
+		chErr <- rpcWs.Run(fmt.Sprintf(":%s", apiConfig.JsonRpc.Port))
+	}()
+
+	return <-chErr
+}
+
+func createEngine(debugMode bool) *gin.Engine {
+	var ws *gin.Engine
+	if debugMode {
+		ws = gin.Default()
+	} else {
+		ws = gin.New()
+		ws.Use(gin.Recovery())
+		gin.SetMode(gin.ReleaseMode)
+	}
+	// the client IP is resolved by the rate limiter, which only trusts X-Forwarded-For from the configured proxies
+	ws.ForwardedByClientIP = false
+	ws.Use(cors.Default())
+
+	return ws
 }
 
 func joinMonitoringSystem(elrondFacade MainApiHandler) error {
@@ -97,31 +154,83 @@ func joinMonitoringSystem(elrondFacade MainApiHandler) error {
 	return err
 }
 
-func registerRoutes(ws *gin.Engine, elrondFacade middleware.ElrondHandler) {
-	nodeRoutes := ws.Group("/node")
-	nodeRoutes.Use(middleware.WithElrondFacade(elrondFacade))
-	node.Routes(nodeRoutes)
-
-	addressRoutes := ws.Group("/address")
-	addressRoutes.Use(middleware.WithElrondFacade(elrondFacade))
-	address.Routes(addressRoutes)
-
-	txRoutes := ws.Group("/transaction")
-	txRoutes.Use(middleware.WithElrondFacade(elrondFacade))
-	transaction.Routes(txRoutes)
+func registerRoutes(ws *gin.Engine, elrondFacade middleware.ElrondHandler, apiConfig config.ApiConfig) error {
+	groups := routeGroups
+	if apiConfig.JsonRpc.Enabled && apiConfig.JsonRpc.Port == "" {
+		groups = append(append(make([]routeGroup, 0, len(routeGroups)+1), routeGroups...), rpcRouteGroup)
+	}
 
-	vmValuesRoutes := ws.Group("/vm-values")
-	vmValuesRoutes.Use(middleware.WithElrondFacade(elrondFacade))
-	vmValues.Routes(vmValuesRoutes)
+	registeredGroups, err := registerRouteGroups(ws, elrondFacade, apiConfig, groups)
+	if err != nil {
+		return err
+	}
 
+	nodeRoutes := registeredGroups["node"]
 	apiHandler, ok := elrondFacade.(MainApiHandler)
-	if ok && apiHandler.PrometheusMonitoring() {
+	if ok && apiHandler.PrometheusMonitoring() && nodeRoutes != nil {
 		nodeRoutes.GET("/metrics", gin.WrapH(promhttp.Handler()))
 	}
 
 	if apiHandler.PprofEnabled() {
 		pprof.Register(ws)
 	}
+
+	return nil
+}
+
+// registerRouteGroups adds the authentication and rate limit middlewares to the engine and registers the groups
+// which are not disabled, returning them by name
+func registerRouteGroups(
+	ws *gin.Engine,
+	elrondFacade middleware.ElrondHandler,
+	apiConfig config.ApiConfig,
+	groups []routeGroup,
+) (map[string]*gin.RouterGroup, error) {
+	authenticator, err := middleware.NewAuthenticator(apiConfig.Auth)
+	if err != nil {
+		return nil, err
+	}
+
+	groupNames := make([]string, 0, len(routeGroups)+1)
+	for _, group := range routeGroups {
+		groupNames = append(groupNames, group.name)
+	}
+	groupNames = append(groupNames, rpcRouteGroup.name)
+	routeAccess, err := middleware.NewRouteAccess(apiConfig.RouteGroups, groupNames)
+	if err != nil {
+		return nil, err
+	}
+
+	rateLimiter, err := middleware.NewRateLimiter(apiConfig.RateLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	ws.Use(middleware.WithAuthentication(authenticator))
+	ws.Use(middleware.WithRateLimit(rateLimiter))
+
+	registeredGroups := make(map[string]*gin.RouterGroup)
+	for _, group := range groups {
+		access := routeAccess.Access(group.name)
+		if access == middleware.AccessDisabled {
+			continue
+		}
+
+		groupRoutes := group.routes
+		if access == middleware.AccessReadOnly && group.readOnlyRoutes != nil {
+			groupRoutes = group.readOnlyRoutes
+			access = middleware.AccessEnabled
+		}
+
+		routes := ws.Group("/" + group.name)
+		routes.Use(middleware.WithRouteAccess(access, apiConfig.Auth.Required))
+		routes.Use(middleware.WithElrondFacade(elrondFacade))
+		groupRoutes(routes)
+
+		registeredGroups[group.name] = routes
+	}
+
+	return registeredGroups, nil
 }
 
 func registerValidators() error {
@@ -0,0 +1,485 @@
+package block
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/gin-gonic/gin"
+)
+
+// BlockService interface defines methods that can be used from `elrondFacade` context variable
+type BlockService interface {
+	GetBlockByNonce(shardId uint32, nonce uint64) (*block.Header, []byte, error)
+	GetBlockByHash(hash []byte) (*block.Header, error)
+	GetMetaBlockByNonce(nonce uint64) (*block.MetaBlock, []byte, error)
+	GetMetaBlockByHash(hash []byte) (*block.MetaBlock, error)
+	GetMiniBlock(hash []byte) (*block.MiniBlock, error)
+	GetMiniBlockTransactions(miniBlock *block.MiniBlock) (map[string]data.TransactionHandler, error)
+	EncodeAddress(address []byte) (string, error)
+	NumberOfShards() uint32
+	IsInterfaceNil() bool
+}
+
+// metachainShardIdParam is the value of the shardId query parameter which selects the meta blocks
+const metachainShardIdParam = "metachain"
+
+// TxResponse represents the structure of a transaction included in a miniblock response
+type TxResponse struct {
+	Hash     string   `json:"hash"`
+	Nonce    uint64   `json:"nonce"`
+	Sender   string   `json:"sender"`
+	Receiver string   `json:"receiver"`
+	Value    *big.Int `json:"value"`
+	Data     string   `json:"data"`
+	GasPrice uint64   `json:"gasPrice"`
+	GasLimit uint64   `json:"gasLimit"`
+}
+
+// MiniBlockResponse represents the structure of a miniblock header included in a block response
+type MiniBlockResponse struct {
+	Hash            string        `json:"hash"`
+	SenderShardID   uint32        `json:"senderShardId"`
+	ReceiverShardID uint32        `json:"receiverShardId"`
+	Type            string        `json:"type"`
+	TxCount         uint32        `json:"txCount"`
+	TxHashes        []string      `json:"txHashes"`
+	Transactions    []*TxResponse `json:"transactions,omitempty"`
+}
+
+// BlockResponse represents the structure on which the response for a shard block will be validated against
+type BlockResponse struct {
+	Hash            string               `json:"hash"`
+	Nonce           uint64               `json:"nonce"`
+	Round           uint64               `json:"round"`
+	Epoch           uint32               `json:"epoch"`
+	ShardID         uint32               `json:"shardId"`
+	TimeStamp       uint64               `json:"timestamp"`
+	PrevHash        string               `json:"prevHash"`
+	PrevRandSeed    string               `json:"prevRandSeed"`
+	RandSeed        string               `json:"randSeed"`
+	PubKeysBitmap   string               `json:"pubKeysBitmap"`
+	Signature       string               `json:"signature"`
+	RootHash        string               `json:"rootHash"`
+	BlockBodyType   string               `json:"blockBodyType"`
+	TxCount         uint32               `json:"txCount"`
+	MetaBlockHashes []string             `json:"metaBlockHashes"`
+	MiniBlocks      []*MiniBlockResponse `json:"miniBlocks"`
+}
+
+// ShardDataResponse represents the structure of the shard data notarized by a meta block
+type ShardDataResponse struct {
+	ShardID    uint32               `json:"shardId"`
+	HeaderHash string               `json:"headerHash"`
+	TxCount    uint32               `json:"txCount"`
+	MiniBlocks []*MiniBlockResponse `json:"miniBlocks"`
+}
+
+// MetaBlockResponse represents the structure on which the response for a meta block will be validated against
+type MetaBlockResponse struct {
+	Hash          string               `json:"hash"`
+	Nonce         uint64               `json:"nonce"`
+	Round         uint64               `json:"round"`
+	Epoch         uint32               `json:"epoch"`
+	TimeStamp     uint64               `json:"timestamp"`
+	PrevHash      string               `json:"prevHash"`
+	PrevRandSeed  string               `json:"prevRandSeed"`
+	RandSeed      string               `json:"randSeed"`
+	PubKeysBitmap string               `json:"pubKeysBitmap"`
+	Signature     string               `json:"signature"`
+	RootHash      string               `json:"rootHash"`
+	TxCount       uint32               `json:"txCount"`
+	ShardInfo     []*ShardDataResponse `json:"shardInfo"`
+}
+
+// Routes defines block related routes
+func Routes(router *gin.RouterGroup) {
+	router.GET("/by-nonce/:nonce", GetBlockByNonce)
+	router.GET("/by-hash/:hash", GetBlockByHash)
+}
+
+// MetaBlockRoutes defines meta block related routes
+func MetaBlockRoutes(router *gin.RouterGroup) {
+	router.GET("/by-nonce/:nonce", GetMetaBlockByNonce)
+	router.GET("/by-hash/:hash", GetMetaBlockByHash)
+}
+
+// GetBlockByNonce returns the block of a shard having the requested nonce. The shard defaults to 0 if the
+// shardId query parameter is not provided. The meta block is returned if the shardId is the metachain, given either
+// by name or by its shard id
+func GetBlockByNonce(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(BlockService)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	nonce, err := strconv.ParseUint(c.Param("nonce"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidBlockNonce.Error())})
+		return
+	}
+
+	shardId, isMetachain, ok := getShardId(c, ef)
+	if !ok {
+		return
+	}
+
+	withTxs, ok := getWithTxs(c)
+	if !ok {
+		return
+	}
+
+	if isMetachain {
+		metaBlock, hash, err := ef.GetMetaBlockByNonce(nonce)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetBlock.Error(), err.Error())})
+			return
+		}
+
+		respondWithMetaBlock(c, ef, metaBlock, hash, withTxs)
+		return
+	}
+
+	header, hash, err := ef.GetBlockByNonce(shardId, nonce)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetBlock.Error(), err.Error())})
+		return
+	}
+
+	respondWithBlock(c, ef, header, hash, withTxs)
+}
+
+// GetBlockByHash returns the block having the requested hash
+func GetBlockByHash(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(BlockService)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	hash, ok := getHash(c)
+	if !ok {
+		return
+	}
+
+	withTxs, ok := getWithTxs(c)
+	if !ok {
+		return
+	}
+
+	header, err := ef.GetBlockByHash(hash)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetBlock.Error(), err.Error())})
+		return
+	}
+
+	respondWithBlock(c, ef, header, hash, withTxs)
+}
+
+// GetMetaBlockByNonce returns the meta block having the requested nonce
+func GetMetaBlockByNonce(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(BlockService)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	nonce, err := strconv.ParseUint(c.Param("nonce"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidBlockNonce.Error())})
+		return
+	}
+
+	withTxs, ok := getWithTxs(c)
+	if !ok {
+		return
+	}
+
+	metaBlock, hash, err := ef.GetMetaBlockByNonce(nonce)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetBlock.Error(), err.Error())})
+		return
+	}
+
+	respondWithMetaBlock(c, ef, metaBlock, hash, withTxs)
+}
+
+// GetMetaBlockByHash returns the meta block having the requested hash
+func GetMetaBlockByHash(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(BlockService)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	hash, ok := getHash(c)
+	if !ok {
+		return
+	}
+
+	withTxs, ok := getWithTxs(c)
+	if !ok {
+		return
+	}
+
+	metaBlock, err := ef.GetMetaBlockByHash(hash)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetBlock.Error(), err.Error())})
+		return
+	}
+
+	respondWithMetaBlock(c, ef, metaBlock, hash, withTxs)
+}
+
+// getShardId reads the shardId query parameter, which has to be either one of the network's shards or the metachain
+func getShardId(c *gin.Context, ef BlockService) (uint32, bool, bool) {
+	shardIdParam := c.DefaultQuery("shardId", "0")
+	if shardIdParam == metachainShardIdParam {
+		return sharding.MetachainShardId, true, true
+	}
+
+	shardId, err := strconv.ParseUint(shardIdParam, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidShardId.Error())})
+		return 0, false, false
+	}
+	if uint32(shardId) == sharding.MetachainShardId {
+		return sharding.MetachainShardId, true, true
+	}
+	if uint32(shardId) >= ef.NumberOfShards() {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidShardId.Error())})
+		return 0, false, false
+	}
+
+	return uint32(shardId), false, true
+}
+
+func getHash(c *gin.Context) ([]byte, bool) {
+	hash, err := hex.DecodeString(c.Param("hash"))
+	if err != nil || len(hash) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidBlockHashHex.Error())})
+		return nil, false
+	}
+
+	return hash, true
+}
+
+func getWithTxs(c *gin.Context) (bool, bool) {
+	withTxs, err := strconv.ParseBool(c.DefaultQuery("withTxs", "false"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error())})
+		return false, false
+	}
+
+	return withTxs, true
+}
+
+func respondWithBlock(c *gin.Context, ef BlockService, header *block.Header, hash []byte, withTxs bool) {
+	if header == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": errors.ErrBlockNotFound.Error()})
+		return
+	}
+
+	response, err := blockResponseFromHeader(ef, header, hash, withTxs)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetBlock.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"block": response})
+}
+
+func respondWithMetaBlock(c *gin.Context, ef BlockService, metaBlock *block.MetaBlock, hash []byte, withTxs bool) {
+	if metaBlock == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": errors.ErrBlockNotFound.Error()})
+		return
+	}
+
+	response, err := metaBlockResponseFromMetaBlock(ef, metaBlock, hash, withTxs)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetBlock.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"metaBlock": response})
+}
+
+func blockResponseFromHeader(ef BlockService, header *block.Header, hash []byte, withTxs bool) (*BlockResponse, error) {
+	response := &BlockResponse{
+		Hash:            hex.EncodeToString(hash),
+		Nonce:           header.Nonce,
+		Round:           header.Round,
+		Epoch:           header.Epoch,
+		ShardID:         header.ShardId,
+		TimeStamp:       header.TimeStamp,
+		PrevHash:        hex.EncodeToString(header.PrevHash),
+		PrevRandSeed:    hex.EncodeToString(header.PrevRandSeed),
+		RandSeed:        hex.EncodeToString(header.RandSeed),
+		PubKeysBitmap:   hex.EncodeToString(header.PubKeysBitmap),
+		Signature:       hex.EncodeToString(header.Signature),
+		RootHash:        hex.EncodeToString(header.RootHash),
+		BlockBodyType:   header.BlockBodyType.String(),
+		TxCount:         header.TxCount,
+		MetaBlockHashes: make([]string, 0, len(header.MetaBlockHashes)),
+		MiniBlocks:      make([]*MiniBlockResponse, 0, len(header.MiniBlockHeaders)),
+	}
+
+	for _, metaBlockHash := range header.MetaBlockHashes {
+		response.MetaBlockHashes = append(response.MetaBlockHashes, hex.EncodeToString(metaBlockHash))
+	}
+
+	for _, mbHeader := range header.MiniBlockHeaders {
+		miniBlock := &MiniBlockResponse{
+			Hash:            hex.EncodeToString(mbHeader.Hash),
+			SenderShardID:   mbHeader.SenderShardID,
+			ReceiverShardID: mbHeader.ReceiverShardID,
+			Type:            mbHeader.Type.String(),
+			TxCount:         mbHeader.TxCount,
+		}
+
+		err := fillMiniBlockResponse(ef, miniBlock, mbHeader.Hash, withTxs)
+		if err != nil {
+			return nil, err
+		}
+
+		response.MiniBlocks = append(response.MiniBlocks, miniBlock)
+	}
+
+	return response, nil
+}
+
+func metaBlockResponseFromMetaBlock(
+	ef BlockService,
+	metaBlock *block.MetaBlock,
+	hash []byte,
+	withTxs bool,
+) (*MetaBlockResponse, error) {
+	response := &MetaBlockResponse{
+		Hash:          hex.EncodeToString(hash),
+		Nonce:         metaBlock.Nonce,
+		Round:         metaBlock.Round,
+		Epoch:         metaBlock.Epoch,
+		TimeStamp:     metaBlock.TimeStamp,
+		PrevHash:      hex.EncodeToString(metaBlock.PrevHash),
+		PrevRandSeed:  hex.EncodeToString(metaBlock.PrevRandSeed),
+		RandSeed:      hex.EncodeToString(metaBlock.RandSeed),
+		PubKeysBitmap: hex.EncodeToString(metaBlock.PubKeysBitmap),
+		Signature:     hex.EncodeToString(metaBlock.Signature),
+		RootHash:      hex.EncodeToString(metaBlock.RootHash),
+		TxCount:       metaBlock.TxCount,
+		ShardInfo:     make([]*ShardDataResponse, 0, len(metaBlock.ShardInfo)),
+	}
+
+	for _, shardData := range metaBlock.ShardInfo {
+		shardDataResponse := &ShardDataResponse{
+			ShardID:    shardData.ShardId,
+			HeaderHash: hex.EncodeToString(shardData.HeaderHash),
+			TxCount:    shardData.TxCount,
+			MiniBlocks: make([]*MiniBlockResponse, 0, len(shardData.ShardMiniBlockHeaders)),
+		}
+
+		for _, mbHeader := range shardData.ShardMiniBlockHeaders {
+			miniBlock := &MiniBlockResponse{
+				Hash:            hex.EncodeToString(mbHeader.Hash),
+				SenderShardID:   mbHeader.SenderShardId,
+				ReceiverShardID: mbHeader.ReceiverShardId,
+				TxCount:         mbHeader.TxCount,
+			}
+
+			err := fillMiniBlockResponse(ef, miniBlock, mbHeader.Hash, withTxs)
+			if err != nil {
+				return nil, err
+			}
+
+			shardDataResponse.MiniBlocks = append(shardDataResponse.MiniBlocks, miniBlock)
+		}
+
+		response.ShardInfo = append(response.ShardInfo, shardDataResponse)
+	}
+
+	return response, nil
+}
+
+// fillMiniBlockResponse adds the tx hashes and, if requested, the transactions of a miniblock found in the node's
+// storage. Miniblocks which were not stored by this node are left with an empty list of tx hashes
+func fillMiniBlockResponse(ef BlockService, response *MiniBlockResponse, hash []byte, withTxs bool) error {
+	response.TxHashes = make([]string, 0)
+
+	miniBlock, err := ef.GetMiniBlock(hash)
+	if err != nil {
+		return err
+	}
+	if miniBlock == nil {
+		return nil
+	}
+
+	response.Type = miniBlock.Type.String()
+	for _, txHash := range miniBlock.TxHashes {
+		response.TxHashes = append(response.TxHashes, hex.EncodeToString(txHash))
+	}
+
+	if !withTxs {
+		return nil
+	}
+
+	txs, err := ef.GetMiniBlockTransactions(miniBlock)
+	if err != nil {
+		return err
+	}
+
+	response.Transactions = make([]*TxResponse, 0, len(txs))
+	for _, txHash := range miniBlock.TxHashes {
+		tx, ok := txs[string(txHash)]
+		if !ok {
+			continue
+		}
+
+		txResponse, err := txResponseFromTransaction(ef, txHash, tx)
+		if err != nil {
+			return err
+		}
+
+		response.Transactions = append(response.Transactions, txResponse)
+	}
+
+	return nil
+}
+
+func txResponseFromTransaction(ef BlockService, hash []byte, tx data.TransactionHandler) (*TxResponse, error) {
+	sender, err := encodeAddress(ef, tx.GetSndAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	receiver, err := encodeAddress(ef, tx.GetRecvAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	return &TxResponse{
+		Hash:     hex.EncodeToString(hash),
+		Nonce:    tx.GetNonce(),
+		Sender:   sender,
+		Receiver: receiver,
+		Value:    tx.GetValue(),
+		Data:     tx.GetData(),
+		GasPrice: tx.GetGasPrice(),
+		GasLimit: tx.GetGasLimit(),
+	}, nil
+}
+
+// encodeAddress leaves empty the missing addresses, as the senders of the reward transactions
+func encodeAddress(ef BlockService, address []byte) (string, error) {
+	if len(address) == 0 {
+		return "", nil
+	}
+
+	return ef.EncodeAddress(address)
+}
@@ -0,0 +1,347 @@
+package block_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/block"
+	errors2 "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/data"
+	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type GeneralResponse struct {
+	Error string `json:"error"`
+}
+
+type BlockResponse struct {
+	GeneralResponse
+	Block *block.BlockResponse `json:"block,omitempty"`
+}
+
+type MetaBlockResponse struct {
+	GeneralResponse
+	MetaBlock *block.MetaBlockResponse `json:"metaBlock,omitempty"`
+}
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func createHeader() *dataBlock.Header {
+	return &dataBlock.Header{
+		Nonce:    7,
+		Round:    8,
+		ShardId:  1,
+		PrevHash: []byte("prev hash"),
+		TxCount:  1,
+		MiniBlockHeaders: []dataBlock.MiniBlockHeader{
+			{Hash: []byte("mb hash"), SenderShardID: 1, ReceiverShardID: 0, TxCount: 1, Type: dataBlock.TxBlock},
+		},
+	}
+}
+
+func numberOfShards() uint32 {
+	return 2
+}
+
+func createFacadeWithMiniBlock() *mock.Facade {
+	return &mock.Facade{
+		NumberOfShardsHandler: numberOfShards,
+		GetMiniBlockHandler: func(hash []byte) (*dataBlock.MiniBlock, error) {
+			return &dataBlock.MiniBlock{TxHashes: [][]byte{[]byte("tx hash")}, Type: dataBlock.TxBlock}, nil
+		},
+		GetMiniBlockTransactionsHandler: func(miniBlock *dataBlock.MiniBlock) (map[string]data.TransactionHandler, error) {
+			return map[string]data.TransactionHandler{
+				"tx hash": &transaction.Transaction{
+					Nonce:   2,
+					Value:   big.NewInt(10),
+					SndAddr: []byte("sender"),
+					RcvAddr: []byte("receiver"),
+				},
+			}, nil
+		},
+	}
+}
+
+func TestGetBlockByNonce_ShouldReturnBlock(t *testing.T) {
+	t.Parallel()
+
+	header := createHeader()
+	hash := []byte("hash")
+	facade := createFacadeWithMiniBlock()
+	facade.GetBlockByNonceHandler = func(shardId uint32, nonce uint64) (*dataBlock.Header, []byte, error) {
+		if shardId == header.ShardId && nonce == header.Nonce {
+			return header, hash, nil
+		}
+		return nil, nil, nil
+	}
+
+	req, _ := http.NewRequest("GET", "/block/by-nonce/7?shardId=1", nil)
+	ws := startNodeServer(facade)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := BlockResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, hex.EncodeToString(hash), response.Block.Hash)
+	assert.Equal(t, header.Nonce, response.Block.Nonce)
+	assert.Equal(t, hex.EncodeToString(header.PrevHash), response.Block.PrevHash)
+	assert.Equal(t, 1, len(response.Block.MiniBlocks))
+	assert.Equal(t, []string{hex.EncodeToString([]byte("tx hash"))}, response.Block.MiniBlocks[0].TxHashes)
+	assert.Nil(t, response.Block.MiniBlocks[0].Transactions)
+}
+
+func TestGetBlockByNonce_WithTxsShouldReturnTransactions(t *testing.T) {
+	t.Parallel()
+
+	facade := createFacadeWithMiniBlock()
+	facade.GetBlockByNonceHandler = func(shardId uint32, nonce uint64) (*dataBlock.Header, []byte, error) {
+		return createHeader(), []byte("hash"), nil
+	}
+
+	req, _ := http.NewRequest("GET", "/block/by-nonce/7?withTxs=true", nil)
+	ws := startNodeServer(facade)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := BlockResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	txs := response.Block.MiniBlocks[0].Transactions
+	assert.Equal(t, 1, len(txs))
+	assert.Equal(t, hex.EncodeToString([]byte("tx hash")), txs[0].Hash)
+	assert.Equal(t, hex.EncodeToString([]byte("sender")), txs[0].Sender)
+	assert.Equal(t, hex.EncodeToString([]byte("receiver")), txs[0].Receiver)
+	assert.Equal(t, big.NewInt(10), txs[0].Value)
+}
+
+func TestGetBlockByNonce_InvalidNonceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	req, _ := http.NewRequest("GET", "/block/by-nonce/abc", nil)
+	ws := startNodeServer(&mock.Facade{})
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := BlockResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, errors2.ErrInvalidBlockNonce.Error())
+}
+
+func TestGetBlockByNonce_UnknownShardShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		NumberOfShardsHandler: numberOfShards,
+		GetBlockByNonceHandler: func(shardId uint32, nonce uint64) (*dataBlock.Header, []byte, error) {
+			assert.Fail(t, "should not have asked for a block of an unknown shard")
+			return nil, nil, nil
+		},
+	}
+
+	req, _ := http.NewRequest("GET", "/block/by-nonce/7?shardId=2", nil)
+	ws := startNodeServer(&facade)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := BlockResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, errors2.ErrInvalidShardId.Error())
+}
+
+func TestGetBlockByNonce_MetachainShouldReturnMetaBlock(t *testing.T) {
+	t.Parallel()
+
+	hash := []byte("meta hash")
+	facade := mock.Facade{
+		NumberOfShardsHandler: numberOfShards,
+		GetMetaBlockByNonceHandler: func(nonce uint64) (*dataBlock.MetaBlock, []byte, error) {
+			return &dataBlock.MetaBlock{Nonce: nonce}, hash, nil
+		},
+	}
+
+	for _, shardIdParam := range []string{"metachain", fmt.Sprintf("%d", sharding.MetachainShardId)} {
+		req, _ := http.NewRequest("GET", "/block/by-nonce/7?shardId="+shardIdParam, nil)
+		ws := startNodeServer(&facade)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := MetaBlockResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, hex.EncodeToString(hash), response.MetaBlock.Hash)
+		assert.Equal(t, uint64(7), response.MetaBlock.Nonce)
+	}
+}
+
+func TestGetBlockByNonce_NotFoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		NumberOfShardsHandler: numberOfShards,
+		GetBlockByNonceHandler: func(shardId uint32, nonce uint64) (*dataBlock.Header, []byte, error) {
+			return nil, nil, nil
+		},
+	}
+
+	req, _ := http.NewRequest("GET", "/block/by-nonce/7", nil)
+	ws := startNodeServer(&facade)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := BlockResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	assert.Equal(t, errors2.ErrBlockNotFound.Error(), response.Error)
+}
+
+func TestGetBlockByHash_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("expected error")
+	facade := mock.Facade{
+		GetBlockByHashHandler: func(hash []byte) (*dataBlock.Header, error) {
+			return nil, errExpected
+		},
+	}
+
+	req, _ := http.NewRequest("GET", "/block/by-hash/"+hex.EncodeToString([]byte("hash")), nil)
+	ws := startNodeServer(&facade)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := BlockResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, errExpected.Error())
+}
+
+func TestGetBlockByHash_InvalidHexShouldErr(t *testing.T) {
+	t.Parallel()
+
+	req, _ := http.NewRequest("GET", "/block/by-hash/zz", nil)
+	ws := startNodeServer(&mock.Facade{})
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := BlockResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, errors2.ErrInvalidBlockHashHex.Error())
+}
+
+func TestGetMetaBlockByHash_ShouldReturnNotarizedShardData(t *testing.T) {
+	t.Parallel()
+
+	hash := []byte("meta hash")
+	facade := mock.Facade{
+		GetMetaBlockByHashHandler: func(h []byte) (*dataBlock.MetaBlock, error) {
+			return &dataBlock.MetaBlock{
+				Nonce: 3,
+				ShardInfo: []dataBlock.ShardData{
+					{
+						ShardId:    1,
+						HeaderHash: []byte("hdr hash"),
+						TxCount:    4,
+						ShardMiniBlockHeaders: []dataBlock.ShardMiniBlockHeader{
+							{Hash: []byte("mb hash"), SenderShardId: 1, ReceiverShardId: 0, TxCount: 4},
+						},
+					},
+				},
+			}, nil
+		},
+	}
+
+	req, _ := http.NewRequest("GET", "/metablock/by-hash/"+hex.EncodeToString(hash), nil)
+	ws := startNodeServer(&facade)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := MetaBlockResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, hex.EncodeToString(hash), response.MetaBlock.Hash)
+	assert.Equal(t, 1, len(response.MetaBlock.ShardInfo))
+	shardData := response.MetaBlock.ShardInfo[0]
+	assert.Equal(t, hex.EncodeToString([]byte("hdr hash")), shardData.HeaderHash)
+	assert.Equal(t, 1, len(shardData.MiniBlocks))
+	assert.Equal(t, uint32(4), shardData.MiniBlocks[0].TxCount)
+	assert.Equal(t, 0, len(shardData.MiniBlocks[0].TxHashes))
+}
+
+func TestGetMetaBlockByNonce_WrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+	req, _ := http.NewRequest("GET", "/metablock/by-nonce/3", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := MetaBlockResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, errors2.ErrInvalidAppContext.Error(), response.Error)
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	if err != nil {
+		fmt.Println(err)
+	}
+}
+
+func startNodeServer(handler block.BlockService) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	blockRoute := ws.Group("/block")
+	metaBlockRoute := ws.Group("/metablock")
+	if handler != nil {
+		blockRoute.Use(middleware.WithElrondFacade(handler))
+		metaBlockRoute.Use(middleware.WithElrondFacade(handler))
+	}
+	block.Routes(blockRoute)
+	block.MetaBlockRoutes(metaBlockRoute)
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("elrondFacade", mock.WrongFacade{})
+	})
+	blockRoute := ws.Group("/block")
+	metaBlockRoute := ws.Group("/metablock")
+	block.Routes(blockRoute)
+	block.MetaBlockRoutes(metaBlockRoute)
+	return ws
+}
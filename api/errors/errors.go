@@ -51,3 +51,78 @@ var ErrGetTransaction = errors.New("transaction getting failed")
 
 // ErrTxNotFound signals an error happened trying to fetch a transaction
 var ErrTxNotFound = errors.New("transaction was not found")
+
+// ErrInvalidBlockNonce signals an invalid block nonce was provided
+var ErrInvalidBlockNonce = errors.New("invalid block nonce")
+
+// ErrInvalidShardId signals an invalid shard id was provided
+var ErrInvalidShardId = errors.New("invalid shard id")
+
+// ErrInvalidBlockHashHex signals a wrong hex value was provided for the block hash
+var ErrInvalidBlockHashHex = errors.New("invalid block hash, could not decode hex value")
+
+// ErrGetBlock signals an error happened trying to fetch a block
+var ErrGetBlock = errors.New("block getting failed")
+
+// ErrBlockNotFound signals that the requested block was not found
+var ErrBlockNotFound = errors.New("block was not found")
+
+// ErrSubscribe signals an error happened trying to subscribe to the node's events
+var ErrSubscribe = errors.New("could not subscribe to the node's events")
+
+// ErrUnknownSubscriptionAction signals that a subscription request had an unknown action
+var ErrUnknownSubscriptionAction = errors.New("unknown subscription action")
+
+// ErrInvalidTransactionHistoryCursor signals an invalid cursor was provided for the transaction history
+var ErrInvalidTransactionHistoryCursor = errors.New("invalid transaction history cursor")
+
+// ErrInvalidTransactionHistoryLimit signals an invalid number of records was requested from the transaction history
+var ErrInvalidTransactionHistoryLimit = errors.New("invalid transaction history limit")
+
+// ErrGetTransactionHistory signals an error happened trying to fetch the transaction history of an address
+var ErrGetTransactionHistory = errors.New("transaction history getting failed")
+
+// ErrInvalidStorageKeyHex signals a wrong hex value was provided for a key of an account's storage
+var ErrInvalidStorageKeyHex = errors.New("invalid storage key, could not decode hex value")
+
+// ErrInvalidAccountStorageLimit signals an invalid number of key/value pairs was requested from an account's storage
+var ErrInvalidAccountStorageLimit = errors.New("invalid account storage limit")
+
+// ErrGetAccountStorage signals an error happened trying to read the storage of an account
+var ErrGetAccountStorage = errors.New("account storage getting failed")
+
+// ErrInvalidRootHashHex signals a missing or wrong hex value was provided for a root hash
+var ErrInvalidRootHashHex = errors.New("invalid root hash, could not decode hex value")
+
+// ErrGetAccountProof signals an error happened trying to get the proof of an account
+var ErrGetAccountProof = errors.New("account proof getting failed")
+
+// ErrEmptyApiKey signals that an API key with an empty value or name was configured
+var ErrEmptyApiKey = errors.New("empty API key or API key name")
+
+// ErrDuplicatedApiKey signals that the same API key was configured more than once
+var ErrDuplicatedApiKey = errors.New("duplicated API key")
+
+// ErrInvalidCredentials signals that the provided API key or JWT was not accepted
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+// ErrMissingCredentials signals that a route requiring authentication was called without credentials
+var ErrMissingCredentials = errors.New("missing credentials")
+
+// ErrAdminOnlyRoute signals that a route restricted to administrators was called without administrator rights
+var ErrAdminOnlyRoute = errors.New("route restricted to administrators")
+
+// ErrReadOnlyRoute signals that a read-only route was called with a method other than GET or HEAD
+var ErrReadOnlyRoute = errors.New("read-only route")
+
+// ErrTooManyRequests signals that the client went over its rate limit
+var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrInvalidRouteAccess signals that an unknown access level was configured for a route group
+var ErrInvalidRouteAccess = errors.New("invalid route access level")
+
+// ErrUnknownRouteGroup signals that an access level was configured for a route group that does not exist
+var ErrUnknownRouteGroup = errors.New("unknown route group")
+
+// ErrInvalidTrustedProxy signals that a trusted proxy of the rate limit config is neither an IP address nor a CIDR range
+var ErrInvalidTrustedProxy = errors.New("invalid trusted proxy")
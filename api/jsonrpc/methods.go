@@ -0,0 +1,304 @@
+package jsonrpc
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+)
+
+// method describes a JSON-RPC method. The parameter names give the order of the positional parameters
+type method struct {
+	paramNames   []string
+	changesState bool
+	newParams    func() interface{}
+	execute      func(ef FacadeHandler, params interface{}) (interface{}, error)
+}
+
+type addressParams struct {
+	Address string `json:"address"`
+}
+
+type storageValueParams struct {
+	Address string `json:"address"`
+	Key     string `json:"key"`
+}
+
+type proofParams struct {
+	Address  string `json:"address"`
+	RootHash string `json:"rootHash"`
+}
+
+type transactionParams struct {
+	Nonce     uint64 `json:"nonce"`
+	Sender    string `json:"sender"`
+	Receiver  string `json:"receiver"`
+	Value     string `json:"value"`
+	GasPrice  uint64 `json:"gasPrice"`
+	GasLimit  uint64 `json:"gasLimit"`
+	Data      string `json:"data"`
+	Signature string `json:"signature"`
+}
+
+type vmQueryParams struct {
+	ScAddress string   `json:"scAddress"`
+	FuncName  string   `json:"funcName"`
+	Args      []string `json:"args"`
+}
+
+type noParams struct {
+}
+
+type accountResult struct {
+	Address  string `json:"address"`
+	Nonce    uint64 `json:"nonce"`
+	Balance  string `json:"balance"`
+	CodeHash string `json:"codeHash"`
+	RootHash string `json:"rootHash"`
+}
+
+type txHashResult struct {
+	TxHash string `json:"txHash"`
+}
+
+var transactionParamNames = []string{"nonce", "sender", "receiver", "value", "gasPrice", "gasLimit", "data", "signature"}
+var vmQueryParamNames = []string{"scAddress", "funcName", "args"}
+
+// methods holds the JSON-RPC methods, namespaced by the area of the node they query
+var methods = map[string]*method{
+	"account_getAccount": {
+		paramNames: []string{"address"},
+		newParams:  func() interface{} { return &addressParams{} },
+		execute:    getAccount,
+	},
+	"account_getBalance": {
+		paramNames: []string{"address"},
+		newParams:  func() interface{} { return &addressParams{} },
+		execute:    getBalance,
+	},
+	"account_getStorageValue": {
+		paramNames: []string{"address", "key"},
+		newParams:  func() interface{} { return &storageValueParams{} },
+		execute:    getStorageValue,
+	},
+	"account_getProof": {
+		paramNames: []string{"address", "rootHash"},
+		newParams:  func() interface{} { return &proofParams{} },
+		execute:    getProof,
+	},
+	"tx_send": {
+		paramNames:   transactionParamNames,
+		changesState: true,
+		newParams:    func() interface{} { return &transactionParams{} },
+		execute:      sendTransaction,
+	},
+	"tx_simulate": {
+		paramNames: transactionParamNames,
+		newParams:  func() interface{} { return &transactionParams{} },
+		execute:    simulateTransaction,
+	},
+	"vm_getValueHex": {
+		paramNames: vmQueryParamNames,
+		newParams:  func() interface{} { return &vmQueryParams{} },
+		execute: func(ef FacadeHandler, params interface{}) (interface{}, error) {
+			data, err := getVmValue(ef, params.(*vmQueryParams))
+			return hex.EncodeToString(data), err
+		},
+	},
+	"vm_getValueString": {
+		paramNames: vmQueryParamNames,
+		newParams:  func() interface{} { return &vmQueryParams{} },
+		execute: func(ef FacadeHandler, params interface{}) (interface{}, error) {
+			data, err := getVmValue(ef, params.(*vmQueryParams))
+			return string(data), err
+		},
+	},
+	"vm_getValueInt": {
+		paramNames: vmQueryParamNames,
+		newParams:  func() interface{} { return &vmQueryParams{} },
+		execute: func(ef FacadeHandler, params interface{}) (interface{}, error) {
+			data, err := getVmValue(ef, params.(*vmQueryParams))
+			return big.NewInt(0).SetBytes(data).String(), err
+		},
+	},
+	"node_status": {
+		newParams: func() interface{} { return &noParams{} },
+		execute: func(ef FacadeHandler, _ interface{}) (interface{}, error) {
+			return ef.StatusMetrics().StatusMetricsMap()
+		},
+	},
+	"node_heartbeats": {
+		newParams: func() interface{} { return &noParams{} },
+		execute: func(ef FacadeHandler, _ interface{}) (interface{}, error) {
+			return ef.GetHeartbeats()
+		},
+	},
+}
+
+// decodeParams fills the parameters of a method from a by-name object or from a positional array
+func (m *method) decodeParams(rawParams json.RawMessage) (interface{}, *Error) {
+	params := m.newParams()
+	rawParams = bytes.TrimSpace(rawParams)
+	if len(rawParams) == 0 || bytes.Equal(rawParams, []byte("null")) {
+		rawParams = []byte("{}")
+	}
+
+	if rawParams[0] == '[' {
+		positional := make([]json.RawMessage, 0)
+		err := json.Unmarshal(rawParams, &positional)
+		if err != nil {
+			return nil, newError(InvalidParamsCode, err.Error())
+		}
+		if len(positional) > len(m.paramNames) {
+			return nil, newError(InvalidParamsCode, "too many parameters")
+		}
+
+		byName := make(map[string]json.RawMessage)
+		for i, value := range positional {
+			byName[m.paramNames[i]] = value
+		}
+		rawParams, err = json.Marshal(byName)
+		if err != nil {
+			return nil, newError(InternalErrorCode, err.Error())
+		}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(rawParams))
+	decoder.DisallowUnknownFields()
+	err := decoder.Decode(params)
+	if err != nil {
+		return nil, newError(InvalidParamsCode, err.Error())
+	}
+
+	return params, nil
+}
+
+func getAccount(ef FacadeHandler, params interface{}) (interface{}, error) {
+	address := params.(*addressParams).Address
+	account, err := ef.GetAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	if account.AddressContainer() != nil {
+		address, err = ef.EncodeAddress(account.AddressContainer().Bytes())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &accountResult{
+		Address:  address,
+		Nonce:    account.Nonce,
+		Balance:  account.Balance.String(),
+		CodeHash: hex.EncodeToString(account.CodeHash),
+		RootHash: hex.EncodeToString(account.RootHash),
+	}, nil
+}
+
+func getBalance(ef FacadeHandler, params interface{}) (interface{}, error) {
+	balance, err := ef.GetBalance(params.(*addressParams).Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return balance.String(), nil
+}
+
+func getStorageValue(ef FacadeHandler, params interface{}) (interface{}, error) {
+	p := params.(*storageValueParams)
+	key, err := hex.DecodeString(p.Key)
+	if err != nil {
+		return nil, newError(InvalidParamsCode, "invalid hex key: "+err.Error())
+	}
+
+	value, err := ef.GetAccountStorageValue(p.Address, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return hex.EncodeToString(value), nil
+}
+
+func getProof(ef FacadeHandler, params interface{}) (interface{}, error) {
+	p := params.(*proofParams)
+	rootHash, err := hex.DecodeString(p.RootHash)
+	if err != nil || len(rootHash) == 0 {
+		return nil, newError(InvalidParamsCode, "invalid hex root hash")
+	}
+
+	proof, err := ef.GetAccountProof(p.Address, rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	encodedProof := make([]string, 0, len(proof))
+	for _, proofNode := range proof {
+		encodedProof = append(encodedProof, hex.EncodeToString(proofNode))
+	}
+
+	return encodedProof, nil
+}
+
+func sendTransaction(ef FacadeHandler, params interface{}) (interface{}, error) {
+	p := params.(*transactionParams)
+	value, signature, err := decodeTransactionParams(p)
+	if err != nil {
+		return nil, err
+	}
+
+	txHash, err := ef.SendTransaction(p.Nonce, p.Sender, p.Receiver, value, p.GasPrice, p.GasLimit, p.Data, signature)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txHashResult{TxHash: txHash}, nil
+}
+
+func simulateTransaction(ef FacadeHandler, params interface{}) (interface{}, error) {
+	p := params.(*transactionParams)
+	value, signature, err := decodeTransactionParams(p)
+	if err != nil {
+		return nil, err
+	}
+
+	txHash, err := ef.SimulateTransaction(p.Nonce, p.Sender, p.Receiver, value, p.GasPrice, p.GasLimit, p.Data, signature)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txHashResult{TxHash: txHash}, nil
+}
+
+// decodeTransactionParams returns the decimal value and the hex signature of a transaction
+func decodeTransactionParams(p *transactionParams) (*big.Int, []byte, error) {
+	value, ok := big.NewInt(0).SetString(p.Value, 10)
+	if !ok {
+		return nil, nil, newError(InvalidParamsCode, "invalid value, it must be a decimal string")
+	}
+
+	signature, err := hex.DecodeString(p.Signature)
+	if err != nil {
+		return nil, nil, newError(InvalidParamsCode, "invalid hex signature: "+err.Error())
+	}
+
+	return value, signature, nil
+}
+
+func getVmValue(ef FacadeHandler, p *vmQueryParams) ([]byte, error) {
+	argsBuff := make([][]byte, 0, len(p.Args))
+	for _, arg := range p.Args {
+		buff, err := hex.DecodeString(arg)
+		if err != nil {
+			return nil, newError(InvalidParamsCode, "invalid hex argument: "+err.Error())
+		}
+		argsBuff = append(argsBuff, buff)
+	}
+
+	scAddress, err := hex.DecodeString(p.ScAddress)
+	if err != nil {
+		return nil, newError(InvalidParamsCode, "invalid hex smart contract address: "+err.Error())
+	}
+
+	return ef.GetVmValue(string(scAddress), p.FuncName, argsBuff...)
+}
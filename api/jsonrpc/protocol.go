@@ -0,0 +1,61 @@
+package jsonrpc
+
+import (
+	"encoding/json"
+)
+
+// Version is the JSON-RPC version of the requests and responses
+const Version = "2.0"
+
+// ParseErrorCode signals that the request body is not valid JSON
+const ParseErrorCode = -32700
+
+// InvalidRequestCode signals that the JSON is not a valid JSON-RPC request
+const InvalidRequestCode = -32600
+
+// MethodNotFoundCode signals that the requested method does not exist
+const MethodNotFoundCode = -32601
+
+// InvalidParamsCode signals that the parameters of the method are not valid
+const InvalidParamsCode = -32602
+
+// InternalErrorCode signals an error of the JSON-RPC server itself
+const InternalErrorCode = -32603
+
+// ServerErrorCode signals that the node failed executing a valid request
+const ServerErrorCode = -32000
+
+// Request is a JSON-RPC request. A request without id is a notification, which gets no response
+type Request struct {
+	JsonRpc string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	ID      json.RawMessage `json:"id,omitempty"`
+}
+
+// Response is a JSON-RPC response, holding either the result or the error of a request
+type Response struct {
+	JsonRpc string          `json:"jsonrpc"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *Error          `json:"error,omitempty"`
+	ID      json.RawMessage `json:"id"`
+}
+
+// Error is the error object of a JSON-RPC response
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Error returns the message of the error
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func newError(code int, message string) *Error {
+	return &Error{Code: code, Message: message}
+}
+
+func newErrorResponse(id json.RawMessage, err *Error) *Response {
+	return &Response{JsonRpc: Version, Error: err, ID: id}
+}
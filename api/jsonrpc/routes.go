@@ -0,0 +1,196 @@
+package jsonrpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/heartbeat"
+	"github.com/gin-gonic/gin"
+)
+
+// maxBodySize is the maximum size, in bytes, of a request body
+const maxBodySize = 1 << 20
+
+// maxBatchSize is the maximum number of requests of a batch
+const maxBatchSize = 100
+
+// FacadeHandler interface defines methods that can be used from `elrondFacade` context variable
+type FacadeHandler interface {
+	GetAccount(address string) (*state.Account, error)
+	GetBalance(address string) (*big.Int, error)
+	EncodeAddress(address []byte) (string, error)
+	GetAccountStorageValue(address string, key []byte) ([]byte, error)
+	GetAccountProof(address string, rootHash []byte) ([][]byte, error)
+	SendTransaction(nonce uint64, sender string, receiver string, value *big.Int, gasPrice uint64, gasLimit uint64,
+		transactionData string, signature []byte) (string, error)
+	SimulateTransaction(nonce uint64, sender string, receiver string, value *big.Int, gasPrice uint64, gasLimit uint64,
+		transactionData string, signature []byte) (string, error)
+	GetVmValue(address string, funcName string, argsBuff ...[]byte) ([]byte, error)
+	StatusMetrics() external.StatusMetricsHandler
+	GetHeartbeats() ([]heartbeat.PubKeyHeartbeat, error)
+	IsInterfaceNil() bool
+}
+
+// Routes defines the JSON-RPC endpoint, serving all methods
+func Routes(router *gin.RouterGroup) {
+	router.POST("", func(c *gin.Context) {
+		serve(c, false)
+	})
+}
+
+// ReadOnlyRoutes defines the JSON-RPC endpoint, rejecting the methods that change the state of the network
+func ReadOnlyRoutes(router *gin.RouterGroup) {
+	router.POST("", func(c *gin.Context) {
+		serve(c, true)
+	})
+}
+
+// serve answers a single request or a batch. Notifications are executed but not answered, so a request made only
+// of notifications gets an empty response
+func serve(c *gin.Context, readOnly bool) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, newErrorResponse(nil, newError(InternalErrorCode, "invalid app context")))
+		return
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxBodySize))
+	if err != nil {
+		c.JSON(http.StatusOK, newErrorResponse(nil, newError(ParseErrorCode, err.Error())))
+		return
+	}
+
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 || body[0] != '[' {
+		response := handleRawRequest(ef, body, readOnly)
+		if response == nil {
+			c.Status(http.StatusNoContent)
+			return
+		}
+		c.JSON(http.StatusOK, response)
+		return
+	}
+
+	batch := make([]json.RawMessage, 0)
+	err = json.Unmarshal(body, &batch)
+	if err != nil {
+		c.JSON(http.StatusOK, newErrorResponse(nil, newError(ParseErrorCode, err.Error())))
+		return
+	}
+	if len(batch) == 0 {
+		c.JSON(http.StatusOK, newErrorResponse(nil, newError(InvalidRequestCode, "empty batch")))
+		return
+	}
+	if len(batch) > maxBatchSize {
+		c.JSON(http.StatusOK, newErrorResponse(nil, newError(InvalidRequestCode, "batch too large")))
+		return
+	}
+	// the rate limit middleware already counted the HTTP request, which stands for the first entry of the batch
+	if !middleware.ConsumeRequests(c, uint32(len(batch)-1)) {
+		return
+	}
+
+	responses := make([]*Response, 0, len(batch))
+	for _, rawRequest := range batch {
+		response := handleRawRequest(ef, rawRequest, readOnly)
+		if response != nil {
+			responses = append(responses, response)
+		}
+	}
+	if len(responses) == 0 {
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+// handleRawRequest executes a request and returns its response, or nil if the request is a notification
+func handleRawRequest(ef FacadeHandler, rawRequest []byte, readOnly bool) *Response {
+	if !json.Valid(rawRequest) {
+		return newErrorResponse(nil, newError(ParseErrorCode, "invalid JSON"))
+	}
+
+	request := &Request{}
+	err := json.Unmarshal(rawRequest, request)
+	if err != nil {
+		return newErrorResponse(nil, newError(InvalidRequestCode, err.Error()))
+	}
+	if request.JsonRpc != Version || request.Method == "" || !isValidID(request.ID) {
+		return newErrorResponse(validIDOrNil(request.ID), newError(InvalidRequestCode, "invalid request"))
+	}
+
+	result, rpcErr := execute(ef, request, readOnly)
+	if request.ID == nil {
+		return nil
+	}
+	if rpcErr != nil {
+		return newErrorResponse(request.ID, rpcErr)
+	}
+
+	return &Response{JsonRpc: Version, Result: result, ID: request.ID}
+}
+
+func execute(ef FacadeHandler, request *Request, readOnly bool) (json.RawMessage, *Error) {
+	m, ok := methods[request.Method]
+	if !ok {
+		return nil, newError(MethodNotFoundCode, "method not found")
+	}
+	if readOnly && m.changesState {
+		return nil, newError(MethodNotFoundCode, "method not available on a read-only endpoint")
+	}
+
+	params, rpcErr := m.decodeParams(request.Params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	result, err := m.execute(ef, params)
+	if err != nil {
+		rpcErr, ok = err.(*Error)
+		if ok {
+			return nil, rpcErr
+		}
+		return nil, newError(ServerErrorCode, err.Error())
+	}
+
+	buff, err := json.Marshal(result)
+	if err != nil {
+		return nil, newError(InternalErrorCode, err.Error())
+	}
+
+	return buff, nil
+}
+
+// isValidID accepts the ids allowed by the specification: a missing id, a string, a number or null
+func isValidID(id json.RawMessage) bool {
+	if id == nil {
+		return true
+	}
+
+	var value interface{}
+	err := json.Unmarshal(id, &value)
+	if err != nil {
+		return false
+	}
+
+	switch value.(type) {
+	case nil, string, float64:
+		return true
+	default:
+		return false
+	}
+}
+
+func validIDOrNil(id json.RawMessage) json.RawMessage {
+	if isValidID(id) {
+		return id
+	}
+	return nil
+}
@@ -0,0 +1,222 @@
+package jsonrpc_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/jsonrpc"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func startNodeServer(handler jsonrpc.FacadeHandler, readOnly bool) *gin.Engine {
+	ws := gin.New()
+	rpcRoute := ws.Group("/rpc")
+	rpcRoute.Use(middleware.WithElrondFacade(handler))
+	if readOnly {
+		jsonrpc.ReadOnlyRoutes(rpcRoute)
+	} else {
+		jsonrpc.Routes(rpcRoute)
+	}
+
+	return ws
+}
+
+func doRequest(ws *gin.Engine, body string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest("POST", "/rpc", bytes.NewBufferString(body))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func loadResponse(t *testing.T, resp *httptest.ResponseRecorder) *jsonrpc.Response {
+	response := &jsonrpc.Response{}
+	err := json.Unmarshal(resp.Body.Bytes(), response)
+	assert.Nil(t, err)
+
+	return response
+}
+
+func createFacade() *mock.Facade {
+	return &mock.Facade{
+		BalanceHandler: func(address string) (*big.Int, error) {
+			if address != "aabb" {
+				return nil, errors.New("unknown address")
+			}
+			return big.NewInt(37), nil
+		},
+		GetAccountHandler: func(address string) (*state.Account, error) {
+			return &state.Account{Nonce: 2, Balance: big.NewInt(10), CodeHash: []byte{1}}, nil
+		},
+		SendTransactionHandler: func(nonce uint64, sender string, receiver string, value *big.Int, gasPrice uint64,
+			gasLimit uint64, transactionData string, signature []byte) (string, error) {
+			return "sent", nil
+		},
+		SimulateTransactionHandler: func(nonce uint64, sender string, receiver string, value *big.Int, gasPrice uint64,
+			gasLimit uint64, transactionData string, signature []byte) (string, error) {
+			if value.Cmp(big.NewInt(100)) != 0 || !bytes.Equal(signature, []byte{0xab}) {
+				return "", errors.New("unexpected transaction")
+			}
+			return "simulated", nil
+		},
+		GetDataValueHandler: func(address string, funcName string, argsBuff ...[]byte) ([]byte, error) {
+			return []byte("value"), nil
+		},
+	}
+}
+
+func TestRoutes_SingleRequestShouldReturnTheResult(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(createFacade(), false)
+	resp := doRequest(ws, `{"jsonrpc":"2.0","method":"account_getBalance","params":{"address":"aabb"},"id":1}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	response := loadResponse(t, resp)
+	assert.Equal(t, jsonrpc.Version, response.JsonRpc)
+	assert.Equal(t, `"37"`, string(response.Result))
+	assert.Nil(t, response.Error)
+	assert.Equal(t, "1", string(response.ID))
+}
+
+func TestRoutes_PositionalParamsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(createFacade(), false)
+	resp := doRequest(ws, `{"jsonrpc":"2.0","method":"tx_simulate",`+
+		`"params":[1,"aa","bb","100",10,20,"data","ab"],"id":"x"}`)
+
+	response := loadResponse(t, resp)
+	assert.Nil(t, response.Error)
+	assert.Equal(t, `{"txHash":"simulated"}`, string(response.Result))
+	assert.Equal(t, `"x"`, string(response.ID))
+}
+
+func TestRoutes_BatchShouldAnswerAllRequestsButTheNotifications(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(createFacade(), false)
+	resp := doRequest(ws, `[
+		{"jsonrpc":"2.0","method":"account_getBalance","params":["aabb"],"id":1},
+		{"jsonrpc":"2.0","method":"account_getBalance","params":["aabb"]},
+		{"jsonrpc":"2.0","method":"vm_getValueString","params":["aa","get",[]],"id":2},
+		{"jsonrpc":"2.0","method":"account_getBalance","params":["ccdd"],"id":3}
+	]`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	responses := make([]*jsonrpc.Response, 0)
+	err := json.Unmarshal(resp.Body.Bytes(), &responses)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(responses))
+	assert.Equal(t, `"37"`, string(responses[0].Result))
+	assert.Equal(t, `"value"`, string(responses[1].Result))
+	assert.Equal(t, jsonrpc.ServerErrorCode, responses[2].Error.Code)
+	assert.Nil(t, responses[2].Result)
+}
+
+func TestRoutes_OnlyNotificationsShouldReturnNoContent(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(createFacade(), false)
+	resp := doRequest(ws, `{"jsonrpc":"2.0","method":"account_getBalance","params":["aabb"]}`)
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+	assert.Equal(t, 0, resp.Body.Len())
+
+	resp = doRequest(ws, `[{"jsonrpc":"2.0","method":"account_getBalance","params":["aabb"]}]`)
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+}
+
+func TestRoutes_ErrorsShouldHaveTheStandardCodes(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(createFacade(), false)
+	testCases := []struct {
+		body string
+		code int
+	}{
+		{body: `{"jsonrpc":"2.0","method"`, code: jsonrpc.ParseErrorCode},
+		{body: `[]`, code: jsonrpc.InvalidRequestCode},
+		{body: `{"jsonrpc":"1.0","method":"node_status","id":1}`, code: jsonrpc.InvalidRequestCode},
+		{body: `{"jsonrpc":"2.0","method":"node_status","id":{}}`, code: jsonrpc.InvalidRequestCode},
+		{body: `{"jsonrpc":"2.0","method":"missing","id":1}`, code: jsonrpc.MethodNotFoundCode},
+		{body: `{"jsonrpc":"2.0","method":"account_getBalance","params":{"addr":"aabb"},"id":1}`, code: jsonrpc.InvalidParamsCode},
+		{body: `{"jsonrpc":"2.0","method":"account_getBalance","params":["aabb","ccdd"],"id":1}`, code: jsonrpc.InvalidParamsCode},
+		{body: `{"jsonrpc":"2.0","method":"account_getProof","params":["aabb","not hex"],"id":1}`, code: jsonrpc.InvalidParamsCode},
+		{body: `{"jsonrpc":"2.0","method":"tx_send","params":[1,"aa","bb","1.5",10,20,"","ab"],"id":1}`, code: jsonrpc.InvalidParamsCode},
+		{body: `{"jsonrpc":"2.0","method":"account_getBalance","params":["ccdd"],"id":1}`, code: jsonrpc.ServerErrorCode},
+	}
+
+	for _, tc := range testCases {
+		resp := doRequest(ws, tc.body)
+		assert.Equal(t, http.StatusOK, resp.Code)
+
+		response := loadResponse(t, resp)
+		assert.NotNil(t, response.Error, tc.body)
+		if response.Error != nil {
+			assert.Equal(t, tc.code, response.Error.Code, tc.body)
+		}
+		assert.Nil(t, response.Result)
+	}
+}
+
+func TestRoutes_GetAccountShouldEncodeTheFields(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(createFacade(), false)
+	resp := doRequest(ws, `{"jsonrpc":"2.0","method":"account_getAccount","params":["aabb"],"id":1}`)
+
+	response := loadResponse(t, resp)
+	assert.Nil(t, response.Error)
+	assert.Equal(t, `{"address":"aabb","nonce":2,"balance":"10","codeHash":"01","rootHash":""}`, string(response.Result))
+}
+
+func TestReadOnlyRoutes_ShouldRejectTheMethodsChangingTheState(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(createFacade(), true)
+	resp := doRequest(ws, `{"jsonrpc":"2.0","method":"tx_send","params":[1,"aa","bb","100",10,20,"","ab"],"id":1}`)
+	response := loadResponse(t, resp)
+	assert.Equal(t, jsonrpc.MethodNotFoundCode, response.Error.Code)
+
+	resp = doRequest(ws, `{"jsonrpc":"2.0","method":"tx_simulate","params":[1,"aa","bb","100",10,20,"","ab"],"id":1}`)
+	response = loadResponse(t, resp)
+	assert.Nil(t, response.Error)
+	assert.Equal(t, `{"txHash":"simulated"}`, string(response.Result))
+}
+
+func TestRoutes_BatchShouldCountEachEntryAgainstTheRateLimit(t *testing.T) {
+	t.Parallel()
+
+	rateLimiter, _ := middleware.NewRateLimiter(config.ApiRateLimitConfig{WindowInSeconds: 60, MaxRequestsPerIP: 4})
+	ws := gin.New()
+	ws.Use(middleware.WithRateLimit(rateLimiter))
+	rpcRoute := ws.Group("/rpc")
+	rpcRoute.Use(middleware.WithElrondFacade(createFacade()))
+	jsonrpc.Routes(rpcRoute)
+
+	sendTx := `{"jsonrpc":"2.0","method":"tx_send","params":[1,"aa","bb","100",10,20,"","ab"],"id":1}`
+	resp := doRequest(ws, "["+sendTx+","+sendTx+"]")
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	// the HTTP request is counted but the rest of the batch goes over the limit
+	resp = doRequest(ws, "["+sendTx+","+sendTx+","+sendTx+"]")
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	assert.NotEmpty(t, resp.Header().Get("Retry-After"))
+
+	resp = doRequest(ws, sendTx)
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
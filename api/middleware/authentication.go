@@ -0,0 +1,186 @@
+package middleware
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-gonic/gin"
+)
+
+// ApiKeyHeader is the request header holding an API key
+const ApiKeyHeader = "X-Api-Key"
+
+const bearerPrefix = "Bearer "
+const identityContextKey = "identity"
+const authErrorContextKey = "authError"
+
+// Identity describes the client that authenticated a request
+type Identity struct {
+	Name  string
+	Admin bool
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+}
+
+type jwtClaims struct {
+	Sub   string `json:"sub"`
+	Exp   int64  `json:"exp"`
+	Nbf   int64  `json:"nbf"`
+	Admin bool   `json:"admin"`
+}
+
+// Authenticator checks the API keys and the HS256 signed JWTs provided with the requests
+type Authenticator struct {
+	apiKeys   map[string]*Identity
+	jwtSecret []byte
+}
+
+// NewAuthenticator creates a new authenticator accepting the API keys and the JWT secret of the provided config.
+// JWTs are accepted only if a secret is configured
+func NewAuthenticator(authConfig config.ApiAuthConfig) (*Authenticator, error) {
+	apiKeys := make(map[string]*Identity)
+	for _, apiKey := range authConfig.ApiKeys {
+		if len(apiKey.Key) == 0 || len(apiKey.Name) == 0 {
+			return nil, errors.ErrEmptyApiKey
+		}
+		if _, ok := apiKeys[apiKey.Key]; ok {
+			return nil, errors.ErrDuplicatedApiKey
+		}
+
+		apiKeys[apiKey.Key] = &Identity{Name: "key:" + apiKey.Name, Admin: apiKey.Admin}
+	}
+
+	return &Authenticator{
+		apiKeys:   apiKeys,
+		jwtSecret: []byte(authConfig.JWTSecret),
+	}, nil
+}
+
+// Authenticate returns the identity of the client from the X-Api-Key header or from the bearer token of the
+// Authorization header. A nil identity and a nil error are returned if the request has no credentials
+func (a *Authenticator) Authenticate(req *http.Request) (*Identity, error) {
+	apiKey := req.Header.Get(ApiKeyHeader)
+	if apiKey != "" {
+		identity, ok := a.apiKeys[apiKey]
+		if !ok {
+			return nil, errors.ErrInvalidCredentials
+		}
+		return identity, nil
+	}
+
+	authorization := req.Header.Get("Authorization")
+	if authorization == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(authorization, bearerPrefix) {
+		return nil, errors.ErrInvalidCredentials
+	}
+
+	return a.verifyJWT(strings.TrimPrefix(authorization, bearerPrefix), time.Now())
+}
+
+// verifyJWT checks the HS256 signature and the validity period of the token
+func (a *Authenticator) verifyJWT(token string, now time.Time) (*Identity, error) {
+	if len(a.jwtSecret) == 0 {
+		return nil, errors.ErrInvalidCredentials
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.ErrInvalidCredentials
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errors.ErrInvalidCredentials
+	}
+
+	mac := hmac.New(sha256.New, a.jwtSecret)
+	_, _ = mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return nil, errors.ErrInvalidCredentials
+	}
+
+	header := &jwtHeader{}
+	err = decodeJWTPart(parts[0], header)
+	if err != nil || header.Alg != "HS256" {
+		return nil, errors.ErrInvalidCredentials
+	}
+
+	claims := &jwtClaims{}
+	err = decodeJWTPart(parts[1], claims)
+	if err != nil || claims.Sub == "" {
+		return nil, errors.ErrInvalidCredentials
+	}
+	if claims.Exp != 0 && now.Unix() >= claims.Exp {
+		return nil, errors.ErrInvalidCredentials
+	}
+	if claims.Nbf != 0 && now.Unix() < claims.Nbf {
+		return nil, errors.ErrInvalidCredentials
+	}
+
+	return &Identity{Name: "jwt:" + claims.Sub, Admin: claims.Admin}, nil
+}
+
+func decodeJWTPart(part string, destination interface{}) error {
+	buff, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(buff, destination)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (a *Authenticator) IsInterfaceNil() bool {
+	if a == nil {
+		return true
+	}
+	return false
+}
+
+// WithAuthentication middleware will set up in the gin context the identity of the client or the error of its
+// credentials. The request is rejected later, by the route access middleware, so that the requests with invalid
+// credentials are still counted against the rate limit of their IP address
+func WithAuthentication(authenticator *Authenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		identity, err := authenticator.Authenticate(c.Request)
+		if err != nil {
+			c.Set(authErrorContextKey, err)
+		}
+		if identity != nil {
+			c.Set(identityContextKey, identity)
+		}
+		c.Next()
+	}
+}
+
+// getIdentity returns the identity set up by the authentication middleware, or nil for anonymous requests
+func getIdentity(c *gin.Context) *Identity {
+	value, ok := c.Get(identityContextKey)
+	if !ok {
+		return nil
+	}
+
+	identity, _ := value.(*Identity)
+	return identity
+}
+
+func getAuthError(c *gin.Context) error {
+	value, ok := c.Get(authErrorContextKey)
+	if !ok {
+		return nil
+	}
+
+	err, _ := value.(error)
+	return err
+}
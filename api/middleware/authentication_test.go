@@ -0,0 +1,112 @@
+package middleware_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/stretchr/testify/assert"
+)
+
+const hs256Header = `{"alg":"HS256","typ":"JWT"}`
+
+func TestNewAuthenticator_InvalidApiKeysShouldErr(t *testing.T) {
+	t.Parallel()
+
+	authenticator, err := middleware.NewAuthenticator(config.ApiAuthConfig{
+		ApiKeys: []config.ApiKeyConfig{{Name: "user", Key: ""}},
+	})
+	assert.Nil(t, authenticator)
+	assert.Equal(t, errors.ErrEmptyApiKey, err)
+
+	authenticator, err = middleware.NewAuthenticator(config.ApiAuthConfig{
+		ApiKeys: []config.ApiKeyConfig{{Name: "user", Key: "key"}, {Name: "other user", Key: "key"}},
+	})
+	assert.Nil(t, authenticator)
+	assert.Equal(t, errors.ErrDuplicatedApiKey, err)
+}
+
+func TestAuthenticator_AuthenticateApiKey(t *testing.T) {
+	t.Parallel()
+
+	authenticator, _ := middleware.NewAuthenticator(createApiConfig().Auth)
+
+	req, _ := http.NewRequest("GET", "/", nil)
+	identity, err := authenticator.Authenticate(req)
+	assert.Nil(t, err)
+	assert.Nil(t, identity)
+
+	req.Header.Set(middleware.ApiKeyHeader, "admin key")
+	identity, err = authenticator.Authenticate(req)
+	assert.Nil(t, err)
+	assert.Equal(t, &middleware.Identity{Name: "key:admin", Admin: true}, identity)
+
+	req.Header.Set(middleware.ApiKeyHeader, "unknown key")
+	identity, err = authenticator.Authenticate(req)
+	assert.Nil(t, identity)
+	assert.Equal(t, errors.ErrInvalidCredentials, err)
+}
+
+func TestAuthenticator_AuthenticateJWT(t *testing.T) {
+	t.Parallel()
+
+	authenticator, _ := middleware.NewAuthenticator(createApiConfig().Auth)
+	authenticate := func(token string) (*middleware.Identity, error) {
+		req, _ := http.NewRequest("GET", "/", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		return authenticator.Authenticate(req)
+	}
+
+	token := createJWT(testJWTSecret, hs256Header, map[string]interface{}{
+		"sub": "bridge",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+	identity, err := authenticate(token)
+	assert.Nil(t, err)
+	assert.Equal(t, &middleware.Identity{Name: "jwt:bridge"}, identity)
+
+	token = createJWT(testJWTSecret, hs256Header, map[string]interface{}{"sub": "operator", "admin": true})
+	identity, err = authenticate(token)
+	assert.Nil(t, err)
+	assert.Equal(t, &middleware.Identity{Name: "jwt:operator", Admin: true}, identity)
+}
+
+func TestAuthenticator_AuthenticateInvalidJWTShouldErr(t *testing.T) {
+	t.Parallel()
+
+	authenticator, _ := middleware.NewAuthenticator(createApiConfig().Auth)
+	noSecretAuthenticator, _ := middleware.NewAuthenticator(config.ApiAuthConfig{})
+	validToken := createJWT(testJWTSecret, hs256Header, map[string]interface{}{"sub": "bridge"})
+
+	invalidTokens := []string{
+		"not a token",
+		createJWT("other secret", hs256Header, map[string]interface{}{"sub": "bridge"}),
+		createJWT(testJWTSecret, `{"alg":"none"}`, map[string]interface{}{"sub": "bridge"}),
+		createJWT(testJWTSecret, hs256Header, map[string]interface{}{"admin": true}),
+		createJWT(testJWTSecret, hs256Header, map[string]interface{}{"sub": "bridge", "exp": time.Now().Add(-time.Minute).Unix()}),
+		createJWT(testJWTSecret, hs256Header, map[string]interface{}{"sub": "bridge", "nbf": time.Now().Add(time.Hour).Unix()}),
+		validToken[:len(validToken)-2],
+	}
+	for _, token := range invalidTokens {
+		req, _ := http.NewRequest("GET", "/", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		identity, err := authenticator.Authenticate(req)
+		assert.Nil(t, identity)
+		assert.Equal(t, errors.ErrInvalidCredentials, err)
+	}
+
+	req, _ := http.NewRequest("GET", "/", nil)
+	req.Header.Set("Authorization", "Bearer "+validToken)
+	identity, err := noSecretAuthenticator.Authenticate(req)
+	assert.Nil(t, identity)
+	assert.Equal(t, errors.ErrInvalidCredentials, err)
+
+	req.Header.Set("Authorization", "Basic dXNlcjpwYXNz")
+	identity, err = authenticator.Authenticate(req)
+	assert.Nil(t, identity)
+	assert.Equal(t, errors.ErrInvalidCredentials, err)
+}
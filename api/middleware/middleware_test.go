@@ -0,0 +1,103 @@
+package middleware_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+const testJWTSecret = "secret"
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+// startServer mounts, behind the access middlewares, a route group answering to GET and POST /group/resource
+func startServer(apiConfig config.ApiConfig, access string) *gin.Engine {
+	authenticator, _ := middleware.NewAuthenticator(apiConfig.Auth)
+
+	ws := gin.New()
+	ws.Use(middleware.WithAuthentication(authenticator))
+	rateLimiter, _ := middleware.NewRateLimiter(apiConfig.RateLimit)
+	ws.Use(middleware.WithRateLimit(rateLimiter))
+
+	group := ws.Group("/group")
+	group.Use(middleware.WithRouteAccess(access, apiConfig.Auth.Required))
+	handler := func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"ok": true})
+	}
+	group.GET("/resource", handler)
+	group.POST("/resource", handler)
+
+	return ws
+}
+
+func createApiConfig() config.ApiConfig {
+	return config.ApiConfig{
+		Auth: config.ApiAuthConfig{
+			JWTSecret: testJWTSecret,
+			ApiKeys: []config.ApiKeyConfig{
+				{Name: "user", Key: "user key"},
+				{Name: "admin", Key: "admin key", Admin: true},
+			},
+		},
+	}
+}
+
+func createJWT(secret string, header string, claims map[string]interface{}) string {
+	claimsBuff, _ := json.Marshal(claims)
+	unsigned := base64.RawURLEncoding.EncodeToString([]byte(header)) + "." +
+		base64.RawURLEncoding.EncodeToString(claimsBuff)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write([]byte(unsigned))
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func doRequest(ws *gin.Engine, method string, headers map[string]string) (*httptest.ResponseRecorder, errorResponse) {
+	req, _ := http.NewRequest(method, "/group/resource", nil)
+	req.RemoteAddr = "10.0.0.1:1234"
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := errorResponse{}
+	_ = json.NewDecoder(resp.Body).Decode(&response)
+
+	return resp, response
+}
+
+func TestWithElrondFacade_ShouldSetTheFacadeInContext(t *testing.T) {
+	t.Parallel()
+
+	facade := "facade"
+	ws := gin.New()
+	ws.Use(middleware.WithElrondFacade(facade))
+	ws.GET("/", func(c *gin.Context) {
+		assert.Equal(t, facade, c.MustGet("elrondFacade"))
+		c.Status(http.StatusOK)
+	})
+
+	req, _ := http.NewRequest("GET", "/", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
@@ -0,0 +1,234 @@
+package middleware
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/gin-gonic/gin"
+)
+
+// defaultMaxTrackedClients is the number of clients whose requests are counted when the config does not set it
+const defaultMaxTrackedClients = 100000
+
+const forwardedForHeader = "X-Forwarded-For"
+const rateLimiterContextKey = "rateLimiter"
+
+// RateLimiter counts the requests of each client during fixed time windows. Authenticated clients are limited by
+// their token, whatever their IP address, while anonymous clients are limited by their IP address. At most
+// MaxTrackedClients clients are counted during a window, the least recently seen ones being forgotten
+type RateLimiter struct {
+	window              time.Duration
+	maxRequestsPerIP    uint32
+	maxRequestsPerToken uint32
+	trustedProxies      []*net.IPNet
+
+	mutCounters sync.Mutex
+	windowStart time.Time
+	counters    *lrucache.LRUCache
+}
+
+// NewRateLimiter creates a new rate limiter. A 0 window disables the rate limiting
+func NewRateLimiter(rateLimitConfig config.ApiRateLimitConfig) (*RateLimiter, error) {
+	trustedProxies, err := parseTrustedProxies(rateLimitConfig.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+
+	maxTrackedClients := int(rateLimitConfig.MaxTrackedClients)
+	if maxTrackedClients == 0 {
+		maxTrackedClients = defaultMaxTrackedClients
+	}
+	counters, err := lrucache.NewCache(maxTrackedClients)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RateLimiter{
+		window:              time.Duration(rateLimitConfig.WindowInSeconds) * time.Second,
+		maxRequestsPerIP:    rateLimitConfig.MaxRequestsPerIP,
+		maxRequestsPerToken: rateLimitConfig.MaxRequestsPerToken,
+		trustedProxies:      trustedProxies,
+		counters:            counters,
+	}, nil
+}
+
+// parseTrustedProxies accepts both IP addresses and CIDR ranges
+func parseTrustedProxies(proxies []string) ([]*net.IPNet, error) {
+	trustedProxies := make([]*net.IPNet, 0, len(proxies))
+	for _, proxy := range proxies {
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, fmt.Errorf("%s: %s", errors.ErrInvalidTrustedProxy.Error(), proxy)
+			}
+
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip = ip.To4()
+				bits = 8 * net.IPv4len
+			}
+			trustedProxies = append(trustedProxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", errors.ErrInvalidTrustedProxy.Error(), proxy)
+		}
+		trustedProxies = append(trustedProxies, ipNet)
+	}
+
+	return trustedProxies, nil
+}
+
+// ClientIP returns the IP address the request was sent from. The X-Forwarded-For header is only taken into account
+// when the request comes from a trusted proxy, the client being the last address of the header which is not a
+// trusted proxy itself
+func (rl *RateLimiter) ClientIP(request *http.Request) string {
+	remoteIP, _, err := net.SplitHostPort(strings.TrimSpace(request.RemoteAddr))
+	if err != nil {
+		remoteIP = strings.TrimSpace(request.RemoteAddr)
+	}
+	if !rl.isTrustedProxy(remoteIP) {
+		return remoteIP
+	}
+
+	forwardedFor := strings.Split(request.Header.Get(forwardedForHeader), ",")
+	clientIP := remoteIP
+	for i := len(forwardedFor) - 1; i >= 0; i-- {
+		forwardedIP := strings.TrimSpace(forwardedFor[i])
+		if net.ParseIP(forwardedIP) == nil {
+			break
+		}
+
+		clientIP = forwardedIP
+		if !rl.isTrustedProxy(forwardedIP) {
+			break
+		}
+	}
+
+	return clientIP
+}
+
+func (rl *RateLimiter) isTrustedProxy(address string) bool {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+
+	for _, trustedProxy := range rl.trustedProxies {
+		if trustedProxy.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Allow counts a request of the client and returns false, along with the time left until the current window ends,
+// if the client went over its limit
+func (rl *RateLimiter) Allow(ip string, identity *Identity, now time.Time) (bool, time.Duration) {
+	return rl.AllowN(ip, identity, 1, now)
+}
+
+// AllowN counts numRequests requests of the client at once. None of them is counted if they would take the client
+// over its limit
+func (rl *RateLimiter) AllowN(ip string, identity *Identity, numRequests uint32, now time.Time) (bool, time.Duration) {
+	key := "ip:" + ip
+	maxRequests := rl.maxRequestsPerIP
+	if identity != nil {
+		key = "token:" + identity.Name
+		maxRequests = rl.maxRequestsPerToken
+	}
+	if rl.window == 0 || maxRequests == 0 {
+		return true, 0
+	}
+
+	rl.mutCounters.Lock()
+	defer rl.mutCounters.Unlock()
+
+	if now.Sub(rl.windowStart) >= rl.window {
+		rl.windowStart = now
+		rl.counters.Clear()
+	}
+
+	count := uint32(0)
+	value, ok := rl.counters.Get([]byte(key))
+	if ok {
+		count = value.(uint32)
+	}
+	if uint64(count)+uint64(numRequests) > uint64(maxRequests) {
+		return false, rl.windowStart.Add(rl.window).Sub(now)
+	}
+	rl.counters.Put([]byte(key), count+numRequests)
+
+	return true, 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rl *RateLimiter) IsInterfaceNil() bool {
+	if rl == nil {
+		return true
+	}
+	return false
+}
+
+// NumTrackedClients returns the number of clients whose requests are counted in the current window
+func (rl *RateLimiter) NumTrackedClients() int {
+	rl.mutCounters.Lock()
+	defer rl.mutCounters.Unlock()
+
+	return rl.counters.Len()
+}
+
+// WithRateLimit middleware will reject with 429 the requests of the clients that went over their limit. The
+// Retry-After header holds the number of seconds until the client can send requests again
+func WithRateLimit(rateLimiter *RateLimiter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(rateLimiterContextKey, rateLimiter)
+		if !allowRequests(c, rateLimiter, 1) {
+			return
+		}
+		c.Next()
+	}
+}
+
+// ConsumeRequests counts numRequests more requests of the client, for the handlers serving several requests at once,
+// like the JSON-RPC batches. It answers with 429 and returns false if the client went over its limit
+func ConsumeRequests(c *gin.Context, numRequests uint32) bool {
+	value, ok := c.Get(rateLimiterContextKey)
+	if !ok || numRequests == 0 {
+		return true
+	}
+
+	rateLimiter, ok := value.(*RateLimiter)
+	if !ok {
+		return true
+	}
+
+	return allowRequests(c, rateLimiter, numRequests)
+}
+
+func allowRequests(c *gin.Context, rateLimiter *RateLimiter, numRequests uint32) bool {
+	allowed, retryAfter := rateLimiter.AllowN(rateLimiter.ClientIP(c.Request), getIdentity(c), numRequests, time.Now())
+	if allowed {
+		return true
+	}
+
+	retryAfterSeconds := int64((retryAfter + time.Second - 1) / time.Second)
+	if retryAfterSeconds < 1 {
+		retryAfterSeconds = 1
+	}
+
+	c.Header("Retry-After", strconv.FormatInt(retryAfterSeconds, 10))
+	c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"error": errors.ErrTooManyRequests.Error()})
+	return false
+}
@@ -0,0 +1,207 @@
+package middleware_test
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter_AllowShouldLimitEachClientDuringTheWindow(t *testing.T) {
+	t.Parallel()
+
+	rl, _ := middleware.NewRateLimiter(config.ApiRateLimitConfig{
+		WindowInSeconds:     10,
+		MaxRequestsPerIP:    2,
+		MaxRequestsPerToken: 3,
+	})
+	token := &middleware.Identity{Name: "key:user"}
+	start := time.Now()
+
+	for i := 0; i < 2; i++ {
+		allowed, _ := rl.Allow("ip1", nil, start)
+		assert.True(t, allowed)
+	}
+	allowed, retryAfter := rl.Allow("ip1", nil, start.Add(4*time.Second))
+	assert.False(t, allowed)
+	assert.Equal(t, 6*time.Second, retryAfter)
+
+	// other IP addresses and the tokens have their own counters
+	allowed, _ = rl.Allow("ip2", nil, start)
+	assert.True(t, allowed)
+	for i := 0; i < 3; i++ {
+		allowed, _ = rl.Allow("ip1", token, start)
+		assert.True(t, allowed)
+	}
+	allowed, _ = rl.Allow("ip2", token, start)
+	assert.False(t, allowed)
+
+	// a new window resets the counters
+	allowed, _ = rl.Allow("ip1", nil, start.Add(10*time.Second))
+	assert.True(t, allowed)
+	allowed, _ = rl.Allow("ip1", token, start.Add(10*time.Second))
+	assert.True(t, allowed)
+}
+
+func TestRateLimiter_AllowNShouldCountAllTheRequestsOrNone(t *testing.T) {
+	t.Parallel()
+
+	rl, _ := middleware.NewRateLimiter(config.ApiRateLimitConfig{WindowInSeconds: 10, MaxRequestsPerIP: 5})
+	now := time.Now()
+
+	allowed, _ := rl.AllowN("ip", nil, 3, now)
+	assert.True(t, allowed)
+	allowed, _ = rl.AllowN("ip", nil, 3, now)
+	assert.False(t, allowed)
+	allowed, _ = rl.AllowN("ip", nil, 2, now)
+	assert.True(t, allowed)
+	allowed, _ = rl.Allow("ip", nil, now)
+	assert.False(t, allowed)
+}
+
+func TestNewRateLimiter_InvalidTrustedProxyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rl, err := middleware.NewRateLimiter(config.ApiRateLimitConfig{TrustedProxies: []string{"10.0.0.1", "not an ip"}})
+	assert.Nil(t, rl)
+	assert.True(t, strings.Contains(err.Error(), errors.ErrInvalidTrustedProxy.Error()))
+
+	rl, err = middleware.NewRateLimiter(config.ApiRateLimitConfig{TrustedProxies: []string{"10.0.0.0/33"}})
+	assert.Nil(t, rl)
+	assert.True(t, strings.Contains(err.Error(), errors.ErrInvalidTrustedProxy.Error()))
+}
+
+func TestRateLimiter_ClientIPShouldIgnoreForwardedForWithoutATrustedProxy(t *testing.T) {
+	t.Parallel()
+
+	rl, _ := middleware.NewRateLimiter(config.ApiRateLimitConfig{})
+	req, _ := http.NewRequest("GET", "/", nil)
+	req.RemoteAddr = "10.0.0.1:1234"
+	req.Header.Set("X-Forwarded-For", "1.2.3.4")
+
+	assert.Equal(t, "10.0.0.1", rl.ClientIP(req))
+}
+
+func TestRateLimiter_ClientIPShouldUseForwardedForFromTheTrustedProxies(t *testing.T) {
+	t.Parallel()
+
+	rl, _ := middleware.NewRateLimiter(config.ApiRateLimitConfig{TrustedProxies: []string{"10.0.0.1", "192.168.0.0/16"}})
+	req, _ := http.NewRequest("GET", "/", nil)
+	req.RemoteAddr = "10.0.0.1:1234"
+
+	// the addresses added by the client itself, on the left of the untrusted one, are ignored
+	req.Header.Set("X-Forwarded-For", "9.9.9.9, 1.2.3.4, 192.168.1.1")
+	assert.Equal(t, "1.2.3.4", rl.ClientIP(req))
+
+	req.Header.Set("X-Forwarded-For", "192.168.1.2")
+	assert.Equal(t, "192.168.1.2", rl.ClientIP(req))
+
+	req.Header.Del("X-Forwarded-For")
+	assert.Equal(t, "10.0.0.1", rl.ClientIP(req))
+
+	req.RemoteAddr = "10.0.0.2:1234"
+	req.Header.Set("X-Forwarded-For", "1.2.3.4")
+	assert.Equal(t, "10.0.0.2", rl.ClientIP(req))
+}
+
+func TestRateLimiter_AllowShouldTrackAtMostMaxTrackedClients(t *testing.T) {
+	t.Parallel()
+
+	rl, _ := middleware.NewRateLimiter(config.ApiRateLimitConfig{
+		WindowInSeconds:   10,
+		MaxRequestsPerIP:  1,
+		MaxTrackedClients: 2,
+	})
+	now := time.Now()
+
+	for i := 0; i < 10; i++ {
+		allowed, _ := rl.Allow(fmt.Sprintf("ip%d", i), nil, now)
+		assert.True(t, allowed)
+	}
+	assert.Equal(t, 2, rl.NumTrackedClients())
+
+	allowed, _ := rl.Allow("ip9", nil, now)
+	assert.False(t, allowed)
+}
+
+func TestRateLimiter_AllowZeroValuesShouldNotLimit(t *testing.T) {
+	t.Parallel()
+
+	noWindow, _ := middleware.NewRateLimiter(config.ApiRateLimitConfig{MaxRequestsPerIP: 1, MaxRequestsPerToken: 1})
+	noTokenLimit, _ := middleware.NewRateLimiter(config.ApiRateLimitConfig{WindowInSeconds: 10, MaxRequestsPerIP: 1})
+	token := &middleware.Identity{Name: "key:user"}
+
+	for i := 0; i < 5; i++ {
+		allowed, _ := noWindow.Allow("ip", nil, time.Now())
+		assert.True(t, allowed)
+
+		allowed, _ = noTokenLimit.Allow("ip", token, time.Now())
+		assert.True(t, allowed)
+	}
+}
+
+func TestWithRateLimit_OverTheLimitShouldReturnTooManyRequests(t *testing.T) {
+	t.Parallel()
+
+	apiConfig := createApiConfig()
+	apiConfig.RateLimit = config.ApiRateLimitConfig{
+		WindowInSeconds:     60,
+		MaxRequestsPerIP:    2,
+		MaxRequestsPerToken: 3,
+	}
+	ws := startServer(apiConfig, middleware.AccessEnabled)
+
+	for i := 0; i < 2; i++ {
+		resp, _ := doRequest(ws, "GET", nil)
+		assert.Equal(t, http.StatusOK, resp.Code)
+	}
+	resp, response := doRequest(ws, "GET", nil)
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	assert.Equal(t, errors.ErrTooManyRequests.Error(), response.Error)
+	assert.Equal(t, "60", resp.Header().Get("Retry-After"))
+
+	// an authenticated client from the same IP address is limited by its token
+	apiKeyHeaders := map[string]string{middleware.ApiKeyHeader: "user key"}
+	for i := 0; i < 3; i++ {
+		resp, _ = doRequest(ws, "GET", apiKeyHeaders)
+		assert.Equal(t, http.StatusOK, resp.Code)
+	}
+	resp, _ = doRequest(ws, "GET", apiKeyHeaders)
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	assert.NotEmpty(t, resp.Header().Get("Retry-After"))
+}
+
+func TestWithRateLimit_SpoofedForwardedForShouldNotBypassTheLimit(t *testing.T) {
+	t.Parallel()
+
+	apiConfig := createApiConfig()
+	apiConfig.RateLimit = config.ApiRateLimitConfig{WindowInSeconds: 60, MaxRequestsPerIP: 1}
+	ws := startServer(apiConfig, middleware.AccessEnabled)
+
+	resp, _ := doRequest(ws, "GET", map[string]string{"X-Forwarded-For": "1.1.1.1"})
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	resp, _ = doRequest(ws, "GET", map[string]string{"X-Forwarded-For": "2.2.2.2"})
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+}
+
+func TestWithRateLimit_InvalidCredentialsShouldCountAgainstTheIP(t *testing.T) {
+	t.Parallel()
+
+	apiConfig := createApiConfig()
+	apiConfig.RateLimit = config.ApiRateLimitConfig{WindowInSeconds: 60, MaxRequestsPerIP: 1}
+	ws := startServer(apiConfig, middleware.AccessEnabled)
+
+	invalidKeyHeaders := map[string]string{middleware.ApiKeyHeader: "unknown key"}
+	resp, _ := doRequest(ws, "GET", invalidKeyHeaders)
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+
+	resp, _ = doRequest(ws, "GET", invalidKeyHeaders)
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+}
@@ -0,0 +1,102 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-gonic/gin"
+)
+
+// AccessEnabled allows all the requests to a route group
+const AccessEnabled = "enabled"
+
+// AccessReadOnly allows only the GET and HEAD requests to a route group
+const AccessReadOnly = "read-only"
+
+// AccessAdmin allows only the requests of the administrators to a route group
+const AccessAdmin = "admin"
+
+// AccessDisabled removes a route group from the API
+const AccessDisabled = "disabled"
+
+// RouteAccess holds the access level of each route group. The groups missing from the config are enabled
+type RouteAccess struct {
+	accessByGroup map[string]string
+}
+
+// NewRouteAccess creates the route access levels from the config, checking them against the available groups
+func NewRouteAccess(routeGroups []config.ApiRouteGroupConfig, availableGroups []string) (*RouteAccess, error) {
+	isAvailable := make(map[string]bool)
+	for _, group := range availableGroups {
+		isAvailable[group] = true
+	}
+
+	accessByGroup := make(map[string]string)
+	for _, routeGroup := range routeGroups {
+		if !isAvailable[routeGroup.Name] {
+			return nil, fmt.Errorf("%s: %s", errors.ErrUnknownRouteGroup.Error(), routeGroup.Name)
+		}
+
+		switch routeGroup.Access {
+		case AccessEnabled, AccessReadOnly, AccessAdmin, AccessDisabled:
+			accessByGroup[routeGroup.Name] = routeGroup.Access
+		default:
+			return nil, fmt.Errorf("%s: %s for %s", errors.ErrInvalidRouteAccess.Error(), routeGroup.Access, routeGroup.Name)
+		}
+	}
+
+	return &RouteAccess{accessByGroup: accessByGroup}, nil
+}
+
+// Access returns the access level of a route group
+func (ra *RouteAccess) Access(group string) string {
+	access, ok := ra.accessByGroup[group]
+	if !ok {
+		return AccessEnabled
+	}
+
+	return access
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ra *RouteAccess) IsInterfaceNil() bool {
+	if ra == nil {
+		return true
+	}
+	return false
+}
+
+// WithRouteAccess middleware will reject the requests that are not allowed by the access level of a route group,
+// the disabled groups not being registered at all. If authRequired is set, the anonymous requests are rejected as well
+func WithRouteAccess(access string, authRequired bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		err := getAuthError(c)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+
+		identity := getIdentity(c)
+		if identity == nil && (authRequired || access == AccessAdmin) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": errors.ErrMissingCredentials.Error()})
+			return
+		}
+
+		switch access {
+		case AccessAdmin:
+			if !identity.Admin {
+				c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": errors.ErrAdminOnlyRoute.Error()})
+				return
+			}
+		case AccessReadOnly:
+			if c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead {
+				c.AbortWithStatusJSON(http.StatusMethodNotAllowed, gin.H{"error": errors.ErrReadOnlyRoute.Error()})
+				return
+			}
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,96 @@
+package middleware_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/stretchr/testify/assert"
+)
+
+var availableGroups = []string{"node", "transaction"}
+
+func TestNewRouteAccess_InvalidConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ra, err := middleware.NewRouteAccess([]config.ApiRouteGroupConfig{{Name: "transactions", Access: "admin"}}, availableGroups)
+	assert.Nil(t, ra)
+	assert.Contains(t, err.Error(), errors.ErrUnknownRouteGroup.Error())
+
+	ra, err = middleware.NewRouteAccess([]config.ApiRouteGroupConfig{{Name: "transaction", Access: "private"}}, availableGroups)
+	assert.Nil(t, ra)
+	assert.Contains(t, err.Error(), errors.ErrInvalidRouteAccess.Error())
+}
+
+func TestRouteAccess_AccessShouldDefaultToEnabled(t *testing.T) {
+	t.Parallel()
+
+	ra, err := middleware.NewRouteAccess([]config.ApiRouteGroupConfig{{Name: "transaction", Access: "admin"}}, availableGroups)
+	assert.Nil(t, err)
+	assert.Equal(t, middleware.AccessAdmin, ra.Access("transaction"))
+	assert.Equal(t, middleware.AccessEnabled, ra.Access("node"))
+}
+
+func TestWithRouteAccess_ReadOnlyShouldAllowOnlyGetRequests(t *testing.T) {
+	t.Parallel()
+
+	ws := startServer(createApiConfig(), middleware.AccessReadOnly)
+
+	resp, _ := doRequest(ws, "GET", nil)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	resp, response := doRequest(ws, "POST", nil)
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.Code)
+	assert.Equal(t, errors.ErrReadOnlyRoute.Error(), response.Error)
+}
+
+func TestWithRouteAccess_AdminShouldAllowOnlyAdministrators(t *testing.T) {
+	t.Parallel()
+
+	ws := startServer(createApiConfig(), middleware.AccessAdmin)
+
+	resp, response := doRequest(ws, "POST", nil)
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	assert.Equal(t, errors.ErrMissingCredentials.Error(), response.Error)
+
+	resp, response = doRequest(ws, "POST", map[string]string{middleware.ApiKeyHeader: "user key"})
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+	assert.Equal(t, errors.ErrAdminOnlyRoute.Error(), response.Error)
+
+	resp, _ = doRequest(ws, "POST", map[string]string{middleware.ApiKeyHeader: "admin key"})
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	adminToken := createJWT(testJWTSecret, hs256Header, map[string]interface{}{"sub": "operator", "admin": true})
+	resp, _ = doRequest(ws, "POST", map[string]string{"Authorization": "Bearer " + adminToken})
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestWithRouteAccess_RequiredAuthShouldRejectAnonymousRequests(t *testing.T) {
+	t.Parallel()
+
+	apiConfig := createApiConfig()
+	apiConfig.Auth.Required = true
+	ws := startServer(apiConfig, middleware.AccessEnabled)
+
+	resp, response := doRequest(ws, "GET", nil)
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	assert.Equal(t, errors.ErrMissingCredentials.Error(), response.Error)
+
+	resp, _ = doRequest(ws, "GET", map[string]string{middleware.ApiKeyHeader: "user key"})
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestWithRouteAccess_InvalidCredentialsShouldBeRejected(t *testing.T) {
+	t.Parallel()
+
+	ws := startServer(createApiConfig(), middleware.AccessEnabled)
+
+	resp, _ := doRequest(ws, "GET", nil)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	resp, response := doRequest(ws, "GET", map[string]string{"Authorization": "Bearer forged"})
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	assert.Equal(t, errors.ErrInvalidCredentials.Error(), response.Error)
+}
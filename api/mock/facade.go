@@ -1,10 +1,15 @@
 package mock
 
 import (
+	"encoding/hex"
 	"errors"
 	"math/big"
 
+	"github.com/ElrondNetwork/elrond-go/core/history"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/node/external"
@@ -21,15 +26,29 @@ type Facade struct {
 	GetHeartbeatsHandler                           func() ([]heartbeat.PubKeyHeartbeat, error)
 	BalanceHandler                                 func(string) (*big.Int, error)
 	GetAccountHandler                              func(address string) (*state.Account, error)
+	EncodeAddressHandler                           func(address []byte) (string, error)
 	GenerateTransactionHandler                     func(sender string, receiver string, value *big.Int, code string) (*transaction.Transaction, error)
 	GetTransactionHandler                          func(hash string) (*transaction.Transaction, error)
 	SendTransactionHandler                         func(nonce uint64, sender string, receiver string, value *big.Int, gasPrice uint64, gasLimit uint64, code string, signature []byte) (string, error)
 	CreateTransactionHandler                       func(nonce uint64, value *big.Int, receiverHex string, senderHex string, gasPrice uint64, gasLimit uint64, data string, signatureHex string, challenge string) (*transaction.Transaction, error)
+	SimulateTransactionHandler                     func(nonce uint64, sender string, receiver string, value *big.Int, gasPrice uint64, gasLimit uint64, code string, signature []byte) (string, error)
 	SendBulkTransactionsHandler                    func(txs []*transaction.Transaction) (uint64, error)
 	GenerateAndSendBulkTransactionsHandler         func(destination string, value *big.Int, nrTransactions uint64) error
 	GenerateAndSendBulkTransactionsOneByOneHandler func(destination string, value *big.Int, nrTransactions uint64) error
 	GetDataValueHandler                            func(address string, funcName string, argsBuff ...[]byte) ([]byte, error)
 	StatusMetricsHandler                           func() external.StatusMetricsHandler
+	NumberOfShardsHandler                          func() uint32
+	GetBlockByNonceHandler                         func(shardId uint32, nonce uint64) (*block.Header, []byte, error)
+	GetBlockByHashHandler                          func(hash []byte) (*block.Header, error)
+	GetMetaBlockByNonceHandler                     func(nonce uint64) (*block.MetaBlock, []byte, error)
+	GetMetaBlockByHashHandler                      func(hash []byte) (*block.MetaBlock, error)
+	GetMiniBlockHandler                            func(hash []byte) (*block.MiniBlock, error)
+	GetMiniBlockTransactionsHandler                func(miniBlock *block.MiniBlock) (map[string]data.TransactionHandler, error)
+	SubscribeHandler                               func() (*subscription.Subscriber, error)
+	GetTransactionHistoryHandler                   func(address string, from uint64, limit int) (*history.Page, error)
+	GetAccountStorageValueHandler                  func(address string, key []byte) ([]byte, error)
+	GetAccountStorageHandler                       func(address string, startKey []byte, limit int) ([]data.TrieLeaf, error)
+	GetAccountProofHandler                         func(address string, rootHash []byte) ([][]byte, error)
 }
 
 // IsNodeRunning is the mock implementation of a handler's IsNodeRunning method
@@ -81,6 +100,35 @@ func (f *Facade) GetAccount(address string) (*state.Account, error) {
 	return f.GetAccountHandler(address)
 }
 
+// EncodeAddress is the mock implementation of a handler's EncodeAddress method
+func (f *Facade) EncodeAddress(address []byte) (string, error) {
+	if f.EncodeAddressHandler != nil {
+		return f.EncodeAddressHandler(address)
+	}
+
+	return hex.EncodeToString(address), nil
+}
+
+// GetTransactionHistory is the mock implementation of a handler's GetTransactionHistory method
+func (f *Facade) GetTransactionHistory(address string, from uint64, limit int) (*history.Page, error) {
+	return f.GetTransactionHistoryHandler(address, from, limit)
+}
+
+// GetAccountStorageValue is the mock implementation of a handler's GetAccountStorageValue method
+func (f *Facade) GetAccountStorageValue(address string, key []byte) ([]byte, error) {
+	return f.GetAccountStorageValueHandler(address, key)
+}
+
+// GetAccountStorage is the mock implementation of a handler's GetAccountStorage method
+func (f *Facade) GetAccountStorage(address string, startKey []byte, limit int) ([]data.TrieLeaf, error) {
+	return f.GetAccountStorageHandler(address, startKey, limit)
+}
+
+// GetAccountProof is the mock implementation of a handler's GetAccountProof method
+func (f *Facade) GetAccountProof(address string, rootHash []byte) ([][]byte, error) {
+	return f.GetAccountProofHandler(address, rootHash)
+}
+
 // GenerateTransaction is the mock implementation of a handler's GenerateTransaction method
 func (f *Facade) GenerateTransaction(sender string, receiver string, value *big.Int,
 	code string) (*transaction.Transaction, error) {
@@ -113,6 +161,11 @@ func (f *Facade) SendTransaction(nonce uint64, sender string, receiver string, v
 	return f.SendTransactionHandler(nonce, sender, receiver, value, gasPrice, gasLimit, code, signature)
 }
 
+// SimulateTransaction is the mock implementation of a handler's SimulateTransaction method
+func (f *Facade) SimulateTransaction(nonce uint64, sender string, receiver string, value *big.Int, gasPrice uint64, gasLimit uint64, code string, signature []byte) (string, error) {
+	return f.SimulateTransactionHandler(nonce, sender, receiver, value, gasPrice, gasLimit, code, signature)
+}
+
 // SendBulkTransactions is the mock implementation of a handler's SendBulkTransactions method
 func (f *Facade) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
 	return f.SendBulkTransactionsHandler(txs)
@@ -137,6 +190,50 @@ func (f *Facade) StatusMetrics() external.StatusMetricsHandler {
 	return f.StatusMetricsHandler()
 }
 
+// NumberOfShards is the mock implementation of a handler's NumberOfShards method
+func (f *Facade) NumberOfShards() uint32 {
+	return f.NumberOfShardsHandler()
+}
+
+// GetBlockByNonce is the mock implementation of a handler's GetBlockByNonce method
+func (f *Facade) GetBlockByNonce(shardId uint32, nonce uint64) (*block.Header, []byte, error) {
+	return f.GetBlockByNonceHandler(shardId, nonce)
+}
+
+// GetBlockByHash is the mock implementation of a handler's GetBlockByHash method
+func (f *Facade) GetBlockByHash(hash []byte) (*block.Header, error) {
+	return f.GetBlockByHashHandler(hash)
+}
+
+// GetMetaBlockByNonce is the mock implementation of a handler's GetMetaBlockByNonce method
+func (f *Facade) GetMetaBlockByNonce(nonce uint64) (*block.MetaBlock, []byte, error) {
+	return f.GetMetaBlockByNonceHandler(nonce)
+}
+
+// GetMetaBlockByHash is the mock implementation of a handler's GetMetaBlockByHash method
+func (f *Facade) GetMetaBlockByHash(hash []byte) (*block.MetaBlock, error) {
+	return f.GetMetaBlockByHashHandler(hash)
+}
+
+// GetMiniBlock is the mock implementation of a handler's GetMiniBlock method
+func (f *Facade) GetMiniBlock(hash []byte) (*block.MiniBlock, error) {
+	if f.GetMiniBlockHandler != nil {
+		return f.GetMiniBlockHandler(hash)
+	}
+
+	return nil, nil
+}
+
+// GetMiniBlockTransactions is the mock implementation of a handler's GetMiniBlockTransactions method
+func (f *Facade) GetMiniBlockTransactions(miniBlock *block.MiniBlock) (map[string]data.TransactionHandler, error) {
+	return f.GetMiniBlockTransactionsHandler(miniBlock)
+}
+
+// Subscribe is the mock implementation of a handler's Subscribe method
+func (f *Facade) Subscribe() (*subscription.Subscriber, error) {
+	return f.SubscribeHandler()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (f *Facade) IsInterfaceNil() bool {
 	if f == nil {
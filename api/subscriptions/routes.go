@@ -0,0 +1,213 @@
+package subscriptions
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// writeWait is the time allowed to write a message to the client
+	writeWait = 10 * time.Second
+	// pongWait is the time allowed to read the next pong message from the client
+	pongWait = 60 * time.Second
+	// pingPeriod is the period of the pings sent to the client, which must be less than pongWait
+	pingPeriod = pongWait * 9 / 10
+	// maxRequestSize is the maximum size of a subscription request sent by the client
+	maxRequestSize = 4096
+	// maxPendingReplies is the number of replies to the client's requests waiting to be written
+	maxPendingReplies = 16
+)
+
+// ActionSubscribe is the action of a request adding a filter for the events sent to the client
+const ActionSubscribe = "subscribe"
+
+// ActionUnsubscribe is the action of a request removing a filter previously added
+const ActionUnsubscribe = "unsubscribe"
+
+// MessageTypeEvent is the type of the messages holding an event
+const MessageTypeEvent = "event"
+
+// MessageTypeAck is the type of the messages confirming a request
+const MessageTypeAck = "ack"
+
+// MessageTypeError is the type of the messages signaling a failed request
+const MessageTypeError = "error"
+
+// SubscriptionService interface defines methods that can be used from `elrondFacade` context variable
+type SubscriptionService interface {
+	Subscribe() (*subscription.Subscriber, error)
+	IsInterfaceNil() bool
+}
+
+// Request represents the structure of the messages sent by the client to change its subscriptions
+type Request struct {
+	Action string `json:"action"`
+	subscription.Filter
+}
+
+// Message represents the structure of the messages sent to the client
+type Message struct {
+	Type    string              `json:"type"`
+	Event   *subscription.Event `json:"event,omitempty"`
+	Request *Request            `json:"request,omitempty"`
+	Error   string              `json:"error,omitempty"`
+}
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	// the REST API accepts requests from any origin
+	CheckOrigin: func(r *http.Request) bool {
+		return true
+	},
+}
+
+// Routes defines subscriptions related routes
+func Routes(router *gin.RouterGroup) {
+	router.GET("/ws", Subscribe)
+}
+
+// Subscribe upgrades the connection to a websocket on which the client sends subscription requests and receives
+// the matching events
+func Subscribe(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(SubscriptionService)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	subscriber, err := ef.Subscribe()
+	if err != nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrSubscribe.Error(), err.Error())})
+		return
+	}
+	defer subscriber.Close()
+
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// the upgrader has already replied to the client with an HTTP error
+		return
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	replies := make(chan *Message, maxPendingReplies)
+	readerDone := make(chan struct{})
+	writerDone := make(chan struct{})
+	defer close(writerDone)
+	go readRequests(conn, subscriber, replies, readerDone, writerDone)
+
+	writeMessages(conn, subscriber, replies, readerDone)
+}
+
+// readRequests applies the subscription requests of the client until the connection is closed
+func readRequests(
+	conn *websocket.Conn,
+	subscriber *subscription.Subscriber,
+	replies chan<- *Message,
+	done chan<- struct{},
+	writerDone <-chan struct{},
+) {
+	defer close(done)
+
+	reply := func(message *Message) bool {
+		select {
+		case replies <- message:
+			return true
+		case <-writerDone:
+			return false
+		}
+	}
+
+	conn.SetReadLimit(maxRequestSize)
+	_ = conn.SetReadDeadline(time.Now().Add(pongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	for {
+		request := &Request{}
+		err := conn.ReadJSON(request)
+		if err != nil {
+			if _, isCloseErr := err.(*websocket.CloseError); isCloseErr || !isJSONError(err) {
+				return
+			}
+
+			if !reply(&Message{Type: MessageTypeError, Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error())}) {
+				return
+			}
+			continue
+		}
+
+		switch request.Action {
+		case ActionSubscribe:
+			err = subscriber.Subscribe(request.Filter)
+		case ActionUnsubscribe:
+			err = subscriber.Unsubscribe(request.Filter)
+		default:
+			err = errors.ErrUnknownSubscriptionAction
+		}
+
+		message := &Message{Type: MessageTypeAck, Request: request}
+		if err != nil {
+			message = &Message{Type: MessageTypeError, Request: request, Error: err.Error()}
+		}
+
+		if !reply(message) {
+			return
+		}
+	}
+}
+
+// writeMessages is the only writer of the connection. It sends the events, the replies to the client's requests
+// and the keep alive pings, until the client goes away or the subscriber is dropped for being too slow
+func writeMessages(conn *websocket.Conn, subscriber *subscription.Subscriber, replies <-chan *Message, readerDone <-chan struct{}) {
+	pingTicker := time.NewTicker(pingPeriod)
+	defer pingTicker.Stop()
+
+	for {
+		var err error
+
+		select {
+		case event := <-subscriber.Events():
+			err = writeJSON(conn, &Message{Type: MessageTypeEvent, Event: event})
+		case reply := <-replies:
+			err = writeJSON(conn, reply)
+		case <-pingTicker.C:
+			_ = conn.SetWriteDeadline(time.Now().Add(writeWait))
+			err = conn.WriteMessage(websocket.PingMessage, nil)
+		case <-subscriber.Done():
+			closeMessage := websocket.FormatCloseMessage(websocket.CloseTryAgainLater, subscriber.Err().Error())
+			_ = conn.WriteControl(websocket.CloseMessage, closeMessage, time.Now().Add(writeWait))
+			return
+		case <-readerDone:
+			return
+		}
+
+		if err != nil {
+			return
+		}
+	}
+}
+
+func writeJSON(conn *websocket.Conn, message *Message) error {
+	_ = conn.SetWriteDeadline(time.Now().Add(writeWait))
+	return conn.WriteJSON(message)
+}
+
+func isJSONError(err error) bool {
+	switch err.(type) {
+	case *json.SyntaxError, *json.UnmarshalTypeError:
+		return true
+	default:
+		return false
+	}
+}
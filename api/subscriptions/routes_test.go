@@ -0,0 +1,230 @@
+package subscriptions_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	errors2 "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/subscriptions"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state/addressConverters"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+type trieStub struct {
+	data.Trie
+}
+
+func (ts *trieStub) IsInterfaceNil() bool {
+	return ts == nil
+}
+
+type marshalizerStub struct {
+	marshal.Marshalizer
+}
+
+func (ms *marshalizerStub) IsInterfaceNil() bool {
+	return ms == nil
+}
+
+type testMessage struct {
+	Type    string `json:"type"`
+	Error   string `json:"error"`
+	Request *subscriptions.Request
+	Event   *struct {
+		Topic string          `json:"topic"`
+		Data  json.RawMessage `json:"data"`
+	} `json:"event"`
+}
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+const numNotifiedBlocks = 100000
+
+func createHub(t *testing.T, bufferSize int) *subscription.Hub {
+	converter, _ := addressConverters.NewPlainAddressConverter(32, "")
+	hub, err := subscription.NewHub(subscription.ArgHub{
+		AddressConverter:         converter,
+		AccountsTrie:             &trieStub{},
+		Marshalizer:              &marshalizerStub{},
+		MaxSubscribers:           1,
+		MaxFiltersPerSubscriber:  10,
+		EventsBufferSize:         bufferSize,
+		CommittedBlocksQueueSize: numNotifiedBlocks,
+	})
+	assert.Nil(t, err)
+
+	return hub
+}
+
+func startServer(handler subscriptions.SubscriptionService) *httptest.Server {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	subscriptionsRoute := ws.Group("/subscriptions")
+	subscriptionsRoute.Use(middleware.WithElrondFacade(handler))
+	subscriptions.Routes(subscriptionsRoute)
+
+	return httptest.NewServer(ws)
+}
+
+func dial(t *testing.T, server *httptest.Server) *websocket.Conn {
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/subscriptions/ws"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	assert.Nil(t, err)
+
+	return conn
+}
+
+func readMessage(t *testing.T, conn *websocket.Conn) *testMessage {
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+	message := &testMessage{}
+	err := conn.ReadJSON(message)
+	assert.Nil(t, err)
+
+	return message
+}
+
+func waitForSubscribers(hub *subscription.Hub, expected int) {
+	for i := 0; i < 100 && hub.NumSubscribers() != expected; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestSubscribe_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("expected error")
+	server := startServer(&mock.Facade{
+		SubscribeHandler: func() (*subscription.Subscriber, error) {
+			return nil, errExpected
+		},
+	})
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/subscriptions/ws")
+	assert.Nil(t, err)
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	body := &bytes.Buffer{}
+	_, _ = body.ReadFrom(resp.Body)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Contains(t, body.String(), errors2.ErrSubscribe.Error())
+	assert.Contains(t, body.String(), errExpected.Error())
+}
+
+func TestSubscribe_ShouldAckRequestsAndSendEvents(t *testing.T) {
+	t.Parallel()
+
+	hub := createHub(t, 10)
+	server := startServer(&mock.Facade{SubscribeHandler: hub.NewSubscriber})
+	defer server.Close()
+
+	conn := dial(t, server)
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	err := conn.WriteJSON(map[string]string{"action": "subscribe", "topic": subscription.TopicHeaders})
+	assert.Nil(t, err)
+	message := readMessage(t, conn)
+	assert.Equal(t, subscriptions.MessageTypeAck, message.Type)
+	assert.Equal(t, subscription.TopicHeaders, message.Request.Topic)
+
+	err = conn.WriteJSON(map[string]string{"action": "subscribe", "topic": "unknown"})
+	assert.Nil(t, err)
+	message = readMessage(t, conn)
+	assert.Equal(t, subscriptions.MessageTypeError, message.Type)
+	assert.Equal(t, subscription.ErrUnknownTopic.Error(), message.Error)
+
+	err = conn.WriteMessage(websocket.TextMessage, []byte("not json"))
+	assert.Nil(t, err)
+	message = readMessage(t, conn)
+	assert.Equal(t, subscriptions.MessageTypeError, message.Type)
+	assert.Contains(t, message.Error, errors2.ErrValidation.Error())
+
+	hub.NotifyCommittedBlock(&block.Header{Nonce: 7}, []byte("hash"), nil)
+	message = readMessage(t, conn)
+	assert.Equal(t, subscriptions.MessageTypeEvent, message.Type)
+	assert.Equal(t, subscription.TopicHeaders, message.Event.Topic)
+	headerEvent := &subscription.HeaderEvent{}
+	_ = json.Unmarshal(message.Event.Data, headerEvent)
+	assert.Equal(t, uint64(7), headerEvent.Nonce)
+	assert.Equal(t, hex.EncodeToString([]byte("hash")), headerEvent.Hash)
+}
+
+func TestSubscribe_ClosingTheConnectionShouldRemoveTheSubscriber(t *testing.T) {
+	t.Parallel()
+
+	hub := createHub(t, 10)
+	server := startServer(&mock.Facade{SubscribeHandler: hub.NewSubscriber})
+	defer server.Close()
+
+	conn := dial(t, server)
+	waitForSubscribers(hub, 1)
+	assert.Equal(t, 1, hub.NumSubscribers())
+
+	_ = conn.Close()
+	waitForSubscribers(hub, 0)
+	assert.Equal(t, 0, hub.NumSubscribers())
+}
+
+func TestSubscribe_SlowClientShouldBeDisconnected(t *testing.T) {
+	t.Parallel()
+
+	hub := createHub(t, 1)
+	server := startServer(&mock.Facade{SubscribeHandler: hub.NewSubscriber})
+	defer server.Close()
+
+	conn := dial(t, server)
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	err := conn.WriteJSON(map[string]string{"action": "subscribe", "topic": subscription.TopicHeaders})
+	assert.Nil(t, err)
+	message := readMessage(t, conn)
+	assert.Equal(t, subscriptions.MessageTypeAck, message.Type)
+
+	// the client does not read, so the events pile up in the subscriber's buffer until it is dropped
+	for nonce := uint64(0); nonce < numNotifiedBlocks && hub.NumSubscribers() > 0; nonce++ {
+		hub.NotifyCommittedBlock(&block.Header{Nonce: nonce}, []byte("hash"), nil)
+	}
+	// the committed blocks are published in the background
+	for start := time.Now(); hub.NumSubscribers() > 0 && time.Since(start) < 5*time.Second; {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, 0, hub.NumSubscribers())
+
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+	for {
+		_, _, err = conn.ReadMessage()
+		if err != nil {
+			break
+		}
+	}
+	closeErr, ok := err.(*websocket.CloseError)
+	assert.True(t, ok)
+	if ok {
+		assert.Equal(t, websocket.CloseTryAgainLater, closeErr.Code)
+		assert.Equal(t, subscription.ErrSlowSubscriber.Error(), closeErr.Text)
+	}
+}
@@ -17,6 +17,7 @@ type TxService interface {
 	SendTransaction(nonce uint64, sender string, receiver string, value *big.Int, gasPrice uint64, gasLimit uint64, code string, signature []byte) (string, error)
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
 	GetTransaction(hash string) (*transaction.Transaction, error)
+	EncodeAddress(address []byte) (string, error)
 	IsInterfaceNil() bool
 }
 
@@ -165,14 +166,30 @@ func GetTransaction(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"transaction": txResponseFromTransaction(tx)})
+	response, err := txResponseFromTransaction(ef, tx)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetTransaction.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"transaction": response})
 }
 
-func txResponseFromTransaction(tx *transaction.Transaction) TxResponse {
+func txResponseFromTransaction(ef TxService, tx *transaction.Transaction) (TxResponse, error) {
+	sender, err := ef.EncodeAddress(tx.SndAddr)
+	if err != nil {
+		return TxResponse{}, err
+	}
+
+	receiver, err := ef.EncodeAddress(tx.RcvAddr)
+	if err != nil {
+		return TxResponse{}, err
+	}
+
 	response := TxResponse{}
 	response.Nonce = tx.Nonce
-	response.Sender = hex.EncodeToString(tx.SndAddr)
-	response.Receiver = hex.EncodeToString(tx.RcvAddr)
+	response.Sender = sender
+	response.Receiver = receiver
 	response.Data = tx.Data
 	response.Signature = hex.EncodeToString(tx.Signature)
 	response.Challenge = string(tx.Challenge)
@@ -180,5 +197,5 @@ func txResponseFromTransaction(tx *transaction.Transaction) TxResponse {
 	response.GasLimit = tx.GasLimit
 	response.GasPrice = tx.GasPrice
 
-	return response
+	return response, nil
 }
@@ -75,6 +75,64 @@ func TestGetTransaction_WithCorrectHashShouldReturnTransaction(t *testing.T) {
 	assert.Equal(t, data, txResp.Data)
 }
 
+func TestGetTransaction_ShouldReturnAddressesInFacadeFormat(t *testing.T) {
+	sender := "sender"
+	receiver := "receiver"
+	facade := mock.Facade{
+		GetTransactionHandler: func(hash string) (i *tr.Transaction, e error) {
+			return &tr.Transaction{
+				SndAddr: []byte(sender),
+				RcvAddr: []byte(receiver),
+				Value:   big.NewInt(10),
+			}, nil
+		},
+		EncodeAddressHandler: func(address []byte) (string, error) {
+			return "erd1" + string(address), nil
+		},
+	}
+
+	req, _ := http.NewRequest("GET", "/transaction/hash", nil)
+	ws := startNodeServer(&facade)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	transactionResponse := TransactionResponse{}
+	loadResponse(resp.Body, &transactionResponse)
+
+	txResp := transactionResponse.TxResp
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "erd1"+sender, txResp.Sender)
+	assert.Equal(t, "erd1"+receiver, txResp.Receiver)
+}
+
+func TestGetTransaction_EncodeAddressFailsShouldErr(t *testing.T) {
+	errExpected := errors.New("expected error")
+	facade := mock.Facade{
+		GetTransactionHandler: func(hash string) (i *tr.Transaction, e error) {
+			return &tr.Transaction{
+				SndAddr: []byte("sender"),
+				RcvAddr: []byte("receiver"),
+			}, nil
+		},
+		EncodeAddressHandler: func(address []byte) (string, error) {
+			return "", errExpected
+		},
+	}
+
+	req, _ := http.NewRequest("GET", "/transaction/hash", nil)
+	ws := startNodeServer(&facade)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	transactionResponse := TransactionResponse{}
+	loadResponse(resp.Body, &transactionResponse)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Nil(t, transactionResponse.TxResp)
+	assert.Contains(t, transactionResponse.Error, errExpected.Error())
+}
+
 func TestGetTransaction_WithUnknownHashShouldReturnNil(t *testing.T) {
 	sender := "sender"
 	receiver := "receiver"
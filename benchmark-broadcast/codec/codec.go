@@ -0,0 +1,96 @@
+package codec
+
+import (
+	"crypto/rand"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core/partitioning"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+)
+
+const numSenders = 100
+
+// Bandwidth generates numTxs transactions, packs them in batches of at most packLimit bytes the way the node
+// broadcasts bulk transactions and sends each batch through the p2p wire codec configured with the provided flags.
+// It returns the number of bytes produced by the packer, the number of bytes that would go on the wire and the
+// number of wire messages
+func Bandwidth(numTxs int, packLimit int, flags p2p.TopicFlags) (rawBytes int, wireBytes int, wireMessages int, err error) {
+	marshalizer := &marshal.JsonMarshalizer{}
+	packer, err := partitioning.NewSizeDataPacker(marshalizer)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	txs, err := generateTransactions(marshalizer, numTxs)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	packs, err := packer.PackDataInChunks(txs, packLimit)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	sender := libp2p.NewWireCodec()
+	receiver := libp2p.NewWireCodec()
+	for _, pack := range packs {
+		rawBytes += len(pack)
+
+		frames, errEncode := sender.Encode(pack, flags)
+		if errEncode != nil {
+			return 0, 0, 0, errEncode
+		}
+
+		for _, frame := range frames {
+			wireBytes += len(frame)
+			wireMessages++
+
+			_, _, err = receiver.Decode("sender", frame)
+			if err != nil {
+				return 0, 0, 0, err
+			}
+		}
+	}
+
+	return rawBytes, wireBytes, wireMessages, nil
+}
+
+func generateTransactions(marshalizer marshal.Marshalizer, numTxs int) ([][]byte, error) {
+	senders := make([][]byte, numSenders)
+	for i := range senders {
+		senders[i] = randomBytes(32)
+	}
+	receiver := randomBytes(32)
+
+	txs := make([][]byte, 0, numTxs)
+	for i := 0; i < numTxs; i++ {
+		tx := &transaction.Transaction{
+			Nonce:     uint64(i / numSenders),
+			Value:     big.NewInt(1000000),
+			RcvAddr:   receiver,
+			SndAddr:   senders[i%numSenders],
+			GasPrice:  10,
+			GasLimit:  1000,
+			Signature: randomBytes(64),
+		}
+
+		buff, err := marshalizer.Marshal(tx)
+		if err != nil {
+			return nil, err
+		}
+
+		txs = append(txs, buff)
+	}
+
+	return txs, nil
+}
+
+func randomBytes(size int) []byte {
+	buff := make([]byte, size)
+	_, _ = rand.Read(buff)
+
+	return buff
+}
@@ -0,0 +1,60 @@
+package codec_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/benchmark-broadcast/codec"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+const packLimit = 256 * 1024
+
+func TestBandwidth(t *testing.T) {
+	var tests = []struct {
+		name         string
+		flags        p2p.TopicFlags
+		maxWireRatio float64
+	}{
+		{"raw", p2p.TopicFlags{}, 1.01},
+		{"compressed", p2p.TopicFlags{CompressionThreshold: 1024}, 0.8},
+		{"compressed and chunked", p2p.TopicFlags{CompressionThreshold: 1024, ChunkSize: 64 * 1024}, 0.8},
+	}
+
+	for _, test := range tests {
+		rawBytes, wireBytes, _, err := codec.Bandwidth(2000, packLimit, test.flags)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+			continue
+		}
+
+		ratio := float64(wireBytes) / float64(rawBytes)
+		if ratio > test.maxWireRatio {
+			t.Errorf("%s: expected wire/raw ratio <= %v, actual %v", test.name, test.maxWireRatio, ratio)
+		}
+	}
+}
+
+func benchmarkBandwidth(b *testing.B, numTxs int, flags p2p.TopicFlags) {
+	rawBytes, wireBytes, wireMessages := 0, 0, 0
+	for i := 0; i < b.N; i++ {
+		var err error
+		rawBytes, wireBytes, wireMessages, err = codec.Bandwidth(numTxs, packLimit, flags)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	b.Logf("raw: %d bytes, wire: %d bytes in %d messages", rawBytes, wireBytes, wireMessages)
+}
+
+func BenchmarkBandwidth_Raw(b *testing.B) {
+	benchmarkBandwidth(b, 5000, p2p.TopicFlags{})
+}
+
+func BenchmarkBandwidth_Compressed(b *testing.B) {
+	benchmarkBandwidth(b, 5000, p2p.TopicFlags{CompressionThreshold: 1024})
+}
+
+func BenchmarkBandwidth_CompressedAndChunked(b *testing.B) {
+	benchmarkBandwidth(b, 5000, p2p.TopicFlags{CompressionThreshold: 1024, ChunkSize: 64 * 1024})
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/360EntSecGroup-Skylar/excelize"
 	"github.com/ElrondNetwork/elrond-go/benchmark-broadcast/broadcast"
+	"github.com/ElrondNetwork/elrond-go/benchmark-broadcast/codec"
+	"github.com/ElrondNetwork/elrond-go/p2p"
 )
 
 func main() {
@@ -118,3 +120,54 @@ func interShard() {
 		}
 	}
 }
+
+func codecBandwidth() {
+	xlsx := excelize.NewFile()
+	index := xlsx.NewSheet("Sheet1")
+	xlsx.SetCellValue("Sheet1", "A1", "Number of txs")
+	xlsx.SetCellValue("Sheet1", "B1", "Pack size (kB)")
+	xlsx.SetCellValue("Sheet1", "C1", "Compression threshold (B)")
+	xlsx.SetCellValue("Sheet1", "D1", "Chunk size (kB)")
+
+	xlsx.SetCellValue("Sheet1", "E1", "Raw size (kB)")
+	xlsx.SetCellValue("Sheet1", "F1", "Wire size (kB)")
+	xlsx.SetCellValue("Sheet1", "G1", "Wire messages")
+
+	row := 2
+	for numTxs := 1000; numTxs <= 50000; numTxs *= 5 {
+		for packSize := 256; packSize <= 1024; packSize *= 2 {
+			for _, threshold := range []int{0, 1024} {
+				for _, chunkSize := range []int{0, 64, 256} {
+					flags := p2p.TopicFlags{
+						CompressionThreshold: threshold,
+						ChunkSize:            chunkSize * 1024,
+					}
+
+					rawBytes, wireBytes, wireMessages, err := codec.Bandwidth(numTxs, packSize*1024, flags)
+					if err != nil {
+						fmt.Println(err)
+						continue
+					}
+
+					xlsx.SetCellValue("Sheet1", "A"+strconv.Itoa(row), numTxs)
+					xlsx.SetCellValue("Sheet1", "B"+strconv.Itoa(row), packSize)
+					xlsx.SetCellValue("Sheet1", "C"+strconv.Itoa(row), threshold)
+					xlsx.SetCellValue("Sheet1", "D"+strconv.Itoa(row), chunkSize)
+
+					xlsx.SetCellValue("Sheet1", "E"+strconv.Itoa(row), rawBytes/1024)
+					xlsx.SetCellValue("Sheet1", "F"+strconv.Itoa(row), wireBytes/1024)
+					xlsx.SetCellValue("Sheet1", "G"+strconv.Itoa(row), wireMessages)
+
+					row++
+				}
+			}
+		}
+	}
+
+	xlsx.SetActiveSheet(index)
+
+	err := xlsx.SaveAs("./benchmark-codec.xlsx")
+	if err != nil {
+		fmt.Println(err)
+	}
+}
@@ -0,0 +1,259 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/consensusreplay/replay"
+	"github.com/ElrondNetwork/elrond-go/consensus/recorder"
+	"github.com/ElrondNetwork/elrond-go/display"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/urfave/cli"
+)
+
+var (
+	replayHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	records = cli.StringFlag{
+		Name:  "records",
+		Usage: "Consensus records file or the folder in which the node wrote its consensus records files",
+		Value: "./consensus",
+	}
+	recordsPrefix = cli.StringFlag{
+		Name:  "records-prefix",
+		Usage: "Prefix of the consensus records files, used when the records flag points to a folder",
+		Value: "",
+	}
+	round = cli.Int64Flag{
+		Name:  "round",
+		Usage: "Round to be replayed. If not set, all the rounds which did not complete are replayed",
+		Value: -1,
+	}
+	consensusType = cli.StringFlag{
+		Name:  "consensus-type",
+		Usage: "Consensus type used by the recording node",
+		Value: "bls",
+	}
+	numOfShards = cli.UintFlag{
+		Name:  "num-of-shards",
+		Usage: "Number of shards of the network in which the round was recorded",
+		Value: 1,
+	}
+	shardId = cli.UintFlag{
+		Name:  "shard-id",
+		Usage: "Shard of the recording node. The metachain is 4294967295",
+		Value: 0,
+	}
+	roundDuration = cli.Uint64Flag{
+		Name:  "round-duration",
+		Usage: "Round duration in milliseconds, as set in the nodes setup file",
+		Value: 4000,
+	}
+	hasherType = cli.StringFlag{
+		Name:  "hasher",
+		Usage: "Hasher type used by the recording node",
+		Value: "blake2b",
+	}
+
+	errNoRoundToReplay = errors.New("no round to replay")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = replayHelpTemplate
+	app.Name = "Consensus replay Tool"
+	app.Version = "v0.0.1"
+	app.Usage = "This binary replays the consensus rounds recorded by a node and reports in which subround and " +
+		"because of which validators the rounds did not complete"
+	app.Flags = []cli.Flag{records, recordsPrefix, round, consensusType, numOfShards, shardId, roundDuration, hasherType}
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+
+	app.Action = func(c *cli.Context) error {
+		return replayRounds(c)
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		fmt.Println(err.Error())
+		os.Exit(1)
+	}
+}
+
+func replayRounds(ctx *cli.Context) error {
+	roundRecords, err := loadRoundRecords(ctx.GlobalString(records.Name), ctx.GlobalString(recordsPrefix.Name))
+	if err != nil {
+		return err
+	}
+
+	roundRecords = selectRoundRecords(roundRecords, ctx.GlobalInt64(round.Name))
+	if len(roundRecords) == 0 {
+		return errNoRoundToReplay
+	}
+
+	hasher, err := createHasher(ctx.GlobalString(hasherType.Name))
+	if err != nil {
+		return err
+	}
+
+	shardCoordinator, err := sharding.NewMultiShardCoordinator(
+		uint32(ctx.GlobalUint(numOfShards.Name)),
+		uint32(ctx.GlobalUint(shardId.Name)),
+	)
+	if err != nil {
+		return err
+	}
+
+	replayer, err := replay.NewReplayer(
+		ctx.GlobalString(consensusType.Name),
+		shardCoordinator,
+		time.Duration(ctx.GlobalUint64(roundDuration.Name))*time.Millisecond,
+		hasher,
+		&marshal.JsonMarshalizer{},
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, roundRecord := range roundRecords {
+		report, err := replayer.Replay(roundRecord)
+		if err != nil {
+			fmt.Printf("round %d could not be replayed: %s\n", roundRecord.RoundIndex, err.Error())
+			continue
+		}
+
+		displayReport(report)
+	}
+
+	return nil
+}
+
+func loadRoundRecords(path string, prefix string) ([]*recorder.RoundRecord, error) {
+	fileInfo, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !fileInfo.IsDir() {
+		return recorder.LoadRoundRecords(path)
+	}
+
+	filePaths, err := recorder.RecordFiles(filepath.Clean(path), prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	return recorder.LoadRoundRecords(filePaths...)
+}
+
+func selectRoundRecords(roundRecords []*recorder.RoundRecord, roundIndex int64) []*recorder.RoundRecord {
+	selected := make([]*recorder.RoundRecord, 0)
+	for _, roundRecord := range roundRecords {
+		if roundIndex >= 0 && roundRecord.RoundIndex == roundIndex {
+			return []*recorder.RoundRecord{roundRecord}
+		}
+
+		if roundIndex < 0 && roundRecord.Outcome != recorder.OutcomeCompleted {
+			selected = append(selected, roundRecord)
+		}
+	}
+
+	return selected
+}
+
+func createHasher(hasherType string) (hashing.Hasher, error) {
+	switch hasherType {
+	case "sha256":
+		return sha256.Sha256{}, nil
+	case "blake2b":
+		return blake2b.Blake2b{}, nil
+	}
+
+	return nil, errors.New("unknown hasher type " + hasherType)
+}
+
+func displayReport(report *replay.RoundReport) {
+	header := []string{"Subround", "Finished", "Ended in recording", "Jobs done", "Missing", "Late messages"}
+	dataLines := make([]*display.LineData, 0, len(report.Subrounds))
+	for _, subroundReport := range report.Subrounds {
+		dataLines = append(dataLines, display.NewLineData(false, []string{
+			subroundReport.SubroundName,
+			fmt.Sprintf("%t", subroundReport.IsFinished),
+			fmt.Sprintf("%t", subroundReport.IsEndedInRecording),
+			fmt.Sprintf("%d/%d", subroundReport.NumJobsDone, subroundReport.Threshold),
+			displayPubKeys(subroundReport.MissingPubKeys),
+			displayPubKeys(subroundReport.LateMessagesPubKeys),
+		}))
+	}
+
+	fmt.Printf("Round %d, self %s, recorded outcome: %s\n",
+		report.RoundIndex,
+		shortHex(report.SelfPubKey),
+		report.RecordedOutcome)
+
+	tblString, err := display.CreateTableString(header, dataLines)
+	if err != nil {
+		fmt.Println(err.Error())
+		return
+	}
+	fmt.Println(tblString)
+
+	for _, rejectedMessage := range report.RejectedMessages {
+		fmt.Printf("Rejected %s from %s: %s\n",
+			rejectedMessage.MessageType,
+			shortHex(rejectedMessage.PubKey),
+			rejectedMessage.Reason)
+	}
+
+	quorumLostIn := report.QuorumLostIn()
+	if quorumLostIn != nil {
+		fmt.Printf("Quorum lost in %s: %d of %d jobs done\n\n",
+			quorumLostIn.SubroundName,
+			quorumLostIn.NumJobsDone,
+			quorumLostIn.Threshold)
+	}
+}
+
+func displayPubKeys(pubKeys [][]byte) string {
+	shortPubKeys := make([]string, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		shortPubKeys[i] = shortHex(pubKey)
+	}
+
+	return strings.Join(shortPubKeys, " ")
+}
+
+func shortHex(buff []byte) string {
+	const maxShownBytes = 6
+	if len(buff) > maxShownBytes {
+		buff = buff[:maxShownBytes]
+	}
+
+	return hex.EncodeToString(buff)
+}
@@ -0,0 +1,21 @@
+package replay
+
+import (
+	"errors"
+)
+
+// ErrNilRoundRecord signals that a nil round record has been provided
+var ErrNilRoundRecord = errors.New("nil round record")
+
+// ErrRecordWithoutConsensusState signals that the round record does not hold the consensus group, which happens for
+// the rounds in which the recording node did not start any subround
+var ErrRecordWithoutConsensusState = errors.New("round record without consensus state")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrNoSubroundGenerated signals that the subrounds factory did not generate any subround
+var ErrNoSubroundGenerated = errors.New("no subround generated")
+
+// ErrUnexpectedCall signals that a component not needed during a replay has been called
+var ErrUnexpectedCall = errors.New("unexpected call during replay")
@@ -0,0 +1,142 @@
+package replay
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// replayBlockProcessor accepts every proposed block, as the recording does not hold the state needed to process it.
+// When the recording node was the leader, the block it proposed is created again from its recorded messages
+type replayBlockProcessor struct {
+	marshalizer    marshal.Marshalizer
+	isMetachain    bool
+	proposedBody   []byte
+	proposedHeader []byte
+}
+
+// ProcessBlock accepts the block
+func (rbp *replayBlockProcessor) ProcessBlock(
+	blockChain data.ChainHandler,
+	header data.HeaderHandler,
+	body data.BodyHandler,
+	haveTime func() time.Duration,
+) error {
+	return nil
+}
+
+// CommitBlock accepts the block
+func (rbp *replayBlockProcessor) CommitBlock(blockChain data.ChainHandler, header data.HeaderHandler, body data.BodyHandler) error {
+	return nil
+}
+
+// RevertAccountState does nothing
+func (rbp *replayBlockProcessor) RevertAccountState() {
+}
+
+// CreateBlockBody returns the recorded proposed block body or an empty one
+func (rbp *replayBlockProcessor) CreateBlockBody(round uint64, haveTime func() bool) (data.BodyHandler, error) {
+	body := rbp.DecodeBlockBody(rbp.proposedBody)
+	if body == nil {
+		return rbp.emptyBody(), nil
+	}
+
+	return body, nil
+}
+
+// RestoreBlockIntoPools does nothing
+func (rbp *replayBlockProcessor) RestoreBlockIntoPools(header data.HeaderHandler, body data.BodyHandler) error {
+	return nil
+}
+
+// CreateBlockHeader returns the recorded proposed block header or an empty one
+func (rbp *replayBlockProcessor) CreateBlockHeader(body data.BodyHandler, round uint64, haveTime func() bool) (data.HeaderHandler, error) {
+	header := rbp.DecodeBlockHeader(rbp.proposedHeader)
+	if header == nil {
+		return rbp.emptyHeader(), nil
+	}
+
+	return header, nil
+}
+
+// MarshalizedDataToBroadcast returns nothing to broadcast
+func (rbp *replayBlockProcessor) MarshalizedDataToBroadcast(
+	header data.HeaderHandler,
+	body data.BodyHandler,
+) (map[uint32][]byte, map[string][][]byte, error) {
+	return make(map[uint32][]byte), make(map[string][][]byte), nil
+}
+
+// DecodeBlockBody decodes the block body as the shard or the metachain block processor does
+func (rbp *replayBlockProcessor) DecodeBlockBody(dta []byte) data.BodyHandler {
+	if dta == nil {
+		return nil
+	}
+
+	if rbp.isMetachain {
+		var body block.MetaBlockBody
+		err := rbp.marshalizer.Unmarshal(&body, dta)
+		if err != nil {
+			return nil
+		}
+
+		return &body
+	}
+
+	var body block.Body
+	err := rbp.marshalizer.Unmarshal(&body, dta)
+	if err != nil {
+		return nil
+	}
+
+	return body
+}
+
+// DecodeBlockHeader decodes the block header as the shard or the metachain block processor does
+func (rbp *replayBlockProcessor) DecodeBlockHeader(dta []byte) data.HeaderHandler {
+	if dta == nil {
+		return nil
+	}
+
+	header := rbp.emptyHeader()
+	err := rbp.marshalizer.Unmarshal(header, dta)
+	if err != nil {
+		return nil
+	}
+
+	return header
+}
+
+// AddLastNotarizedHdr does nothing
+func (rbp *replayBlockProcessor) AddLastNotarizedHdr(shardId uint32, processedHdr data.HeaderHandler) {
+}
+
+// SetConsensusData does nothing
+func (rbp *replayBlockProcessor) SetConsensusData(randomness []byte, round uint64, epoch uint32, shardId uint32) {
+}
+
+func (rbp *replayBlockProcessor) emptyBody() data.BodyHandler {
+	if rbp.isMetachain {
+		return &block.MetaBlockBody{}
+	}
+
+	return make(block.Body, 0)
+}
+
+func (rbp *replayBlockProcessor) emptyHeader() data.HeaderHandler {
+	if rbp.isMetachain {
+		return &block.MetaBlock{}
+	}
+
+	return &block.Header{}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rbp *replayBlockProcessor) IsInterfaceNil() bool {
+	if rbp == nil {
+		return true
+	}
+	return false
+}
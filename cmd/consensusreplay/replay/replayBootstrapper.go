@@ -0,0 +1,39 @@
+package replay
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+// replayBootstrapper considers the node always synchronized
+type replayBootstrapper struct {
+}
+
+// AddSyncStateListener does nothing
+func (rb *replayBootstrapper) AddSyncStateListener(func(isSyncing bool)) {
+}
+
+// ShouldSync returns false as the replayed node is considered synchronized
+func (rb *replayBootstrapper) ShouldSync() bool {
+	return false
+}
+
+// StopSync does nothing
+func (rb *replayBootstrapper) StopSync() {
+}
+
+// StartSync does nothing
+func (rb *replayBootstrapper) StartSync() {
+}
+
+// SetStatusHandler does nothing
+func (rb *replayBootstrapper) SetStatusHandler(handler core.AppStatusHandler) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rb *replayBootstrapper) IsInterfaceNil() bool {
+	if rb == nil {
+		return true
+	}
+	return false
+}
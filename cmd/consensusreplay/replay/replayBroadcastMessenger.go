@@ -0,0 +1,50 @@
+package replay
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// replayBroadcastMessenger drops everything, as the messages sent by the recording node are already in the recording
+type replayBroadcastMessenger struct {
+}
+
+// BroadcastBlock does nothing
+func (rbm *replayBroadcastMessenger) BroadcastBlock(data.BodyHandler, data.HeaderHandler) error {
+	return nil
+}
+
+// BroadcastHeader does nothing
+func (rbm *replayBroadcastMessenger) BroadcastHeader(data.HeaderHandler) error {
+	return nil
+}
+
+// BroadcastMiniBlocks does nothing
+func (rbm *replayBroadcastMessenger) BroadcastMiniBlocks(map[uint32][]byte) error {
+	return nil
+}
+
+// BroadcastTransactions does nothing
+func (rbm *replayBroadcastMessenger) BroadcastTransactions(map[string][][]byte) error {
+	return nil
+}
+
+// BroadcastConsensusMessage does nothing
+func (rbm *replayBroadcastMessenger) BroadcastConsensusMessage(*consensus.Message) error {
+	return nil
+}
+
+// SetDelayedBroadcastData does nothing
+func (rbm *replayBroadcastMessenger) SetDelayedBroadcastData(delayedData *consensus.DelayedBroadcastData, delay time.Duration) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rbm *replayBroadcastMessenger) IsInterfaceNil() bool {
+	if rbm == nil {
+		return true
+	}
+	return false
+}
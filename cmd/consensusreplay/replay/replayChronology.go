@@ -0,0 +1,43 @@
+package replay
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+// replayChronology only keeps the generated subrounds, which are then driven by the replayer
+type replayChronology struct {
+	subroundHandlers []consensus.SubroundHandler
+}
+
+// AddSubround keeps the provided subround
+func (rc *replayChronology) AddSubround(subroundHandler consensus.SubroundHandler) {
+	rc.subroundHandlers = append(rc.subroundHandlers, subroundHandler)
+}
+
+// RemoveAllSubrounds removes all the kept subrounds
+func (rc *replayChronology) RemoveAllSubrounds() {
+	rc.subroundHandlers = make([]consensus.SubroundHandler, 0)
+}
+
+// StartRounds does nothing as the subrounds are driven by the replayer
+func (rc *replayChronology) StartRounds() {
+}
+
+// subround returns the kept subround with the provided id
+func (rc *replayChronology) subround(subroundId int) consensus.SubroundHandler {
+	for _, subroundHandler := range rc.subroundHandlers {
+		if subroundHandler.Current() == subroundId {
+			return subroundHandler
+		}
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rc *replayChronology) IsInterfaceNil() bool {
+	if rc == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,129 @@
+package replay
+
+import (
+	"github.com/ElrondNetwork/elrond-go/crypto"
+)
+
+// replayMultiSigner accepts every signature share and commitment, as the recording holds the signatures of the
+// proposed block but not the keys needed to check them again. It implements the belare neven extension as well, so
+// it can be used by both the bls and bn subrounds
+type replayMultiSigner struct {
+	signatureShares  map[uint16][]byte
+	commitments      map[uint16][]byte
+	commitmentHashes map[uint16][]byte
+}
+
+func newReplayMultiSigner() *replayMultiSigner {
+	rms := &replayMultiSigner{}
+	_ = rms.Reset(nil, 0)
+
+	return rms
+}
+
+// Create returns the same multi signer
+func (rms *replayMultiSigner) Create(pubKeys []string, index uint16) (crypto.MultiSigner, error) {
+	return rms, nil
+}
+
+// SetAggregatedSig accepts the aggregated signature
+func (rms *replayMultiSigner) SetAggregatedSig([]byte) error {
+	return nil
+}
+
+// Verify accepts the aggregated signature
+func (rms *replayMultiSigner) Verify(msg []byte, bitmap []byte) error {
+	return nil
+}
+
+// Reset removes all the stored signature shares and commitments
+func (rms *replayMultiSigner) Reset(pubKeys []string, index uint16) error {
+	rms.signatureShares = make(map[uint16][]byte)
+	rms.commitments = make(map[uint16][]byte)
+	rms.commitmentHashes = make(map[uint16][]byte)
+
+	return nil
+}
+
+// CreateSignatureShare returns a placeholder signature share
+func (rms *replayMultiSigner) CreateSignatureShare(msg []byte, bitmap []byte) ([]byte, error) {
+	return []byte("replayed signature share"), nil
+}
+
+// StoreSignatureShare stores the signature share of the provided index
+func (rms *replayMultiSigner) StoreSignatureShare(index uint16, sig []byte) error {
+	rms.signatureShares[index] = sig
+
+	return nil
+}
+
+// SignatureShare returns the stored signature share of the provided index
+func (rms *replayMultiSigner) SignatureShare(index uint16) ([]byte, error) {
+	sig, ok := rms.signatureShares[index]
+	if !ok {
+		return nil, crypto.ErrNilElement
+	}
+
+	return sig, nil
+}
+
+// VerifySignatureShare accepts the signature share
+func (rms *replayMultiSigner) VerifySignatureShare(index uint16, sig []byte, msg []byte, bitmap []byte) error {
+	return nil
+}
+
+// AggregateSigs returns a placeholder aggregated signature
+func (rms *replayMultiSigner) AggregateSigs(bitmap []byte) ([]byte, error) {
+	return []byte("replayed aggregated signature"), nil
+}
+
+// CreateCommitment returns a placeholder commitment
+func (rms *replayMultiSigner) CreateCommitment() (commSecret []byte, commitment []byte) {
+	return []byte("replayed commitment secret"), []byte("replayed commitment")
+}
+
+// StoreCommitmentHash stores the commitment hash of the provided index
+func (rms *replayMultiSigner) StoreCommitmentHash(index uint16, commHash []byte) error {
+	rms.commitmentHashes[index] = commHash
+
+	return nil
+}
+
+// CommitmentHash returns the stored commitment hash of the provided index
+func (rms *replayMultiSigner) CommitmentHash(index uint16) ([]byte, error) {
+	commHash, ok := rms.commitmentHashes[index]
+	if !ok {
+		return nil, crypto.ErrNilElement
+	}
+
+	return commHash, nil
+}
+
+// StoreCommitment stores the commitment of the provided index
+func (rms *replayMultiSigner) StoreCommitment(index uint16, value []byte) error {
+	rms.commitments[index] = value
+
+	return nil
+}
+
+// Commitment returns the stored commitment of the provided index
+func (rms *replayMultiSigner) Commitment(index uint16) ([]byte, error) {
+	commitment, ok := rms.commitments[index]
+	if !ok {
+		return nil, crypto.ErrNilElement
+	}
+
+	return commitment, nil
+}
+
+// AggregateCommitments accepts the commitments
+func (rms *replayMultiSigner) AggregateCommitments(bitmap []byte) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rms *replayMultiSigner) IsInterfaceNil() bool {
+	if rms == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,118 @@
+package replay
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// replayNodesCoordinator always selects the recorded consensus group
+type replayNodesCoordinator struct {
+	consensusGroup []sharding.Validator
+}
+
+func newReplayNodesCoordinator(consensusGroup [][]byte) (*replayNodesCoordinator, error) {
+	validators := make([]sharding.Validator, 0, len(consensusGroup))
+	for _, pubKey := range consensusGroup {
+		v, err := sharding.NewValidator(big.NewInt(0), 0, pubKey, pubKey)
+		if err != nil {
+			return nil, err
+		}
+
+		validators = append(validators, v)
+	}
+
+	return &replayNodesCoordinator{
+		consensusGroup: validators,
+	}, nil
+}
+
+// GetValidatorsIndexes returns the indexes of the provided public keys in the recorded consensus group
+func (rnc *replayNodesCoordinator) GetValidatorsIndexes(publicKeys []string) []uint64 {
+	indexes := make([]uint64, 0)
+	for _, pubKey := range publicKeys {
+		for i, v := range rnc.consensusGroup {
+			if string(v.PubKey()) == pubKey {
+				indexes = append(indexes, uint64(i))
+			}
+		}
+	}
+
+	return indexes
+}
+
+// GetAllValidatorsPublicKeys returns the public keys of the recorded consensus group
+func (rnc *replayNodesCoordinator) GetAllValidatorsPublicKeys() map[uint32][][]byte {
+	return map[uint32][][]byte{0: rnc.publicKeys()}
+}
+
+// GetSelectedPublicKeys returns the public keys of the recorded consensus group
+func (rnc *replayNodesCoordinator) GetSelectedPublicKeys(selection []byte, shardId uint32) ([]string, error) {
+	return rnc.publicKeysAsStrings(), nil
+}
+
+// GetValidatorsPublicKeys returns the public keys of the recorded consensus group
+func (rnc *replayNodesCoordinator) GetValidatorsPublicKeys(randomness []byte, round uint64, shardId uint32) ([]string, error) {
+	return rnc.publicKeysAsStrings(), nil
+}
+
+// GetValidatorsRewardsAddresses returns the public keys of the recorded consensus group
+func (rnc *replayNodesCoordinator) GetValidatorsRewardsAddresses(randomness []byte, round uint64, shardId uint32) ([]string, error) {
+	return rnc.publicKeysAsStrings(), nil
+}
+
+// GetOwnPublicKey returns nil as it is not needed during a replay
+func (rnc *replayNodesCoordinator) GetOwnPublicKey() []byte {
+	return nil
+}
+
+// SetNodesPerShards returns an error as the validators can not be changed during a replay
+func (rnc *replayNodesCoordinator) SetNodesPerShards(nodes map[uint32][]sharding.Validator) error {
+	return ErrUnexpectedCall
+}
+
+// ComputeValidatorsGroup returns the recorded consensus group
+func (rnc *replayNodesCoordinator) ComputeValidatorsGroup(
+	randomness []byte,
+	round uint64,
+	shardId uint32,
+) ([]sharding.Validator, error) {
+	return rnc.consensusGroup, nil
+}
+
+// GetValidatorWithPublicKey returns the validator from the recorded consensus group with the provided public key
+func (rnc *replayNodesCoordinator) GetValidatorWithPublicKey(publicKey []byte) (sharding.Validator, uint32, error) {
+	for _, v := range rnc.consensusGroup {
+		if string(v.PubKey()) == string(publicKey) {
+			return v, 0, nil
+		}
+	}
+
+	return nil, 0, sharding.ErrValidatorNotFound
+}
+
+func (rnc *replayNodesCoordinator) publicKeys() [][]byte {
+	pubKeys := make([][]byte, len(rnc.consensusGroup))
+	for i, v := range rnc.consensusGroup {
+		pubKeys[i] = v.PubKey()
+	}
+
+	return pubKeys
+}
+
+func (rnc *replayNodesCoordinator) publicKeysAsStrings() []string {
+	pubKeys := make([]string, len(rnc.consensusGroup))
+	for i, v := range rnc.consensusGroup {
+		pubKeys[i] = string(v.PubKey())
+	}
+
+	return pubKeys
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rnc *replayNodesCoordinator) IsInterfaceNil() bool {
+	if rnc == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,45 @@
+package replay
+
+import (
+	"time"
+)
+
+// replayRounder is a rounder frozen in the replayed round. As the replay does not wait for messages, the remaining
+// time is always zero, so each subround ends right after its job and its consensus check
+type replayRounder struct {
+	index        int64
+	timeStamp    time.Time
+	timeDuration time.Duration
+}
+
+// Index returns the index of the replayed round
+func (rr *replayRounder) Index() int64 {
+	return rr.index
+}
+
+// UpdateRound does nothing as the replay does not leave the recorded round
+func (rr *replayRounder) UpdateRound(time.Time, time.Time) {
+}
+
+// TimeStamp returns the time stamp of the replayed round
+func (rr *replayRounder) TimeStamp() time.Time {
+	return rr.timeStamp
+}
+
+// TimeDuration returns the duration of the replayed round
+func (rr *replayRounder) TimeDuration() time.Duration {
+	return rr.timeDuration
+}
+
+// RemainingTime returns zero, as the recorded messages are not received in real time
+func (rr *replayRounder) RemainingTime(startTime time.Time, maxTime time.Duration) time.Duration {
+	return 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rr *replayRounder) IsInterfaceNil() bool {
+	if rr == nil {
+		return true
+	}
+	return false
+}
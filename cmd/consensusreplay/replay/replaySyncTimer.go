@@ -0,0 +1,40 @@
+package replay
+
+import (
+	"fmt"
+	"time"
+)
+
+// replaySyncTimer returns the time stamp of the replayed round as the current time
+type replaySyncTimer struct {
+	currentTime time.Time
+}
+
+// StartSync does nothing
+func (rst *replaySyncTimer) StartSync() {
+}
+
+// ClockOffset returns zero
+func (rst *replaySyncTimer) ClockOffset() time.Duration {
+	return 0
+}
+
+// FormattedCurrentTime returns the formatted time stamp of the replayed round
+func (rst *replaySyncTimer) FormattedCurrentTime() string {
+	t := rst.currentTime
+	return fmt.Sprintf("%.4d-%.2d-%.2d %.2d:%.2d:%.2d.%.9d ", t.Year(), t.Month(), t.Day(), t.Hour(),
+		t.Minute(), t.Second(), t.Nanosecond())
+}
+
+// CurrentTime returns the time stamp of the replayed round
+func (rst *replaySyncTimer) CurrentTime() time.Time {
+	return rst.currentTime
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rst *replaySyncTimer) IsInterfaceNil() bool {
+	if rst == nil {
+		return true
+	}
+	return false
+}
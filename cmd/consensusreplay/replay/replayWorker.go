@@ -0,0 +1,81 @@
+package replay
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// replayWorker keeps the received message calls registered by the subrounds and executes the recorded messages
+// synchronously, in the order decided by the replayer, instead of receiving them from the network
+type replayWorker struct {
+	consensusService             spos.ConsensusService
+	consensusState               *spos.ConsensusState
+	receivedMessagesCalls        map[consensus.MessageType]func(*consensus.Message) bool
+	consensusStateChangedChannel chan bool
+}
+
+func newReplayWorker(consensusService spos.ConsensusService, consensusState *spos.ConsensusState) *replayWorker {
+	return &replayWorker{
+		consensusService:             consensusService,
+		consensusState:               consensusState,
+		receivedMessagesCalls:        make(map[consensus.MessageType]func(*consensus.Message) bool),
+		consensusStateChangedChannel: make(chan bool, 1),
+	}
+}
+
+// AddReceivedMessageCall adds a new handler function for a received message type
+func (rw *replayWorker) AddReceivedMessageCall(messageType consensus.MessageType, receivedMessageCall func(cnsDta *consensus.Message) bool) {
+	rw.receivedMessagesCalls[messageType] = receivedMessageCall
+}
+
+// RemoveAllReceivedMessagesCalls removes all the functions handlers
+func (rw *replayWorker) RemoveAllReceivedMessagesCalls() {
+	rw.receivedMessagesCalls = make(map[consensus.MessageType]func(*consensus.Message) bool)
+}
+
+// ProcessReceivedMessage returns an error as the replay does not receive messages from the network
+func (rw *replayWorker) ProcessReceivedMessage(message p2p.MessageP2P, broadcastHandler func(buffToSend []byte)) error {
+	return ErrUnexpectedCall
+}
+
+// Extend does nothing as the replay does not continue with the next rounds
+func (rw *replayWorker) Extend(subroundId int) {
+}
+
+// GetConsensusStateChangedChannel returns a channel which is never written, so a subround ends right after its job
+// and its consensus check
+func (rw *replayWorker) GetConsensusStateChangedChannel() chan bool {
+	return rw.consensusStateChangedChannel
+}
+
+// ExecuteStoredMessages does nothing as the stored messages are executed by the replayer
+func (rw *replayWorker) ExecuteStoredMessages() {
+}
+
+// canExecute returns true if the message can be executed in the current state, as the worker checks it
+func (rw *replayWorker) canExecute(cnsDta *consensus.Message) bool {
+	if rw.consensusState.RoundIndex != cnsDta.RoundIndex {
+		return false
+	}
+
+	return rw.consensusService.CanProceed(rw.consensusState, consensus.MessageType(cnsDta.MsgType))
+}
+
+// execute calls the subround handler registered for the message type and returns its result
+func (rw *replayWorker) execute(cnsDta *consensus.Message) bool {
+	callReceivedMessage, exist := rw.receivedMessagesCalls[consensus.MessageType(cnsDta.MsgType)]
+	if !exist {
+		return false
+	}
+
+	return callReceivedMessage(cnsDta)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rw *replayWorker) IsInterfaceNil() bool {
+	if rw == nil {
+		return true
+	}
+	return false
+}
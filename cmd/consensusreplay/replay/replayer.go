@@ -0,0 +1,443 @@
+package replay
+
+import (
+	"sort"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/recorder"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/blockchain"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+)
+
+const badBlocksCacheSize = 10
+
+// replayer runs a recorded round through the bls or bn subrounds, built by the same factory used by the node, but
+// with components which accept everything the recording can not verify again. The recorded messages are executed in
+// the order they were received and a subround only sees the messages received before it ended in the recording
+type replayer struct {
+	consensusType    string
+	shardCoordinator sharding.Coordinator
+	roundDuration    time.Duration
+	hasher           hashing.Hasher
+	marshalizer      marshal.Marshalizer
+}
+
+// NewReplayer creates a new replayer for the provided consensus type. The hasher and the marshalizer have to be
+// the ones used by the recording node, so the proposed block has the same hash when created again
+func NewReplayer(
+	consensusType string,
+	shardCoordinator sharding.Coordinator,
+	roundDuration time.Duration,
+	hasher hashing.Hasher,
+	marshalizer marshal.Marshalizer,
+) (*replayer, error) {
+
+	_, err := sposFactory.GetConsensusCoreFactory(consensusType)
+	if err != nil {
+		return nil, err
+	}
+	if shardCoordinator == nil || shardCoordinator.IsInterfaceNil() {
+		return nil, ErrNilShardCoordinator
+	}
+	if hasher == nil || hasher.IsInterfaceNil() {
+		return nil, spos.ErrNilHasher
+	}
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, spos.ErrNilMarshalizer
+	}
+
+	return &replayer{
+		consensusType:    consensusType,
+		shardCoordinator: shardCoordinator,
+		roundDuration:    roundDuration,
+		hasher:           hasher,
+		marshalizer:      marshalizer,
+	}, nil
+}
+
+// Replay runs the provided round record through the subrounds and reports their state
+func (r *replayer) Replay(record *recorder.RoundRecord) (*RoundReport, error) {
+	if record == nil {
+		return nil, ErrNilRoundRecord
+	}
+	if len(record.ConsensusGroup) == 0 {
+		return nil, ErrRecordWithoutConsensusState
+	}
+
+	consensusService, err := sposFactory.GetConsensusCoreFactory(r.consensusType)
+	if err != nil {
+		return nil, err
+	}
+
+	consensusState := createConsensusState(record)
+	worker := newReplayWorker(consensusService, consensusState)
+	chronology := &replayChronology{}
+
+	proposedHeader, proposedBody := proposedBlock(record, consensusService)
+	blockProcessor := &replayBlockProcessor{
+		marshalizer:    r.marshalizer,
+		isMetachain:    r.shardCoordinator.SelfId() == sharding.MetachainShardId,
+		proposedHeader: proposedHeader,
+		proposedBody:   proposedBody,
+	}
+	header := blockProcessor.DecodeBlockHeader(proposedHeader)
+
+	consensusCore, err := r.createConsensusCore(record, blockProcessor, header, chronology)
+	if err != nil {
+		return nil, err
+	}
+
+	subroundsFactory, err := sposFactory.GetSubroundsFactory(
+		consensusCore,
+		consensusState,
+		worker,
+		r.consensusType,
+		statusHandler.NewNilStatusHandler(),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = subroundsFactory.GenerateSubrounds()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(chronology.subroundHandlers) == 0 {
+		return nil, ErrNoSubroundGenerated
+	}
+
+	return r.run(record, consensusCore.Rounder(), consensusService, consensusState, worker, chronology), nil
+}
+
+func (r *replayer) createConsensusCore(
+	record *recorder.RoundRecord,
+	blockProcessor *replayBlockProcessor,
+	header data.HeaderHandler,
+	chronology *replayChronology,
+) (*spos.ConsensusCore, error) {
+
+	blockChain, err := createBlockChain(blockProcessor, header)
+	if err != nil {
+		return nil, err
+	}
+
+	nodesCoordinator, err := newReplayNodesCoordinator(record.ConsensusGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	randSeed := make([]byte, 0)
+	if header != nil {
+		randSeed = header.GetRandSeed()
+	}
+
+	rounder := &replayRounder{
+		index:        record.RoundIndex,
+		timeStamp:    roundTimeStamp(record, header),
+		timeDuration: r.roundDuration,
+	}
+
+	//the randomness private key is only passed to the randomness signer, which returns the recorded random seed
+	randomnessPrivateKey, _ := signing.NewKeyGenerator(kyber.NewBlakeSHA256Ed25519()).GeneratePair()
+
+	return spos.NewConsensusCore(
+		blockChain,
+		blockProcessor,
+		&replayBootstrapper{},
+		&replayBroadcastMessenger{},
+		chronology,
+		r.hasher,
+		r.marshalizer,
+		randomnessPrivateKey,
+		&replaySingleSigner{randSeed: randSeed},
+		newReplayMultiSigner(),
+		rounder,
+		r.shardCoordinator,
+		nodesCoordinator,
+		&replaySyncTimer{currentTime: rounder.timeStamp},
+	)
+}
+
+// run follows the subrounds chain as the chronology does, stopping at the first subround which does not finish
+func (r *replayer) run(
+	record *recorder.RoundRecord,
+	rounder consensus.Rounder,
+	consensusService spos.ConsensusService,
+	consensusState *spos.ConsensusState,
+	worker *replayWorker,
+	chronology *replayChronology,
+) *RoundReport {
+
+	report := &RoundReport{
+		RoundIndex:       record.RoundIndex,
+		SelfPubKey:       record.SelfPubKey,
+		RecordedOutcome:  record.Outcome,
+		Subrounds:        make([]*SubroundReport, 0),
+		RejectedMessages: createRejectedMessagesReport(record, consensusService),
+	}
+
+	pending := sortedReceivedMessages(record)
+	subroundHandler := chronology.subroundHandlers[0]
+	for subroundHandler != nil {
+		endTime, isEndedInRecording := subroundEndTime(record, subroundHandler.Current())
+		pending = executeMessages(worker, pending, endTime, isEndedInRecording)
+
+		isFinished := subroundHandler.DoWork(rounder)
+
+		subroundReport := createSubroundReport(record, consensusService, consensusState, subroundHandler)
+		subroundReport.IsFinished = isFinished
+		subroundReport.IsEndedInRecording = isEndedInRecording
+		report.Subrounds = append(report.Subrounds, subroundReport)
+
+		if !isFinished {
+			break
+		}
+
+		subroundHandler = chronology.subround(subroundHandler.Next())
+	}
+
+	return report
+}
+
+// executeMessages executes, in the order they were received, the pending messages which were received before the
+// provided end time and which can be processed in the current state. The others are returned as still pending
+func executeMessages(
+	worker *replayWorker,
+	pending []*recorder.RecordedMessage,
+	endTime int64,
+	isEndedInRecording bool,
+) []*recorder.RecordedMessage {
+
+	stillPending := make([]*recorder.RecordedMessage, 0, len(pending))
+	for _, recordedMessage := range pending {
+		isReceivedInTime := !isEndedInRecording || recordedMessage.Timestamp <= endTime
+		if !isReceivedInTime || !worker.canExecute(recordedMessage.Message) {
+			stillPending = append(stillPending, recordedMessage)
+			continue
+		}
+
+		worker.execute(recordedMessage.Message)
+	}
+
+	return stillPending
+}
+
+func createSubroundReport(
+	record *recorder.RoundRecord,
+	consensusService spos.ConsensusService,
+	consensusState *spos.ConsensusState,
+	subroundHandler consensus.SubroundHandler,
+) *SubroundReport {
+
+	subroundId := subroundHandler.Current()
+	subroundReport := &SubroundReport{
+		SubroundId:          subroundId,
+		SubroundName:        subroundHandler.Name(),
+		Threshold:           consensusState.Threshold(subroundId),
+		MissingPubKeys:      make([][]byte, 0),
+		LateMessagesPubKeys: make([][]byte, 0),
+	}
+
+	for _, pubKey := range consensusState.ConsensusGroup() {
+		isJobDone, err := consensusState.JobDone(pubKey, subroundId)
+		if err == nil && isJobDone {
+			subroundReport.NumJobsDone++
+			continue
+		}
+
+		subroundReport.MissingPubKeys = append(subroundReport.MissingPubKeys, []byte(pubKey))
+	}
+
+	endTime, isEndedInRecording := subroundEndTime(record, subroundId)
+	if !isEndedInRecording {
+		return subroundReport
+	}
+
+	for _, recordedMessage := range record.ReceivedMessages() {
+		msgType := consensus.MessageType(recordedMessage.Message.MsgType)
+		isLate := consensusService.GetSubroundIdForMessageType(msgType) == subroundId &&
+			recordedMessage.Timestamp > endTime
+		if isLate {
+			subroundReport.LateMessagesPubKeys = append(subroundReport.LateMessagesPubKeys, recordedMessage.Message.PubKey)
+		}
+	}
+
+	return subroundReport
+}
+
+func createRejectedMessagesReport(
+	record *recorder.RoundRecord,
+	consensusService spos.ConsensusService,
+) []*RejectedMessageReport {
+
+	rejectedMessages := make([]*RejectedMessageReport, 0)
+	for _, recordedMessage := range record.RejectedMessages() {
+		msgType := consensus.MessageType(recordedMessage.Message.MsgType)
+		rejectedMessages = append(rejectedMessages, &RejectedMessageReport{
+			PubKey:      recordedMessage.Message.PubKey,
+			MessageType: consensusService.GetStringValue(msgType),
+			Reason:      recordedMessage.ValidationError,
+		})
+	}
+
+	return rejectedMessages
+}
+
+func createConsensusState(record *recorder.RoundRecord) *spos.ConsensusState {
+	consensusGroup := make([]string, len(record.ConsensusGroup))
+	for i, pubKey := range record.ConsensusGroup {
+		consensusGroup[i] = string(pubKey)
+	}
+
+	roundConsensus := spos.NewRoundConsensus(
+		consensusGroup,
+		len(consensusGroup),
+		string(record.SelfPubKey))
+
+	roundConsensus.ResetRoundState()
+
+	roundThreshold := spos.NewRoundThreshold()
+
+	roundStatus := spos.NewRoundStatus()
+	roundStatus.ResetRoundStatus()
+
+	return spos.NewConsensusState(
+		roundConsensus,
+		roundThreshold,
+		roundStatus)
+}
+
+// createBlockChain creates a blockchain which has, as the last block, the one on top of which the recorded block was
+// proposed, so the header created again by a leader has the same nonce, previous hash and previous random seed
+func createBlockChain(blockProcessor *replayBlockProcessor, header data.HeaderHandler) (data.ChainHandler, error) {
+	badBlocksCache, err := lrucache.NewCache(badBlocksCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var blockChain data.ChainHandler
+	if blockProcessor.isMetachain {
+		blockChain, err = blockchain.NewMetaChain(badBlocksCache)
+	} else {
+		blockChain, err = blockchain.NewBlockChain(badBlocksCache)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	genesisHeader := blockProcessor.emptyHeader()
+	if header == nil {
+		return blockChain, blockChain.SetGenesisHeader(genesisHeader)
+	}
+
+	previousHeader := blockProcessor.emptyHeader()
+	previousHeader.SetRandSeed(header.GetPrevRandSeed())
+
+	if header.GetNonce() <= 1 {
+		blockChain.SetGenesisHeaderHash(header.GetPrevHash())
+		return blockChain, blockChain.SetGenesisHeader(previousHeader)
+	}
+
+	err = blockChain.SetGenesisHeader(genesisHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	previousHeader.SetNonce(header.GetNonce() - 1)
+	blockChain.SetCurrentBlockHeaderHash(header.GetPrevHash())
+
+	return blockChain, blockChain.SetCurrentBlockHeader(previousHeader)
+}
+
+// proposedBlock returns the marshalized header and body proposed by the leader, as they were recorded
+func proposedBlock(record *recorder.RoundRecord, consensusService spos.ConsensusService) ([]byte, []byte) {
+	leader := string(record.ConsensusGroup[0])
+
+	var header []byte
+	blockSubroundId := 0
+	for _, recordedMessage := range record.Messages {
+		if recordedMessage.IsRejected() {
+			continue
+		}
+
+		msgType := consensus.MessageType(recordedMessage.Message.MsgType)
+		isLeaderHeader := string(recordedMessage.Message.PubKey) == leader &&
+			consensusService.IsMessageWithBlockHeader(msgType)
+		if isLeaderHeader {
+			header = recordedMessage.Message.SubRoundData
+			blockSubroundId = consensusService.GetSubroundIdForMessageType(msgType)
+			break
+		}
+	}
+
+	if header == nil {
+		return nil, nil
+	}
+
+	//the block body is the other message processed in the subround in which the header is processed
+	for _, recordedMessage := range record.Messages {
+		if recordedMessage.IsRejected() {
+			continue
+		}
+
+		msgType := consensus.MessageType(recordedMessage.Message.MsgType)
+		isLeaderBody := string(recordedMessage.Message.PubKey) == leader &&
+			!consensusService.IsMessageWithBlockHeader(msgType) &&
+			consensusService.GetSubroundIdForMessageType(msgType) == blockSubroundId
+		if isLeaderBody {
+			return header, recordedMessage.Message.SubRoundData
+		}
+	}
+
+	return header, nil
+}
+
+// roundTimeStamp returns the round time stamp as it was set in the proposed header or in the consensus messages
+func roundTimeStamp(record *recorder.RoundRecord, header data.HeaderHandler) time.Time {
+	if header != nil {
+		return time.Unix(int64(header.GetTimeStamp()), 0)
+	}
+
+	if len(record.Messages) > 0 {
+		return time.Unix(int64(record.Messages[0].Message.TimeStamp), 0)
+	}
+
+	if len(record.Transitions) > 0 {
+		return time.Unix(0, record.Transitions[0].Timestamp)
+	}
+
+	return time.Unix(0, 0)
+}
+
+// subroundEndTime returns the moment the subround finished or was extended in the recording
+func subroundEndTime(record *recorder.RoundRecord, subroundId int) (int64, bool) {
+	for i := len(record.Transitions) - 1; i >= 0; i-- {
+		transition := record.Transitions[i]
+		if transition.SubroundId == subroundId && transition.Event != recorder.EventStarted {
+			return transition.Timestamp, true
+		}
+	}
+
+	return 0, false
+}
+
+func sortedReceivedMessages(record *recorder.RoundRecord) []*recorder.RecordedMessage {
+	messages := record.ReceivedMessages()
+	sort.SliceStable(messages, func(i, j int) bool {
+		return messages[i].Timestamp < messages[j].Timestamp
+	})
+
+	return messages
+}
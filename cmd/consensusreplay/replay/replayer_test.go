@@ -0,0 +1,203 @@
+package replay_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/consensusreplay/replay"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/recorder"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+)
+
+const roundIndex = int64(7)
+
+func replayRecord(record *recorder.RoundRecord) (*replay.RoundReport, error) {
+	shardCoordinator, _ := sharding.NewMultiShardCoordinator(1, 0)
+	replayer, _ := replay.NewReplayer(
+		"bls",
+		shardCoordinator,
+		4*time.Second,
+		blake2b.Blake2b{},
+		&marshal.JsonMarshalizer{},
+	)
+
+	return replayer.Replay(record)
+}
+
+func createMessage(timestamp int64, isSent bool, pubKey string, hash []byte, data []byte, msgType consensus.MessageType) *recorder.RecordedMessage {
+	return &recorder.RecordedMessage{
+		Timestamp: timestamp,
+		IsSent:    isSent,
+		Message: &consensus.Message{
+			BlockHeaderHash: hash,
+			SubRoundData:    data,
+			PubKey:          []byte(pubKey),
+			MsgType:         int(msgType),
+			RoundIndex:      roundIndex,
+		},
+	}
+}
+
+func createTransition(timestamp int64, subroundId int, event string) *recorder.RecordedTransition {
+	return &recorder.RecordedTransition{
+		Timestamp:  timestamp,
+		SubroundId: subroundId,
+		Event:      event,
+	}
+}
+
+// createStalledRecord creates the record of a round led by the recording node, in which the signature of C was
+// received after the signature subround was extended and the signature of D was never received
+func createStalledRecord(t *testing.T) *recorder.RoundRecord {
+	marshalizer := &marshal.JsonMarshalizer{}
+	header := &block.Header{
+		Nonce:        5,
+		Round:        uint64(roundIndex),
+		TimeStamp:    1000,
+		PrevHash:     []byte("prev hash"),
+		PrevRandSeed: []byte("prev rand seed"),
+		RandSeed:     []byte("rand seed"),
+	}
+	headerBuff, err := marshalizer.Marshal(header)
+	assert.Nil(t, err)
+	headerHash := blake2b.Blake2b{}.Compute(string(headerBuff))
+
+	bodyBuff, err := marshalizer.Marshal(make(block.Body, 0))
+	assert.Nil(t, err)
+
+	return &recorder.RoundRecord{
+		RoundIndex:     roundIndex,
+		SelfPubKey:     []byte("A"),
+		ConsensusGroup: [][]byte{[]byte("A"), []byte("B"), []byte("C"), []byte("D")},
+		Messages: []*recorder.RecordedMessage{
+			createMessage(120, true, "A", nil, bodyBuff, bls.MtBlockBody),
+			createMessage(121, true, "A", headerHash, headerBuff, bls.MtBlockHeader),
+			createMessage(205, true, "A", headerHash, []byte("sig A"), bls.MtSignature),
+			createMessage(250, false, "B", headerHash, []byte("sig B"), bls.MtSignature),
+			createMessage(350, false, "C", headerHash, []byte("sig C"), bls.MtSignature),
+		},
+		Transitions: []*recorder.RecordedTransition{
+			createTransition(100, bls.SrStartRound, recorder.EventStarted),
+			createTransition(110, bls.SrStartRound, recorder.EventFinished),
+			createTransition(110, bls.SrBlock, recorder.EventStarted),
+			createTransition(200, bls.SrBlock, recorder.EventFinished),
+			createTransition(200, bls.SrSignature, recorder.EventStarted),
+			createTransition(300, bls.SrSignature, recorder.EventExtended),
+		},
+		Outcome: "stalled in (SIGNATURE)",
+	}
+}
+
+//------- NewReplayer
+
+func TestNewReplayer_InvalidConsensusTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	shardCoordinator, _ := sharding.NewMultiShardCoordinator(1, 0)
+	replayer, err := replay.NewReplayer(
+		"invalid",
+		shardCoordinator,
+		time.Second,
+		blake2b.Blake2b{},
+		&marshal.JsonMarshalizer{},
+	)
+
+	assert.Nil(t, replayer)
+	assert.NotNil(t, err)
+}
+
+func TestNewReplayer_NilShardCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	replayer, err := replay.NewReplayer(
+		"bls",
+		nil,
+		time.Second,
+		blake2b.Blake2b{},
+		&marshal.JsonMarshalizer{},
+	)
+
+	assert.Nil(t, replayer)
+	assert.Equal(t, replay.ErrNilShardCoordinator, err)
+}
+
+//------- Replay
+
+func TestReplayer_ReplayNilRecordShouldErr(t *testing.T) {
+	t.Parallel()
+
+	report, err := replayRecord(nil)
+
+	assert.Nil(t, report)
+	assert.Equal(t, replay.ErrNilRoundRecord, err)
+}
+
+func TestReplayer_ReplayRecordWithoutConsensusStateShouldErr(t *testing.T) {
+	t.Parallel()
+
+	report, err := replayRecord(&recorder.RoundRecord{RoundIndex: roundIndex})
+
+	assert.Nil(t, report)
+	assert.Equal(t, replay.ErrRecordWithoutConsensusState, err)
+}
+
+func TestReplayer_ReplayShouldReportQuorumLostInSignatureSubround(t *testing.T) {
+	t.Parallel()
+
+	report, err := replayRecord(createStalledRecord(t))
+
+	assert.Nil(t, err)
+	assert.Equal(t, roundIndex, report.RoundIndex)
+	assert.Equal(t, 3, len(report.Subrounds))
+	assert.True(t, report.Subrounds[0].IsFinished)
+	assert.True(t, report.Subrounds[1].IsFinished)
+
+	signatureReport := report.LastSubround()
+	assert.Equal(t, bls.SrSignature, signatureReport.SubroundId)
+	assert.False(t, signatureReport.IsFinished)
+	assert.True(t, signatureReport.IsEndedInRecording)
+	assert.Equal(t, 2, signatureReport.NumJobsDone)
+	assert.Equal(t, [][]byte{[]byte("C"), []byte("D")}, signatureReport.MissingPubKeys)
+	assert.Equal(t, [][]byte{[]byte("C")}, signatureReport.LateMessagesPubKeys)
+	assert.Equal(t, signatureReport, report.QuorumLostIn())
+}
+
+func TestReplayer_ReplayWithAllMessagesInTimeShouldFinishTheSignatureSubround(t *testing.T) {
+	t.Parallel()
+
+	record := createStalledRecord(t)
+	record.Messages[4].Timestamp = 260
+
+	report, err := replayRecord(record)
+
+	assert.Nil(t, err)
+	assert.Nil(t, report.QuorumLostIn())
+	assert.True(t, report.Subrounds[2].IsFinished)
+	assert.Equal(t, 3, report.Subrounds[2].NumJobsDone)
+}
+
+func TestReplayer_ReplayShouldNotExecuteRejectedMessages(t *testing.T) {
+	t.Parallel()
+
+	record := createStalledRecord(t)
+	record.Messages[4].Timestamp = 260
+	record.Messages[4].ValidationError = "signature is invalid"
+
+	report, err := replayRecord(record)
+
+	assert.Nil(t, err)
+	signatureReport := report.LastSubround()
+	assert.False(t, signatureReport.IsFinished)
+	assert.Equal(t, 2, signatureReport.NumJobsDone)
+	assert.Equal(t, 0, len(signatureReport.LateMessagesPubKeys))
+
+	assert.Equal(t, 1, len(report.RejectedMessages))
+	assert.Equal(t, []byte("C"), report.RejectedMessages[0].PubKey)
+	assert.Equal(t, "signature is invalid", report.RejectedMessages[0].Reason)
+}
@@ -0,0 +1,55 @@
+package replay
+
+// SubroundReport holds the state of a subround at the end of its replay
+type SubroundReport struct {
+	SubroundId          int
+	SubroundName        string
+	IsFinished          bool
+	IsEndedInRecording  bool
+	Threshold           int
+	NumJobsDone         int
+	MissingPubKeys      [][]byte
+	LateMessagesPubKeys [][]byte
+}
+
+// HasQuorum returns true if the number of jobs done in the subround reached its threshold
+func (sr *SubroundReport) HasQuorum() bool {
+	return sr.NumJobsDone >= sr.Threshold
+}
+
+// RejectedMessageReport holds a received message which was rejected by the worker, so it was not replayed
+type RejectedMessageReport struct {
+	PubKey      []byte
+	MessageType string
+	Reason      string
+}
+
+// RoundReport holds the result of a round replay. The subrounds are in the order in which they were replayed, the
+// replay stopping at the first subround which did not finish
+type RoundReport struct {
+	RoundIndex       int64
+	SelfPubKey       []byte
+	RecordedOutcome  string
+	Subrounds        []*SubroundReport
+	RejectedMessages []*RejectedMessageReport
+}
+
+// QuorumLostIn returns the first replayed subround which did not reach its threshold, or nil if there is none
+func (rr *RoundReport) QuorumLostIn() *SubroundReport {
+	for _, subroundReport := range rr.Subrounds {
+		if !subroundReport.HasQuorum() {
+			return subroundReport
+		}
+	}
+
+	return nil
+}
+
+// LastSubround returns the last replayed subround
+func (rr *RoundReport) LastSubround() *SubroundReport {
+	if len(rr.Subrounds) == 0 {
+		return nil
+	}
+
+	return rr.Subrounds[len(rr.Subrounds)-1]
+}
@@ -0,0 +1,328 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/keystore"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/data/state/addressConverters"
+	"github.com/urfave/cli"
+)
+
+const kindAll = "all"
+
+var (
+	keystoreDir = cli.StringFlag{
+		Name:  "keystore",
+		Usage: "Directory of the keystore",
+		Value: "./keystore",
+	}
+	passwordFile = cli.StringFlag{
+		Name:  "password-file",
+		Usage: "The file containing the password of the key files. If not set, the password is asked on the terminal",
+		Value: "",
+	}
+	keyKind = cli.StringFlag{
+		Name:  "kind",
+		Usage: "Kind of the key: tx, for signing transactions, or validator, for signing blocks",
+		Value: keystore.KindTx,
+	}
+	publicKey = cli.StringFlag{
+		Name:  "public-key",
+		Usage: "Public key, in hex, or a prefix of it, identifying the key in the keystore",
+		Value: "",
+	}
+	pemFile = cli.StringFlag{
+		Name:  "pem",
+		Usage: "PEM file of the private keys",
+		Value: initialBalancesSkFileName,
+	}
+	skIndex = cli.IntFlag{
+		Name:  "sk-index",
+		Usage: "The 0-th based index of the private key to be imported from the PEM file",
+		Value: 0,
+	}
+
+	errPasswordsMismatch = errors.New("the passwords do not match")
+	errUnknownKeyKind    = errors.New("unknown key kind")
+)
+
+func keystoreCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "generate",
+			Usage: "generates new keys, encrypted with a password, in the keystore",
+			Flags: []cli.Flag{
+				keystoreDir,
+				passwordFile,
+				cli.StringFlag{
+					Name:  keyKind.Name,
+					Usage: "Kind of the keys to generate: tx, validator or all",
+					Value: kindAll,
+				},
+			},
+			Action: generateKeys,
+		},
+		{
+			Name:   "import",
+			Usage:  "imports a private key from a PEM file in the keystore, encrypted with a password",
+			Flags:  []cli.Flag{keystoreDir, passwordFile, keyKind, pemFile, skIndex},
+			Action: importKey,
+		},
+		{
+			Name:   "export",
+			Usage:  "decrypts a private key from the keystore and writes it in a PEM file",
+			Flags:  []cli.Flag{keystoreDir, passwordFile, publicKey, pemFile},
+			Action: exportKey,
+		},
+		{
+			Name:   "list",
+			Usage:  "lists the keys from the keystore",
+			Flags:  []cli.Flag{keystoreDir},
+			Action: listKeys,
+		},
+		{
+			Name:   "show",
+			Usage:  "shows the public key and, for tx keys, the address of a key from the keystore",
+			Flags:  []cli.Flag{keystoreDir, publicKey},
+			Action: showKey,
+		},
+	}
+}
+
+func generateKeys(ctx *cli.Context) error {
+	kinds, err := getKindsToGenerate(ctx.String(keyKind.Name))
+	if err != nil {
+		return err
+	}
+
+	password, err := readNewPassword(ctx.String(passwordFile.Name))
+	if err != nil {
+		return err
+	}
+
+	for _, kind := range kinds {
+		keyGen, err := getKeyGenerator(ctx, kind)
+		if err != nil {
+			return err
+		}
+
+		sk, pk := keyGen.GeneratePair()
+		skBytes, err := sk.ToByteArray()
+		if err != nil {
+			return err
+		}
+
+		path, err := encryptAndSave(ctx.String(keystoreDir.Name), skBytes, pk, kind, password)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Generated %s key in %s\n", kind, path)
+	}
+
+	return nil
+}
+
+func importKey(ctx *cli.Context) error {
+	kind := ctx.String(keyKind.Name)
+	keyGen, err := getKeyGenerator(ctx, kind)
+	if err != nil {
+		return err
+	}
+
+	encodedSk, err := core.LoadSkFromPemFile(ctx.String(pemFile.Name), logger.DefaultLogger(), ctx.Int(skIndex.Name))
+	if err != nil {
+		return err
+	}
+
+	skBytes, err := hex.DecodeString(string(encodedSk))
+	if err != nil {
+		return err
+	}
+
+	sk, err := keyGen.PrivateKeyFromByteArray(skBytes)
+	if err != nil {
+		return err
+	}
+
+	password, err := readNewPassword(ctx.String(passwordFile.Name))
+	if err != nil {
+		return err
+	}
+
+	path, err := encryptAndSave(ctx.String(keystoreDir.Name), skBytes, sk.GeneratePublic(), kind, password)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Imported %s key in %s\n", kind, path)
+
+	return nil
+}
+
+func exportKey(ctx *cli.Context) error {
+	storedKey, err := keystore.FindKeyFile(ctx.String(keystoreDir.Name), ctx.String(publicKey.Name))
+	if err != nil {
+		return err
+	}
+
+	password, err := keystore.ReadPassword(ctx.String(passwordFile.Name), "Password: ")
+	if err != nil {
+		return err
+	}
+
+	skBytes, err := keystore.DecryptKey(storedKey.KeyFile, password)
+	if err != nil {
+		return err
+	}
+
+	fileName := ctx.String(pemFile.Name)
+	backupFileIfExists(fileName)
+	file, err := os.OpenFile(fileName, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = file.Close()
+		if err != nil {
+			fmt.Println(err.Error())
+		}
+	}()
+
+	err = core.SaveSkToPemFile(file, storedKey.KeyFile.PublicKey, []byte(hex.EncodeToString(skBytes)))
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Exported %s key in %s\n", storedKey.KeyFile.Kind, fileName)
+
+	return nil
+}
+
+func listKeys(ctx *cli.Context) error {
+	storedKeys, err := keystore.ListKeyFiles(ctx.String(keystoreDir.Name))
+	if err != nil {
+		return err
+	}
+
+	if len(storedKeys) == 0 {
+		fmt.Println("No keys found in keystore")
+		return nil
+	}
+
+	for _, storedKey := range storedKeys {
+		fmt.Printf("%s\t%s\t%s\n", storedKey.KeyFile.Kind, storedKey.KeyFile.PublicKey, storedKey.Path)
+	}
+
+	return nil
+}
+
+func showKey(ctx *cli.Context) error {
+	storedKey, err := keystore.FindKeyFile(ctx.String(keystoreDir.Name), ctx.String(publicKey.Name))
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("kind:\t%s\n", storedKey.KeyFile.Kind)
+	fmt.Printf("file:\t%s\n", storedKey.Path)
+	fmt.Printf("public key:\t%s\n", storedKey.KeyFile.PublicKey)
+	if storedKey.KeyFile.Kind != keystore.KindTx {
+		return nil
+	}
+
+	ac, err := addressConverters.NewPlainAddressConverter(32, "")
+	if err != nil {
+		return err
+	}
+
+	adr, err := ac.CreateAddressFromHex(storedKey.KeyFile.PublicKey)
+	if err != nil {
+		return err
+	}
+
+	bech32, err := ac.ConvertToBech32(adr)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("address:\t%s\n", bech32)
+
+	return nil
+}
+
+func getKindsToGenerate(kind string) ([]string, error) {
+	switch kind {
+	case kindAll:
+		return []string{keystore.KindTx, keystore.KindValidator}, nil
+	case keystore.KindTx, keystore.KindValidator:
+		return []string{kind}, nil
+	}
+
+	return nil, errUnknownKeyKind
+}
+
+func getKeyGenerator(ctx *cli.Context, kind string) (crypto.KeyGenerator, error) {
+	switch kind {
+	case keystore.KindTx:
+		return signing.NewKeyGenerator(getSuiteForBalanceSk()), nil
+	case keystore.KindValidator:
+		suite := getSuiteForBlockSigningSk(ctx.GlobalString(consensusType.Name))
+		if suite == nil {
+			return nil, errors.New("unknown consensus type")
+		}
+
+		return signing.NewKeyGenerator(suite), nil
+	}
+
+	return nil, errUnknownKeyKind
+}
+
+func encryptAndSave(
+	dir string,
+	skBytes []byte,
+	pk crypto.PublicKey,
+	kind string,
+	password []byte,
+) (string, error) {
+
+	pkBytes, err := pk.ToByteArray()
+	if err != nil {
+		return "", err
+	}
+
+	keyFile, err := keystore.EncryptKey(skBytes, pkBytes, kind, password, keystore.StandardScryptParams)
+	if err != nil {
+		return "", err
+	}
+
+	return keystore.SaveKeyFile(dir, keyFile)
+}
+
+// readNewPassword reads the password of new key files, asking for it twice when it is read from the terminal
+func readNewPassword(passwordFileName string) ([]byte, error) {
+	password, err := keystore.ReadPassword(passwordFileName, "Password: ")
+	if err != nil {
+		return nil, err
+	}
+	if passwordFileName != "" {
+		return password, nil
+	}
+
+	confirmation, err := keystore.ReadPassword("", "Repeat password: ")
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(password, confirmation) {
+		return nil, errPasswordsMismatch
+	}
+
+	return password, nil
+}
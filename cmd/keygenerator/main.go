@@ -23,6 +23,9 @@ USAGE:
 AUTHOR:
    {{range .Authors}}{{ . }}{{end}}
    {{end}}{{if .Commands}}
+COMMANDS:
+   {{range .Commands}}{{join .Names ", "}}{{ "\t" }}{{.Usage}}
+   {{end}}
 GLOBAL OPTIONS:
    {{range .VisibleFlags}}{{.}}
    {{end}}
@@ -45,8 +48,10 @@ func main() {
 	cli.AppHelpTemplate = fileGenHelpTemplate
 	app.Name = "Key generation Tool"
 	app.Version = "v0.0.1"
-	app.Usage = "This binary will generate a initialBalancesSk.pem and initialNodesSk.pem, each containing one private key"
+	app.Usage = "This binary will generate a initialBalancesSk.pem and initialNodesSk.pem, each containing one private key. " +
+		"The commands manage the keys of a password encrypted keystore"
 	app.Flags = []cli.Flag{consensusType}
+	app.Commands = append(keystoreCommands(), walletCommands()...)
 	app.Authors = []cli.Author{
 		{
 			Name:  "The Elrond Team",
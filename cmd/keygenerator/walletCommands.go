@@ -0,0 +1,207 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/crypto/keystore"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/wallet"
+	"github.com/ElrondNetwork/elrond-go/data/state/addressConverters"
+	"github.com/urfave/cli"
+)
+
+var (
+	numWords = cli.IntFlag{
+		Name:  "words",
+		Usage: "Number of words of the mnemonic phrase: 12, 15, 18, 21 or 24",
+		Value: 24,
+	}
+	mnemonicFile = cli.StringFlag{
+		Name:  "mnemonic-file",
+		Usage: "The file containing the mnemonic phrase. If not set, the mnemonic phrase is read from the terminal",
+		Value: "",
+	}
+	passphraseFile = cli.StringFlag{
+		Name:  "passphrase-file",
+		Usage: "The file containing the optional BIP39 passphrase protecting the mnemonic phrase",
+		Value: "",
+	}
+	derivationPath = cli.StringFlag{
+		Name:  "path",
+		Usage: "Hardened derivation path of the first derived key",
+		Value: wallet.DefaultDerivationPath,
+	}
+	numAccounts = cli.UintFlag{
+		Name:  "count",
+		Usage: "Number of keys to derive, incrementing the last index of the derivation path",
+		Value: 1,
+	}
+	addressLength = cli.IntFlag{
+		Name:  "address-length",
+		Usage: "Length of the addresses, as set in the Address section of the node configuration",
+		Value: 32,
+	}
+	addressPrefix = cli.StringFlag{
+		Name:  "address-prefix",
+		Usage: "Prefix of the addresses, as set in the Address section of the node configuration",
+		Value: "0x",
+	}
+	saveInKeystore = cli.BoolFlag{
+		Name:  "save",
+		Usage: "Saves the derived keys in the keystore, encrypted with a password",
+	}
+)
+
+func walletCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:   "mnemonic",
+			Usage:  "generates a new BIP39 mnemonic phrase",
+			Flags:  []cli.Flag{numWords},
+			Action: generateMnemonic,
+		},
+		{
+			Name:  "derive",
+			Usage: "derives tx keys and addresses from a BIP39 mnemonic phrase, along a SLIP-0010 derivation path",
+			Flags: []cli.Flag{
+				mnemonicFile,
+				passphraseFile,
+				derivationPath,
+				numAccounts,
+				addressLength,
+				addressPrefix,
+				saveInKeystore,
+				keystoreDir,
+				passwordFile,
+			},
+			Action: deriveKeys,
+		},
+	}
+}
+
+func generateMnemonic(ctx *cli.Context) error {
+	mnemonic, err := wallet.NewMnemonic(ctx.Int(numWords.Name))
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(mnemonic)
+
+	return nil
+}
+
+func deriveKeys(ctx *cli.Context) error {
+	mnemonic, err := readMnemonic(ctx.String(mnemonicFile.Name))
+	if err != nil {
+		return err
+	}
+
+	passphrase := ""
+	if ctx.IsSet(passphraseFile.Name) {
+		buff, errRead := ioutil.ReadFile(ctx.String(passphraseFile.Name))
+		if errRead != nil {
+			return errRead
+		}
+		passphrase = strings.TrimRight(string(buff), "\r\n")
+	}
+
+	seed, err := wallet.SeedFromMnemonic(mnemonic, passphrase)
+	if err != nil {
+		return err
+	}
+
+	paths, err := createDerivationPaths(ctx.String(derivationPath.Name), ctx.Uint(numAccounts.Name))
+	if err != nil {
+		return err
+	}
+
+	ac, err := addressConverters.NewPlainAddressConverter(ctx.Int(addressLength.Name), ctx.String(addressPrefix.Name))
+	if err != nil {
+		return err
+	}
+
+	var password []byte
+	if ctx.Bool(saveInKeystore.Name) {
+		password, err = readNewPassword(ctx.String(passwordFile.Name))
+		if err != nil {
+			return err
+		}
+	}
+
+	keyGen := signing.NewKeyGenerator(getSuiteForBalanceSk())
+	for _, path := range paths {
+		account, err := wallet.DeriveAccount(keyGen, ac, seed, path)
+		if err != nil {
+			return err
+		}
+
+		pkHex, err := ac.ConvertToHex(account.Address)
+		if err != nil {
+			return err
+		}
+		bech32, err := ac.ConvertToBech32(account.Address)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("%s\t%s\t%s\n", path, pkHex, bech32)
+
+		if !ctx.Bool(saveInKeystore.Name) {
+			continue
+		}
+
+		skBytes, err := account.PrivateKey.ToByteArray()
+		if err != nil {
+			return err
+		}
+
+		keyFilePath, err := encryptAndSave(ctx.String(keystoreDir.Name), skBytes, account.PublicKey, keystore.KindTx, password)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("\tsaved in %s\n", keyFilePath)
+	}
+
+	return nil
+}
+
+func readMnemonic(mnemonicFileName string) (string, error) {
+	if mnemonicFileName != "" {
+		buff, err := ioutil.ReadFile(mnemonicFileName)
+		if err != nil {
+			return "", err
+		}
+
+		return string(buff), nil
+	}
+
+	fmt.Print("Mnemonic phrase: ")
+	return bufio.NewReader(os.Stdin).ReadString('\n')
+}
+
+// createDerivationPaths returns the given path followed by the paths obtained by incrementing its last index
+func createDerivationPaths(firstPath string, count uint) ([]string, error) {
+	indexes, err := wallet.ParseDerivationPath(firstPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(indexes) == 0 {
+		return []string{firstPath}, nil
+	}
+
+	elements := strings.Split(strings.TrimSpace(firstPath), "/")
+	parentPath := strings.Join(elements[:len(elements)-1], "/")
+	lastIndex := indexes[len(indexes)-1] - wallet.HardenedKeyStart
+
+	paths := make([]string, 0, count)
+	for i := uint32(0); i < uint32(count); i++ {
+		paths = append(paths, fmt.Sprintf("%s/%d'", parentPath, lastIndex+i))
+	}
+
+	return paths, nil
+}
@@ -14,7 +14,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
-	"github.com/ElrondNetwork/elrond-go/consensus/round"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/genesis"
 	"github.com/ElrondNetwork/elrond-go/core/logger"
@@ -23,11 +23,13 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/statistics/softwareVersion"
 	factorySoftawareVersion "github.com/ElrondNetwork/elrond-go/core/statistics/softwareVersion/factory"
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/keystore"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber"
 	blsMultiSig "github.com/ElrondNetwork/elrond-go/crypto/signing/kyber/multisig"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber/singlesig"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/multisig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/remote"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/address"
 	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
@@ -63,9 +65,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/factory/metachain"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
+	"github.com/ElrondNetwork/elrond-go/process/headerCheck"
+	"github.com/ElrondNetwork/elrond-go/process/interceptors/pipeline"
 	"github.com/ElrondNetwork/elrond-go/process/rewardTransaction"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	processSync "github.com/ElrondNetwork/elrond-go/process/sync"
+	"github.com/ElrondNetwork/elrond-go/process/throttle"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
@@ -89,6 +94,18 @@ const (
 	// BnConsensusType specifies te signature scheme used in the consensus
 	BnConsensusType = "bn"
 
+	// LocalBlockSignerType specifies that the block signing key is kept in the node process
+	LocalBlockSignerType = "local"
+
+	// RemoteBlockSignerType specifies that the block signing key is kept by a separate signer process
+	RemoteBlockSignerType = "remote"
+
+	// HexAddressType specifies that the addresses are represented as hex strings
+	HexAddressType = "hex"
+
+	// Bech32AddressType specifies that the addresses are represented as bech32 strings
+	Bech32AddressType = "bech32"
+
 	// MaxTxsToRequest specifies the maximum number of txs to request
 	MaxTxsToRequest = 100
 )
@@ -132,13 +149,17 @@ type Data struct {
 
 // Crypto struct holds the crypto components of the Elrond protocol
 type Crypto struct {
-	TxSingleSigner crypto.SingleSigner
-	SingleSigner   crypto.SingleSigner
-	MultiSigner    crypto.MultiSigner
-	TxSignKeyGen   crypto.KeyGenerator
-	TxSignPrivKey  crypto.PrivateKey
-	TxSignPubKey   crypto.PublicKey
-	InitialPubKeys map[uint32][]string
+	TxSingleSigner  crypto.SingleSigner
+	SingleSigner    crypto.SingleSigner
+	MultiSigner     crypto.MultiSigner
+	BlockSignKeyGen crypto.KeyGenerator
+	TxSignKeyGen    crypto.KeyGenerator
+	TxSignPrivKey   crypto.PrivateKey
+	TxSignPubKey    crypto.PublicKey
+	InitialPubKeys  map[uint32][]string
+	// HeaderProviderSetter is the remote block signer which needs the block header held by the consensus state. It is
+	// nil when the block signing key is kept by the node
+	HeaderProviderSetter remote.HeaderProviderSetter
 }
 
 // Process struct holds the process components of the Elrond protocol
@@ -214,11 +235,7 @@ func NewStateComponentsFactoryArgs(
 
 // StateComponentsFactory creates the state components
 func StateComponentsFactory(args *stateComponentsFactoryArgs) (*State, error) {
-	addressConverter, err := addressConverters.NewPlainAddressConverter(
-		args.config.Address.Length,
-		args.config.Address.Prefix,
-	)
-
+	addressConverter, err := getAddressConverterFromConfig(args.config.Address)
 	if err != nil {
 		return nil, errors.New("could not create address converter: " + err.Error())
 	}
@@ -312,10 +329,13 @@ type cryptoComponentsFactoryArgs struct {
 	shardCoordinator             sharding.Coordinator
 	keyGen                       crypto.KeyGenerator
 	privKey                      crypto.PrivateKey
+	signerBackend                remote.SignerBackend
 	log                          *logger.Logger
 	initialBalancesSkPemFileName string
 	txSignSkName                 string
 	txSignSkIndexName            string
+	txSignKeyFileName            string
+	keyPassword                  []byte
 }
 
 // NewCryptoComponentsFactoryArgs initializes the arguments necessary for creating the crypto components
@@ -326,10 +346,13 @@ func NewCryptoComponentsFactoryArgs(
 	shardCoordinator sharding.Coordinator,
 	keyGen crypto.KeyGenerator,
 	privKey crypto.PrivateKey,
+	signerBackend remote.SignerBackend,
 	log *logger.Logger,
 	initialBalancesSkPemFileName string,
 	txSignSkName string,
 	txSignSkIndexName string,
+	txSignKeyFileName string,
+	keyPassword []byte,
 ) *cryptoComponentsFactoryArgs {
 	return &cryptoComponentsFactoryArgs{
 		ctx:                          ctx,
@@ -338,10 +361,13 @@ func NewCryptoComponentsFactoryArgs(
 		shardCoordinator:             shardCoordinator,
 		keyGen:                       keyGen,
 		privKey:                      privKey,
+		signerBackend:                signerBackend,
 		log:                          log,
 		initialBalancesSkPemFileName: initialBalancesSkPemFileName,
 		txSignSkName:                 txSignSkName,
 		txSignSkIndexName:            txSignSkIndexName,
+		txSignKeyFileName:            txSignKeyFileName,
+		keyPassword:                  keyPassword,
 	}
 }
 
@@ -354,6 +380,15 @@ func CryptoComponentsFactory(args *cryptoComponentsFactoryArgs) (*Crypto, error)
 		return nil, errors.New("could not create singleSigner: " + err.Error())
 	}
 
+	var llSigner crypto.LowLevelSignerBLS = &blsMultiSig.KyberMultiSignerBLS{}
+	var headerProviderSetter remote.HeaderProviderSetter
+	if args.config.BlockSigner.Type == RemoteBlockSignerType {
+		singleSigner, llSigner, headerProviderSetter, err = createRemoteSigners(args, singleSigner, llSigner)
+		if err != nil {
+			return nil, errors.New("could not create remote signers: " + err.Error())
+		}
+	}
+
 	multisigHasher, err := getMultisigHasherFromConfig(args.config)
 	if err != nil {
 		return nil, errors.New("could not create multisig hasher: " + err.Error())
@@ -364,35 +399,59 @@ func CryptoComponentsFactory(args *cryptoComponentsFactoryArgs) (*Crypto, error)
 		return nil, errors.New("could not start creation of multiSigner: " + err.Error())
 	}
 
-	multiSigner, err := createMultiSigner(args.config, multisigHasher, currentShardNodesPubKeys, args.privKey, args.keyGen)
+	multiSigner, err := createMultiSigner(
+		args.config,
+		multisigHasher,
+		currentShardNodesPubKeys,
+		args.privKey,
+		args.keyGen,
+		llSigner,
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	initialBalancesSkPemFileName := args.ctx.GlobalString(args.initialBalancesSkPemFileName)
-	txSignKeyGen, txSignPrivKey, txSignPubKey, err := GetSigningParams(
-		args.ctx,
-		args.log,
-		args.txSignSkName,
-		args.txSignSkIndexName,
-		initialBalancesSkPemFileName,
-		kyber.NewBlakeSHA256Ed25519())
+	txSignKeyGen, txSignPrivKey, txSignPubKey, err := getTxSigningParams(args)
 	if err != nil {
 		return nil, err
 	}
 	args.log.Info("Starting with tx sign public key: " + GetPkEncoded(txSignPubKey))
 
 	return &Crypto{
-		TxSingleSigner: txSingleSigner,
-		SingleSigner:   singleSigner,
-		MultiSigner:    multiSigner,
-		TxSignKeyGen:   txSignKeyGen,
-		TxSignPrivKey:  txSignPrivKey,
-		TxSignPubKey:   txSignPubKey,
-		InitialPubKeys: initialPubKeys,
+		TxSingleSigner:  txSingleSigner,
+		SingleSigner:    singleSigner,
+		MultiSigner:     multiSigner,
+		BlockSignKeyGen: args.keyGen,
+		TxSignKeyGen:    txSignKeyGen,
+		TxSignPrivKey:   txSignPrivKey,
+		TxSignPubKey:    txSignPubKey,
+		InitialPubKeys:  initialPubKeys,
+
+		HeaderProviderSetter: headerProviderSetter,
 	}, nil
 }
 
+func getTxSigningParams(
+	args *cryptoComponentsFactoryArgs,
+) (crypto.KeyGenerator, crypto.PrivateKey, crypto.PublicKey, error) {
+
+	if args.ctx.GlobalIsSet(args.txSignKeyFileName) {
+		return GetSigningParamsFromKeyFile(
+			args.ctx.GlobalString(args.txSignKeyFileName),
+			args.keyPassword,
+			kyber.NewBlakeSHA256Ed25519())
+	}
+
+	initialBalancesSkPemFileName := args.ctx.GlobalString(args.initialBalancesSkPemFileName)
+	return GetSigningParams(
+		args.ctx,
+		args.log,
+		args.txSignSkName,
+		args.txSignSkIndexName,
+		initialBalancesSkPemFileName,
+		kyber.NewBlakeSHA256Ed25519())
+}
+
 // NetworkComponentsFactory creates the network components
 func NetworkComponentsFactory(p2pConfig *config.P2PConfig, log *logger.Logger, core *Core) (*Network, error) {
 	var randReader io.Reader
@@ -417,6 +476,7 @@ type processComponentsFactoryArgs struct {
 	economicsData        *economics.EconomicsData
 	nodesConfig          *sharding.NodesSetup
 	syncer               ntp.SyncTimer
+	rounder              consensus.Rounder
 	shardCoordinator     sharding.Coordinator
 	nodesCoordinator     sharding.NodesCoordinator
 	data                 *Data
@@ -425,6 +485,9 @@ type processComponentsFactoryArgs struct {
 	state                *State
 	network              *Network
 	coreServiceContainer serviceContainer.Core
+	consensusType        string
+	adaptiveRoundTiming  config.AdaptiveRoundTimingConfig
+	sigVerification      config.SigVerificationPipelineConfig
 }
 
 // NewProcessComponentsFactoryArgs initializes the arguments necessary for creating the process components
@@ -433,6 +496,7 @@ func NewProcessComponentsFactoryArgs(
 	economicsData *economics.EconomicsData,
 	nodesConfig *sharding.NodesSetup,
 	syncer ntp.SyncTimer,
+	rounder consensus.Rounder,
 	shardCoordinator sharding.Coordinator,
 	nodesCoordinator sharding.NodesCoordinator,
 	data *Data,
@@ -441,12 +505,16 @@ func NewProcessComponentsFactoryArgs(
 	state *State,
 	network *Network,
 	coreServiceContainer serviceContainer.Core,
+	consensusType string,
+	adaptiveRoundTiming config.AdaptiveRoundTimingConfig,
+	sigVerification config.SigVerificationPipelineConfig,
 ) *processComponentsFactoryArgs {
 	return &processComponentsFactoryArgs{
 		genesisConfig:        genesisConfig,
 		economicsData:        economicsData,
 		nodesConfig:          nodesConfig,
 		syncer:               syncer,
+		rounder:              rounder,
 		shardCoordinator:     shardCoordinator,
 		nodesCoordinator:     nodesCoordinator,
 		data:                 data,
@@ -455,11 +523,32 @@ func NewProcessComponentsFactoryArgs(
 		state:                state,
 		network:              network,
 		coreServiceContainer: coreServiceContainer,
+		consensusType:        consensusType,
+		adaptiveRoundTiming:  adaptiveRoundTiming,
+		sigVerification:      sigVerification,
 	}
 }
 
 // ProcessComponentsFactory creates the process components
 func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, error) {
+	argsHeaderSig := &headerCheck.ArgsHeaderSigVerifier{
+		Marshalizer:       args.core.Marshalizer,
+		Hasher:            args.core.Hasher,
+		NodesCoordinator:  args.nodesCoordinator,
+		MultiSigVerifier:  args.crypto.MultiSigner,
+		SingleSigVerifier: args.crypto.SingleSigner,
+		KeyGen:            args.crypto.BlockSignKeyGen,
+	}
+	headerSigVerifier, err := headerCheck.NewHeaderSigVerifier(argsHeaderSig)
+	if err != nil {
+		return nil, err
+	}
+
+	interceptedDataVerifier, err := newInterceptedDataVerifier(args)
+	if err != nil {
+		return nil, err
+	}
+
 	interceptorContainerFactory, resolversContainerFactory, err := newInterceptorAndResolverContainerFactory(
 		args.shardCoordinator,
 		args.nodesCoordinator,
@@ -468,6 +557,8 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		args.state,
 		args.network,
 		args.economicsData,
+		headerSigVerifier,
+		interceptedDataVerifier,
 	)
 	if err != nil {
 		return nil, err
@@ -489,16 +580,7 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		return nil, err
 	}
 
-	rounder, err := round.NewRound(
-		time.Unix(args.nodesConfig.StartTime, 0),
-		args.syncer.CurrentTime(),
-		time.Millisecond*time.Duration(args.nodesConfig.RoundDuration),
-		args.syncer)
-	if err != nil {
-		return nil, err
-	}
-
-	forkDetector, err := newForkDetector(rounder, args.shardCoordinator)
+	forkDetector, err := newForkDetector(args.rounder, args.shardCoordinator)
 	if err != nil {
 		return nil, err
 	}
@@ -519,6 +601,11 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		return nil, err
 	}
 
+	blockSizeThrottler, err := newBlockSizeThrottler(args, args.rounder)
+	if err != nil {
+		return nil, err
+	}
+
 	blockProcessor, err := newBlockProcessor(
 		resolversFinder,
 		args.shardCoordinator,
@@ -530,6 +617,8 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		forkDetector,
 		shardsGenesisBlocks,
 		args.coreServiceContainer,
+		blockSizeThrottler,
+		headerSigVerifier,
 	)
 
 	if err != nil {
@@ -539,12 +628,62 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 	return &Process{
 		InterceptorsContainer: interceptorsContainer,
 		ResolversFinder:       resolversFinder,
-		Rounder:               rounder,
+		Rounder:               args.rounder,
 		ForkDetector:          forkDetector,
 		BlockProcessor:        blockProcessor,
 	}, nil
 }
 
+func newInterceptedDataVerifier(args *processComponentsFactoryArgs) (process.InterceptedDataVerifier, error) {
+	sigVerificationCache, err := storageUnit.NewCache(
+		storageUnit.CacheType(args.sigVerification.Cache.Type),
+		args.sigVerification.Cache.Size,
+		args.sigVerification.Cache.Shards,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	argPipeline := &pipeline.ArgVerificationPipeline{
+		NumWorkers:    args.sigVerification.NumWorkers,
+		Cacher:        sigVerificationCache,
+		StatusHandler: args.core.StatusHandler,
+	}
+
+	return pipeline.NewVerificationPipeline(argPipeline)
+}
+
+func newBlockSizeThrottler(
+	args *processComponentsFactoryArgs,
+	rounder consensus.Rounder,
+) (process.BlockSizeThrottler, error) {
+
+	blockSizeThrottler, err := throttle.NewBlockSizeThrottle()
+	if err != nil {
+		return nil, err
+	}
+
+	if !args.adaptiveRoundTiming.Enabled {
+		return blockSizeThrottler, nil
+	}
+
+	endRoundDeadline, err := sposFactory.GetEndRoundDeadline(args.consensusType)
+	if err != nil {
+		return nil, err
+	}
+
+	commitDeadline := time.Duration(float64(rounder.TimeDuration()) * endRoundDeadline)
+
+	return throttle.NewAdaptiveBlockSizeThrottle(
+		blockSizeThrottler,
+		rounder,
+		args.syncer,
+		commitDeadline,
+		args.adaptiveRoundTiming.CloseToDeadlinePercent,
+		args.adaptiveRoundTiming.NumRecentRounds,
+	)
+}
+
 func prepareGenesisBlock(args *processComponentsFactoryArgs, shardsGenesisBlocks map[uint32]data.HeaderHandler) error {
 	genesisBlock, ok := shardsGenesisBlocks[args.shardCoordinator.SelfId()]
 	if !ok {
@@ -670,6 +809,17 @@ func getHasherFromConfig(cfg *config.Config) (hashing.Hasher, error) {
 	return nil, errors.New("no hasher provided in config file")
 }
 
+func getAddressConverterFromConfig(cfg config.AddressConfig) (state.AddressConverter, error) {
+	switch cfg.Type {
+	case "", HexAddressType:
+		return addressConverters.NewPlainAddressConverter(cfg.Length, cfg.Prefix)
+	case Bech32AddressType:
+		return addressConverters.NewBech32AddressConverter(cfg.Length, cfg.Hrp, cfg.Prefix)
+	}
+
+	return nil, errors.New("no address converter provided in config file")
+}
+
 func getMarshalizerFromConfig(cfg *config.Config) (marshal.Marshalizer, error) {
 	switch cfg.Marshalizer.Type {
 	case "json":
@@ -769,11 +919,15 @@ func createShardDataStoreFromConfig(
 	var rewardTxUnit *storageUnit.Unit
 	var metaHdrHashNonceUnit *storageUnit.Unit
 	var shardHdrHashNonceUnit *storageUnit.Unit
+	var txHistoryUnit *storageUnit.Unit
 	var err error
 
 	defer func() {
 		// cleanup
 		if err != nil {
+			if txHistoryUnit != nil {
+				_ = txHistoryUnit.DestroyUnit()
+			}
 			if headerUnit != nil {
 				_ = headerUnit.DestroyUnit()
 			}
@@ -900,6 +1054,17 @@ func createShardDataStoreFromConfig(
 	store.AddStorer(hdrNonceHashDataUnit, shardHdrHashNonceUnit)
 	store.AddStorer(dataRetriever.HeartbeatUnit, heartbeatStorageUnit)
 
+	if config.TransactionHistory.Enabled {
+		txHistoryUnit, err = storageUnit.NewStorageUnitFromConf(
+			getCacherFromConfig(config.TransactionHistory.Storage.Cache),
+			getDBFromConfig(config.TransactionHistory.Storage.DB, uniqueID),
+			getBloomFromConfig(config.TransactionHistory.Storage.Bloom))
+		if err != nil {
+			return nil, err
+		}
+		store.AddStorer(dataRetriever.TransactionHistoryUnit, txHistoryUnit)
+	}
+
 	return store, err
 }
 
@@ -1209,18 +1374,54 @@ func getMultisigHasherFromConfig(cfg *config.Config) (hashing.Hasher, error) {
 	return nil, errors.New("no multisig hasher provided in config file")
 }
 
+// createRemoteSigners wraps the single signer and the low level BLS signer so that the signatures are created by the
+// signer backend, which keeps the block signing key. The remote low level signer is also returned as the component
+// which has to be given the block header held by the consensus state, as the signature shares are requested for it
+func createRemoteSigners(
+	args *cryptoComponentsFactoryArgs,
+	singleSigner crypto.SingleSigner,
+	llSigner crypto.LowLevelSignerBLS,
+) (crypto.SingleSigner, crypto.LowLevelSignerBLS, remote.HeaderProviderSetter, error) {
+
+	if args.config.Consensus.Type != BlsConsensusType {
+		return nil, nil, nil, errors.New("remote block signer is supported only by the bls consensus type")
+	}
+
+	remoteSingleSigner, err := remote.NewRemoteSingleSigner(args.signerBackend, singleSigner)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	marshalizer, err := getMarshalizerFromConfig(args.config)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	hasher, err := getHasherFromConfig(args.config)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	remoteLLSigner, err := remote.NewRemoteLowLevelSigner(llSigner, args.signerBackend, marshalizer, hasher)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return remoteSingleSigner, remoteLLSigner, remoteLLSigner, nil
+}
+
 func createMultiSigner(
 	config *config.Config,
 	hasher hashing.Hasher,
 	pubKeys []string,
 	privateKey crypto.PrivateKey,
 	keyGen crypto.KeyGenerator,
+	llSigner crypto.LowLevelSignerBLS,
 ) (crypto.MultiSigner, error) {
 
 	switch config.Consensus.Type {
 	case BlsConsensusType:
-		blsSigner := &blsMultiSig.KyberMultiSignerBLS{}
-		return multisig.NewBLSMultisig(blsSigner, hasher, pubKeys, privateKey, keyGen, uint16(0))
+		return multisig.NewBLSMultisig(llSigner, hasher, pubKeys, privateKey, keyGen, uint16(0))
 	case BnConsensusType:
 		return multisig.NewBelNevMultisig(hasher, pubKeys, privateKey, keyGen, uint16(0))
 	}
@@ -1263,9 +1464,46 @@ func createNetMessenger(
 		return nil, err
 	}
 
+	err = applyTopicCodecs(nm, p2pConfig.TopicCodecs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(p2pConfig.Node.NetworkID) > 0 {
+		err = nm.SetNetworkID(p2pConfig.Node.NetworkID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return nm, nil
 }
 
+func applyTopicCodecs(messenger p2p.Messenger, topicCodecs []config.TopicCodecConfig) error {
+	if len(topicCodecs) == 0 {
+		return nil
+	}
+
+	flagsHandler, ok := messenger.(p2p.TopicFlagsHandler)
+	if !ok {
+		return errors.New("messenger does not support topic flags")
+	}
+
+	for _, tc := range topicCodecs {
+		flags := p2p.TopicFlags{
+			CompressionThreshold: tc.CompressionThresholdInBytes,
+			ChunkSize:            tc.ChunkSizeInBytes,
+		}
+
+		err := flagsHandler.SetTopicFlags(tc.TopicPrefix, flags)
+		if err != nil {
+			return errors.New(fmt.Sprintf("%s for topic prefix %s", err.Error(), tc.TopicPrefix))
+		}
+	}
+
+	return nil
+}
+
 func newInterceptorAndResolverContainerFactory(
 	shardCoordinator sharding.Coordinator,
 	nodesCoordinator sharding.NodesCoordinator,
@@ -1275,6 +1513,8 @@ func newInterceptorAndResolverContainerFactory(
 	state *State,
 	network *Network,
 	economics *economics.EconomicsData,
+	headerSigVerifier process.InterceptedHeaderSigVerifier,
+	interceptedDataVerifier process.InterceptedDataVerifier,
 ) (process.InterceptorsContainerFactory, dataRetriever.ResolversContainerFactory, error) {
 
 	if shardCoordinator.SelfId() < shardCoordinator.NumberOfShards() {
@@ -1287,6 +1527,8 @@ func newInterceptorAndResolverContainerFactory(
 			state,
 			network,
 			economics,
+			headerSigVerifier,
+			interceptedDataVerifier,
 		)
 	}
 	if shardCoordinator.SelfId() == sharding.MetachainShardId {
@@ -1299,6 +1541,8 @@ func newInterceptorAndResolverContainerFactory(
 			network,
 			state,
 			economics,
+			headerSigVerifier,
+			interceptedDataVerifier,
 		)
 	}
 
@@ -1314,6 +1558,8 @@ func newShardInterceptorAndResolverContainerFactory(
 	state *State,
 	network *Network,
 	economics *economics.EconomicsData,
+	headerSigVerifier process.InterceptedHeaderSigVerifier,
+	interceptedDataVerifier process.InterceptedDataVerifier,
 ) (process.InterceptorsContainerFactory, dataRetriever.ResolversContainerFactory, error) {
 
 	interceptorContainerFactory, err := shard.NewInterceptorsContainerFactory(
@@ -1326,11 +1572,12 @@ func newShardInterceptorAndResolverContainerFactory(
 		core.Hasher,
 		crypto.TxSignKeyGen,
 		crypto.TxSingleSigner,
-		crypto.MultiSigner,
+		headerSigVerifier,
 		data.Datapool,
 		state.AddressConverter,
 		maxTxNonceDeltaAllowed,
 		economics,
+		interceptedDataVerifier,
 	)
 	if err != nil {
 		return nil, nil, err
@@ -1366,6 +1613,8 @@ func newMetaInterceptorAndResolverContainerFactory(
 	network *Network,
 	state *State,
 	economics *economics.EconomicsData,
+	headerSigVerifier process.InterceptedHeaderSigVerifier,
+	interceptedDataVerifier process.InterceptedDataVerifier,
 ) (process.InterceptorsContainerFactory, dataRetriever.ResolversContainerFactory, error) {
 
 	interceptorContainerFactory, err := metachain.NewInterceptorsContainerFactory(
@@ -1375,7 +1624,7 @@ func newMetaInterceptorAndResolverContainerFactory(
 		data.Store,
 		core.Marshalizer,
 		core.Hasher,
-		crypto.MultiSigner,
+		headerSigVerifier,
 		data.MetaDatapool,
 		state.AccountsAdapter,
 		state.AddressConverter,
@@ -1383,6 +1632,7 @@ func newMetaInterceptorAndResolverContainerFactory(
 		crypto.TxSignKeyGen,
 		maxTxNonceDeltaAllowed,
 		economics,
+		interceptedDataVerifier,
 	)
 	if err != nil {
 		return nil, nil, err
@@ -1558,6 +1808,8 @@ func newBlockProcessor(
 	forkDetector process.ForkDetector,
 	shardsGenesisBlocks map[uint32]data.HeaderHandler,
 	coreServiceContainer serviceContainer.Core,
+	blockSizeThrottler process.BlockSizeThrottler,
+	headerSigVerifier process.InterceptedHeaderSigVerifier,
 ) (process.BlockProcessor, error) {
 
 	communityAddr := economics.CommunityAddress()
@@ -1600,6 +1852,8 @@ func newBlockProcessor(
 			shardsGenesisBlocks,
 			coreServiceContainer,
 			economics,
+			blockSizeThrottler,
+			headerSigVerifier,
 		)
 	}
 	if shardCoordinator.SelfId() == sharding.MetachainShardId {
@@ -1614,6 +1868,8 @@ func newBlockProcessor(
 			forkDetector,
 			shardsGenesisBlocks,
 			coreServiceContainer,
+			blockSizeThrottler,
+			headerSigVerifier,
 		)
 	}
 
@@ -1632,6 +1888,8 @@ func newShardBlockProcessor(
 	shardsGenesisBlocks map[uint32]data.HeaderHandler,
 	coreServiceContainer serviceContainer.Core,
 	economics *economics.EconomicsData,
+	blockSizeThrottler process.BlockSizeThrottler,
+	headerSigVerifier process.InterceptedHeaderSigVerifier,
 ) (process.BlockProcessor, error) {
 	argsParser, err := smartContract.NewAtArgumentParser()
 	if err != nil {
@@ -1703,6 +1961,13 @@ func newShardBlockProcessor(
 		return nil, err
 	}
 
+	if coreServiceContainer != nil && coreServiceContainer.SubscriptionNotifier() != nil {
+		err = scProcessor.SetLogsHandler(coreServiceContainer.SubscriptionNotifier())
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	requestHandler, err := requestHandlers.NewShardResolverRequestHandler(
 		resolversFinder,
 		factory.TransactionTopic,
@@ -1813,6 +2078,8 @@ func newShardBlockProcessor(
 		StartHeaders:          shardsGenesisBlocks,
 		RequestHandler:        requestHandler,
 		Core:                  coreServiceContainer,
+		BlockSizeThrottler:    blockSizeThrottler,
+		HeaderSigVerifier:     headerSigVerifier,
 	}
 	arguments := block.ArgShardProcessor{
 		ArgBaseProcessor: argumentsBaseProcessor,
@@ -1845,6 +2112,8 @@ func newMetaBlockProcessor(
 	forkDetector process.ForkDetector,
 	shardsGenesisBlocks map[uint32]data.HeaderHandler,
 	coreServiceContainer serviceContainer.Core,
+	blockSizeThrottler process.BlockSizeThrottler,
+	headerSigVerifier process.InterceptedHeaderSigVerifier,
 ) (process.BlockProcessor, error) {
 
 	requestHandler, err := requestHandlers.NewMetaResolverRequestHandler(
@@ -1872,6 +2141,8 @@ func newMetaBlockProcessor(
 		StartHeaders:          shardsGenesisBlocks,
 		RequestHandler:        requestHandler,
 		Core:                  coreServiceContainer,
+		BlockSizeThrottler:    blockSizeThrottler,
+		HeaderSigVerifier:     headerSigVerifier,
 	}
 	arguments := block.ArgMetaProcessor{
 		ArgBaseProcessor: argumentsBaseProcessor,
@@ -1970,6 +2241,43 @@ func GetSigningParams(
 	return keyGen, privKey, pubKey, err
 }
 
+// GetSigningParamsFromKeyFile returns a key generator, a private key, and a public key, the private key being
+// decrypted with the password from the given key file
+func GetSigningParamsFromKeyFile(
+	keyFileName string,
+	password []byte,
+	suite crypto.Suite,
+) (keyGen crypto.KeyGenerator, privKey crypto.PrivateKey, pubKey crypto.PublicKey, err error) {
+
+	keyFile, err := keystore.LoadKeyFile(keyFileName)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	sk, err := keystore.DecryptKey(keyFile, password)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	keyGen = signing.NewKeyGenerator(suite)
+
+	privKey, err = keyGen.PrivateKeyFromByteArray(sk)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	pubKey = privKey.GeneratePublic()
+	pkBytes, err := pubKey.ToByteArray()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if encodeAddress(pkBytes) != keyFile.PublicKey {
+		return nil, nil, nil, errors.New("the private key does not match the public key of the key file " + keyFileName)
+	}
+
+	return keyGen, privKey, pubKey, nil
+}
+
 // GetPkEncoded returns the encoded public key
 func GetPkEncoded(pubKey crypto.PublicKey) string {
 	pk, err := pubKey.ToByteArray()
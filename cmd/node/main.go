@@ -23,14 +23,22 @@ import (
 	"github.com/ElrondNetwork/elrond-go/cmd/node/factory"
 	"github.com/ElrondNetwork/elrond-go/cmd/node/metrics"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus/recorder"
+	"github.com/ElrondNetwork/elrond-go/consensus/round"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/history"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/core/logger"
 	"github.com/ElrondNetwork/elrond-go/core/serviceContainer"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/keystore"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/remote"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/facade"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
@@ -44,9 +52,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	factoryViews "github.com/ElrondNetwork/elrond-go/statusHandler/factory"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/ElrondNetwork/elrond-vm/iele/elrond/node/endpoint"
 	"github.com/google/gops/agent"
+	_ "github.com/lib/pq"
 	"github.com/urfave/cli"
 )
 
@@ -119,6 +130,12 @@ VERSION:
 		Usage: "The preferences configuration file to load",
 		Value: "./config/prefs.toml",
 	}
+	// configurationApiFile defines a flag for the path to the REST API access toml configuration file
+	configurationApiFile = cli.StringFlag{
+		Name:  "configApi",
+		Usage: "The REST API access configuration file to load",
+		Value: "./config/api.toml",
+	}
 	// p2pConfigurationFile defines a flag for the path to the toml file containing P2P configuration
 	p2pConfigurationFile = cli.StringFlag{
 		Name:  "p2pconfig",
@@ -253,6 +270,18 @@ VERSION:
 		Name:  "tx-indexing",
 		Usage: "Enables transaction indexing. There can be cases when it's too expensive to index all transactions so we provide the command line option to disable this behaviour",
 	}
+	// indexBackfillStartNonce defines a flag for the nonce of the first block to be indexed from the local storage
+	indexBackfillStartNonce = cli.Uint64Flag{
+		Name:  "index-backfill-start-nonce",
+		Usage: "Nonce of the first block to be re-indexed from the local storage, when index-backfill-end-nonce is set",
+		Value: 0,
+	}
+	// indexBackfillEndNonce defines a flag for the nonce of the last block to be indexed from the local storage
+	indexBackfillEndNonce = cli.Uint64Flag{
+		Name: "index-backfill-end-nonce",
+		Usage: "If set, and if the explorer is enabled, the blocks having the nonces between index-backfill-start-nonce" +
+			" and this nonce are indexed from the local storage. A stopped backfill resumes when restarted with the same nonces",
+	}
 
 	// workingDirectory defines a flag for the path for the working directory.
 	workingDirectory = cli.StringFlag{
@@ -268,6 +297,25 @@ VERSION:
 		Value: "",
 	}
 
+	// txSignKeyFile defines a flag for the path of the password encrypted key file of the transactions signing key
+	txSignKeyFile = cli.StringFlag{
+		Name:  "tx-sign-key-file",
+		Usage: "Password encrypted key file, from a keystore, of the key that will sign transactions. If set, it overwrites the tx-sign-sk flags",
+		Value: "",
+	}
+	// validatorKeyFile defines a flag for the path of the password encrypted key file of the block signing key
+	validatorKeyFile = cli.StringFlag{
+		Name:  "validator-key-file",
+		Usage: "Password encrypted key file, from a keystore, of the key that will sign blocks. If set, it overwrites the sk flags",
+		Value: "",
+	}
+	// keyPasswordFile defines a flag for the path of the file containing the password of the key files
+	keyPasswordFile = cli.StringFlag{
+		Name:  "key-password-file",
+		Usage: "The file containing the password of the key files. If not set, the password is asked on the terminal",
+		Value: "",
+	}
+
 	rm *statistics.ResourceMonitor
 )
 
@@ -275,6 +323,14 @@ VERSION:
 //  certain conditions. If those conditions will not be met, it will stay as nil
 var dbIndexer indexer.Indexer
 
+// indexBackfiller will hold the backfiller which indexes the blocks from the local storage, if one was requested
+var indexBackfiller indexBackfillHandler
+
+type indexBackfillHandler interface {
+	Start()
+	Close() error
+}
+
 // coreServiceContainer is defined globally so it can be injected with appropriate
 //  params depending on the type of node we are starting
 var coreServiceContainer serviceContainer.Core
@@ -303,6 +359,7 @@ func main() {
 		configurationFile,
 		configurationEconomicsFile,
 		configurationPreferencesFile,
+		configurationApiFile,
 		p2pConfigurationFile,
 		txSignSk,
 		sk,
@@ -323,8 +380,13 @@ func main() {
 		useLogView,
 		bootstrapRoundIndex,
 		enableTxIndexing,
+		indexBackfillStartNonce,
+		indexBackfillEndNonce,
 		workingDirectory,
 		destinationShardAsObserver,
+		txSignKeyFile,
+		validatorKeyFile,
+		keyPasswordFile,
 	}
 	app.Authors = []cli.Author{
 		{
@@ -359,6 +421,59 @@ func getSuite(config *config.Config) (crypto.Suite, error) {
 	return nil, errors.New("no consensus provided in config file")
 }
 
+// createBlockSigningParams returns the key generator and the key pair used for block signing. With a remote block
+// signer, the private key is kept by the signer process and the returned signer backend forwards the signing requests
+// to it. Otherwise, the private key is loaded in this process and the returned signer backend is nil
+func createBlockSigningParams(
+	ctx *cli.Context,
+	generalConfig *config.Config,
+	suite crypto.Suite,
+	keyPassword []byte,
+	log *logger.Logger,
+) (crypto.KeyGenerator, crypto.PrivateKey, crypto.PublicKey, remote.SignerBackend, error) {
+
+	switch generalConfig.BlockSigner.Type {
+	case factory.LocalBlockSignerType:
+		if ctx.IsSet(validatorKeyFile.Name) {
+			keyGen, privKey, pubKey, err := factory.GetSigningParamsFromKeyFile(
+				ctx.GlobalString(validatorKeyFile.Name),
+				keyPassword,
+				suite)
+
+			return keyGen, privKey, pubKey, nil, err
+		}
+
+		initialNodesSkPemFileName := ctx.GlobalString(initialNodesSkPemFile.Name)
+		keyGen, privKey, pubKey, err := factory.GetSigningParams(
+			ctx,
+			log,
+			sk.Name,
+			skIndex.Name,
+			initialNodesSkPemFileName,
+			suite)
+
+		return keyGen, privKey, pubKey, nil, err
+	case factory.RemoteBlockSignerType:
+		signerConfig := generalConfig.BlockSigner
+		signerClient, err := remote.NewSignerClient(
+			signerConfig.Network,
+			signerConfig.Address,
+			time.Millisecond*time.Duration(signerConfig.RequestTimeoutInMs),
+		)
+		if err != nil {
+			return nil, nil, nil, nil, errors.New("could not connect to the remote signer: " + err.Error())
+		}
+		log.Info(fmt.Sprintf("Connected to the remote signer on %s %s", signerConfig.Network, signerConfig.Address))
+
+		keyGen := signing.NewKeyGenerator(suite)
+		privKey, pubKey, err := remote.CreateKeyPair(signerClient, keyGen)
+
+		return keyGen, privKey, pubKey, signerClient, err
+	}
+
+	return nil, nil, nil, nil, errors.New("unknown block signer type in config file: " + generalConfig.BlockSigner.Type)
+}
+
 func startNode(ctx *cli.Context, log *logger.Logger, version string) error {
 	logLevel := ctx.GlobalString(logLevel.Name)
 	log.SetLevel(logLevel)
@@ -393,6 +508,13 @@ func startNode(ctx *cli.Context, log *logger.Logger, version string) error {
 	}
 	log.Info(fmt.Sprintf("Initialized with config preferences from: %s", configurationPreferencesFileName))
 
+	configurationApiFileName := ctx.GlobalString(configurationApiFile.Name)
+	apiConfig, err := loadApiConfig(configurationApiFileName, log)
+	if err != nil {
+		return err
+	}
+	log.Info(fmt.Sprintf("Initialized with config api from: %s", configurationApiFileName))
+
 	p2pConfigurationFileName := ctx.GlobalString(p2pConfigurationFile.Name)
 	p2pConfig, err := core.LoadP2PConfig(p2pConfigurationFileName)
 	if err != nil {
@@ -438,14 +560,24 @@ func startNode(ctx *cli.Context, log *logger.Logger, version string) error {
 		return err
 	}
 
-	initialNodesSkPemFileName := ctx.GlobalString(initialNodesSkPemFile.Name)
-	keyGen, privKey, pubKey, err := factory.GetSigningParams(
-		ctx,
-		log,
-		sk.Name,
-		skIndex.Name,
-		initialNodesSkPemFileName,
-		suite)
+	rounder, err := round.NewRound(
+		startTime,
+		syncer.CurrentTime(),
+		time.Millisecond*time.Duration(nodesConfig.RoundDuration),
+		syncer)
+	if err != nil {
+		return err
+	}
+
+	var keyPassword []byte
+	if ctx.IsSet(txSignKeyFile.Name) || ctx.IsSet(validatorKeyFile.Name) {
+		keyPassword, err = keystore.ReadPassword(ctx.GlobalString(keyPasswordFile.Name), "Key files password: ")
+		if err != nil {
+			return err
+		}
+	}
+
+	keyGen, privKey, pubKey, signerBackend, err := createBlockSigningParams(ctx, generalConfig, suite, keyPassword, log)
 	if err != nil {
 		return err
 	}
@@ -468,6 +600,10 @@ func startNode(ctx *cli.Context, log *logger.Logger, version string) error {
 		return err
 	}
 
+	if nodeType == core.NodeTypeValidator && !generalConfig.TransactionHistory.EnabledOnValidators {
+		generalConfig.TransactionHistory.Enabled = false
+	}
+
 	var workingDir = ""
 	if ctx.IsSet(workingDirectory.Name) {
 		workingDir = ctx.GlobalString(workingDirectory.Name)
@@ -596,10 +732,13 @@ func startNode(ctx *cli.Context, log *logger.Logger, version string) error {
 		shardCoordinator,
 		keyGen,
 		privKey,
+		signerBackend,
 		log,
 		initialBalancesSkPemFile.Name,
 		txSignSk.Name,
 		txSignSkIndex.Name,
+		txSignKeyFile.Name,
+		keyPassword,
 	)
 	cryptoComponents, err := factory.CryptoComponentsFactory(cryptoArgs)
 	if err != nil {
@@ -639,21 +778,72 @@ func startNode(ctx *cli.Context, log *logger.Logger, version string) error {
 		return err
 	}
 
+	var subscriptionHub *subscription.Hub
+	var subscriptionNotifier subscription.Notifier
+	if generalConfig.Subscriptions.Enabled {
+		subscriptionHub, err = createSubscriptionHub(
+			generalConfig.Subscriptions,
+			coreComponents,
+			stateComponents,
+			dataComponents,
+			shardCoordinator,
+		)
+		if err != nil {
+			return err
+		}
+		subscriptionNotifier = subscriptionHub
+	}
+
+	var txHistory *history.TransactionHistory
+	var txHistoryHandler history.Handler
+	if generalConfig.TransactionHistory.Enabled && shardCoordinator.SelfId() < shardCoordinator.NumberOfShards() {
+		txHistory, err = history.NewTransactionHistory(history.ArgTransactionHistory{
+			Storer:           dataComponents.Store.GetStorer(dataRetriever.TransactionHistoryUnit),
+			Marshalizer:      coreComponents.Marshalizer,
+			AddressConverter: stateComponents.AddressConverter,
+			ShardCoordinator: shardCoordinator,
+		})
+		if err != nil {
+			return err
+		}
+		txHistoryHandler = txHistory
+	}
+
 	if generalConfig.Explorer.Enabled {
 		serversConfigurationFileName := ctx.GlobalString(serversConfigurationFile.Name)
-		dbIndexer, err = createElasticIndexer(
+		dbIndexer, err = createIndexer(
 			ctx,
 			serversConfigurationFileName,
-			generalConfig.Explorer.IndexerURL,
+			generalConfig.Explorer,
+			uniqueDBFolder,
 			shardCoordinator,
 			coreComponents.Marshalizer,
 			coreComponents.Hasher,
+			coreComponents.StatusHandler,
 			log)
 		if err != nil {
 			return err
 		}
 
-		err = setServiceContainer(shardCoordinator, tpsBenchmark)
+		if ctx.GlobalIsSet(indexBackfillEndNonce.Name) {
+			indexBackfiller, err = createIndexBackfiller(
+				ctx,
+				generalConfig.Explorer.BackfillDB,
+				uniqueDBFolder,
+				dataComponents.Store,
+				coreComponents,
+				shardCoordinator,
+				nodesCoordinator,
+				log)
+			if err != nil {
+				return err
+			}
+			indexBackfiller.Start()
+		}
+	}
+
+	if generalConfig.Explorer.Enabled || generalConfig.Subscriptions.Enabled || txHistory != nil {
+		err = setServiceContainer(shardCoordinator, tpsBenchmark, subscriptionNotifier, txHistoryHandler)
 		if err != nil {
 			return err
 		}
@@ -669,6 +859,7 @@ func startNode(ctx *cli.Context, log *logger.Logger, version string) error {
 		economicsData,
 		nodesConfig,
 		syncer,
+		rounder,
 		shardCoordinator,
 		nodesCoordinator,
 		dataComponents,
@@ -677,6 +868,9 @@ func startNode(ctx *cli.Context, log *logger.Logger, version string) error {
 		stateComponents,
 		networkComponents,
 		coreServiceContainer,
+		generalConfig.Consensus.Type,
+		generalConfig.AdaptiveRoundTiming,
+		generalConfig.SigVerificationPipeline,
 	)
 	processComponents, err := factory.ProcessComponentsFactory(processArgs)
 	if err != nil {
@@ -709,11 +903,19 @@ func startNode(ctx *cli.Context, log *logger.Logger, version string) error {
 		ctx.GlobalUint64(bootstrapRoundIndex.Name),
 		version,
 		elasticIndexer,
+		economicsData,
 	)
 	if err != nil {
 		return err
 	}
 
+	if generalConfig.ConsensusRecorder.Enabled {
+		err = setConsensusRecorder(currentNode, generalConfig.ConsensusRecorder, pubKey, workingDir)
+		if err != nil {
+			return err
+		}
+	}
+
 	softwareVersionChecker, err := factory.CreateSoftwareVersionChecker(coreComponents.StatusHandler)
 	if err != nil {
 		log.Info("nil software version checker", err)
@@ -763,12 +965,19 @@ func startNode(ctx *cli.Context, log *logger.Logger, version string) error {
 		Prometheus:        usePrometheusBool,
 		PrometheusJoinURL: prometheusJoinUrl,
 		PrometheusJobName: generalConfig.GeneralSettings.NetworkID,
+		Api:               *apiConfig,
 	}
 
 	ef.SetLogger(log)
 	ef.SetSyncer(syncer)
 	ef.SetTpsBenchmark(tpsBenchmark)
 	ef.SetConfig(efConfig)
+	if subscriptionHub != nil {
+		ef.SetSubscriptionHub(subscriptionHub)
+	}
+	if txHistory != nil {
+		ef.SetTransactionHistory(txHistory)
+	}
 
 	wg := sync.WaitGroup{}
 	go ef.StartBackgroundServices(&wg)
@@ -792,10 +1001,32 @@ func startNode(ctx *cli.Context, log *logger.Logger, version string) error {
 	log.Info("Application is now running...")
 	<-stop
 
+	err = currentNode.CloseConsensusRecorder()
+	log.LogIfError(err)
+
+	err = currentNode.ClosePeerIdentityHandshake()
+	log.LogIfError(err)
+
 	if rm != nil {
 		err = rm.Close()
 		log.LogIfError(err)
 	}
+
+	if indexBackfiller != nil {
+		err = indexBackfiller.Close()
+		log.LogIfError(err)
+	}
+
+	if subscriptionHub != nil {
+		err = subscriptionHub.Close()
+		log.LogIfError(err)
+	}
+
+	indexerCloser, ok := dbIndexer.(io.Closer)
+	if ok {
+		err = indexerCloser.Close()
+		log.LogIfError(err)
+	}
 	return nil
 }
 
@@ -883,6 +1114,16 @@ func loadPreferencesConfig(filepath string, log *logger.Logger) (*config.ConfigP
 	return cfg, nil
 }
 
+func loadApiConfig(filepath string, log *logger.Logger) (*config.ApiConfig, error) {
+	cfg := &config.ApiConfig{}
+	err := core.LoadTomlFile(cfg, filepath, log)
+	if err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
 func getShardIdFromNodePubKey(pubKey crypto.PublicKey, nodesConfig *sharding.NodesSetup) (uint32, error) {
 	if pubKey == nil {
 		return 0, errors.New("nil public key")
@@ -1008,15 +1249,18 @@ func processDestinationShardAsObserver(settingsConfig config.GeneralSettingsConf
 	return uint32(val), err
 }
 
-// createElasticIndexer creates a new elasticIndexer where the server listens on the url,
-// authentication for the server is using the username and password
-func createElasticIndexer(
+// createIndexer creates the indexers listed in the servers configuration file, feeding all of them through a
+// fan-out indexer when there are more. If none is listed, a single elasticIndexer is created, where the server
+// listens on the explorer's url and the authentication is using the elastic search username and password
+func createIndexer(
 	ctx *cli.Context,
 	serversConfigurationFileName string,
-	url string,
+	explorerConfig config.ExplorerConfig,
+	uniqueDBFolder string,
 	coordinator sharding.Coordinator,
 	marshalizer marshal.Marshalizer,
 	hasher hashing.Hasher,
+	appStatusHandler core.AppStatusHandler,
 	log *logger.Logger,
 ) (indexer.Indexer, error) {
 	serversConfig, err := core.LoadServersPConfig(serversConfigurationFileName)
@@ -1024,20 +1268,124 @@ func createElasticIndexer(
 		return nil, err
 	}
 
-	dbIndexer, err = indexer.NewElasticIndexer(
-		url,
-		serversConfig.ElasticSearch.Username,
-		serversConfig.ElasticSearch.Password,
-		coordinator,
-		marshalizer,
-		hasher,
-		log,
-		&indexer.Options{TxIndexingEnabled: ctx.GlobalBoolT(enableTxIndexing.Name)})
+	indexersConfig := serversConfig.Indexers
+	if len(indexersConfig) == 0 {
+		indexersConfig = []config.IndexerConfig{
+			{
+				Driver:   indexer.ElasticSearchDriver,
+				URL:      explorerConfig.IndexerURL,
+				Username: serversConfig.ElasticSearch.Username,
+				Password: serversConfig.ElasticSearch.Password,
+			},
+		}
+	}
+
+	options := &indexer.Options{
+		TxIndexingEnabled: ctx.GlobalBoolT(enableTxIndexing.Name),
+		StatusHandler:     appStatusHandler,
+		MinRetryDelay:     time.Duration(explorerConfig.MinRetryDelayInMs) * time.Millisecond,
+		MaxRetryDelay:     time.Duration(explorerConfig.MaxRetryDelayInMs) * time.Millisecond,
+	}
+
+	createQueuePersister := func(name string) (storage.Persister, error) {
+		queueDBConfig := explorerConfig.QueueDB
+		filePath := queueDBConfig.FilePath
+		if len(name) > 0 {
+			filePath += "_" + name
+		}
+
+		return storageUnit.NewDB(
+			storageUnit.DBType(queueDBConfig.Type),
+			filepath.Join(uniqueDBFolder, filePath),
+			queueDBConfig.BatchDelaySeconds,
+			queueDBConfig.MaxBatchSize,
+			queueDBConfig.MaxOpenFiles,
+		)
+	}
+
+	indexers := make([]indexer.Indexer, 0, len(indexersConfig))
+	for _, indexerConfig := range indexersConfig {
+		idx, errCreate := indexer.NewIndexerFromDriver(indexer.DriverArgs{
+			Config:               indexerConfig,
+			ShardCoordinator:     coordinator,
+			Marshalizer:          marshalizer,
+			Hasher:               hasher,
+			Logger:               log,
+			Options:              options,
+			CreateQueuePersister: createQueuePersister,
+		})
+		if errCreate != nil {
+			closeIndexers(indexers, log)
+			return nil, fmt.Errorf("cannot create the %s indexer %s: %s",
+				indexerConfig.Driver, indexerConfig.Name, errCreate.Error())
+		}
+
+		log.Info(fmt.Sprintf("created the %s indexer %s", indexerConfig.Driver, indexerConfig.Name))
+		indexers = append(indexers, idx)
+	}
+
+	if len(indexers) == 1 {
+		return indexers[0], nil
+	}
+
+	return indexer.NewMultiIndexer(indexers...)
+}
+
+// createIndexBackfiller creates the backfiller which indexes, from the local storage, the blocks having the nonces
+// set through the command line flags
+func createIndexBackfiller(
+	ctx *cli.Context,
+	backfillDBConfig config.DBConfig,
+	uniqueDBFolder string,
+	store dataRetriever.StorageService,
+	coreComponents *factory.Core,
+	coordinator sharding.Coordinator,
+	nodesCoordinator sharding.PublicKeysSelector,
+	log *logger.Logger,
+) (indexBackfillHandler, error) {
+	blockIndexer, ok := dbIndexer.(indexer.BlockIndexer)
+	if !ok {
+		return nil, errors.New("the configured indexer can not be used for backfilling")
+	}
+
+	persister, err := storageUnit.NewDB(
+		storageUnit.DBType(backfillDBConfig.Type),
+		filepath.Join(uniqueDBFolder, backfillDBConfig.FilePath),
+		backfillDBConfig.BatchDelaySeconds,
+		backfillDBConfig.MaxBatchSize,
+		backfillDBConfig.MaxOpenFiles,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	backfiller, err := indexer.NewBackfiller(indexer.ArgBackfiller{
+		Store:            store,
+		Indexer:          blockIndexer,
+		Marshalizer:      coreComponents.Marshalizer,
+		Uint64Converter:  coreComponents.Uint64ByteSliceConverter,
+		ShardCoordinator: coordinator,
+		NodesCoordinator: nodesCoordinator,
+		Persister:        persister,
+		Logger:           log,
+		StartNonce:       ctx.GlobalUint64(indexBackfillStartNonce.Name),
+		EndNonce:         ctx.GlobalUint64(indexBackfillEndNonce.Name),
+	})
 	if err != nil {
+		_ = persister.Close()
 		return nil, err
 	}
 
-	return dbIndexer, nil
+	return backfiller, nil
+}
+
+func closeIndexers(indexers []indexer.Indexer, log *logger.Logger) {
+	for _, idx := range indexers {
+		closer, ok := idx.(io.Closer)
+		if ok {
+			log.LogIfError(closer.Close())
+		}
+	}
 }
 
 func getConsensusGroupSize(nodesConfig *sharding.NodesSetup, shardCoordinator sharding.Coordinator) (uint32, error) {
@@ -1070,6 +1418,7 @@ func createNode(
 	bootstrapRoundIndex uint64,
 	version string,
 	indexer indexer.Indexer,
+	economicsData *economics.EconomicsData,
 ) (*node.Node, error) {
 	consensusGroupSize, err := getConsensusGroupSize(nodesConfig, shardCoordinator)
 	if err != nil {
@@ -1106,10 +1455,12 @@ func createNode(
 		node.WithResolversFinder(process.ResolversFinder),
 		node.WithConsensusType(config.Consensus.Type),
 		node.WithTxSingleSigner(crypto.TxSingleSigner),
+		node.WithTxSignKeyGen(crypto.TxSignKeyGen),
 		node.WithTxStorageSize(config.TxStorage.Cache.Size),
 		node.WithBootstrapRoundIndex(bootstrapRoundIndex),
 		node.WithAppStatusHandler(core.StatusHandler),
 		node.WithIndexer(indexer),
+		node.WithFeeHandler(economicsData),
 	)
 	if err != nil {
 		return nil, errors.New("error creating node: " + err.Error())
@@ -1120,6 +1471,18 @@ func createNode(
 		return nil, err
 	}
 
+	err = nd.StartPeerIdentityHandshake(config.PeerIdentity)
+	if err != nil {
+		return nil, err
+	}
+
+	if crypto.HeaderProviderSetter != nil {
+		err = nd.ApplyOptions(node.WithHeaderProviderSetter(crypto.HeaderProviderSetter))
+		if err != nil {
+			return nil, errors.New("error creating node: " + err.Error())
+		}
+	}
+
 	if shardCoordinator.SelfId() < shardCoordinator.NumberOfShards() {
 		err = nd.ApplyOptions(
 			node.WithInitialNodesBalances(state.InBalanceForShard),
@@ -1142,6 +1505,30 @@ func createNode(
 	return nd, nil
 }
 
+func setConsensusRecorder(
+	currentNode *node.Node,
+	recorderConfig config.ConsensusRecorderConfig,
+	pubKey crypto.PublicKey,
+	workingDir string,
+) error {
+	publicKey, err := pubKey.ToByteArray()
+	if err != nil {
+		return err
+	}
+
+	writer, err := recorder.NewRotatingFileWriter(
+		filepath.Join(workingDir, recorderConfig.FolderPath),
+		core.GetTrimmedPk(hex.EncodeToString(publicKey)),
+		int64(recorderConfig.MaxFileSizeInMB)*1024*1024,
+		recorderConfig.MaxFiles,
+	)
+	if err != nil {
+		return err
+	}
+
+	return currentNode.ApplyOptions(node.WithConsensusRecordWriter(writer))
+}
+
 func initLogFileAndStatsMonitor(config *config.Config, pubKey crypto.PublicKey, log *logger.Logger,
 	workingDir string) error {
 	publicKey, err := pubKey.ToByteArray()
@@ -1169,10 +1556,18 @@ func initLogFileAndStatsMonitor(config *config.Config, pubKey crypto.PublicKey,
 	return nil
 }
 
-func setServiceContainer(shardCoordinator sharding.Coordinator, tpsBenchmark *statistics.TpsBenchmark) error {
+func setServiceContainer(
+	shardCoordinator sharding.Coordinator,
+	tpsBenchmark *statistics.TpsBenchmark,
+	notifier subscription.Notifier,
+	txHistory history.Handler,
+) error {
 	var err error
 	if shardCoordinator.SelfId() < shardCoordinator.NumberOfShards() {
-		coreServiceContainer, err = serviceContainer.NewServiceContainer(serviceContainer.WithIndexer(dbIndexer))
+		coreServiceContainer, err = serviceContainer.NewServiceContainer(
+			serviceContainer.WithIndexer(dbIndexer),
+			serviceContainer.WithSubscriptionNotifier(notifier),
+			serviceContainer.WithTransactionHistory(txHistory))
 		if err != nil {
 			return err
 		}
@@ -1181,7 +1576,8 @@ func setServiceContainer(shardCoordinator sharding.Coordinator, tpsBenchmark *st
 	if shardCoordinator.SelfId() == sharding.MetachainShardId {
 		coreServiceContainer, err = serviceContainer.NewServiceContainer(
 			serviceContainer.WithIndexer(dbIndexer),
-			serviceContainer.WithTPSBenchmark(tpsBenchmark))
+			serviceContainer.WithTPSBenchmark(tpsBenchmark),
+			serviceContainer.WithSubscriptionNotifier(notifier))
 		if err != nil {
 			return err
 		}
@@ -1190,6 +1586,38 @@ func setServiceContainer(shardCoordinator sharding.Coordinator, tpsBenchmark *st
 	return errors.New("could not init core service container")
 }
 
+// createSubscriptionHub creates the hub which dispatches the node's events to the websocket subscribers. On shard
+// nodes, the transactions added in the pool are published as pending transactions
+func createSubscriptionHub(
+	config config.SubscriptionsConfig,
+	coreComponents *factory.Core,
+	stateComponents *factory.State,
+	dataComponents *factory.Data,
+	shardCoordinator sharding.Coordinator,
+) (*subscription.Hub, error) {
+	hub, err := subscription.NewHub(subscription.ArgHub{
+		AddressConverter:         stateComponents.AddressConverter,
+		AccountsTrie:             coreComponents.Trie,
+		Marshalizer:              coreComponents.Marshalizer,
+		MaxSubscribers:           config.MaxSubscribers,
+		MaxFiltersPerSubscriber:  config.MaxFiltersPerSubscriber,
+		EventsBufferSize:         config.EventsBufferSize,
+		CommittedBlocksQueueSize: config.CommittedBlocksQueueSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if shardCoordinator.SelfId() < shardCoordinator.NumberOfShards() {
+		err = hub.RegisterTransactionsPool(dataComponents.Datapool.Transactions())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return hub, nil
+}
+
 func startStatisticsMonitor(file *os.File, config config.ResourceStatsConfig, log *logger.Logger) error {
 	if !config.Enabled {
 		return nil
@@ -122,6 +122,16 @@ func registerPollConnectedPeers(
 	numOfConnectedPeersHandlerFunc := func(appStatusHandler core.AppStatusHandler) {
 		numOfConnectedPeers := uint64(len(networkComponents.NetMessenger.ConnectedAddresses()))
 		appStatusHandler.SetUInt64Value(core.MetricNumConnectedPeers, numOfConnectedPeers)
+
+		reachability := networkComponents.NetMessenger.Reachability()
+		numReachable := uint64(0)
+		for _, peerReachability := range reachability {
+			if peerReachability.IsReachable {
+				numReachable++
+			}
+		}
+		appStatusHandler.SetUInt64Value(core.MetricNumStaticPeers, uint64(len(reachability)))
+		appStatusHandler.SetUInt64Value(core.MetricNumReachableStaticPeers, numReachable)
 	}
 
 	err := appStatusPollingHandler.RegisterPollingFunc(numOfConnectedPeersHandlerFunc)
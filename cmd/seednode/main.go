@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"os/signal"
 	"sort"
@@ -14,15 +15,18 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/cmd/seednode/peers"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/display"
 	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/discovery"
 	factoryP2P "github.com/ElrondNetwork/elrond-go/p2p/libp2p/factory"
 	"github.com/ElrondNetwork/elrond-go/p2p/loadBalancer"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
 	"github.com/btcsuite/btcd/btcec"
 	libp2pCrypto "github.com/libp2p/go-libp2p-core/crypto"
 	"github.com/urfave/cli"
@@ -57,7 +61,8 @@ VERSION:
 		Value: "seed",
 	}
 
-	p2pConfigurationFile = "./config/p2p.toml"
+	p2pConfigurationFile      = "./config/p2p.toml"
+	seedNodeConfigurationFile = "./config/seednode.toml"
 
 	errNilSeed                     = errors.New("nil seed")
 	errEmotySeed                   = errors.New("empty seed")
@@ -65,8 +70,12 @@ VERSION:
 	errEmptyBuffer                 = errors.New("empty buffer")
 	errInvalidPort                 = errors.New("cannot start node on port < 0")
 	errPeerDiscoveryShouldBeKadDht = errors.New("kad-dht peer discovery should have been enabled")
+	errMessengerNotSupported       = errors.New("messenger does not provide network information")
+	errInvalidSampleInterval       = errors.New("connection sample interval should be greater than 0")
 )
 
+const peerStoreMaxOpenFiles = 10
+
 type seedRandReader struct {
 	index int
 	seed  []byte
@@ -143,16 +152,45 @@ func startNode(ctx *cli.Context) error {
 		p2pConfig.Node.Seed = ctx.GlobalString(p2pSeed.Name)
 	}
 
+	seedNodeConfig, err := core.LoadSeedNodeConfig(seedNodeConfigurationFile)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Initialized with seed node config from: %s\n", seedNodeConfigurationFile)
+	if seedNodeConfig.ConnectionSampleIntervalInSec < 1 {
+		return errInvalidSampleInterval
+	}
+
 	fmt.Println("Seed node....")
 	messenger, err := createNode(p2pConfig)
 	if err != nil {
 		return err
 	}
+
+	tracker, store, err := createPeerTracker(messenger, seedNodeConfig)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		errClose := store.Close()
+		if errClose != nil {
+			fmt.Printf("closing the peer store: %s\n", errClose.Error())
+		}
+	}()
+	fmt.Printf("Dialed %d known peers\n", tracker.DialKnownPeers())
+
 	err = messenger.Bootstrap()
 	if err != nil {
 		return err
 	}
 
+	err = startRestApi(seedNodeConfig.RestApiInterface, tracker)
+	if err != nil {
+		return err
+	}
+	chStopTracking := make(chan struct{})
+	go trackPeers(tracker, seedNodeConfig, chStopTracking)
+
 	go func() {
 		<-sigs
 		fmt.Println("terminating at user's signal...")
@@ -164,6 +202,7 @@ func startNode(ctx *cli.Context) error {
 	for {
 		select {
 		case <-stop:
+			close(chStopTracking)
 			return nil
 		case <-time.After(time.Second * 5):
 			displayMessengerInfo(messenger)
@@ -226,9 +265,108 @@ func createNetMessenger(
 		return nil, err
 	}
 
+	if len(p2pConfig.Node.NetworkID) > 0 {
+		err = nm.SetNetworkID(p2pConfig.Node.NetworkID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return nm, nil
 }
 
+func createPeerTracker(
+	messenger p2p.Messenger,
+	seedNodeConfig *config.SeedNodeConfig,
+) (*peers.PeerTracker, *peers.PeerStore, error) {
+
+	seedMessenger, ok := messenger.(peers.SeedMessenger)
+	if !ok {
+		return nil, nil, errMessengerNotSupported
+	}
+
+	persister, err := leveldb.NewDB(seedNodeConfig.PeerStore.DBPath, 1, 1, peerStoreMaxOpenFiles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	store, err := peers.NewPeerStore(persister, &marshal.JsonMarshalizer{})
+	if err != nil {
+		_ = persister.Close()
+		return nil, nil, err
+	}
+	fmt.Printf("Loaded %d known peers from %s\n", store.Len(), seedNodeConfig.PeerStore.DBPath)
+
+	tracker, err := peers.NewPeerTracker(
+		seedMessenger,
+		store,
+		seedNodeConfig.AllowedNetworkIDs,
+		seedNodeConfig.MaxConnectionSamples,
+	)
+	if err != nil {
+		_ = store.Close()
+		return nil, nil, err
+	}
+
+	err = messenger.AddConnectionNotifiee(tracker)
+	if err != nil {
+		_ = store.Close()
+		return nil, nil, err
+	}
+
+	return tracker, store, nil
+}
+
+func startRestApi(restApiInterface string, tracker *peers.PeerTracker) error {
+	if len(restApiInterface) == 0 {
+		return nil
+	}
+
+	handler, err := peers.NewApiHandler(tracker)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		fmt.Printf("Starting REST API on %s\n", restApiInterface)
+		errServe := http.ListenAndServe(restApiInterface, handler)
+		if errServe != nil {
+			fmt.Printf("REST API stopped: %s\n", errServe.Error())
+		}
+	}()
+
+	return nil
+}
+
+func trackPeers(tracker *peers.PeerTracker, seedNodeConfig *config.SeedNodeConfig, chStop chan struct{}) {
+	sampleInterval := time.Second * time.Duration(seedNodeConfig.ConnectionSampleIntervalInSec)
+	redialInterval := time.Second * time.Duration(seedNodeConfig.PeerStore.RedialIntervalInSec)
+	forgetAfter := time.Hour * time.Duration(seedNodeConfig.PeerStore.ForgetPeersAfterInHours)
+	lastRedial := time.Now()
+
+	for {
+		select {
+		case <-chStop:
+			return
+		case <-time.After(sampleInterval):
+		}
+
+		tracker.Sample()
+
+		if forgetAfter > 0 {
+			err := tracker.ForgetPeersNotSeenSince(time.Now().Add(-forgetAfter))
+			if err != nil {
+				fmt.Printf("forgetting old peers: %s\n", err.Error())
+			}
+		}
+
+		if redialInterval > 0 && time.Since(lastRedial) >= redialInterval {
+			tracker.DialKnownPeers()
+			lastRedial = time.Now()
+		}
+	}
+}
+
 func displayMessengerInfo(messenger p2p.Messenger) {
 	headerSeedAddresses := []string{"Seednode addresses:"}
 	addresses := make([]*display.LineData, 0)
@@ -0,0 +1,42 @@
+package peers
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// NewApiHandler creates the HTTP handler serving the seed node peers and connection stats
+func NewApiHandler(statsProvider StatsProvider) (http.Handler, error) {
+	if statsProvider == nil || statsProvider.IsInterfaceNil() {
+		return nil, ErrNilStatsProvider
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/peers", func(w http.ResponseWriter, r *http.Request) {
+		writeJson(w, r, map[string]interface{}{"peers": statsProvider.KnownPeers()})
+	})
+	mux.HandleFunc("/connections", func(w http.ResponseWriter, r *http.Request) {
+		writeJson(w, r, map[string]interface{}{
+			"numConnectedPeers": statsProvider.NumConnectedPeers(),
+			"samples":           statsProvider.ConnectionSamples(),
+		})
+	})
+	mux.HandleFunc("/protocols", func(w http.ResponseWriter, r *http.Request) {
+		writeJson(w, r, map[string]interface{}{"protocols": statsProvider.ProtocolStats()})
+	})
+
+	return mux, nil
+}
+
+func writeJson(w http.ResponseWriter, r *http.Request, response interface{}) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewEncoder(w).Encode(response)
+	if err != nil {
+		log.Debug(err.Error())
+	}
+}
@@ -0,0 +1,93 @@
+package peers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/seednode/peers"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/stretchr/testify/assert"
+)
+
+func createApiHandler(t *testing.T) http.Handler {
+	connected := map[p2p.PeerID]string{"pid": testNetworkID}
+	store, _ := createPeerStore(t)
+	pt, _ := peers.NewPeerTracker(createMessengerStub(connected), store, nil, 10)
+	pt.Sample()
+
+	handler, err := peers.NewApiHandler(pt)
+	assert.Nil(t, err)
+
+	return handler
+}
+
+func doGet(handler http.Handler, path string, response interface{}) int {
+	req, _ := http.NewRequest(http.MethodGet, path, nil)
+	resp := httptest.NewRecorder()
+	handler.ServeHTTP(resp, req)
+
+	_ = json.NewDecoder(resp.Body).Decode(response)
+
+	return resp.Code
+}
+
+func TestNewApiHandler_NilStatsProviderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	handler, err := peers.NewApiHandler(nil)
+
+	assert.Nil(t, handler)
+	assert.Equal(t, peers.ErrNilStatsProvider, err)
+}
+
+func TestApiHandler_PeersShouldWork(t *testing.T) {
+	t.Parallel()
+
+	response := struct {
+		Peers []peers.PeerInfo `json:"peers"`
+	}{}
+	code := doGet(createApiHandler(t), "/peers", &response)
+
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, 1, len(response.Peers))
+	assert.True(t, response.Peers[0].IsConnected)
+	assert.Equal(t, testNetworkID, response.Peers[0].NetworkID)
+}
+
+func TestApiHandler_ConnectionsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	response := struct {
+		NumConnectedPeers int                      `json:"numConnectedPeers"`
+		Samples           []peers.ConnectionSample `json:"samples"`
+	}{}
+	code := doGet(createApiHandler(t), "/connections", &response)
+
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, 1, response.NumConnectedPeers)
+	assert.Equal(t, 1, len(response.Samples))
+}
+
+func TestApiHandler_ProtocolsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	response := struct {
+		Protocols map[string]int `json:"protocols"`
+	}{}
+	code := doGet(createApiHandler(t), "/protocols", &response)
+
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, 1, response.Protocols["/elrond/network/"+testNetworkID])
+}
+
+func TestApiHandler_PostShouldNotBeAllowed(t *testing.T) {
+	t.Parallel()
+
+	req, _ := http.NewRequest(http.MethodPost, "/peers", nil)
+	resp := httptest.NewRecorder()
+	createApiHandler(t).ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.Code)
+}
@@ -0,0 +1,21 @@
+package peers
+
+import "errors"
+
+// ErrNilMessenger signals that a nil messenger has been provided
+var ErrNilMessenger = errors.New("nil messenger")
+
+// ErrNilPersister signals that a nil persister has been provided
+var ErrNilPersister = errors.New("nil persister")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilPeerStore signals that a nil peer store has been provided
+var ErrNilPeerStore = errors.New("nil peer store")
+
+// ErrNilStatsProvider signals that a nil stats provider has been provided
+var ErrNilStatsProvider = errors.New("nil stats provider")
+
+// ErrInvalidMaxSamples signals that an invalid maximum number of connection samples has been provided
+var ErrInvalidMaxSamples = errors.New("invalid maximum number of connection samples")
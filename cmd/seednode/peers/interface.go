@@ -0,0 +1,28 @@
+package peers
+
+import (
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// SeedMessenger defines the messenger operations used by the seed node components
+type SeedMessenger interface {
+	ID() p2p.PeerID
+	ConnectedPeers() []p2p.PeerID
+	IsConnected(pid p2p.PeerID) bool
+	ConnectToPeer(address string) error
+	AddConnectionNotifiee(notifiee p2p.ConnectionNotifiee) error
+	PeerNetworkID(pid p2p.PeerID) string
+	PeerAddresses(pid p2p.PeerID) []string
+	PeerProtocols(pid p2p.PeerID) []string
+	ClosePeer(pid p2p.PeerID) error
+	IsInterfaceNil() bool
+}
+
+// StatsProvider defines the component that provides the information served by the seed node API
+type StatsProvider interface {
+	KnownPeers() []PeerInfo
+	ConnectionSamples() []ConnectionSample
+	NumConnectedPeers() int
+	ProtocolStats() map[string]int
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,60 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+type MessengerStub struct {
+	IDCalled                    func() p2p.PeerID
+	ConnectedPeersCalled        func() []p2p.PeerID
+	IsConnectedCalled           func(pid p2p.PeerID) bool
+	ConnectToPeerCalled         func(address string) error
+	AddConnectionNotifieeCalled func(notifiee p2p.ConnectionNotifiee) error
+	PeerNetworkIDCalled         func(pid p2p.PeerID) string
+	PeerAddressesCalled         func(pid p2p.PeerID) []string
+	PeerProtocolsCalled         func(pid p2p.PeerID) []string
+	ClosePeerCalled             func(pid p2p.PeerID) error
+}
+
+func (ms *MessengerStub) ID() p2p.PeerID {
+	return ms.IDCalled()
+}
+
+func (ms *MessengerStub) ConnectedPeers() []p2p.PeerID {
+	return ms.ConnectedPeersCalled()
+}
+
+func (ms *MessengerStub) IsConnected(pid p2p.PeerID) bool {
+	return ms.IsConnectedCalled(pid)
+}
+
+func (ms *MessengerStub) ConnectToPeer(address string) error {
+	return ms.ConnectToPeerCalled(address)
+}
+
+func (ms *MessengerStub) AddConnectionNotifiee(notifiee p2p.ConnectionNotifiee) error {
+	return ms.AddConnectionNotifieeCalled(notifiee)
+}
+
+func (ms *MessengerStub) PeerNetworkID(pid p2p.PeerID) string {
+	return ms.PeerNetworkIDCalled(pid)
+}
+
+func (ms *MessengerStub) PeerAddresses(pid p2p.PeerID) []string {
+	return ms.PeerAddressesCalled(pid)
+}
+
+func (ms *MessengerStub) PeerProtocols(pid p2p.PeerID) []string {
+	return ms.PeerProtocolsCalled(pid)
+}
+
+func (ms *MessengerStub) ClosePeer(pid p2p.PeerID) error {
+	return ms.ClosePeerCalled(pid)
+}
+
+func (ms *MessengerStub) IsInterfaceNil() bool {
+	if ms == nil {
+		return true
+	}
+	return false
+}
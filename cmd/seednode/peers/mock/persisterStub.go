@@ -0,0 +1,43 @@
+package mock
+
+type PersisterStub struct {
+	PutCalled    func(key, val []byte) error
+	GetCalled    func(key []byte) ([]byte, error)
+	RemoveCalled func(key []byte) error
+}
+
+func (ps *PersisterStub) Put(key, val []byte) error {
+	return ps.PutCalled(key, val)
+}
+
+func (ps *PersisterStub) Get(key []byte) ([]byte, error) {
+	return ps.GetCalled(key)
+}
+
+func (ps *PersisterStub) Has(key []byte) error {
+	_, err := ps.GetCalled(key)
+	return err
+}
+
+func (ps *PersisterStub) Init() error {
+	return nil
+}
+
+func (ps *PersisterStub) Close() error {
+	return nil
+}
+
+func (ps *PersisterStub) Remove(key []byte) error {
+	return ps.RemoveCalled(key)
+}
+
+func (ps *PersisterStub) Destroy() error {
+	return nil
+}
+
+func (ps *PersisterStub) IsInterfaceNil() bool {
+	if ps == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,22 @@
+package peers
+
+// PeerRecord holds the information persisted about a known peer
+type PeerRecord struct {
+	PeerID    string   `json:"peerID"`
+	Addresses []string `json:"addresses"`
+	NetworkID string   `json:"networkID"`
+	FirstSeen int64    `json:"firstSeen"`
+	LastSeen  int64    `json:"lastSeen"`
+}
+
+// PeerInfo holds a known peer record together with its current connection status
+type PeerInfo struct {
+	PeerRecord
+	IsConnected bool `json:"isConnected"`
+}
+
+// ConnectionSample holds the number of connected peers at a moment in time
+type ConnectionSample struct {
+	Timestamp         int64 `json:"timestamp"`
+	NumConnectedPeers int   `json:"numConnectedPeers"`
+}
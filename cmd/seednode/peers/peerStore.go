@@ -0,0 +1,281 @@
+package peers
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.DefaultLogger()
+
+var knownPeersKey = []byte("knownPeers")
+
+const peerKeyPrefix = "peer_"
+
+// lastSeenPersistIntervalInSec is the minimum advance of a peer's last seen time that is written to the persister
+// when nothing else changed in its record. The in memory record is always up to date
+const lastSeenPersistIntervalInSec = 300
+
+// PeerStore keeps the known peers in memory and mirrors them in a persister so they survive restarts.
+// As the persister can not be iterated, the list of known peer IDs is kept under a separate key
+type PeerStore struct {
+	persister   storage.Persister
+	marshalizer marshal.Marshalizer
+
+	mutRecords        sync.RWMutex
+	records           map[p2p.PeerID]*PeerRecord
+	persistedLastSeen map[p2p.PeerID]int64
+}
+
+// NewPeerStore creates a new peer store and loads the peers already saved in the persister
+func NewPeerStore(persister storage.Persister, marshalizer marshal.Marshalizer) (*PeerStore, error) {
+	if persister == nil || persister.IsInterfaceNil() {
+		return nil, ErrNilPersister
+	}
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, ErrNilMarshalizer
+	}
+
+	ps := &PeerStore{
+		persister:         persister,
+		marshalizer:       marshalizer,
+		records:           make(map[p2p.PeerID]*PeerRecord),
+		persistedLastSeen: make(map[p2p.PeerID]int64),
+	}
+
+	err := ps.load()
+	if err != nil {
+		return nil, err
+	}
+
+	return ps, nil
+}
+
+func (ps *PeerStore) load() error {
+	//persisters report missing keys through different errors
+	if ps.persister.Has(knownPeersKey) != nil {
+		return nil
+	}
+
+	buff, err := ps.persister.Get(knownPeersKey)
+	if err != nil {
+		return err
+	}
+
+	pids := make([][]byte, 0)
+	err = ps.marshalizer.Unmarshal(&pids, buff)
+	if err != nil {
+		return err
+	}
+
+	for _, pid := range pids {
+		buffRecord, errGet := ps.persister.Get(peerKey(p2p.PeerID(pid)))
+		if errGet != nil {
+			log.Debug("known peer record not found: " + errGet.Error())
+			continue
+		}
+
+		record := &PeerRecord{}
+		errGet = ps.marshalizer.Unmarshal(record, buffRecord)
+		if errGet != nil {
+			log.Debug("known peer record corrupted: " + errGet.Error())
+			continue
+		}
+
+		ps.records[p2p.PeerID(pid)] = record
+		ps.persistedLastSeen[p2p.PeerID(pid)] = record.LastSeen
+	}
+
+	return nil
+}
+
+// Update saves the provided record. The first seen time of an already known peer is preserved. The record is
+// written to the persister only if it changed, apart from small advances of the last seen time
+func (ps *PeerStore) Update(pid p2p.PeerID, record PeerRecord) error {
+	ps.mutRecords.Lock()
+	defer ps.mutRecords.Unlock()
+
+	existing, found := ps.records[pid]
+	if found {
+		record.FirstSeen = existing.FirstSeen
+		if !ps.shouldPersist(pid, existing, &record) {
+			ps.records[pid] = &record
+			return nil
+		}
+	}
+
+	buff, err := ps.marshalizer.Marshal(&record)
+	if err != nil {
+		return err
+	}
+
+	err = ps.persister.Put(peerKey(pid), buff)
+	if err != nil {
+		return err
+	}
+
+	ps.records[pid] = &record
+	ps.persistedLastSeen[pid] = record.LastSeen
+	if found {
+		return nil
+	}
+
+	return ps.saveKnownPeers()
+}
+
+// shouldPersist should be called under mutex protection
+func (ps *PeerStore) shouldPersist(pid p2p.PeerID, existing *PeerRecord, record *PeerRecord) bool {
+	if existing.NetworkID != record.NetworkID || existing.PeerID != record.PeerID {
+		return true
+	}
+	if len(existing.Addresses) != len(record.Addresses) {
+		return true
+	}
+	for i := range existing.Addresses {
+		if existing.Addresses[i] != record.Addresses[i] {
+			return true
+		}
+	}
+
+	return record.LastSeen-ps.persistedLastSeen[pid] >= lastSeenPersistIntervalInSec
+}
+
+// Close writes the last seen times not yet persisted and closes the persister
+func (ps *PeerStore) Close() error {
+	ps.mutRecords.Lock()
+	for pid, record := range ps.records {
+		if ps.persistedLastSeen[pid] == record.LastSeen {
+			continue
+		}
+
+		buff, err := ps.marshalizer.Marshal(record)
+		if err != nil {
+			log.Debug("known peer record not saved: " + err.Error())
+			continue
+		}
+
+		err = ps.persister.Put(peerKey(pid), buff)
+		if err != nil {
+			log.Debug("known peer record not saved: " + err.Error())
+			continue
+		}
+		ps.persistedLastSeen[pid] = record.LastSeen
+	}
+	ps.mutRecords.Unlock()
+
+	return ps.persister.Close()
+}
+
+// Touch updates the last seen time of an already known peer
+func (ps *PeerStore) Touch(pid p2p.PeerID, lastSeen time.Time) error {
+	ps.mutRecords.RLock()
+	existing, found := ps.records[pid]
+	ps.mutRecords.RUnlock()
+
+	if !found {
+		return nil
+	}
+
+	record := *existing
+	record.LastSeen = lastSeen.Unix()
+
+	return ps.Update(pid, record)
+}
+
+// RemoveNotSeenSince removes all the peers that were last seen before the provided moment
+func (ps *PeerStore) RemoveNotSeenSince(moment time.Time) error {
+	ps.mutRecords.Lock()
+	defer ps.mutRecords.Unlock()
+
+	numRemoved := 0
+	for pid, record := range ps.records {
+		if record.LastSeen >= moment.Unix() {
+			continue
+		}
+
+		err := ps.persister.Remove(peerKey(pid))
+		if err != nil {
+			return err
+		}
+
+		delete(ps.records, pid)
+		delete(ps.persistedLastSeen, pid)
+		numRemoved++
+	}
+
+	if numRemoved == 0 {
+		return nil
+	}
+
+	return ps.saveKnownPeers()
+}
+
+// Get returns the record of the provided peer
+func (ps *PeerStore) Get(pid p2p.PeerID) (PeerRecord, bool) {
+	ps.mutRecords.RLock()
+	defer ps.mutRecords.RUnlock()
+
+	record, found := ps.records[pid]
+	if !found {
+		return PeerRecord{}, false
+	}
+
+	return *record, true
+}
+
+// PeerIDs returns the known peer IDs, the most recently seen first
+func (ps *PeerStore) PeerIDs() []p2p.PeerID {
+	ps.mutRecords.RLock()
+	defer ps.mutRecords.RUnlock()
+
+	pids := make([]p2p.PeerID, 0, len(ps.records))
+	for pid := range ps.records {
+		pids = append(pids, pid)
+	}
+
+	sort.Slice(pids, func(i, j int) bool {
+		return ps.records[pids[i]].LastSeen > ps.records[pids[j]].LastSeen
+	})
+
+	return pids
+}
+
+// Len returns the number of known peers
+func (ps *PeerStore) Len() int {
+	ps.mutRecords.RLock()
+	defer ps.mutRecords.RUnlock()
+
+	return len(ps.records)
+}
+
+// saveKnownPeers should be called under mutex protection
+func (ps *PeerStore) saveKnownPeers() error {
+	pids := make([][]byte, 0, len(ps.records))
+	for pid := range ps.records {
+		pids = append(pids, pid.Bytes())
+	}
+
+	buff, err := ps.marshalizer.Marshal(&pids)
+	if err != nil {
+		return err
+	}
+
+	return ps.persister.Put(knownPeersKey, buff)
+}
+
+func peerKey(pid p2p.PeerID) []byte {
+	return append([]byte(peerKeyPrefix), pid.Bytes()...)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ps *PeerStore) IsInterfaceNil() bool {
+	if ps == nil {
+		return true
+	}
+	return false
+}
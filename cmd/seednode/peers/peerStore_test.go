@@ -0,0 +1,207 @@
+package peers_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/seednode/peers"
+	"github.com/ElrondNetwork/elrond-go/cmd/seednode/peers/mock"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+)
+
+func createPeerStore(t *testing.T) (*peers.PeerStore, *memorydb.DB) {
+	persister, _ := memorydb.New()
+	store, err := peers.NewPeerStore(persister, &marshal.JsonMarshalizer{})
+	assert.Nil(t, err)
+
+	return store, persister
+}
+
+func TestNewPeerStore_NilPersisterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	store, err := peers.NewPeerStore(nil, &marshal.JsonMarshalizer{})
+
+	assert.Nil(t, store)
+	assert.Equal(t, peers.ErrNilPersister, err)
+}
+
+func TestNewPeerStore_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	persister, _ := memorydb.New()
+	store, err := peers.NewPeerStore(persister, nil)
+
+	assert.Nil(t, store)
+	assert.Equal(t, peers.ErrNilMarshalizer, err)
+}
+
+func TestNewPeerStore_CorruptedIndexShouldErr(t *testing.T) {
+	t.Parallel()
+
+	persister, _ := memorydb.New()
+	_ = persister.Put([]byte("knownPeers"), []byte("not a json"))
+
+	store, err := peers.NewPeerStore(persister, &marshal.JsonMarshalizer{})
+
+	assert.Nil(t, store)
+	assert.NotNil(t, err)
+}
+
+func TestPeerStore_UpdateShouldPreserveFirstSeen(t *testing.T) {
+	t.Parallel()
+
+	store, _ := createPeerStore(t)
+	pid := p2p.PeerID("pid")
+
+	_ = store.Update(pid, peers.PeerRecord{PeerID: "pid", FirstSeen: 10, LastSeen: 10})
+	_ = store.Update(pid, peers.PeerRecord{PeerID: "pid", FirstSeen: 20, LastSeen: 20})
+
+	record, found := store.Get(pid)
+	assert.True(t, found)
+	assert.Equal(t, int64(10), record.FirstSeen)
+	assert.Equal(t, int64(20), record.LastSeen)
+}
+
+func TestPeerStore_RecordsShouldSurviveReload(t *testing.T) {
+	t.Parallel()
+
+	store, persister := createPeerStore(t)
+	_ = store.Update("pid1", peers.PeerRecord{PeerID: "pid1", Addresses: []string{"addr1"}, LastSeen: 1})
+	_ = store.Update("pid2", peers.PeerRecord{PeerID: "pid2", Addresses: []string{"addr2"}, LastSeen: 2})
+
+	reloaded, err := peers.NewPeerStore(persister, &marshal.JsonMarshalizer{})
+	assert.Nil(t, err)
+
+	assert.Equal(t, []p2p.PeerID{"pid2", "pid1"}, reloaded.PeerIDs())
+	record, _ := reloaded.Get("pid1")
+	assert.Equal(t, []string{"addr1"}, record.Addresses)
+}
+
+func TestPeerStore_TouchUnknownPeerShouldNotAdd(t *testing.T) {
+	t.Parallel()
+
+	store, _ := createPeerStore(t)
+
+	err := store.Touch("pid", time.Now())
+
+	assert.Nil(t, err)
+	assert.Equal(t, 0, store.Len())
+}
+
+func TestPeerStore_TouchShouldUpdateLastSeen(t *testing.T) {
+	t.Parallel()
+
+	store, _ := createPeerStore(t)
+	_ = store.Update("pid", peers.PeerRecord{PeerID: "pid", LastSeen: 1})
+	now := time.Now()
+
+	_ = store.Touch("pid", now)
+
+	record, _ := store.Get("pid")
+	assert.Equal(t, now.Unix(), record.LastSeen)
+}
+
+func TestPeerStore_RemoveNotSeenSinceShouldRemoveFromPersister(t *testing.T) {
+	t.Parallel()
+
+	store, persister := createPeerStore(t)
+	_ = store.Update("old", peers.PeerRecord{PeerID: "old", LastSeen: 100})
+	_ = store.Update("new", peers.PeerRecord{PeerID: "new", LastSeen: 300})
+
+	err := store.RemoveNotSeenSince(time.Unix(200, 0))
+	assert.Nil(t, err)
+	assert.Equal(t, []p2p.PeerID{"new"}, store.PeerIDs())
+
+	reloaded, _ := peers.NewPeerStore(persister, &marshal.JsonMarshalizer{})
+	assert.Equal(t, []p2p.PeerID{"new"}, reloaded.PeerIDs())
+}
+
+func TestPeerStore_UpdatePersisterErrorsShouldNotStore(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("expected error")
+	store, _ := peers.NewPeerStore(
+		&mock.PersisterStub{
+			GetCalled: func(key []byte) ([]byte, error) {
+				return nil, storage.ErrKeyNotFound
+			},
+			PutCalled: func(key, val []byte) error {
+				return errExpected
+			},
+		},
+		&marshal.JsonMarshalizer{},
+	)
+
+	err := store.Update("pid", peers.PeerRecord{})
+
+	assert.Equal(t, errExpected, err)
+	assert.Equal(t, 0, store.Len())
+}
+
+func createCountingPersister() (*mock.PersisterStub, map[string][]byte, *int) {
+	data := make(map[string][]byte)
+	numPuts := 0
+	persister := &mock.PersisterStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			val, found := data[string(key)]
+			if !found {
+				return nil, storage.ErrKeyNotFound
+			}
+			return val, nil
+		},
+		PutCalled: func(key, val []byte) error {
+			numPuts++
+			data[string(key)] = val
+			return nil
+		},
+	}
+
+	return persister, data, &numPuts
+}
+
+func TestPeerStore_UpdateUnchangedRecordShouldNotWrite(t *testing.T) {
+	t.Parallel()
+
+	persister, _, numPuts := createCountingPersister()
+	store, _ := peers.NewPeerStore(persister, &marshal.JsonMarshalizer{})
+	record := peers.PeerRecord{PeerID: "pid", Addresses: []string{"addr"}, NetworkID: "net", LastSeen: 1000}
+
+	_ = store.Update("pid", record)
+	numPutsAfterAdd := *numPuts
+
+	record.LastSeen = 1010
+	_ = store.Update("pid", record)
+	assert.Equal(t, numPutsAfterAdd, *numPuts)
+	stored, _ := store.Get("pid")
+	assert.Equal(t, int64(1010), stored.LastSeen)
+
+	record.Addresses = []string{"another addr"}
+	_ = store.Update("pid", record)
+	assert.Equal(t, numPutsAfterAdd+1, *numPuts)
+
+	record.LastSeen = 2000
+	_ = store.Update("pid", record)
+	assert.Equal(t, numPutsAfterAdd+2, *numPuts)
+}
+
+func TestPeerStore_CloseShouldWriteTheLastSeenTimes(t *testing.T) {
+	t.Parallel()
+
+	persister, _, _ := createCountingPersister()
+	store, _ := peers.NewPeerStore(persister, &marshal.JsonMarshalizer{})
+	_ = store.Update("pid", peers.PeerRecord{PeerID: "pid", LastSeen: 1000})
+	_ = store.Update("pid", peers.PeerRecord{PeerID: "pid", LastSeen: 1010})
+
+	err := store.Close()
+	assert.Nil(t, err)
+
+	reloaded, _ := peers.NewPeerStore(persister, &marshal.JsonMarshalizer{})
+	record, _ := reloaded.Get("pid")
+	assert.Equal(t, int64(1010), record.LastSeen)
+}
@@ -0,0 +1,254 @@
+package peers
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// identifyTimeout is the time a newly connected peer has to announce its protocols before it is considered
+// as not advertising any network ID
+const identifyTimeout = time.Second * 10
+
+// PeerTracker follows the seed node connections: it saves the connected peers in the peer store, disconnects
+// the peers that do not belong to an allowed network, samples the number of connections and re-dials the known peers.
+// The network ID is the one advertised by each peer and it is not authenticated, so the allowlist only keeps away
+// honest peers of other networks, such as misconfigured nodes. It is not an access control mechanism
+type PeerTracker struct {
+	messenger         SeedMessenger
+	store             *PeerStore
+	allowedNetworkIDs map[string]struct{}
+	maxSamples        int
+
+	mutConnected sync.Mutex
+	connectedAt  map[p2p.PeerID]time.Time
+
+	mutSamples sync.RWMutex
+	samples    []ConnectionSample
+}
+
+// NewPeerTracker creates a new peer tracker. An empty allowed network IDs list will accept all peers
+func NewPeerTracker(
+	messenger SeedMessenger,
+	store *PeerStore,
+	allowedNetworkIDs []string,
+	maxSamples int,
+) (*PeerTracker, error) {
+
+	if messenger == nil || messenger.IsInterfaceNil() {
+		return nil, ErrNilMessenger
+	}
+	if store == nil || store.IsInterfaceNil() {
+		return nil, ErrNilPeerStore
+	}
+	if maxSamples < 1 {
+		return nil, ErrInvalidMaxSamples
+	}
+
+	allowed := make(map[string]struct{})
+	for _, networkID := range allowedNetworkIDs {
+		allowed[networkID] = struct{}{}
+	}
+
+	return &PeerTracker{
+		messenger:         messenger,
+		store:             store,
+		allowedNetworkIDs: allowed,
+		maxSamples:        maxSamples,
+		connectedAt:       make(map[p2p.PeerID]time.Time),
+		samples:           make([]ConnectionSample, 0, maxSamples),
+	}, nil
+}
+
+// PeerConnected is called each time a new peer connects. The check is done on a separate go routine as
+// it might close the connection that is being notified
+func (pt *PeerTracker) PeerConnected(pid p2p.PeerID) {
+	pt.mutConnected.Lock()
+	_, alreadyConnected := pt.connectedAt[pid]
+	if !alreadyConnected {
+		pt.connectedAt[pid] = time.Now()
+	}
+	pt.mutConnected.Unlock()
+
+	go pt.checkPeer(pid)
+}
+
+// PeerDisconnected is called each time a peer disconnects
+func (pt *PeerTracker) PeerDisconnected(pid p2p.PeerID) {
+	if pt.messenger.IsConnected(pid) {
+		return
+	}
+
+	pt.mutConnected.Lock()
+	delete(pt.connectedAt, pid)
+	pt.mutConnected.Unlock()
+
+	err := pt.store.Touch(pid, time.Now())
+	if err != nil {
+		log.Debug(fmt.Sprintf("peer store update for %s: %s", pid.Pretty(), err.Error()))
+	}
+}
+
+// Sample records the current number of connections and refreshes the records of the connected peers
+func (pt *PeerTracker) Sample() {
+	connectedPeers := pt.messenger.ConnectedPeers()
+	for _, pid := range connectedPeers {
+		pt.checkPeer(pid)
+	}
+
+	sample := ConnectionSample{
+		Timestamp:         time.Now().Unix(),
+		NumConnectedPeers: len(connectedPeers),
+	}
+
+	pt.mutSamples.Lock()
+	if len(pt.samples) == pt.maxSamples {
+		pt.samples = pt.samples[1:]
+	}
+	pt.samples = append(pt.samples, sample)
+	pt.mutSamples.Unlock()
+}
+
+// checkPeer waits until the peer protocols are known, disconnects it if it does not belong to an allowed network
+// and saves it otherwise
+func (pt *PeerTracker) checkPeer(pid p2p.PeerID) {
+	if pid == pt.messenger.ID() {
+		return
+	}
+
+	pt.mutConnected.Lock()
+	connectedAt, found := pt.connectedAt[pid]
+	if !found {
+		connectedAt = time.Now()
+		pt.connectedAt[pid] = connectedAt
+	}
+	pt.mutConnected.Unlock()
+
+	isIdentified := len(pt.messenger.PeerProtocols(pid)) > 0
+	if !isIdentified && time.Since(connectedAt) < identifyTimeout {
+		return
+	}
+
+	networkID := pt.messenger.PeerNetworkID(pid)
+	if !pt.isNetworkAllowed(networkID) {
+		log.Debug(fmt.Sprintf("disconnecting %s, network ID %q is not allowed", pid.Pretty(), networkID))
+		err := pt.messenger.ClosePeer(pid)
+		if err != nil {
+			log.Debug(err.Error())
+		}
+		return
+	}
+
+	now := time.Now().Unix()
+	record := PeerRecord{
+		PeerID:    pid.Pretty(),
+		Addresses: pt.messenger.PeerAddresses(pid),
+		NetworkID: networkID,
+		FirstSeen: now,
+		LastSeen:  now,
+	}
+
+	err := pt.store.Update(pid, record)
+	if err != nil {
+		log.Debug(fmt.Sprintf("peer store update for %s: %s", pid.Pretty(), err.Error()))
+	}
+}
+
+func (pt *PeerTracker) isNetworkAllowed(networkID string) bool {
+	if len(pt.allowedNetworkIDs) == 0 {
+		return true
+	}
+
+	_, isAllowed := pt.allowedNetworkIDs[networkID]
+	return isAllowed
+}
+
+// DialKnownPeers tries to connect to all the known peers that are not connected, using their saved addresses
+// It returns the number of peers successfully dialed
+func (pt *PeerTracker) DialKnownPeers() int {
+	numDialed := 0
+	for _, pid := range pt.store.PeerIDs() {
+		if pid == pt.messenger.ID() || pt.messenger.IsConnected(pid) {
+			continue
+		}
+
+		record, found := pt.store.Get(pid)
+		if !found {
+			continue
+		}
+
+		for _, address := range record.Addresses {
+			err := pt.messenger.ConnectToPeer(address)
+			if err == nil {
+				numDialed++
+				break
+			}
+
+			log.Debug(fmt.Sprintf("dialing known peer %s on %s: %s", record.PeerID, address, err.Error()))
+		}
+	}
+
+	return numDialed
+}
+
+// ForgetPeersNotSeenSince removes from the peer store the peers that were last seen before the provided moment
+func (pt *PeerTracker) ForgetPeersNotSeenSince(moment time.Time) error {
+	return pt.store.RemoveNotSeenSince(moment)
+}
+
+// KnownPeers returns all the known peers, the most recently seen first
+func (pt *PeerTracker) KnownPeers() []PeerInfo {
+	pids := pt.store.PeerIDs()
+	infos := make([]PeerInfo, 0, len(pids))
+	for _, pid := range pids {
+		record, found := pt.store.Get(pid)
+		if !found {
+			continue
+		}
+
+		infos = append(infos, PeerInfo{
+			PeerRecord:  record,
+			IsConnected: pt.messenger.IsConnected(pid),
+		})
+	}
+
+	return infos
+}
+
+// ConnectionSamples returns the recorded connection samples, the oldest first
+func (pt *PeerTracker) ConnectionSamples() []ConnectionSample {
+	pt.mutSamples.RLock()
+	defer pt.mutSamples.RUnlock()
+
+	samples := make([]ConnectionSample, len(pt.samples))
+	copy(samples, pt.samples)
+
+	return samples
+}
+
+// NumConnectedPeers returns the current number of connected peers
+func (pt *PeerTracker) NumConnectedPeers() int {
+	return len(pt.messenger.ConnectedPeers())
+}
+
+// ProtocolStats returns, for each protocol, the number of connected peers supporting it
+func (pt *PeerTracker) ProtocolStats() map[string]int {
+	stats := make(map[string]int)
+	for _, pid := range pt.messenger.ConnectedPeers() {
+		for _, protocol := range pt.messenger.PeerProtocols(pid) {
+			stats[protocol]++
+		}
+	}
+
+	return stats
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pt *PeerTracker) IsInterfaceNil() bool {
+	if pt == nil {
+		return true
+	}
+	return false
+}
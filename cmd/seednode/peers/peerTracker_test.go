@@ -0,0 +1,200 @@
+package peers_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/seednode/peers"
+	"github.com/ElrondNetwork/elrond-go/cmd/seednode/peers/mock"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/stretchr/testify/assert"
+)
+
+const testNetworkID = "testnet"
+
+func createMessengerStub(connected map[p2p.PeerID]string) *mock.MessengerStub {
+	return &mock.MessengerStub{
+		IDCalled: func() p2p.PeerID {
+			return "self"
+		},
+		ConnectedPeersCalled: func() []p2p.PeerID {
+			pids := make([]p2p.PeerID, 0)
+			for pid := range connected {
+				pids = append(pids, pid)
+			}
+			return pids
+		},
+		IsConnectedCalled: func(pid p2p.PeerID) bool {
+			_, found := connected[pid]
+			return found
+		},
+		PeerNetworkIDCalled: func(pid p2p.PeerID) string {
+			return connected[pid]
+		},
+		PeerAddressesCalled: func(pid p2p.PeerID) []string {
+			return []string{"/ip4/127.0.0.1/tcp/10000/p2p/" + string(pid)}
+		},
+		PeerProtocolsCalled: func(pid p2p.PeerID) []string {
+			return []string{"/directsend/1.0.0", "/elrond/network/" + connected[pid]}
+		},
+		ClosePeerCalled: func(pid p2p.PeerID) error {
+			delete(connected, pid)
+			return nil
+		},
+	}
+}
+
+func TestNewPeerTracker_NilMessengerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	store, _ := createPeerStore(t)
+	pt, err := peers.NewPeerTracker(nil, store, nil, 10)
+
+	assert.Nil(t, pt)
+	assert.Equal(t, peers.ErrNilMessenger, err)
+}
+
+func TestNewPeerTracker_NilPeerStoreShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pt, err := peers.NewPeerTracker(&mock.MessengerStub{}, nil, nil, 10)
+
+	assert.Nil(t, pt)
+	assert.Equal(t, peers.ErrNilPeerStore, err)
+}
+
+func TestNewPeerTracker_InvalidMaxSamplesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	store, _ := createPeerStore(t)
+	pt, err := peers.NewPeerTracker(&mock.MessengerStub{}, store, nil, 0)
+
+	assert.Nil(t, pt)
+	assert.Equal(t, peers.ErrInvalidMaxSamples, err)
+}
+
+func TestPeerTracker_SampleShouldStoreAllowedPeersAndDisconnectTheOthers(t *testing.T) {
+	t.Parallel()
+
+	connected := map[p2p.PeerID]string{
+		"allowed":   testNetworkID,
+		"forbidden": "mainnet",
+	}
+	store, _ := createPeerStore(t)
+	pt, _ := peers.NewPeerTracker(createMessengerStub(connected), store, []string{testNetworkID}, 10)
+
+	pt.Sample()
+
+	assert.Equal(t, []p2p.PeerID{"allowed"}, store.PeerIDs())
+	_, isStillConnected := connected["forbidden"]
+	assert.False(t, isStillConnected)
+
+	record, _ := store.Get("allowed")
+	assert.Equal(t, testNetworkID, record.NetworkID)
+	assert.Equal(t, 1, len(record.Addresses))
+}
+
+func TestPeerTracker_EmptyAllowlistShouldAcceptAllPeers(t *testing.T) {
+	t.Parallel()
+
+	connected := map[p2p.PeerID]string{
+		"first":  testNetworkID,
+		"second": "",
+	}
+	store, _ := createPeerStore(t)
+	pt, _ := peers.NewPeerTracker(createMessengerStub(connected), store, nil, 10)
+
+	pt.Sample()
+
+	assert.Equal(t, 2, store.Len())
+}
+
+func TestPeerTracker_NotIdentifiedPeerShouldWait(t *testing.T) {
+	t.Parallel()
+
+	connected := map[p2p.PeerID]string{"pid": "mainnet"}
+	messenger := createMessengerStub(connected)
+	messenger.PeerProtocolsCalled = func(pid p2p.PeerID) []string {
+		return make([]string, 0)
+	}
+	store, _ := createPeerStore(t)
+	pt, _ := peers.NewPeerTracker(messenger, store, []string{testNetworkID}, 10)
+
+	pt.Sample()
+
+	assert.Equal(t, 1, len(connected))
+	assert.Equal(t, 0, store.Len())
+}
+
+func TestPeerTracker_SampleShouldKeepMaxSamples(t *testing.T) {
+	t.Parallel()
+
+	connected := map[p2p.PeerID]string{"pid": testNetworkID}
+	store, _ := createPeerStore(t)
+	pt, _ := peers.NewPeerTracker(createMessengerStub(connected), store, nil, 2)
+
+	pt.Sample()
+	pt.Sample()
+	delete(connected, "pid")
+	pt.Sample()
+
+	samples := pt.ConnectionSamples()
+	assert.Equal(t, 2, len(samples))
+	assert.Equal(t, 1, samples[0].NumConnectedPeers)
+	assert.Equal(t, 0, samples[1].NumConnectedPeers)
+}
+
+func TestPeerTracker_DialKnownPeersShouldDialOnlyDisconnectedPeers(t *testing.T) {
+	t.Parallel()
+
+	store, _ := createPeerStore(t)
+	_ = store.Update("connected", peers.PeerRecord{Addresses: []string{"addr-connected"}})
+	_ = store.Update("disconnected", peers.PeerRecord{Addresses: []string{"bad-addr", "good-addr"}})
+
+	dialed := make([]string, 0)
+	messenger := createMessengerStub(map[p2p.PeerID]string{"connected": testNetworkID})
+	messenger.ConnectToPeerCalled = func(address string) error {
+		dialed = append(dialed, address)
+		if address == "bad-addr" {
+			return errors.New("unreachable")
+		}
+		return nil
+	}
+	pt, _ := peers.NewPeerTracker(messenger, store, nil, 10)
+
+	numDialed := pt.DialKnownPeers()
+
+	assert.Equal(t, 1, numDialed)
+	assert.Equal(t, []string{"bad-addr", "good-addr"}, dialed)
+}
+
+func TestPeerTracker_PeerDisconnectedShouldUpdateLastSeen(t *testing.T) {
+	t.Parallel()
+
+	store, _ := createPeerStore(t)
+	_ = store.Update("pid", peers.PeerRecord{LastSeen: 1})
+	pt, _ := peers.NewPeerTracker(createMessengerStub(map[p2p.PeerID]string{}), store, nil, 10)
+
+	pt.PeerDisconnected("pid")
+
+	record, _ := store.Get("pid")
+	assert.True(t, record.LastSeen > 1)
+}
+
+func TestPeerTracker_ProtocolStatsShouldCountConnectedPeers(t *testing.T) {
+	t.Parallel()
+
+	connected := map[p2p.PeerID]string{
+		"first":  testNetworkID,
+		"second": testNetworkID,
+		"third":  "mainnet",
+	}
+	store, _ := createPeerStore(t)
+	pt, _ := peers.NewPeerTracker(createMessengerStub(connected), store, nil, 10)
+
+	stats := pt.ProtocolStats()
+
+	assert.Equal(t, 3, stats["/directsend/1.0.0"])
+	assert.Equal(t, 2, stats["/elrond/network/"+testNetworkID])
+	assert.Equal(t, 1, stats["/elrond/network/mainnet"])
+}
@@ -0,0 +1,248 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/node/factory"
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/keystore"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber/multisig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber/singlesig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/remote"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+	"github.com/urfave/cli"
+)
+
+const (
+	// maxOpenFiles is the maximum number of files the slashing protection database keeps open
+	maxOpenFiles = 10
+	// batchDelaySeconds is required by the database but not used, as each signed share is written immediately
+	batchDelaySeconds = 1
+	// maxBatchSize of one forces each signed share to be written before the signature is returned
+	maxBatchSize = 1
+)
+
+var (
+	signerHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	sk = cli.StringFlag{
+		Name:  "sk",
+		Usage: "Private key that the signer will load on startup and will sign blocks with",
+		Value: "",
+	}
+	skIndex = cli.IntFlag{
+		Name:  "sk-index",
+		Usage: "Private key index specifies the 0-th based index of the private key to be used from initialNodesSk.pem file.",
+		Value: 0,
+	}
+	initialNodesSkPemFile = cli.StringFlag{
+		Name:  "initialNodesSkPemFile",
+		Usage: "The file containing the secret keys of the nodes",
+		Value: "./config/initialNodesSk.pem",
+	}
+	keyFile = cli.StringFlag{
+		Name:  "key-file",
+		Usage: "Password encrypted key file, from a keystore, of the block signing key. If set, it overwrites the sk flags",
+		Value: "",
+	}
+	keyPasswordFile = cli.StringFlag{
+		Name:  "key-password-file",
+		Usage: "The file containing the password of the key file. If not set, the password is asked on the terminal",
+		Value: "",
+	}
+	network = cli.StringFlag{
+		Name:  "network",
+		Usage: "Network on which the signer listens for the node requests: unix or tcp",
+		Value: "unix",
+	}
+	address = cli.StringFlag{
+		Name: "address",
+		Usage: "Unix socket path or loopback tcp address on which the signer listens for the node requests. The unix " +
+			"socket is accessible only to the user running the signer",
+		Value: "./signer.sock",
+	}
+	protectionDB = cli.StringFlag{
+		Name:  "protection-db",
+		Usage: "Folder of the database which keeps the signed block headers, by round and shard",
+		Value: "./signerdb",
+	}
+	hasherType = cli.StringFlag{
+		Name:  "hasher",
+		Usage: "Hasher used by the node for the block headers, as set in its config.toml: blake2b or sha256",
+		Value: "blake2b",
+	}
+	marshalizerType = cli.StringFlag{
+		Name:  "marshalizer",
+		Usage: "Marshalizer used by the node for the block headers, as set in its config.toml: json",
+		Value: "json",
+	}
+	metachain = cli.BoolFlag{
+		Name:  "metachain",
+		Usage: "Set if the validator is in the metachain, whose block headers are meta blocks",
+	}
+	logLevel = cli.StringFlag{
+		Name:  "logLevel",
+		Usage: "This flag specifies the logger level",
+		Value: logger.LogInfo,
+	}
+)
+
+func main() {
+	log := logger.DefaultLogger()
+	log.SetLevel(logger.LogInfo)
+
+	app := cli.NewApp()
+	cli.AppHelpTemplate = signerHelpTemplate
+	app.Name = "Block signer Tool"
+	app.Version = "v0.0.1"
+	app.Usage = "This binary keeps the block signing key of a validator and signs the blocks on behalf of the node, " +
+		"refusing to sign two different blocks for the same round and shard"
+	app.Flags = []cli.Flag{sk, skIndex, initialNodesSkPemFile, keyFile, keyPasswordFile, network, address, protectionDB,
+		hasherType, marshalizerType, metachain, logLevel}
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+
+	app.Action = func(c *cli.Context) error {
+		return startSigner(c, log)
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func startSigner(ctx *cli.Context, log *logger.Logger) error {
+	log.SetLevel(ctx.GlobalString(logLevel.Name))
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+
+	privKey, pubKey, err := loadBlockSigningKey(ctx, log)
+	if err != nil {
+		return err
+	}
+	log.Info("Starting signer with public key: " + factory.GetPkEncoded(pubKey))
+
+	hasher, err := createHasher(ctx.GlobalString(hasherType.Name))
+	if err != nil {
+		return err
+	}
+
+	marshalizer, err := createMarshalizer(ctx.GlobalString(marshalizerType.Name))
+	if err != nil {
+		return err
+	}
+
+	db, err := leveldb.NewDB(ctx.GlobalString(protectionDB.Name), batchDelaySeconds, maxBatchSize, maxOpenFiles)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		log.LogIfError(db.Close())
+	}()
+
+	keySigner, err := remote.NewKeySigner(
+		privKey,
+		&singlesig.BlsSingleSigner{},
+		&multisig.KyberMultiSignerBLS{},
+		hasher,
+		marshalizer,
+		ctx.GlobalBool(metachain.Name),
+		db,
+	)
+	if err != nil {
+		return err
+	}
+
+	listenNetwork := ctx.GlobalString(network.Name)
+	listenAddress := ctx.GlobalString(address.Name)
+	listener, err := remote.NewSignerListener(listenNetwork, listenAddress)
+	if err != nil {
+		return err
+	}
+
+	server, err := remote.NewSignerServer(keySigner, listener)
+	if err != nil {
+		log.LogIfError(listener.Close())
+		return err
+	}
+	server.Start()
+	log.Info(fmt.Sprintf("Signer listening on %s %s", listenNetwork, listenAddress))
+
+	<-sigs
+	log.Info("terminating the signer...")
+
+	return server.Close()
+}
+
+func createHasher(hasherType string) (hashing.Hasher, error) {
+	switch hasherType {
+	case "sha256":
+		return sha256.Sha256{}, nil
+	case "blake2b":
+		return blake2b.Blake2b{}, nil
+	}
+
+	return nil, errors.New("unknown hasher type " + hasherType)
+}
+
+func createMarshalizer(marshalizerType string) (marshal.Marshalizer, error) {
+	switch marshalizerType {
+	case "json":
+		return &marshal.JsonMarshalizer{}, nil
+	}
+
+	return nil, errors.New("unknown marshalizer type " + marshalizerType)
+}
+
+func loadBlockSigningKey(ctx *cli.Context, log *logger.Logger) (crypto.PrivateKey, crypto.PublicKey, error) {
+	suite := kyber.NewSuitePairingBn256()
+	if ctx.GlobalIsSet(keyFile.Name) {
+		password, err := keystore.ReadPassword(ctx.GlobalString(keyPasswordFile.Name), "Key file password: ")
+		if err != nil {
+			return nil, nil, err
+		}
+
+		_, privKey, pubKey, err := factory.GetSigningParamsFromKeyFile(ctx.GlobalString(keyFile.Name), password, suite)
+		return privKey, pubKey, err
+	}
+
+	_, privKey, pubKey, err := factory.GetSigningParams(
+		ctx,
+		log,
+		sk.Name,
+		skIndex.Name,
+		ctx.GlobalString(initialNodesSkPemFile.Name),
+		suite)
+
+	return privKey, pubKey, err
+}
@@ -0,0 +1,47 @@
+package config
+
+// ApiConfig will hold the settings of the REST API access: the rate limits, the authentication, the access
+// level of each route group and the JSON-RPC endpoint
+type ApiConfig struct {
+	RateLimit   ApiRateLimitConfig
+	Auth        ApiAuthConfig
+	RouteGroups []ApiRouteGroupConfig
+	JsonRpc     ApiJsonRpcConfig
+}
+
+// ApiRateLimitConfig will hold the maximum number of requests accepted during a time window from an IP address and
+// from an authenticated client. A 0 value means no limit. The X-Forwarded-For header is only trusted for the requests
+// coming from the TrustedProxies, given as IP addresses or CIDR ranges
+type ApiRateLimitConfig struct {
+	WindowInSeconds     uint32
+	MaxRequestsPerIP    uint32
+	MaxRequestsPerToken uint32
+	MaxTrackedClients   uint32
+	TrustedProxies      []string
+}
+
+// ApiAuthConfig will hold the credentials accepted by the REST API
+type ApiAuthConfig struct {
+	Required  bool
+	JWTSecret string
+	ApiKeys   []ApiKeyConfig
+}
+
+// ApiKeyConfig will hold an API key and the name and rights of its owner
+type ApiKeyConfig struct {
+	Name  string
+	Key   string
+	Admin bool
+}
+
+// ApiRouteGroupConfig will hold the access level of a route group, such as "transaction" or "node"
+type ApiRouteGroupConfig struct {
+	Name   string
+	Access string
+}
+
+// ApiJsonRpcConfig will hold the settings of the JSON-RPC endpoint. An empty port serves it on the REST API port
+type ApiJsonRpcConfig struct {
+	Enabled bool
+	Port    string
+}
@@ -39,8 +39,10 @@ type LoggerConfig struct {
 
 // AddressConfig will map the json address configuration
 type AddressConfig struct {
+	Type   string `json:"type"`
 	Length int    `json:"length"`
 	Prefix string `json:"prefix"`
+	Hrp    string `json:"hrp"`
 }
 
 // TypeConfig will map the json string type configuration
@@ -96,17 +98,27 @@ type Config struct {
 
 	ResourceStats   ResourceStatsConfig
 	Heartbeat       HeartbeatConfig
+	PeerIdentity    PeerIdentityConfig
 	GeneralSettings GeneralSettingsConfig
 	Consensus       TypeConfig
 	Explorer        ExplorerConfig
+	Subscriptions   SubscriptionsConfig
+
+	TransactionHistory TransactionHistoryConfig
+
+	ConsensusRecorder       ConsensusRecorderConfig
+	AdaptiveRoundTiming     AdaptiveRoundTimingConfig
+	SigVerificationPipeline SigVerificationPipelineConfig
+	BlockSigner             BlockSignerConfig
 
 	NTPConfig NTPConfig
 }
 
 // NodeConfig will hold basic p2p settings
 type NodeConfig struct {
-	Port int
-	Seed string
+	Port      int
+	Seed      string
+	NetworkID string
 }
 
 // KadDhtPeerDiscoveryConfig will hold the kad-dht discovery config settings
@@ -117,10 +129,55 @@ type KadDhtPeerDiscoveryConfig struct {
 	InitialPeerList      []string
 }
 
+// PeerDiscoveryConfig will hold the selection of the peer discovery mechanism
+type PeerDiscoveryConfig struct {
+	Type string
+}
+
+// MdnsPeerDiscoveryConfig will hold the mDNS discovery config settings
+type MdnsPeerDiscoveryConfig struct {
+	RefreshIntervalInSec int
+	ServiceTag           string
+}
+
+// StaticPeerDiscoveryConfig will hold the static peers list discovery config settings
+type StaticPeerDiscoveryConfig struct {
+	PeerList           []string
+	CheckIntervalInSec int
+	MaxBackoffInSec    int
+}
+
+// TopicCodecConfig will hold the wire-level compression and chunking settings for a group of topics
+type TopicCodecConfig struct {
+	TopicPrefix                 string
+	CompressionThresholdInBytes int
+	ChunkSizeInBytes            int
+}
+
 // P2PConfig will hold all the P2P settings
 type P2PConfig struct {
 	Node                NodeConfig
+	PeerDiscovery       PeerDiscoveryConfig
 	KadDhtPeerDiscovery KadDhtPeerDiscoveryConfig
+	MdnsPeerDiscovery   MdnsPeerDiscoveryConfig
+	StaticPeerDiscovery StaticPeerDiscoveryConfig
+	TopicCodecs         []TopicCodecConfig
+}
+
+// PeerStoreConfig will hold the settings of the seed node's known peers database
+type PeerStoreConfig struct {
+	DBPath                  string
+	RedialIntervalInSec     int
+	ForgetPeersAfterInHours int
+}
+
+// SeedNodeConfig will hold the seed node specific settings
+type SeedNodeConfig struct {
+	PeerStore                     PeerStoreConfig
+	RestApiInterface              string
+	ConnectionSampleIntervalInSec int
+	MaxConnectionSamples          int
+	AllowedNetworkIDs             []string
 }
 
 // ResourceStatsConfig will hold all resource stats settings
@@ -138,6 +195,12 @@ type HeartbeatConfig struct {
 	HeartbeatStorage                    StorageConfig
 }
 
+// PeerIdentityConfig will hold the settings for the handshake that binds p2p peer IDs to validator public keys
+type PeerIdentityConfig struct {
+	Enabled                   bool
+	HandshakeRetryIntervalSec int
+}
+
 // GeneralSettingsConfig will hold the general settings for a node
 type GeneralSettingsConfig struct {
 	DestinationShardAsObserver string
@@ -147,14 +210,67 @@ type GeneralSettingsConfig struct {
 
 // ExplorerConfig will hold the configuration for the explorer indexer
 type ExplorerConfig struct {
-	Enabled    bool
-	IndexerURL string
+	Enabled           bool
+	IndexerURL        string
+	MinRetryDelayInMs uint32
+	MaxRetryDelayInMs uint32
+	QueueDB           DBConfig
+	BackfillDB        DBConfig
+}
+
+// ConsensusRecorderConfig will hold the settings of the recorder of the consensus rounds
+type ConsensusRecorderConfig struct {
+	Enabled         bool
+	FolderPath      string
+	MaxFileSizeInMB int
+	MaxFiles        int
+}
+
+// AdaptiveRoundTimingConfig will hold the settings of the mode in which the leader shrinks the proposed block when the
+// blocks it recently proposed were committed by consensus close to the end round deadline
+type AdaptiveRoundTimingConfig struct {
+	Enabled                bool
+	NumRecentRounds        uint32
+	CloseToDeadlinePercent uint32
+}
+
+// SigVerificationPipelineConfig will hold the settings of the worker pool which verifies the signatures of the
+// intercepted transactions and of the cache which keeps the verification results
+type SigVerificationPipelineConfig struct {
+	NumWorkers uint32
+	Cache      CacheConfig
+}
+
+// BlockSignerConfig will hold the settings of the block signing key holder: either the key is kept in the node
+// process ("local"), or it is kept by a separate signer process reached on the given network and address ("remote")
+type BlockSignerConfig struct {
+	Type               string
+	Network            string
+	Address            string
+	RequestTimeoutInMs uint32
 }
 
 // ServersConfig will hold all the confidential settings for servers
 type ServersConfig struct {
 	ElasticSearch ElasticSearchConfig
 	Prometheus    PrometheusConfig
+	Indexers      []IndexerConfig
+}
+
+// IndexerConfig will hold the settings of one of the indexers fed by the node. Driver selects the implementation
+// ("elasticsearch", "sql" or "file") and only the fields used by that driver need to be set. Name identifies the
+// indexer, being used for its indexing queue, so it has to be unique and should not change between restarts
+type IndexerConfig struct {
+	Name            string
+	Driver          string
+	URL             string
+	Username        string
+	Password        string
+	SQLDriverName   string
+	DataSourceName  string
+	FolderPath      string
+	MaxFileSizeInMB int
+	MaxFiles        int
 }
 
 // PrometheusConfig will hold configuration for prometheus, such as the join URL
@@ -170,6 +286,22 @@ type ElasticSearchConfig struct {
 	Password string
 }
 
+// SubscriptionsConfig will hold the settings of the websocket subscriptions to the node's events
+type SubscriptionsConfig struct {
+	Enabled                  bool
+	MaxSubscribers           int
+	MaxFiltersPerSubscriber  int
+	EventsBufferSize         int
+	CommittedBlocksQueueSize int
+}
+
+// TransactionHistoryConfig will hold the settings of the local index of the transactions of each account
+type TransactionHistoryConfig struct {
+	Enabled             bool
+	EnabledOnValidators bool
+	Storage             StorageConfig
+}
+
 // FacadeConfig will hold different configuration option that will be passed to the main ElrondFacade
 type FacadeConfig struct {
 	RestApiPort       string
@@ -177,4 +309,5 @@ type FacadeConfig struct {
 	Prometheus        bool
 	PrometheusJoinURL string
 	PrometheusJobName string
+	Api               ApiConfig
 }
@@ -2,6 +2,7 @@ package broadcast
 
 import (
 	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/logger"
 	"github.com/ElrondNetwork/elrond-go/crypto"
@@ -17,6 +18,17 @@ type commonMessenger struct {
 	privateKey       crypto.PrivateKey
 	shardCoordinator sharding.Coordinator
 	singleSigner     crypto.SingleSigner
+	roundRecorder    consensus.RoundRecorder
+}
+
+// SetRoundRecorder sets the recorder of the broadcast consensus messages, which is by default a no-op one
+func (cm *commonMessenger) SetRoundRecorder(roundRecorder consensus.RoundRecorder) error {
+	if roundRecorder == nil || roundRecorder.IsInterfaceNil() {
+		return spos.ErrNilRoundRecorder
+	}
+
+	cm.roundRecorder = roundRecorder
+	return nil
 }
 
 // BroadcastConsensusMessage will send on consensus topic the consensus message
@@ -36,6 +48,8 @@ func (cm *commonMessenger) BroadcastConsensusMessage(message *consensus.Message)
 	consensusTopic := core.ConsensusTopic +
 		cm.shardCoordinator.CommunicationIdentifier(cm.shardCoordinator.SelfId())
 
+	cm.roundRecorder.RecordSentMessage(message)
+
 	go cm.messenger.Broadcast(consensusTopic, buff)
 
 	return nil
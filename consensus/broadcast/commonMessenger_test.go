@@ -6,6 +6,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/broadcast"
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -63,6 +64,50 @@ func TestCommonMessenger_BroadcastConsensusMessageShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestCommonMessenger_SetRoundRecorderNilShouldErr(t *testing.T) {
+	cm, _ := broadcast.NewCommonMessenger(
+		&mock.MarshalizerMock{},
+		&mock.MessengerStub{},
+		&mock.PrivateKeyMock{},
+		&mock.ShardCoordinatorMock{},
+		&mock.SingleSignerMock{},
+	)
+
+	err := cm.SetRoundRecorder(nil)
+	assert.Equal(t, spos.ErrNilRoundRecorder, err)
+}
+
+func TestCommonMessenger_BroadcastConsensusMessageShouldRecordTheSignedMessage(t *testing.T) {
+	cm, _ := broadcast.NewCommonMessenger(
+		&mock.MarshalizerMock{},
+		&mock.MessengerStub{
+			BroadcastCalled: func(topic string, buff []byte) {
+			},
+		},
+		&mock.PrivateKeyMock{},
+		&mock.ShardCoordinatorMock{},
+		&mock.SingleSignerMock{
+			SignStub: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+				return []byte("signature"), nil
+			},
+		},
+	)
+	var recordedMessage *consensus.Message
+	err := cm.SetRoundRecorder(&mock.RoundRecorderStub{
+		RecordSentMessageCalled: func(message *consensus.Message) {
+			recordedMessage = message
+		},
+	})
+	assert.Nil(t, err)
+
+	msg := &consensus.Message{}
+	err = cm.BroadcastConsensusMessage(msg)
+
+	assert.Nil(t, err)
+	assert.Equal(t, msg, recordedMessage)
+	assert.Equal(t, []byte("signature"), recordedMessage.Signature)
+}
+
 func TestCommonMessenger_SignMessageShouldErrWhenMarshalFail(t *testing.T) {
 	marshalizerMock := &mock.MarshalizerMock{}
 	messengerMock := &mock.MessengerStub{}
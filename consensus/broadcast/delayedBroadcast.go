@@ -0,0 +1,220 @@
+package broadcast
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// pendingBroadcast holds the delayed broadcast data of one round together with what was already seen on the network
+type pendingBroadcast struct {
+	data             *consensus.DelayedBroadcastData
+	headerSeen       bool
+	unseenMiniBlocks map[uint32]map[string]struct{}
+	timer            *time.Timer
+}
+
+// delayedBroadcaster keeps the proposed block data for which the node is a backup broadcaster. The headers and
+// miniblocks pools are observed: once the final header of the kept block is added in pool by the interceptors, the
+// miniblocks it references are tracked and, when the delay expires, only the ones not seen yet on their topics are
+// broadcast, together with their transactions. The header is never broadcast by a backup, as a backup can not build
+// the same final header as the leader. If no header is seen for the round, or if a header of a different block is
+// seen for it at any time, nothing is broadcast. So a leader which disconnects before broadcasting its final header
+// is not covered: the round is lost and the block is proposed again by the next leaders
+type delayedBroadcaster struct {
+	headersPool      storage.Cacher
+	miniBlocksPool   storage.Cacher
+	shardCoordinator sharding.Coordinator
+	broadcaster      consensus.BroadcastMessenger
+
+	mutPending sync.Mutex
+	pending    *pendingBroadcast
+}
+
+func newDelayedBroadcaster(
+	headersPool storage.Cacher,
+	miniBlocksPool storage.Cacher,
+	shardCoordinator sharding.Coordinator,
+	broadcaster consensus.BroadcastMessenger,
+) *delayedBroadcaster {
+
+	db := &delayedBroadcaster{
+		headersPool:      headersPool,
+		miniBlocksPool:   miniBlocksPool,
+		shardCoordinator: shardCoordinator,
+		broadcaster:      broadcaster,
+	}
+
+	db.headersPool.RegisterHandler(db.receivedHeader)
+	db.miniBlocksPool.RegisterHandler(db.receivedMiniBlock)
+
+	return db
+}
+
+// setData replaces the pending data, if any, with the provided one, which will be broadcast after the given delay
+func (db *delayedBroadcaster) setData(delayedData *consensus.DelayedBroadcastData, delay time.Duration) {
+	pb := &pendingBroadcast{
+		data:             delayedData,
+		unseenMiniBlocks: make(map[uint32]map[string]struct{}),
+	}
+
+	db.mutPending.Lock()
+	if db.pending != nil {
+		db.pending.timer.Stop()
+	}
+	db.pending = pb
+	pb.timer = time.AfterFunc(delay, func() {
+		db.broadcastPending(pb)
+	})
+	db.mutPending.Unlock()
+
+	//the final header might have been received before the data was set
+	for _, key := range db.headersPool.Keys() {
+		db.receivedHeader(key)
+	}
+}
+
+func (db *delayedBroadcaster) receivedHeader(key []byte) {
+	value, ok := db.headersPool.Peek(key)
+	if !ok {
+		return
+	}
+
+	header, ok := value.(data.HeaderHandler)
+	if !ok {
+		return
+	}
+
+	db.mutPending.Lock()
+	defer db.mutPending.Unlock()
+
+	if db.pending == nil {
+		return
+	}
+
+	pendingHeader := db.pending.data.Header
+	isSameRound := header.GetShardID() == pendingHeader.GetShardID() && header.GetRound() == pendingHeader.GetRound()
+	if !isSameRound {
+		return
+	}
+
+	if !db.isSameBlock(header, db.pending.data) {
+		log.Debug(fmt.Sprintf("another block was seen for round %d, the delayed broadcast is canceled\n",
+			header.GetRound()))
+		db.pending.timer.Stop()
+		db.pending = nil
+		return
+	}
+	if db.pending.headerSeen {
+		return
+	}
+
+	db.pending.headerSeen = true
+	for shardId := range db.pending.data.MiniBlocks {
+		hashes := make(map[string]struct{})
+		for hash := range header.GetMiniBlockHeadersWithDst(shardId) {
+			if !db.miniBlocksPool.Has([]byte(hash)) {
+				hashes[hash] = struct{}{}
+			}
+		}
+		db.pending.unseenMiniBlocks[shardId] = hashes
+	}
+}
+
+// isSameBlock returns true if the final header references the same cross shard miniblocks as the kept block. The
+// header hashes can not be compared, as the hash of the final header includes the aggregated signature
+func (db *delayedBroadcaster) isSameBlock(header data.HeaderHandler, delayedData *consensus.DelayedBroadcastData) bool {
+	if header.GetNonce() != delayedData.Header.GetNonce() {
+		return false
+	}
+
+	for shardId := range delayedData.MiniBlocks {
+		hashes := header.GetMiniBlockHeadersWithDst(shardId)
+		keptHashes := delayedData.Header.GetMiniBlockHeadersWithDst(shardId)
+		if len(hashes) != len(keptHashes) {
+			return false
+		}
+		for hash := range keptHashes {
+			_, found := hashes[hash]
+			if !found {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func (db *delayedBroadcaster) receivedMiniBlock(key []byte) {
+	db.mutPending.Lock()
+	defer db.mutPending.Unlock()
+
+	if db.pending == nil {
+		return
+	}
+
+	for _, hashes := range db.pending.unseenMiniBlocks {
+		delete(hashes, string(key))
+	}
+}
+
+// broadcastPending sends the miniblocks of the pending data which were not seen during the delay. The transactions
+// of a destination shard are sent along with its miniblocks, as they are disseminated together by the leader
+func (db *delayedBroadcaster) broadcastPending(pb *pendingBroadcast) {
+	db.mutPending.Lock()
+	if db.pending != pb {
+		db.mutPending.Unlock()
+		return
+	}
+
+	headerSeen := pb.headerSeen
+	shardsToBroadcast := make([]uint32, 0)
+	for shardId, hashes := range pb.unseenMiniBlocks {
+		if len(hashes) > 0 {
+			shardsToBroadcast = append(shardsToBroadcast, shardId)
+		}
+	}
+	db.pending = nil
+	db.mutPending.Unlock()
+
+	if !headerSeen {
+		log.Debug(fmt.Sprintf("no final header was seen for round %d, the delayed broadcast is dropped\n",
+			pb.data.Header.GetRound()))
+		return
+	}
+
+	for _, shardId := range shardsToBroadcast {
+		db.broadcastMiniBlocksAndTransactions(pb.data, shardId)
+	}
+}
+
+func (db *delayedBroadcaster) broadcastMiniBlocksAndTransactions(
+	delayedData *consensus.DelayedBroadcastData,
+	shardId uint32,
+) {
+	log.Info(fmt.Sprintf("miniblocks for shard %d were not seen, broadcasting them as backup\n", shardId))
+
+	err := db.broadcaster.BroadcastMiniBlocks(map[uint32][]byte{shardId: delayedData.MiniBlocks[shardId]})
+	if err != nil {
+		log.Error(err.Error())
+	}
+
+	identifier := db.shardCoordinator.CommunicationIdentifier(shardId)
+	transactions := make(map[string][][]byte)
+	for topic, txs := range delayedData.Transactions {
+		if strings.HasSuffix(topic, identifier) {
+			transactions[topic] = txs
+		}
+	}
+
+	err = db.broadcaster.BroadcastTransactions(transactions)
+	if err != nil {
+		log.Error(err.Error())
+	}
+}
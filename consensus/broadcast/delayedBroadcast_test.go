@@ -0,0 +1,291 @@
+package broadcast_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/broadcast"
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+const testDelay = 100 * time.Millisecond
+
+type topicsRecorder struct {
+	mutTopics sync.Mutex
+	topics    map[string]int
+}
+
+func (tr *topicsRecorder) broadcast(topic string, buff []byte) {
+	tr.mutTopics.Lock()
+	tr.topics[topic]++
+	tr.mutTopics.Unlock()
+}
+
+func (tr *topicsRecorder) numBroadcasts(topic string) int {
+	tr.mutTopics.Lock()
+	defer tr.mutTopics.Unlock()
+
+	return tr.topics[topic]
+}
+
+func createDelayedBroadcastMessenger(headersPool storage.Cacher, miniBlocksPool storage.Cacher) (
+	consensus.BroadcastMessenger,
+	*topicsRecorder,
+	sharding.Coordinator,
+) {
+	recorder := &topicsRecorder{
+		topics: make(map[string]int),
+	}
+	shardCoordinator, _ := sharding.NewMultiShardCoordinator(3, 0)
+
+	scm, _ := broadcast.NewShardChainMessenger(
+		&mock.MarshalizerMock{},
+		&mock.MessengerStub{
+			BroadcastCalled: recorder.broadcast,
+		},
+		&mock.PrivateKeyMock{},
+		shardCoordinator,
+		&mock.SingleSignerMock{},
+		headersPool,
+		miniBlocksPool,
+	)
+
+	return scm, recorder, shardCoordinator
+}
+
+func createDelayedBroadcastData(shardCoordinator sharding.Coordinator) *consensus.DelayedBroadcastData {
+	header := &block.Header{
+		Nonce:   1,
+		Round:   2,
+		ShardId: 0,
+		MiniBlockHeaders: []block.MiniBlockHeader{
+			{Hash: []byte("mb shard 1"), SenderShardID: 0, ReceiverShardID: 1},
+			{Hash: []byte("mb shard 2"), SenderShardID: 0, ReceiverShardID: 2},
+		},
+	}
+
+	return &consensus.DelayedBroadcastData{
+		Header: header,
+		Body:   block.Body{},
+		MiniBlocks: map[uint32][]byte{
+			1: []byte("miniblocks for shard 1"),
+			2: []byte("miniblocks for shard 2"),
+		},
+		Transactions: map[string][][]byte{
+			factory.TransactionTopic + shardCoordinator.CommunicationIdentifier(1): {[]byte("tx1")},
+			factory.TransactionTopic + shardCoordinator.CommunicationIdentifier(2): {[]byte("tx2")},
+		},
+	}
+}
+
+func waitForDelayedBroadcast() {
+	time.Sleep(testDelay * 3)
+}
+
+//------- SetDelayedBroadcastData
+
+func TestShardChainMessenger_SetDelayedBroadcastDataNilDataShouldErr(t *testing.T) {
+	scm, _, _ := createDelayedBroadcastMessenger(createCacher(), createCacher())
+
+	err := scm.SetDelayedBroadcastData(nil, testDelay)
+
+	assert.Equal(t, spos.ErrNilDelayedBroadcastData, err)
+}
+
+func TestShardChainMessenger_SetDelayedBroadcastDataNilHeaderShouldErr(t *testing.T) {
+	scm, _, shardCoordinator := createDelayedBroadcastMessenger(createCacher(), createCacher())
+	delayedData := createDelayedBroadcastData(shardCoordinator)
+	delayedData.Header = nil
+
+	err := scm.SetDelayedBroadcastData(delayedData, testDelay)
+
+	assert.Equal(t, spos.ErrNilHeader, err)
+}
+
+func TestShardChainMessenger_SetDelayedBroadcastDataNilBodyShouldErr(t *testing.T) {
+	scm, _, shardCoordinator := createDelayedBroadcastMessenger(createCacher(), createCacher())
+	delayedData := createDelayedBroadcastData(shardCoordinator)
+	delayedData.Body = nil
+
+	err := scm.SetDelayedBroadcastData(delayedData, testDelay)
+
+	assert.Equal(t, spos.ErrNilBody, err)
+}
+
+func TestShardChainMessenger_SetDelayedBroadcastDataNegativeDelayShouldErr(t *testing.T) {
+	scm, _, shardCoordinator := createDelayedBroadcastMessenger(createCacher(), createCacher())
+
+	err := scm.SetDelayedBroadcastData(createDelayedBroadcastData(shardCoordinator), -time.Second)
+
+	assert.Equal(t, spos.ErrInvalidDelay, err)
+}
+
+func createFinalHeader(delayedData *consensus.DelayedBroadcastData) *block.Header {
+	header := *delayedData.Header.(*block.Header)
+	header.Signature = []byte("aggregated signature")
+	header.PubKeysBitmap = []byte{1}
+
+	return &header
+}
+
+func assertNoBroadcast(t *testing.T, recorder *topicsRecorder, shardCoordinator sharding.Coordinator) {
+	metaIdentifier := shardCoordinator.CommunicationIdentifier(sharding.MetachainShardId)
+	assert.Equal(t, 0, recorder.numBroadcasts(factory.HeadersTopic+shardCoordinator.CommunicationIdentifier(0)))
+	assert.Equal(t, 0, recorder.numBroadcasts(factory.ShardHeadersForMetachainTopic+metaIdentifier))
+	for shardId := uint32(1); shardId < 3; shardId++ {
+		identifier := shardCoordinator.CommunicationIdentifier(shardId)
+		assert.Equal(t, 0, recorder.numBroadcasts(factory.MiniBlocksTopic+identifier))
+		assert.Equal(t, 0, recorder.numBroadcasts(factory.TransactionTopic+identifier))
+	}
+}
+
+func TestShardChainMessenger_SetDelayedBroadcastDataNoHeaderSeenShouldNotBroadcast(t *testing.T) {
+	scm, recorder, shardCoordinator := createDelayedBroadcastMessenger(createCacher(), createCacher())
+
+	err := scm.SetDelayedBroadcastData(createDelayedBroadcastData(shardCoordinator), testDelay)
+	assert.Nil(t, err)
+
+	waitForDelayedBroadcast()
+
+	assertNoBroadcast(t, recorder, shardCoordinator)
+}
+
+func TestShardChainMessenger_SetDelayedBroadcastDataHeaderSeenShouldBroadcastMiniBlocksButNotTheHeader(t *testing.T) {
+	headersPool := createCacher()
+	scm, recorder, shardCoordinator := createDelayedBroadcastMessenger(headersPool, createCacher())
+	delayedData := createDelayedBroadcastData(shardCoordinator)
+
+	err := scm.SetDelayedBroadcastData(delayedData, testDelay)
+	assert.Nil(t, err)
+
+	headersPool.Put([]byte("header from leader"), createFinalHeader(delayedData))
+
+	assert.Equal(t, 0, recorder.numBroadcasts(factory.MiniBlocksTopic+shardCoordinator.CommunicationIdentifier(1)))
+
+	waitForDelayedBroadcast()
+
+	metaIdentifier := shardCoordinator.CommunicationIdentifier(sharding.MetachainShardId)
+	assert.Equal(t, 0, recorder.numBroadcasts(factory.HeadersTopic+shardCoordinator.CommunicationIdentifier(0)))
+	assert.Equal(t, 0, recorder.numBroadcasts(factory.ShardHeadersForMetachainTopic+metaIdentifier))
+	for shardId := uint32(1); shardId < 3; shardId++ {
+		identifier := shardCoordinator.CommunicationIdentifier(shardId)
+		assert.Equal(t, 1, recorder.numBroadcasts(factory.MiniBlocksTopic+identifier))
+		assert.Equal(t, 1, recorder.numBroadcasts(factory.TransactionTopic+identifier))
+	}
+}
+
+func TestShardChainMessenger_SetDelayedBroadcastDataHeaderSeenBeforeSettingTheDataShouldBroadcastMiniBlocks(t *testing.T) {
+	headersPool := createCacher()
+	scm, recorder, shardCoordinator := createDelayedBroadcastMessenger(headersPool, createCacher())
+	delayedData := createDelayedBroadcastData(shardCoordinator)
+	headersPool.Put([]byte("header from leader"), createFinalHeader(delayedData))
+
+	err := scm.SetDelayedBroadcastData(delayedData, testDelay)
+	assert.Nil(t, err)
+
+	waitForDelayedBroadcast()
+
+	assert.Equal(t, 1, recorder.numBroadcasts(factory.MiniBlocksTopic+shardCoordinator.CommunicationIdentifier(1)))
+}
+
+func TestShardChainMessenger_SetDelayedBroadcastDataOtherBlockSeenForTheRoundShouldCancel(t *testing.T) {
+	headersPool := createCacher()
+	scm, recorder, shardCoordinator := createDelayedBroadcastMessenger(headersPool, createCacher())
+	delayedData := createDelayedBroadcastData(shardCoordinator)
+
+	err := scm.SetDelayedBroadcastData(delayedData, testDelay)
+	assert.Nil(t, err)
+
+	otherBlock := createFinalHeader(delayedData)
+	otherBlock.MiniBlockHeaders = []block.MiniBlockHeader{
+		{Hash: []byte("other mb"), SenderShardID: 0, ReceiverShardID: 1},
+	}
+	headersPool.Put([]byte("other block"), otherBlock)
+	headersPool.Put([]byte("header from leader"), createFinalHeader(delayedData))
+
+	waitForDelayedBroadcast()
+
+	assertNoBroadcast(t, recorder, shardCoordinator)
+}
+
+func TestShardChainMessenger_SetDelayedBroadcastDataHeaderForOtherRoundShouldNotBroadcast(t *testing.T) {
+	headersPool := createCacher()
+	scm, recorder, shardCoordinator := createDelayedBroadcastMessenger(headersPool, createCacher())
+	delayedData := createDelayedBroadcastData(shardCoordinator)
+
+	err := scm.SetDelayedBroadcastData(delayedData, testDelay)
+	assert.Nil(t, err)
+
+	otherRound := createFinalHeader(delayedData)
+	otherRound.Round = 1
+	headersPool.Put([]byte("header for other round"), otherRound)
+
+	waitForDelayedBroadcast()
+
+	assertNoBroadcast(t, recorder, shardCoordinator)
+}
+
+func TestShardChainMessenger_SetDelayedBroadcastDataMiniBlocksSeenShouldBroadcastOnlyUnseenShards(t *testing.T) {
+	headersPool := createCacher()
+	miniBlocksPool := createCacher()
+	scm, recorder, shardCoordinator := createDelayedBroadcastMessenger(headersPool, miniBlocksPool)
+	delayedData := createDelayedBroadcastData(shardCoordinator)
+
+	//miniblocks already in pool before the header is seen are considered seen
+	miniBlocksPool.Put([]byte("mb shard 1"), &block.MiniBlock{})
+
+	err := scm.SetDelayedBroadcastData(delayedData, testDelay)
+	assert.Nil(t, err)
+	headersPool.Put([]byte("header from leader"), createFinalHeader(delayedData))
+
+	waitForDelayedBroadcast()
+
+	identifierShard1 := shardCoordinator.CommunicationIdentifier(1)
+	identifierShard2 := shardCoordinator.CommunicationIdentifier(2)
+	assert.Equal(t, 0, recorder.numBroadcasts(factory.MiniBlocksTopic+identifierShard1))
+	assert.Equal(t, 0, recorder.numBroadcasts(factory.TransactionTopic+identifierShard1))
+	assert.Equal(t, 1, recorder.numBroadcasts(factory.MiniBlocksTopic+identifierShard2))
+	assert.Equal(t, 1, recorder.numBroadcasts(factory.TransactionTopic+identifierShard2))
+}
+
+func TestShardChainMessenger_SetDelayedBroadcastDataMiniBlocksReceivedDuringDelayShouldNotBroadcast(t *testing.T) {
+	headersPool := createCacher()
+	miniBlocksPool := createCacher()
+	scm, recorder, shardCoordinator := createDelayedBroadcastMessenger(headersPool, miniBlocksPool)
+	delayedData := createDelayedBroadcastData(shardCoordinator)
+
+	err := scm.SetDelayedBroadcastData(delayedData, testDelay)
+	assert.Nil(t, err)
+
+	headersPool.Put([]byte("header from leader"), createFinalHeader(delayedData))
+	miniBlocksPool.Put([]byte("mb shard 1"), &block.MiniBlock{})
+	miniBlocksPool.Put([]byte("mb shard 2"), &block.MiniBlock{})
+
+	waitForDelayedBroadcast()
+
+	assertNoBroadcast(t, recorder, shardCoordinator)
+}
+
+func TestShardChainMessenger_SetDelayedBroadcastDataTwiceShouldBroadcastOnlyTheLastOne(t *testing.T) {
+	headersPool := createCacher()
+	scm, recorder, shardCoordinator := createDelayedBroadcastMessenger(headersPool, createCacher())
+	delayedData := createDelayedBroadcastData(shardCoordinator)
+
+	err := scm.SetDelayedBroadcastData(delayedData, testDelay)
+	assert.Nil(t, err)
+	err = scm.SetDelayedBroadcastData(createDelayedBroadcastData(shardCoordinator), testDelay)
+	assert.Nil(t, err)
+	headersPool.Put([]byte("header from leader"), createFinalHeader(delayedData))
+
+	waitForDelayedBroadcast()
+
+	assert.Equal(t, 1, recorder.numBroadcasts(factory.MiniBlocksTopic+shardCoordinator.CommunicationIdentifier(1)))
+}
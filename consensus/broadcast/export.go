@@ -2,6 +2,7 @@ package broadcast
 
 import (
 	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/recorder"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -25,5 +26,6 @@ func NewCommonMessenger(
 		privateKey:       privateKey,
 		shardCoordinator: shardCoordinator,
 		singleSigner:     singleSigner,
+		roundRecorder:    recorder.NewNilRoundRecorder(),
 	}, nil
 }
@@ -1,7 +1,10 @@
 package broadcast
 
 import (
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/recorder"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
@@ -36,6 +39,7 @@ func NewMetaChainMessenger(
 		privateKey:       privateKey,
 		shardCoordinator: shardCoordinator,
 		singleSigner:     singleSigner,
+		roundRecorder:    recorder.NewNilRoundRecorder(),
 	}
 
 	mcm := &metaChainMessenger{
@@ -110,6 +114,16 @@ func (mcm *metaChainMessenger) BroadcastTransactions(transactions map[string][][
 	return nil
 }
 
+// SetDelayedBroadcastData does nothing for the meta chain messenger
+func (mcm *metaChainMessenger) SetDelayedBroadcastData(
+	delayedData *consensus.DelayedBroadcastData,
+	delay time.Duration,
+) error {
+	// meta chain does not use backup broadcasters but this method is created to satisfy the BroadcastMessenger
+	// interface
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (mcm *metaChainMessenger) IsInterfaceNil() bool {
 	if mcm == nil {
@@ -2,8 +2,10 @@ package broadcast
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/recorder"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/partitioning"
@@ -12,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
 type shardChainMessenger struct {
@@ -19,6 +22,7 @@ type shardChainMessenger struct {
 	marshalizer      marshal.Marshalizer
 	messenger        consensus.P2PMessenger
 	shardCoordinator sharding.Coordinator
+	delayedBroadcast *delayedBroadcaster
 }
 
 // NewShardChainMessenger creates a new shardChainMessenger object
@@ -28,9 +32,19 @@ func NewShardChainMessenger(
 	privateKey crypto.PrivateKey,
 	shardCoordinator sharding.Coordinator,
 	singleSigner crypto.SingleSigner,
+	headersPool storage.Cacher,
+	miniBlocksPool storage.Cacher,
 ) (*shardChainMessenger, error) {
 
-	err := checkShardChainNilParameters(marshalizer, messenger, shardCoordinator, privateKey, singleSigner)
+	err := checkShardChainNilParameters(
+		marshalizer,
+		messenger,
+		shardCoordinator,
+		privateKey,
+		singleSigner,
+		headersPool,
+		miniBlocksPool,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -41,6 +55,7 @@ func NewShardChainMessenger(
 		privateKey:       privateKey,
 		shardCoordinator: shardCoordinator,
 		singleSigner:     singleSigner,
+		roundRecorder:    recorder.NewNilRoundRecorder(),
 	}
 
 	scm := &shardChainMessenger{
@@ -49,6 +64,7 @@ func NewShardChainMessenger(
 		messenger:        messenger,
 		shardCoordinator: shardCoordinator,
 	}
+	scm.delayedBroadcast = newDelayedBroadcaster(headersPool, miniBlocksPool, shardCoordinator, scm)
 
 	return scm, nil
 }
@@ -59,6 +75,8 @@ func checkShardChainNilParameters(
 	shardCoordinator sharding.Coordinator,
 	privateKey crypto.PrivateKey,
 	singleSigner crypto.SingleSigner,
+	headersPool storage.Cacher,
+	miniBlocksPool storage.Cacher,
 ) error {
 	if marshalizer == nil || marshalizer.IsInterfaceNil() {
 		return spos.ErrNilMarshalizer
@@ -75,6 +93,12 @@ func checkShardChainNilParameters(
 	if singleSigner == nil || singleSigner.IsInterfaceNil() {
 		return spos.ErrNilSingleSigner
 	}
+	if headersPool == nil || headersPool.IsInterfaceNil() {
+		return spos.ErrNilHeadersPool
+	}
+	if miniBlocksPool == nil || miniBlocksPool.IsInterfaceNil() {
+		return spos.ErrNilMiniBlocksPool
+	}
 
 	return nil
 }
@@ -177,6 +201,31 @@ func (scm *shardChainMessenger) BroadcastTransactions(transactions map[string][]
 	return nil
 }
 
+// SetDelayedBroadcastData keeps the provided proposed block data. After the given delay, if the final header of the
+// block was seen, the miniblocks and transactions that were not seen meanwhile on their topics are broadcast. Any
+// previously set data which was not broadcast yet is dropped
+func (scm *shardChainMessenger) SetDelayedBroadcastData(
+	delayedData *consensus.DelayedBroadcastData,
+	delay time.Duration,
+) error {
+	if delayedData == nil {
+		return spos.ErrNilDelayedBroadcastData
+	}
+	if delayedData.Header == nil || delayedData.Header.IsInterfaceNil() {
+		return spos.ErrNilHeader
+	}
+	if delayedData.Body == nil || delayedData.Body.IsInterfaceNil() {
+		return spos.ErrNilBody
+	}
+	if delay < 0 {
+		return spos.ErrInvalidDelay
+	}
+
+	scm.delayedBroadcast.setData(delayedData, delay)
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (scm *shardChainMessenger) IsInterfaceNil() bool {
 	if scm == nil {
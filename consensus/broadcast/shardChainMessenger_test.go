@@ -9,9 +9,16 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/stretchr/testify/assert"
 )
 
+func createCacher() storage.Cacher {
+	cacher, _ := storageUnit.NewCache(storageUnit.LRUCache, 100, 1)
+	return cacher
+}
+
 func TestShardChainMessenger_NewShardChainMessengerNilMarshalizerShouldFail(t *testing.T) {
 	messengerMock := &mock.MessengerStub{}
 	privateKeyMock := &mock.PrivateKeyMock{}
@@ -24,6 +31,8 @@ func TestShardChainMessenger_NewShardChainMessengerNilMarshalizerShouldFail(t *t
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		createCacher(),
+		createCacher(),
 	)
 
 	assert.Nil(t, scm)
@@ -42,6 +51,8 @@ func TestShardChainMessenger_NewShardChainMessengerNilMessengerShouldFail(t *tes
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		createCacher(),
+		createCacher(),
 	)
 
 	assert.Nil(t, scm)
@@ -60,6 +71,8 @@ func TestShardChainMessenger_NewShardChainMessengerNilPrivateKeyShouldFail(t *te
 		nil,
 		shardCoordinatorMock,
 		singleSignerMock,
+		createCacher(),
+		createCacher(),
 	)
 
 	assert.Nil(t, scm)
@@ -78,6 +91,8 @@ func TestShardChainMessenger_NewShardChainMessengerNilShardCoordinatorShouldFail
 		privateKeyMock,
 		nil,
 		singleSignerMock,
+		createCacher(),
+		createCacher(),
 	)
 
 	assert.Nil(t, scm)
@@ -96,12 +111,56 @@ func TestShardChainMessenger_NewShardChainMessengerNilSingleSignerShouldFail(t *
 		privateKeyMock,
 		shardCoordinatorMock,
 		nil,
+		createCacher(),
+		createCacher(),
 	)
 
 	assert.Nil(t, scm)
 	assert.Equal(t, spos.ErrNilSingleSigner, err)
 }
 
+func TestShardChainMessenger_NewShardChainMessengerNilHeadersPoolShouldFail(t *testing.T) {
+	marshalizerMock := &mock.MarshalizerMock{}
+	messengerMock := &mock.MessengerStub{}
+	privateKeyMock := &mock.PrivateKeyMock{}
+	shardCoordinatorMock := &mock.ShardCoordinatorMock{}
+	singleSignerMock := &mock.SingleSignerMock{}
+
+	scm, err := broadcast.NewShardChainMessenger(
+		marshalizerMock,
+		messengerMock,
+		privateKeyMock,
+		shardCoordinatorMock,
+		singleSignerMock,
+		nil,
+		createCacher(),
+	)
+
+	assert.Nil(t, scm)
+	assert.Equal(t, spos.ErrNilHeadersPool, err)
+}
+
+func TestShardChainMessenger_NewShardChainMessengerNilMiniBlocksPoolShouldFail(t *testing.T) {
+	marshalizerMock := &mock.MarshalizerMock{}
+	messengerMock := &mock.MessengerStub{}
+	privateKeyMock := &mock.PrivateKeyMock{}
+	shardCoordinatorMock := &mock.ShardCoordinatorMock{}
+	singleSignerMock := &mock.SingleSignerMock{}
+
+	scm, err := broadcast.NewShardChainMessenger(
+		marshalizerMock,
+		messengerMock,
+		privateKeyMock,
+		shardCoordinatorMock,
+		singleSignerMock,
+		createCacher(),
+		nil,
+	)
+
+	assert.Nil(t, scm)
+	assert.Equal(t, spos.ErrNilMiniBlocksPool, err)
+}
+
 func TestShardChainMessenger_NewShardChainMessengerShouldWork(t *testing.T) {
 	marshalizerMock := &mock.MarshalizerMock{}
 	messengerMock := &mock.MessengerStub{}
@@ -115,6 +174,8 @@ func TestShardChainMessenger_NewShardChainMessengerShouldWork(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		createCacher(),
+		createCacher(),
 	)
 
 	assert.NotNil(t, scm)
@@ -134,6 +195,8 @@ func TestShardChainMessenger_BroadcastBlockShouldErrNilBody(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		createCacher(),
+		createCacher(),
 	)
 
 	err := scm.BroadcastBlock(nil, &block.Header{})
@@ -153,6 +216,8 @@ func TestShardChainMessenger_BroadcastBlockShouldErrNilHeader(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		createCacher(),
+		createCacher(),
 	)
 
 	err := scm.BroadcastBlock(&block.Body{}, nil)
@@ -173,6 +238,8 @@ func TestShardChainMessenger_BroadcastBlockShouldErrMockMarshalizer(t *testing.T
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		createCacher(),
+		createCacher(),
 	)
 
 	err := scm.BroadcastBlock(&block.Body{}, &block.Header{})
@@ -195,6 +262,8 @@ func TestShardChainMessenger_BroadcastBlockShouldWork(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		createCacher(),
+		createCacher(),
 	)
 
 	err := scm.BroadcastBlock(&block.Body{}, &block.Header{})
@@ -214,6 +283,8 @@ func TestShardChainMessenger_BroadcastHeaderShouldErrNilHeader(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		createCacher(),
+		createCacher(),
 	)
 
 	err := scm.BroadcastHeader(nil)
@@ -234,6 +305,8 @@ func TestShardChainMessenger_BroadcastHeaderShouldErrMockMarshalizer(t *testing.
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		createCacher(),
+		createCacher(),
 	)
 
 	err := scm.BroadcastHeader(&block.Header{})
@@ -256,6 +329,8 @@ func TestShardChainMessenger_BroadcastHeaderShouldWork(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		createCacher(),
+		createCacher(),
 	)
 
 	err := scm.BroadcastHeader(&block.Header{})
@@ -282,6 +357,8 @@ func TestShardChainMessenger_BroadcastMiniBlocksShouldBeDone(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		createCacher(),
+		createCacher(),
 	)
 
 	miniBlocks := make(map[uint32][]byte)
@@ -325,6 +402,8 @@ func TestShardChainMessenger_BroadcastTransactionsShouldNotBeCalled(t *testing.T
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		createCacher(),
+		createCacher(),
 	)
 
 	transactions := make(map[string][][]byte)
@@ -374,6 +453,8 @@ func TestShardChainMessenger_BroadcastTransactionsShouldBeCalled(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		createCacher(),
+		createCacher(),
 	)
 
 	transactions := make(map[string][][]byte)
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/recorder"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/logger"
 	"github.com/ElrondNetwork/elrond-go/ntp"
@@ -30,6 +31,7 @@ type chronology struct {
 	subroundHandlers []consensus.SubroundHandler
 	mutSubrounds     sync.RWMutex
 	appStatusHandler core.AppStatusHandler
+	roundRecorder    consensus.RoundRecorder
 }
 
 // NewChronology creates a new chronology object
@@ -52,7 +54,8 @@ func NewChronology(
 		genesisTime:      genesisTime,
 		rounder:          rounder,
 		syncTimer:        syncTimer,
-		appStatusHandler: statusHandler.NewNilStatusHandler()}
+		appStatusHandler: statusHandler.NewNilStatusHandler(),
+		roundRecorder:    recorder.NewNilRoundRecorder()}
 
 	chr.subroundId = srBeforeStartRound
 
@@ -88,6 +91,16 @@ func (chr *chronology) SetAppStatusHandler(ash core.AppStatusHandler) error {
 	return nil
 }
 
+// SetRoundRecorder will set the RoundRecorder which will be used for recording the subround transitions
+func (chr *chronology) SetRoundRecorder(roundRecorder consensus.RoundRecorder) error {
+	if roundRecorder == nil || roundRecorder.IsInterfaceNil() {
+		return ErrNilRoundRecorder
+	}
+
+	chr.roundRecorder = roundRecorder
+	return nil
+}
+
 // AddSubround adds new SubroundHandler implementation to the chronology
 func (chr *chronology) AddSubround(subroundHandler consensus.SubroundHandler) {
 	chr.mutSubrounds.Lock()
@@ -135,11 +148,17 @@ func (chr *chronology) startRound() {
 	msg := fmt.Sprintf("SUBROUND %s BEGINS", sr.Name())
 	log.Info(log.Headline(msg, chr.syncTimer.FormattedCurrentTime(), "."))
 
+	roundIndex := chr.rounder.Index()
+	chr.roundRecorder.RecordSubroundStarted(roundIndex, sr.Current(), sr.Name())
+
 	if !sr.DoWork(chr.rounder) {
+		chr.roundRecorder.RecordSubroundFinished(roundIndex, sr.Current(), false, false)
 		chr.subroundId = srBeforeStartRound
 		return
 	}
 
+	chr.roundRecorder.RecordSubroundFinished(roundIndex, sr.Current(), true, sr.Next() == srBeforeStartRound)
+
 	chr.subroundId = sr.Next()
 }
 
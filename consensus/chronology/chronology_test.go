@@ -355,3 +355,83 @@ func TestChronology_CheckIfStatusHandlerWorks(t *testing.T) {
 		assert.Fail(t, "AppStatusHandler not working")
 	}
 }
+
+func TestChronology_SetRoundRecorderWithNilValueShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rounderMock := &mock.RounderMock{}
+	syncTimerMock := &mock.SyncTimerMock{}
+	chr, _ := chronology.NewChronology(
+		syncTimerMock.CurrentTime(),
+		rounderMock,
+		syncTimerMock)
+	err := chr.SetRoundRecorder(nil)
+
+	assert.Equal(t, chronology.ErrNilRoundRecorder, err)
+}
+
+func TestChronology_StartRoundShouldRecordSubroundTransitions(t *testing.T) {
+	t.Parallel()
+
+	rounderMock := &mock.RounderMock{}
+	syncTimerMock := &mock.SyncTimerMock{}
+	chr, _ := chronology.NewChronology(
+		syncTimerMock.CurrentTime(),
+		rounderMock,
+		syncTimerMock)
+
+	startedSubroundName := ""
+	isDoneRecorded := false
+	isLastSubroundRecorded := false
+	err := chr.SetRoundRecorder(&mock.RoundRecorderStub{
+		RecordSubroundStartedCalled: func(roundIndex int64, subroundId int, subroundName string) {
+			startedSubroundName = subroundName
+		},
+		RecordSubroundFinishedCalled: func(roundIndex int64, subroundId int, isDone bool, isLastSubround bool) {
+			isDoneRecorded = isDone
+			isLastSubroundRecorded = isLastSubround
+		},
+	})
+	assert.Nil(t, err)
+
+	srm := initSubroundHandlerMock()
+	srm.DoWorkCalled = func(rounder consensus.Rounder) bool {
+		return true
+	}
+	srm.NextCalled = func() int {
+		return -1
+	}
+
+	chr.AddSubround(srm)
+	chr.StartRound()
+
+	assert.Equal(t, "(TEST)", startedSubroundName)
+	assert.True(t, isDoneRecorded)
+	assert.True(t, isLastSubroundRecorded)
+}
+
+func TestChronology_StartRoundWithUnfinishedSubroundShouldRecordItAsNotDone(t *testing.T) {
+	t.Parallel()
+
+	rounderMock := &mock.RounderMock{}
+	syncTimerMock := &mock.SyncTimerMock{}
+	chr, _ := chronology.NewChronology(
+		syncTimerMock.CurrentTime(),
+		rounderMock,
+		syncTimerMock)
+
+	numFinishedRecorded := 0
+	isDoneRecorded := true
+	_ = chr.SetRoundRecorder(&mock.RoundRecorderStub{
+		RecordSubroundFinishedCalled: func(roundIndex int64, subroundId int, isDone bool, isLastSubround bool) {
+			numFinishedRecorded++
+			isDoneRecorded = isDone
+		},
+	})
+
+	chr.AddSubround(initSubroundHandlerMock())
+	chr.StartRound()
+
+	assert.Equal(t, 1, numFinishedRecorded)
+	assert.False(t, isDoneRecorded)
+}
@@ -12,3 +12,6 @@ var ErrNilSyncTimer = errors.New("sync timer is nil")
 
 // ErrNilAppStatusHandler is raised when the AppStatusHandler is nil when setting it
 var ErrNilAppStatusHandler = errors.New("nil AppStatusHandler")
+
+// ErrNilRoundRecorder is raised when the RoundRecorder is nil when setting it
+var ErrNilRoundRecorder = errors.New("nil RoundRecorder")
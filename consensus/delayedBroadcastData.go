@@ -0,0 +1,14 @@
+package consensus
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// DelayedBroadcastData holds the proposed block data kept by a backup consensus member. Its miniblocks and
+// transactions are broadcast later, only if the leader did not disseminate them
+type DelayedBroadcastData struct {
+	Header       data.HeaderHandler
+	Body         data.BodyHandler
+	MiniBlocks   map[uint32][]byte
+	Transactions map[string][][]byte
+}
@@ -59,6 +59,7 @@ type BroadcastMessenger interface {
 	BroadcastMiniBlocks(map[uint32][]byte) error
 	BroadcastTransactions(map[string][][]byte) error
 	BroadcastConsensusMessage(*Message) error
+	SetDelayedBroadcastData(delayedData *DelayedBroadcastData, delay time.Duration) error
 	IsInterfaceNil() bool
 }
 
@@ -67,3 +68,14 @@ type P2PMessenger interface {
 	Broadcast(topic string, buff []byte)
 	IsInterfaceNil() bool
 }
+
+// RoundRecorder defines the behaviour of a component able to record, per round, the consensus messages and the
+// subround transitions, in order to debug the rounds which did not end with a committed block
+type RoundRecorder interface {
+	RecordReceivedMessage(message *Message, validationErr error)
+	RecordSentMessage(message *Message)
+	RecordSubroundStarted(roundIndex int64, subroundId int, subroundName string)
+	RecordSubroundFinished(roundIndex int64, subroundId int, isDone bool, isLastSubround bool)
+	Close() error
+	IsInterfaceNil() bool
+}
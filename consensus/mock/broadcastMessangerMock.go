@@ -1,6 +1,8 @@
 package mock
 
 import (
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/data"
 )
@@ -11,6 +13,7 @@ type BroadcastMessengerMock struct {
 	BroadcastMiniBlocksCalled       func(map[uint32][]byte) error
 	BroadcastTransactionsCalled     func(map[string][][]byte) error
 	BroadcastConsensusMessageCalled func(*consensus.Message) error
+	SetDelayedBroadcastDataCalled   func(*consensus.DelayedBroadcastData, time.Duration) error
 }
 
 func (bmm *BroadcastMessengerMock) BroadcastBlock(bodyHandler data.BodyHandler, headerhandler data.HeaderHandler) error {
@@ -48,6 +51,16 @@ func (bmm *BroadcastMessengerMock) BroadcastConsensusMessage(message *consensus.
 	return nil
 }
 
+func (bmm *BroadcastMessengerMock) SetDelayedBroadcastData(
+	delayedData *consensus.DelayedBroadcastData,
+	delay time.Duration,
+) error {
+	if bmm.SetDelayedBroadcastDataCalled != nil {
+		return bmm.SetDelayedBroadcastDataCalled(delayedData, delay)
+	}
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (bmm *BroadcastMessengerMock) IsInterfaceNil() bool {
 	if bmm == nil {
@@ -0,0 +1,25 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+type ConsensusMessageValidatorStub struct {
+	ValidateConsensusMessageCalled func(cnsDta *consensus.Message, originator p2p.PeerID) error
+}
+
+func (cmvs *ConsensusMessageValidatorStub) ValidateConsensusMessage(cnsDta *consensus.Message, originator p2p.PeerID) error {
+	if cmvs.ValidateConsensusMessageCalled != nil {
+		return cmvs.ValidateConsensusMessageCalled(cnsDta, originator)
+	}
+
+	return nil
+}
+
+func (cmvs *ConsensusMessageValidatorStub) IsInterfaceNil() bool {
+	if cmvs == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,19 @@
+package mock
+
+type ConsensusStateProviderStub struct {
+	ConsensusGroupCalled func() []string
+	SelfPubKeyCalled     func() string
+	JobDoneCalled        func(key string, subroundId int) (bool, error)
+}
+
+func (csps *ConsensusStateProviderStub) ConsensusGroup() []string {
+	return csps.ConsensusGroupCalled()
+}
+
+func (csps *ConsensusStateProviderStub) SelfPubKey() string {
+	return csps.SelfPubKeyCalled()
+}
+
+func (csps *ConsensusStateProviderStub) JobDone(key string, subroundId int) (bool, error) {
+	return csps.JobDoneCalled(key, subroundId)
+}
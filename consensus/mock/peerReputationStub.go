@@ -0,0 +1,37 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+type PeerReputationStub struct {
+	RecordValidMessageCalled   func(pid p2p.PeerID)
+	RecordInvalidMessageCalled func(pid p2p.PeerID, reason error)
+	HasBadReputationCalled     func(pid p2p.PeerID) bool
+}
+
+func (prs *PeerReputationStub) RecordValidMessage(pid p2p.PeerID) {
+	if prs.RecordValidMessageCalled != nil {
+		prs.RecordValidMessageCalled(pid)
+	}
+}
+
+func (prs *PeerReputationStub) RecordInvalidMessage(pid p2p.PeerID, reason error) {
+	if prs.RecordInvalidMessageCalled != nil {
+		prs.RecordInvalidMessageCalled(pid, reason)
+	}
+}
+
+func (prs *PeerReputationStub) HasBadReputation(pid p2p.PeerID) bool {
+	if prs.HasBadReputationCalled != nil {
+		return prs.HasBadReputationCalled(pid)
+	}
+	return false
+}
+
+func (prs *PeerReputationStub) IsInterfaceNil() bool {
+	if prs == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,53 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+type RoundRecorderStub struct {
+	RecordReceivedMessageCalled  func(message *consensus.Message, validationErr error)
+	RecordSentMessageCalled      func(message *consensus.Message)
+	RecordSubroundStartedCalled  func(roundIndex int64, subroundId int, subroundName string)
+	RecordSubroundFinishedCalled func(roundIndex int64, subroundId int, isDone bool, isLastSubround bool)
+	CloseCalled                  func() error
+}
+
+func (rrs *RoundRecorderStub) RecordReceivedMessage(message *consensus.Message, validationErr error) {
+	if rrs.RecordReceivedMessageCalled != nil {
+		rrs.RecordReceivedMessageCalled(message, validationErr)
+	}
+}
+
+func (rrs *RoundRecorderStub) RecordSentMessage(message *consensus.Message) {
+	if rrs.RecordSentMessageCalled != nil {
+		rrs.RecordSentMessageCalled(message)
+	}
+}
+
+func (rrs *RoundRecorderStub) RecordSubroundStarted(roundIndex int64, subroundId int, subroundName string) {
+	if rrs.RecordSubroundStartedCalled != nil {
+		rrs.RecordSubroundStartedCalled(roundIndex, subroundId, subroundName)
+	}
+}
+
+func (rrs *RoundRecorderStub) RecordSubroundFinished(roundIndex int64, subroundId int, isDone bool, isLastSubround bool) {
+	if rrs.RecordSubroundFinishedCalled != nil {
+		rrs.RecordSubroundFinishedCalled(roundIndex, subroundId, isDone, isLastSubround)
+	}
+}
+
+func (rrs *RoundRecorderStub) Close() error {
+	if rrs.CloseCalled != nil {
+		return rrs.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rrs *RoundRecorderStub) IsInterfaceNil() bool {
+	if rrs == nil {
+		return true
+	}
+	return false
+}
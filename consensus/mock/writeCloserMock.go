@@ -0,0 +1,36 @@
+package mock
+
+import (
+	"bytes"
+	"sync"
+)
+
+// WriteCloserMock keeps in memory everything written into it
+type WriteCloserMock struct {
+	mutBuffer sync.Mutex
+	buffer    bytes.Buffer
+	IsClosed  bool
+}
+
+func (wcm *WriteCloserMock) Write(p []byte) (int, error) {
+	wcm.mutBuffer.Lock()
+	defer wcm.mutBuffer.Unlock()
+
+	return wcm.buffer.Write(p)
+}
+
+func (wcm *WriteCloserMock) Close() error {
+	wcm.mutBuffer.Lock()
+	wcm.IsClosed = true
+	wcm.mutBuffer.Unlock()
+
+	return nil
+}
+
+// Bytes returns a copy of everything written so far
+func (wcm *WriteCloserMock) Bytes() []byte {
+	wcm.mutBuffer.Lock()
+	defer wcm.mutBuffer.Unlock()
+
+	return append([]byte{}, wcm.buffer.Bytes()...)
+}
@@ -0,0 +1,29 @@
+package recorder
+
+import (
+	"errors"
+)
+
+// ErrNilConsensusStateProvider signals that a nil consensus state provider has been provided
+var ErrNilConsensusStateProvider = errors.New("nil consensus state provider")
+
+// ErrNilSyncTimer signals that a nil sync timer has been provided
+var ErrNilSyncTimer = errors.New("nil sync timer")
+
+// ErrNilWriter signals that a nil writer has been provided
+var ErrNilWriter = errors.New("nil writer")
+
+// ErrEmptyFolderPath signals that an empty folder path has been provided
+var ErrEmptyFolderPath = errors.New("empty folder path")
+
+// ErrInvalidMaxFileSize signals that an invalid maximum file size has been provided
+var ErrInvalidMaxFileSize = errors.New("invalid maximum file size")
+
+// ErrInvalidMaxFiles signals that an invalid maximum number of files has been provided
+var ErrInvalidMaxFiles = errors.New("invalid maximum number of files")
+
+// ErrRecorderClosed signals that the recorder, or its writer, has already been closed
+var ErrRecorderClosed = errors.New("recorder closed")
+
+// ErrNilReader signals that a nil reader has been provided
+var ErrNilReader = errors.New("nil reader")
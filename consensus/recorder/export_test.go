@@ -0,0 +1,3 @@
+package recorder
+
+const MaxMessagesPerRound = maxMessagesPerRound
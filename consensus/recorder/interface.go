@@ -0,0 +1,8 @@
+package recorder
+
+// ConsensusStateProvider defines the consensus state information needed by the recorder when a round is finalized
+type ConsensusStateProvider interface {
+	ConsensusGroup() []string
+	SelfPubKey() string
+	JobDone(key string, subroundId int) (bool, error)
+}
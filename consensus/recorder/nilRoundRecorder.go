@@ -0,0 +1,43 @@
+package recorder
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+// NilRoundRecorder will be used when a RoundRecorder is required, but recording is not enabled
+type NilRoundRecorder struct {
+}
+
+// NewNilRoundRecorder will return an instance of the struct
+func NewNilRoundRecorder() *NilRoundRecorder {
+	return new(NilRoundRecorder)
+}
+
+// RecordReceivedMessage method - won't do anything
+func (nrr *NilRoundRecorder) RecordReceivedMessage(message *consensus.Message, validationErr error) {
+}
+
+// RecordSentMessage method - won't do anything
+func (nrr *NilRoundRecorder) RecordSentMessage(message *consensus.Message) {
+}
+
+// RecordSubroundStarted method - won't do anything
+func (nrr *NilRoundRecorder) RecordSubroundStarted(roundIndex int64, subroundId int, subroundName string) {
+}
+
+// RecordSubroundFinished method - won't do anything
+func (nrr *NilRoundRecorder) RecordSubroundFinished(roundIndex int64, subroundId int, isDone bool, isLastSubround bool) {
+}
+
+// Close method - won't do anything
+func (nrr *NilRoundRecorder) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nrr *NilRoundRecorder) IsInterfaceNil() bool {
+	if nrr == nil {
+		return true
+	}
+	return false
+}
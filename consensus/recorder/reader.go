@@ -0,0 +1,59 @@
+package recorder
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+)
+
+// ReadRoundRecords decodes all the round records written, one per line, in the provided reader
+func ReadRoundRecords(reader io.Reader) ([]*RoundRecord, error) {
+	if reader == nil {
+		return nil, ErrNilReader
+	}
+
+	records := make([]*RoundRecord, 0)
+	decoder := json.NewDecoder(reader)
+	for decoder.More() {
+		record := &RoundRecord{}
+		err := decoder.Decode(record)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// LoadRoundRecords reads the round records from the provided files, in the given order
+func LoadRoundRecords(filePaths ...string) ([]*RoundRecord, error) {
+	records := make([]*RoundRecord, 0)
+	for _, filePath := range filePaths {
+		fileRecords, err := loadRoundRecordsFromFile(filePath)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, fileRecords...)
+	}
+
+	return records, nil
+}
+
+func loadRoundRecordsFromFile(filePath string) ([]*RoundRecord, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		errClose := file.Close()
+		if errClose != nil {
+			log.Error(errClose.Error())
+		}
+	}()
+
+	return ReadRoundRecords(file)
+}
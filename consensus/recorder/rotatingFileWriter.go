@@ -0,0 +1,157 @@
+package recorder
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RecordFileExtension is the extension of the files written by the rotating file writer
+const RecordFileExtension = ".jsonl"
+
+// rotatingFileWriter writes in a file until its maximum size is reached, when a new file is created. Only the most
+// recent maxFiles files, having the same prefix, are kept in the folder. A single write is never split between files
+type rotatingFileWriter struct {
+	folderPath  string
+	prefix      string
+	maxFileSize int64
+	maxFiles    int
+
+	mutFile     sync.Mutex
+	file        *os.File
+	currentSize int64
+	isClosed    bool
+}
+
+// NewRotatingFileWriter creates a new rotating file writer
+func NewRotatingFileWriter(folderPath string, prefix string, maxFileSize int64, maxFiles int) (*rotatingFileWriter, error) {
+	if len(folderPath) == 0 {
+		return nil, ErrEmptyFolderPath
+	}
+	if maxFileSize <= 0 {
+		return nil, ErrInvalidMaxFileSize
+	}
+	if maxFiles <= 0 {
+		return nil, ErrInvalidMaxFiles
+	}
+
+	err := os.MkdirAll(folderPath, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rotatingFileWriter{
+		folderPath:  folderPath,
+		prefix:      prefix,
+		maxFileSize: maxFileSize,
+		maxFiles:    maxFiles,
+	}, nil
+}
+
+// Write appends the provided buffer in the current file, rotating it first if the maximum size would be exceeded
+func (rfw *rotatingFileWriter) Write(p []byte) (int, error) {
+	rfw.mutFile.Lock()
+	defer rfw.mutFile.Unlock()
+
+	if rfw.isClosed {
+		return 0, ErrRecorderClosed
+	}
+
+	shouldRotate := rfw.file == nil || (rfw.currentSize > 0 && rfw.currentSize+int64(len(p)) > rfw.maxFileSize)
+	if shouldRotate {
+		err := rfw.rotate()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := rfw.file.Write(p)
+	rfw.currentSize += int64(n)
+
+	return n, err
+}
+
+// Close closes the current file
+func (rfw *rotatingFileWriter) Close() error {
+	rfw.mutFile.Lock()
+	defer rfw.mutFile.Unlock()
+
+	if rfw.isClosed {
+		return ErrRecorderClosed
+	}
+	rfw.isClosed = true
+
+	if rfw.file == nil {
+		return nil
+	}
+
+	return rfw.file.Close()
+}
+
+// rotate should be called under mutex protection
+func (rfw *rotatingFileWriter) rotate() error {
+	if rfw.file != nil {
+		err := rfw.file.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	//the timestamp format keeps the lexicographic order of the file names equal to the creation order
+	fileName := fmt.Sprintf("%s-%s%s", rfw.prefix, time.Now().Format("20060102-150405.000000000"), RecordFileExtension)
+	file, err := os.OpenFile(filepath.Join(rfw.folderPath, fileName), os.O_CREATE|os.O_WRONLY|os.O_APPEND, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	rfw.file = file
+	rfw.currentSize = 0
+
+	return rfw.removeOldFiles()
+}
+
+// removeOldFiles should be called under mutex protection
+func (rfw *rotatingFileWriter) removeOldFiles() error {
+	fileNames, err := RecordFiles(rfw.folderPath, rfw.prefix)
+	if err != nil {
+		return err
+	}
+
+	for len(fileNames) > rfw.maxFiles {
+		err = os.Remove(fileNames[0])
+		if err != nil {
+			return err
+		}
+		fileNames = fileNames[1:]
+	}
+
+	return nil
+}
+
+// RecordFiles returns, from the oldest to the newest, the paths of the record files with the provided prefix
+func RecordFiles(folderPath string, prefix string) ([]string, error) {
+	fileInfos, err := ioutil.ReadDir(folderPath)
+	if err != nil {
+		return nil, err
+	}
+
+	fileNames := make([]string, 0)
+	for _, fileInfo := range fileInfos {
+		name := fileInfo.Name()
+		isRecordFile := !fileInfo.IsDir() &&
+			strings.HasPrefix(name, prefix+"-") &&
+			strings.HasSuffix(name, RecordFileExtension)
+		if isRecordFile {
+			fileNames = append(fileNames, filepath.Join(folderPath, name))
+		}
+	}
+
+	sort.Strings(fileNames)
+
+	return fileNames, nil
+}
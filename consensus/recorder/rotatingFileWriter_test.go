@@ -0,0 +1,148 @@
+package recorder_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus/recorder"
+	"github.com/stretchr/testify/assert"
+)
+
+const recordsPrefix = "consensus"
+
+func createTempFolder(t *testing.T) string {
+	folderPath, err := ioutil.TempDir("", "recorder")
+	assert.Nil(t, err)
+
+	return folderPath
+}
+
+//------- NewRotatingFileWriter
+
+func TestNewRotatingFileWriter_EmptyFolderPathShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rfw, err := recorder.NewRotatingFileWriter("", recordsPrefix, 10, 1)
+
+	assert.Nil(t, rfw)
+	assert.Equal(t, recorder.ErrEmptyFolderPath, err)
+}
+
+func TestNewRotatingFileWriter_InvalidMaxFileSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rfw, err := recorder.NewRotatingFileWriter(os.TempDir(), recordsPrefix, 0, 1)
+
+	assert.Nil(t, rfw)
+	assert.Equal(t, recorder.ErrInvalidMaxFileSize, err)
+}
+
+func TestNewRotatingFileWriter_InvalidMaxFilesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rfw, err := recorder.NewRotatingFileWriter(os.TempDir(), recordsPrefix, 10, 0)
+
+	assert.Nil(t, rfw)
+	assert.Equal(t, recorder.ErrInvalidMaxFiles, err)
+}
+
+//------- Write
+
+func TestRotatingFileWriter_WriteShouldRotateAndKeepMaxFiles(t *testing.T) {
+	t.Parallel()
+
+	folderPath := createTempFolder(t)
+	defer func() {
+		_ = os.RemoveAll(folderPath)
+	}()
+
+	rfw, _ := recorder.NewRotatingFileWriter(folderPath, recordsPrefix, 10, 2)
+
+	//each write exceeds the half of the maximum size, so every write goes into a new file
+	for i := 0; i < 4; i++ {
+		n, err := rfw.Write([]byte("record-" + string('0'+byte(i))))
+		assert.Nil(t, err)
+		assert.Equal(t, 8, n)
+	}
+	_ = rfw.Close()
+
+	fileNames, err := recorder.RecordFiles(folderPath, recordsPrefix)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(fileNames))
+
+	lastButOne, _ := ioutil.ReadFile(fileNames[0])
+	last, _ := ioutil.ReadFile(fileNames[1])
+	assert.Equal(t, "record-2", string(lastButOne))
+	assert.Equal(t, "record-3", string(last))
+}
+
+func TestRotatingFileWriter_SmallWritesShouldGoInTheSameFile(t *testing.T) {
+	t.Parallel()
+
+	folderPath := createTempFolder(t)
+	defer func() {
+		_ = os.RemoveAll(folderPath)
+	}()
+
+	rfw, _ := recorder.NewRotatingFileWriter(folderPath, recordsPrefix, 100, 2)
+	_, _ = rfw.Write([]byte("a"))
+	_, _ = rfw.Write([]byte("b"))
+	_ = rfw.Close()
+
+	fileNames, _ := recorder.RecordFiles(folderPath, recordsPrefix)
+	assert.Equal(t, 1, len(fileNames))
+
+	buff, _ := ioutil.ReadFile(fileNames[0])
+	assert.Equal(t, "ab", string(buff))
+}
+
+func TestRotatingFileWriter_WriteAfterCloseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	folderPath := createTempFolder(t)
+	defer func() {
+		_ = os.RemoveAll(folderPath)
+	}()
+
+	rfw, _ := recorder.NewRotatingFileWriter(folderPath, recordsPrefix, 100, 2)
+	_ = rfw.Close()
+
+	n, err := rfw.Write([]byte("a"))
+
+	assert.Equal(t, 0, n)
+	assert.Equal(t, recorder.ErrRecorderClosed, err)
+}
+
+//------- LoadRoundRecords
+
+func TestLoadRoundRecords_ShouldReadAllFilesInOrder(t *testing.T) {
+	t.Parallel()
+
+	folderPath := createTempFolder(t)
+	defer func() {
+		_ = os.RemoveAll(folderPath)
+	}()
+
+	rfw, _ := recorder.NewRotatingFileWriter(folderPath, recordsPrefix, 10, 5)
+	_, _ = rfw.Write([]byte("{\"RoundIndex\":1}\n"))
+	_, _ = rfw.Write([]byte("{\"RoundIndex\":2}\n"))
+	_ = rfw.Close()
+
+	fileNames, _ := recorder.RecordFiles(folderPath, recordsPrefix)
+	records, err := recorder.LoadRoundRecords(fileNames...)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(records))
+	assert.Equal(t, int64(1), records[0].RoundIndex)
+	assert.Equal(t, int64(2), records[1].RoundIndex)
+}
+
+func TestReadRoundRecords_NilReaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	records, err := recorder.ReadRoundRecords(nil)
+
+	assert.Nil(t, records)
+	assert.Equal(t, recorder.ErrNilReader, err)
+}
@@ -0,0 +1,100 @@
+package recorder
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+const (
+	// EventStarted marks the moment the chronology started a subround
+	EventStarted = "started"
+	// EventFinished marks a subround which finished its job in time
+	EventFinished = "finished"
+	// EventExtended marks a subround which did not finish its job in time and was extended
+	EventExtended = "extended"
+)
+
+const (
+	// OutcomeCompleted marks a round in which all the subrounds finished their job
+	OutcomeCompleted = "completed"
+	// OutcomeNotStarted marks a round in which no subround was started by the chronology
+	OutcomeNotStarted = "not started"
+)
+
+// RecordedMessage holds a consensus message together with the moment it was sent or received. For a received
+// message, ValidationError holds the reason for which the worker rejected it, being empty if the message was accepted
+type RecordedMessage struct {
+	Timestamp       int64
+	IsSent          bool
+	ValidationError string
+	Message         *consensus.Message
+}
+
+// IsRejected returns true if the message was received and rejected by the worker
+func (rm *RecordedMessage) IsRejected() bool {
+	return len(rm.ValidationError) > 0
+}
+
+// RecordedTransition holds a subround transition done by the chronology
+type RecordedTransition struct {
+	Timestamp    int64
+	SubroundId   int
+	SubroundName string
+	Event        string
+}
+
+// SubroundJobs holds, in the consensus group order, the job done flags of the consensus group members for a subround
+type SubroundJobs struct {
+	SubroundId   int
+	SubroundName string
+	JobsDone     []bool
+}
+
+// RoundRecord holds everything recorded by a node during one round. The timestamps are in unix nanoseconds.
+// NumDroppedMessages counts the messages which were not recorded as the round already had too many messages
+type RoundRecord struct {
+	RoundIndex         int64
+	SelfPubKey         []byte
+	ConsensusGroup     [][]byte
+	Messages           []*RecordedMessage
+	NumDroppedMessages int
+	Transitions        []*RecordedTransition
+	Jobs               []*SubroundJobs
+	Outcome            string
+}
+
+// ReceivedMessages returns the recorded messages which were received from the other consensus group members and
+// accepted by the worker
+func (rr *RoundRecord) ReceivedMessages() []*RecordedMessage {
+	messages := make([]*RecordedMessage, 0)
+	for _, recordedMessage := range rr.Messages {
+		if !recordedMessage.IsSent && !recordedMessage.IsRejected() {
+			messages = append(messages, recordedMessage)
+		}
+	}
+
+	return messages
+}
+
+// RejectedMessages returns the recorded messages which were received and rejected by the worker
+func (rr *RoundRecord) RejectedMessages() []*RecordedMessage {
+	messages := make([]*RecordedMessage, 0)
+	for _, recordedMessage := range rr.Messages {
+		if recordedMessage.IsRejected() {
+			messages = append(messages, recordedMessage)
+		}
+	}
+
+	return messages
+}
+
+// SentMessages returns the recorded messages which were sent by the node itself
+func (rr *RoundRecord) SentMessages() []*RecordedMessage {
+	messages := make([]*RecordedMessage, 0)
+	for _, recordedMessage := range rr.Messages {
+		if recordedMessage.IsSent {
+			messages = append(messages, recordedMessage)
+		}
+	}
+
+	return messages
+}
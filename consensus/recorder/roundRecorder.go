@@ -0,0 +1,332 @@
+package recorder
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/ntp"
+)
+
+var log = logger.DefaultLogger()
+
+// maxMessagesPerRound is the maximum number of messages recorded in a round, the next ones being only counted
+const maxMessagesPerRound = 1000
+
+// finalizedRecordsQueueSize is the number of finalized records which can wait to be written, the next ones being
+// dropped
+const finalizedRecordsQueueSize = 10
+
+// roundRecorder keeps in memory the records of the current round and of the next one, for which early messages may
+// be received. When the chronology starts a subround of a newer round, the older records are finalized, with the job
+// done flags taken from the consensus state before it is reset for the new round, and removed from memory. The
+// finalized records are written one JSON per line in the background, so the chronology does not wait for the writer
+type roundRecorder struct {
+	stateProvider ConsensusStateProvider
+	syncTimer     ntp.SyncTimer
+	writer        io.WriteCloser
+
+	mutRecords        sync.Mutex
+	records           map[int64]*RoundRecord
+	currentRoundIndex int64
+	isClosed          bool
+
+	finalizedRecords chan *RoundRecord
+	chanWriterDone   chan struct{}
+}
+
+// NewRoundRecorder creates a new round recorder which writes the finalized rounds in the provided writer
+func NewRoundRecorder(
+	stateProvider ConsensusStateProvider,
+	syncTimer ntp.SyncTimer,
+	writer io.WriteCloser,
+) (*roundRecorder, error) {
+
+	if stateProvider == nil {
+		return nil, ErrNilConsensusStateProvider
+	}
+	if syncTimer == nil || syncTimer.IsInterfaceNil() {
+		return nil, ErrNilSyncTimer
+	}
+	if writer == nil {
+		return nil, ErrNilWriter
+	}
+
+	rr := &roundRecorder{
+		stateProvider:     stateProvider,
+		syncTimer:         syncTimer,
+		writer:            writer,
+		records:           make(map[int64]*RoundRecord),
+		currentRoundIndex: -1,
+		finalizedRecords:  make(chan *RoundRecord, finalizedRecordsQueueSize),
+		chanWriterDone:    make(chan struct{}),
+	}
+
+	go rr.writeFinalizedRecords()
+
+	return rr, nil
+}
+
+// RecordReceivedMessage records a consensus message received by the worker, together with the error returned by its
+// validation, which is nil if the message was accepted
+func (rr *roundRecorder) RecordReceivedMessage(message *consensus.Message, validationErr error) {
+	recordedMessage := &RecordedMessage{
+		Message: message,
+	}
+	if validationErr != nil {
+		recordedMessage.ValidationError = validationErr.Error()
+	}
+
+	rr.recordMessage(recordedMessage)
+}
+
+// RecordSentMessage records a consensus message broadcast by the node itself
+func (rr *roundRecorder) RecordSentMessage(message *consensus.Message) {
+	rr.recordMessage(&RecordedMessage{
+		IsSent:  true,
+		Message: message,
+	})
+}
+
+func (rr *roundRecorder) recordMessage(recordedMessage *RecordedMessage) {
+	message := recordedMessage.Message
+	if message == nil {
+		return
+	}
+
+	rr.mutRecords.Lock()
+	defer rr.mutRecords.Unlock()
+
+	if rr.isClosed {
+		return
+	}
+
+	if message.RoundIndex < rr.currentRoundIndex {
+		log.Debug(fmt.Sprintf("late message for round %d is not recorded in round %d\n",
+			message.RoundIndex, rr.currentRoundIndex))
+		return
+	}
+	if message.RoundIndex > rr.currentRoundIndex+1 {
+		log.Debug(fmt.Sprintf("message for round %d is too early to be recorded in round %d\n",
+			message.RoundIndex, rr.currentRoundIndex))
+		return
+	}
+
+	record := rr.getOrCreateRecord(message.RoundIndex)
+	if len(record.Messages) >= maxMessagesPerRound {
+		record.NumDroppedMessages++
+		return
+	}
+
+	recordedMessage.Timestamp = rr.syncTimer.CurrentTime().UnixNano()
+	record.Messages = append(record.Messages, recordedMessage)
+}
+
+// RecordSubroundStarted records the start of a subround. The first subround started in a newer round finalizes
+// all the older records
+func (rr *roundRecorder) RecordSubroundStarted(roundIndex int64, subroundId int, subroundName string) {
+	rr.mutRecords.Lock()
+	defer rr.mutRecords.Unlock()
+
+	if rr.isClosed {
+		return
+	}
+
+	if roundIndex > rr.currentRoundIndex {
+		rr.finalizeRecordsBefore(roundIndex)
+		rr.currentRoundIndex = roundIndex
+	}
+
+	rr.addTransition(roundIndex, subroundId, subroundName, EventStarted)
+	rr.records[roundIndex].Outcome = fmt.Sprintf("interrupted in %s", subroundName)
+}
+
+// RecordSubroundFinished records the end of a subround of the current round, either with its job done or extended
+func (rr *roundRecorder) RecordSubroundFinished(roundIndex int64, subroundId int, isDone bool, isLastSubround bool) {
+	rr.mutRecords.Lock()
+	defer rr.mutRecords.Unlock()
+
+	if rr.isClosed || roundIndex != rr.currentRoundIndex {
+		return
+	}
+
+	subroundName := rr.subroundName(roundIndex, subroundId)
+	if !isDone {
+		rr.addTransition(roundIndex, subroundId, subroundName, EventExtended)
+		rr.records[roundIndex].Outcome = fmt.Sprintf("stalled in %s", subroundName)
+		return
+	}
+
+	rr.addTransition(roundIndex, subroundId, subroundName, EventFinished)
+	if isLastSubround {
+		rr.records[roundIndex].Outcome = OutcomeCompleted
+		return
+	}
+
+	rr.records[roundIndex].Outcome = fmt.Sprintf("interrupted after %s", subroundName)
+}
+
+// Close finalizes all the records kept in memory and closes the writer after all the finalized records are written
+func (rr *roundRecorder) Close() error {
+	rr.mutRecords.Lock()
+	if rr.isClosed {
+		rr.mutRecords.Unlock()
+		return ErrRecorderClosed
+	}
+
+	rr.finalizeRecordsBefore(rr.maxRecordedRoundIndex() + 1)
+	rr.isClosed = true
+	close(rr.finalizedRecords)
+	rr.mutRecords.Unlock()
+
+	<-rr.chanWriterDone
+
+	return rr.writer.Close()
+}
+
+// getOrCreateRecord should be called under mutex protection
+func (rr *roundRecorder) getOrCreateRecord(roundIndex int64) *RoundRecord {
+	record, found := rr.records[roundIndex]
+	if !found {
+		record = &RoundRecord{
+			RoundIndex:  roundIndex,
+			Messages:    make([]*RecordedMessage, 0),
+			Transitions: make([]*RecordedTransition, 0),
+			Jobs:        make([]*SubroundJobs, 0),
+			Outcome:     OutcomeNotStarted,
+		}
+		rr.records[roundIndex] = record
+	}
+
+	return record
+}
+
+// addTransition should be called under mutex protection
+func (rr *roundRecorder) addTransition(roundIndex int64, subroundId int, subroundName string, event string) {
+	record := rr.getOrCreateRecord(roundIndex)
+	record.Transitions = append(record.Transitions, &RecordedTransition{
+		Timestamp:    rr.syncTimer.CurrentTime().UnixNano(),
+		SubroundId:   subroundId,
+		SubroundName: subroundName,
+		Event:        event,
+	})
+}
+
+// subroundName should be called under mutex protection
+func (rr *roundRecorder) subroundName(roundIndex int64, subroundId int) string {
+	record := rr.getOrCreateRecord(roundIndex)
+	for _, transition := range record.Transitions {
+		if transition.SubroundId == subroundId {
+			return transition.SubroundName
+		}
+	}
+
+	return fmt.Sprintf("subround %d", subroundId)
+}
+
+// maxRecordedRoundIndex should be called under mutex protection
+func (rr *roundRecorder) maxRecordedRoundIndex() int64 {
+	maxRoundIndex := rr.currentRoundIndex
+	for roundIndex := range rr.records {
+		if roundIndex > maxRoundIndex {
+			maxRoundIndex = roundIndex
+		}
+	}
+
+	return maxRoundIndex
+}
+
+// finalizeRecordsBefore should be called under mutex protection
+func (rr *roundRecorder) finalizeRecordsBefore(roundIndex int64) {
+	roundIndexes := make([]int64, 0)
+	for index := range rr.records {
+		if index < roundIndex {
+			roundIndexes = append(roundIndexes, index)
+		}
+	}
+
+	sort.Slice(roundIndexes, func(i, j int) bool {
+		return roundIndexes[i] < roundIndexes[j]
+	})
+
+	for _, index := range roundIndexes {
+		record := rr.records[index]
+		delete(rr.records, index)
+
+		//the consensus state holds the data of the current round only, the other records have just the messages
+		if index == rr.currentRoundIndex {
+			rr.snapshotConsensusState(record)
+		}
+
+		select {
+		case rr.finalizedRecords <- record:
+		default:
+			log.Warn(fmt.Sprintf("round %d could not be recorded: the writer is too slow\n", index))
+		}
+	}
+}
+
+// snapshotConsensusState should be called under mutex protection
+func (rr *roundRecorder) snapshotConsensusState(record *RoundRecord) {
+	consensusGroup := rr.stateProvider.ConsensusGroup()
+
+	record.SelfPubKey = []byte(rr.stateProvider.SelfPubKey())
+	record.ConsensusGroup = make([][]byte, len(consensusGroup))
+	for i, pubKey := range consensusGroup {
+		record.ConsensusGroup[i] = []byte(pubKey)
+	}
+
+	seenSubrounds := make(map[int]struct{})
+	for _, transition := range record.Transitions {
+		if _, isSeen := seenSubrounds[transition.SubroundId]; isSeen {
+			continue
+		}
+		seenSubrounds[transition.SubroundId] = struct{}{}
+
+		subroundJobs := &SubroundJobs{
+			SubroundId:   transition.SubroundId,
+			SubroundName: transition.SubroundName,
+			JobsDone:     make([]bool, len(consensusGroup)),
+		}
+		for i, pubKey := range consensusGroup {
+			isJobDone, err := rr.stateProvider.JobDone(pubKey, transition.SubroundId)
+			subroundJobs.JobsDone[i] = err == nil && isJobDone
+		}
+
+		record.Jobs = append(record.Jobs, subroundJobs)
+	}
+}
+
+// writeFinalizedRecords writes the finalized records until the recorder is closed
+func (rr *roundRecorder) writeFinalizedRecords() {
+	for record := range rr.finalizedRecords {
+		err := rr.writeRecord(record)
+		if err != nil {
+			log.Error(fmt.Sprintf("round %d could not be recorded: %s\n", record.RoundIndex, err.Error()))
+		}
+	}
+
+	close(rr.chanWriterDone)
+}
+
+func (rr *roundRecorder) writeRecord(record *RoundRecord) error {
+	buff, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	_, err = rr.writer.Write(append(buff, '\n'))
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rr *roundRecorder) IsInterfaceNil() bool {
+	if rr == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,364 @@
+package recorder_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/recorder"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	subroundStartRound = 0
+	subroundBlock      = 1
+	subroundSignature  = 2
+	subroundEndRound   = 3
+)
+
+func createStateProvider(jobsDone map[string]bool) *mock.ConsensusStateProviderStub {
+	return &mock.ConsensusStateProviderStub{
+		ConsensusGroupCalled: func() []string {
+			return []string{"A", "B", "C"}
+		},
+		SelfPubKeyCalled: func() string {
+			return "A"
+		},
+		JobDoneCalled: func(key string, subroundId int) (bool, error) {
+			return jobsDone[key], nil
+		},
+	}
+}
+
+func createSyncTimer() *mock.SyncTimerMock {
+	return &mock.SyncTimerMock{
+		CurrentTimeCalled: func() time.Time {
+			return time.Unix(0, 1000)
+		},
+	}
+}
+
+func createRoundRecorder(jobsDone map[string]bool) (consensus.RoundRecorder, *mock.WriteCloserMock) {
+	writer := &mock.WriteCloserMock{}
+	rr, _ := recorder.NewRoundRecorder(createStateProvider(jobsDone), createSyncTimer(), writer)
+
+	return rr, writer
+}
+
+func readRecords(t *testing.T, writer *mock.WriteCloserMock) []*recorder.RoundRecord {
+	records, err := recorder.ReadRoundRecords(bytes.NewReader(writer.Bytes()))
+	assert.Nil(t, err)
+
+	return records
+}
+
+// waitRecords waits for the provided number of records as the finalized records are written in the background
+func waitRecords(t *testing.T, writer *mock.WriteCloserMock, numRecords int) []*recorder.RoundRecord {
+	for i := 0; i < 100; i++ {
+		records := readRecords(t, writer)
+		if len(records) >= numRecords {
+			return records
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	assert.Fail(t, "timeout waiting for the records to be written")
+	return readRecords(t, writer)
+}
+
+// blockingWriteCloser blocks the writes until chanUnblock is closed
+type blockingWriteCloser struct {
+	mock.WriteCloserMock
+	chanUnblock chan struct{}
+}
+
+func (bwc *blockingWriteCloser) Write(p []byte) (int, error) {
+	<-bwc.chanUnblock
+	return bwc.WriteCloserMock.Write(p)
+}
+
+//------- NewRoundRecorder
+
+func TestNewRoundRecorder_NilStateProviderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rr, err := recorder.NewRoundRecorder(nil, createSyncTimer(), &mock.WriteCloserMock{})
+
+	assert.Nil(t, rr)
+	assert.Equal(t, recorder.ErrNilConsensusStateProvider, err)
+}
+
+func TestNewRoundRecorder_NilSyncTimerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rr, err := recorder.NewRoundRecorder(createStateProvider(nil), nil, &mock.WriteCloserMock{})
+
+	assert.Nil(t, rr)
+	assert.Equal(t, recorder.ErrNilSyncTimer, err)
+}
+
+func TestNewRoundRecorder_NilWriterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rr, err := recorder.NewRoundRecorder(createStateProvider(nil), createSyncTimer(), nil)
+
+	assert.Nil(t, rr)
+	assert.Equal(t, recorder.ErrNilWriter, err)
+}
+
+func TestNewRoundRecorder_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	rr, err := recorder.NewRoundRecorder(createStateProvider(nil), createSyncTimer(), &mock.WriteCloserMock{})
+
+	assert.NotNil(t, rr)
+	assert.Nil(t, err)
+}
+
+//------- recording
+
+func TestRoundRecorder_RoundIsWrittenWhenNextRoundStarts(t *testing.T) {
+	t.Parallel()
+
+	rr, writer := createRoundRecorder(map[string]bool{"A": true, "C": true})
+
+	rr.RecordSubroundStarted(1, subroundStartRound, "(START_ROUND)")
+	rr.RecordSubroundFinished(1, subroundStartRound, true, false)
+	rr.RecordSentMessage(&consensus.Message{PubKey: []byte("A"), RoundIndex: 1})
+	rr.RecordReceivedMessage(&consensus.Message{PubKey: []byte("C"), RoundIndex: 1}, nil)
+	rr.RecordSubroundStarted(1, subroundBlock, "(BLOCK)")
+	rr.RecordSubroundFinished(1, subroundBlock, false, false)
+
+	assert.Equal(t, 0, len(writer.Bytes()))
+
+	rr.RecordSubroundStarted(2, subroundStartRound, "(START_ROUND)")
+
+	records := waitRecords(t, writer, 1)
+	if !assert.Equal(t, 1, len(records)) {
+		return
+	}
+
+	record := records[0]
+	assert.Equal(t, int64(1), record.RoundIndex)
+	assert.Equal(t, []byte("A"), record.SelfPubKey)
+	assert.Equal(t, [][]byte{[]byte("A"), []byte("B"), []byte("C")}, record.ConsensusGroup)
+	assert.Equal(t, "stalled in (BLOCK)", record.Outcome)
+
+	assert.Equal(t, 2, len(record.Messages))
+	assert.True(t, record.Messages[0].IsSent)
+	assert.False(t, record.Messages[1].IsSent)
+	assert.Equal(t, 1, len(record.SentMessages()))
+	assert.Equal(t, 1, len(record.ReceivedMessages()))
+
+	assert.Equal(t, 4, len(record.Transitions))
+	assert.Equal(t, recorder.EventStarted, record.Transitions[2].Event)
+	assert.Equal(t, recorder.EventExtended, record.Transitions[3].Event)
+	assert.Equal(t, "(BLOCK)", record.Transitions[3].SubroundName)
+
+	assert.Equal(t, 2, len(record.Jobs))
+	assert.Equal(t, subroundBlock, record.Jobs[1].SubroundId)
+	assert.Equal(t, []bool{true, false, true}, record.Jobs[1].JobsDone)
+}
+
+func TestRoundRecorder_RejectedMessagesShouldBeRecordedWithTheReason(t *testing.T) {
+	t.Parallel()
+
+	rr, writer := createRoundRecorder(nil)
+
+	rr.RecordSubroundStarted(1, subroundStartRound, "(START_ROUND)")
+	rr.RecordReceivedMessage(&consensus.Message{PubKey: []byte("B"), RoundIndex: 1}, nil)
+	rr.RecordReceivedMessage(&consensus.Message{PubKey: []byte("C"), RoundIndex: 1}, errors.New("signature is invalid"))
+	_ = rr.Close()
+
+	records := readRecords(t, writer)
+	assert.Equal(t, 1, len(records))
+
+	record := records[0]
+	assert.Equal(t, 2, len(record.Messages))
+	assert.Equal(t, 1, len(record.ReceivedMessages()))
+	assert.Equal(t, []byte("B"), record.ReceivedMessages()[0].Message.PubKey)
+	assert.Equal(t, 1, len(record.RejectedMessages()))
+	assert.Equal(t, []byte("C"), record.RejectedMessages()[0].Message.PubKey)
+	assert.Equal(t, "signature is invalid", record.RejectedMessages()[0].ValidationError)
+	assert.Equal(t, 0, len(record.SentMessages()))
+}
+
+func TestRoundRecorder_OutcomeShouldReflectLastTransition(t *testing.T) {
+	t.Parallel()
+
+	rr, writer := createRoundRecorder(nil)
+
+	rr.RecordSubroundStarted(1, subroundSignature, "(SIGNATURE)")
+	rr.RecordSubroundStarted(2, subroundSignature, "(SIGNATURE)")
+	rr.RecordSubroundFinished(2, subroundSignature, true, false)
+	rr.RecordSubroundStarted(3, subroundEndRound, "(END_ROUND)")
+	rr.RecordSubroundFinished(3, subroundEndRound, true, true)
+	_ = rr.Close()
+
+	records := readRecords(t, writer)
+	assert.Equal(t, 3, len(records))
+	assert.Equal(t, "interrupted in (SIGNATURE)", records[0].Outcome)
+	assert.Equal(t, "interrupted after (SIGNATURE)", records[1].Outcome)
+	assert.Equal(t, recorder.OutcomeCompleted, records[2].Outcome)
+}
+
+func TestRoundRecorder_EarlyMessagesShouldBeKeptForTheirRound(t *testing.T) {
+	t.Parallel()
+
+	rr, writer := createRoundRecorder(nil)
+
+	rr.RecordSubroundStarted(1, subroundStartRound, "(START_ROUND)")
+	rr.RecordReceivedMessage(&consensus.Message{PubKey: []byte("B"), RoundIndex: 2}, nil)
+	rr.RecordSubroundStarted(2, subroundStartRound, "(START_ROUND)")
+
+	records := waitRecords(t, writer, 1)
+	if !assert.Equal(t, 1, len(records)) {
+		return
+	}
+	assert.Equal(t, 0, len(records[0].Messages))
+
+	rr.RecordSubroundStarted(3, subroundStartRound, "(START_ROUND)")
+
+	records = waitRecords(t, writer, 2)
+	if !assert.Equal(t, 2, len(records)) {
+		return
+	}
+	assert.Equal(t, int64(2), records[1].RoundIndex)
+	assert.Equal(t, 1, len(records[1].Messages))
+}
+
+func TestRoundRecorder_MessagesAfterTheNextRoundShouldNotBeRecorded(t *testing.T) {
+	t.Parallel()
+
+	rr, writer := createRoundRecorder(nil)
+
+	rr.RecordReceivedMessage(&consensus.Message{PubKey: []byte("B"), RoundIndex: 5}, nil)
+	rr.RecordSubroundStarted(1, subroundStartRound, "(START_ROUND)")
+	rr.RecordReceivedMessage(&consensus.Message{PubKey: []byte("B"), RoundIndex: 3}, nil)
+	rr.RecordSubroundFinished(4, subroundStartRound, true, false)
+	_ = rr.Close()
+
+	records := readRecords(t, writer)
+	assert.Equal(t, 1, len(records))
+	assert.Equal(t, int64(1), records[0].RoundIndex)
+	assert.Equal(t, 0, len(records[0].Messages))
+}
+
+func TestRoundRecorder_MessagesOverTheLimitShouldOnlyBeCounted(t *testing.T) {
+	t.Parallel()
+
+	rr, writer := createRoundRecorder(nil)
+
+	rr.RecordSubroundStarted(1, subroundStartRound, "(START_ROUND)")
+	for i := 0; i < recorder.MaxMessagesPerRound+2; i++ {
+		rr.RecordReceivedMessage(&consensus.Message{PubKey: []byte("B"), RoundIndex: 1}, nil)
+	}
+	_ = rr.Close()
+
+	records := readRecords(t, writer)
+	assert.Equal(t, 1, len(records))
+	assert.Equal(t, recorder.MaxMessagesPerRound, len(records[0].Messages))
+	assert.Equal(t, 2, records[0].NumDroppedMessages)
+}
+
+func TestRoundRecorder_SlowWriterShouldNotBlockTheChronology(t *testing.T) {
+	t.Parallel()
+
+	writer := &blockingWriteCloser{chanUnblock: make(chan struct{})}
+	rr, _ := recorder.NewRoundRecorder(createStateProvider(nil), createSyncTimer(), writer)
+
+	chanDone := make(chan struct{})
+	go func() {
+		for roundIndex := int64(1); roundIndex <= 100; roundIndex++ {
+			rr.RecordSubroundStarted(roundIndex, subroundStartRound, "(START_ROUND)")
+		}
+		close(chanDone)
+	}()
+
+	select {
+	case <-chanDone:
+	case <-time.After(time.Second):
+		assert.Fail(t, "recording the rounds waited for the writer")
+	}
+
+	close(writer.chanUnblock)
+	err := rr.Close()
+
+	assert.Nil(t, err)
+	assert.True(t, writer.IsClosed)
+}
+
+func TestRoundRecorder_LateMessagesShouldNotBeRecorded(t *testing.T) {
+	t.Parallel()
+
+	rr, writer := createRoundRecorder(nil)
+
+	rr.RecordSubroundStarted(2, subroundStartRound, "(START_ROUND)")
+	rr.RecordReceivedMessage(&consensus.Message{PubKey: []byte("B"), RoundIndex: 1}, nil)
+	_ = rr.Close()
+
+	records := readRecords(t, writer)
+	assert.Equal(t, 1, len(records))
+	assert.Equal(t, int64(2), records[0].RoundIndex)
+	assert.Equal(t, 0, len(records[0].Messages))
+}
+
+func TestRoundRecorder_RoundWithoutSubroundsShouldHaveNoStateSnapshot(t *testing.T) {
+	t.Parallel()
+
+	rr, writer := createRoundRecorder(nil)
+
+	rr.RecordSubroundStarted(1, subroundStartRound, "(START_ROUND)")
+	rr.RecordReceivedMessage(&consensus.Message{PubKey: []byte("B"), RoundIndex: 2}, nil)
+	rr.RecordSubroundStarted(3, subroundStartRound, "(START_ROUND)")
+
+	records := waitRecords(t, writer, 2)
+	if !assert.Equal(t, 2, len(records)) {
+		return
+	}
+	assert.Equal(t, int64(2), records[1].RoundIndex)
+	assert.Equal(t, recorder.OutcomeNotStarted, records[1].Outcome)
+	assert.Nil(t, records[1].ConsensusGroup)
+}
+
+//------- Close
+
+func TestRoundRecorder_CloseShouldWriteAllRecordsAndCloseWriter(t *testing.T) {
+	t.Parallel()
+
+	rr, writer := createRoundRecorder(nil)
+
+	rr.RecordSubroundStarted(1, subroundStartRound, "(START_ROUND)")
+	err := rr.Close()
+
+	assert.Nil(t, err)
+	assert.True(t, writer.IsClosed)
+	assert.Equal(t, 1, len(readRecords(t, writer)))
+}
+
+func TestRoundRecorder_CloseTwiceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rr, _ := createRoundRecorder(nil)
+
+	_ = rr.Close()
+	err := rr.Close()
+
+	assert.Equal(t, recorder.ErrRecorderClosed, err)
+}
+
+func TestRoundRecorder_RecordAfterCloseShouldNotWrite(t *testing.T) {
+	t.Parallel()
+
+	rr, writer := createRoundRecorder(nil)
+
+	_ = rr.Close()
+	rr.RecordSubroundStarted(1, subroundStartRound, "(START_ROUND)")
+	rr.RecordReceivedMessage(&consensus.Message{PubKey: []byte("B"), RoundIndex: 1}, nil)
+	rr.RecordSubroundStarted(2, subroundStartRound, "(START_ROUND)")
+
+	assert.Equal(t, 0, len(writer.Bytes()))
+}
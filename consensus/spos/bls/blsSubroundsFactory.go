@@ -182,6 +182,11 @@ func (fct *factory) generateBlockSubround() error {
 		return err
 	}
 
+	err = subroundBlock.SetAppStatusHandler(fct.appStatusHandler)
+	if err != nil {
+		return err
+	}
+
 	fct.worker.AddReceivedMessageCall(MtBlockBody, subroundBlock.ReceivedBlockBody)
 	fct.worker.AddReceivedMessageCall(MtBlockHeader, subroundBlock.ReceivedBlockHeader)
 	fct.consensusCore.Chronology().AddSubround(subroundBlock)
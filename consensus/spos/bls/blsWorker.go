@@ -76,6 +76,23 @@ func (wrk *worker) CanProceed(consensusState *spos.ConsensusState, msgType conse
 	return false
 }
 
+//IsMessageTypeValid returns if the messageType is known by the bls consensus type
+func (wrk *worker) IsMessageTypeValid(msgType consensus.MessageType) bool {
+	return msgType >= MtBlockBody && msgType <= MtSignature
+}
+
+//GetSubroundIdForMessageType gets the id of the subround in which the messageType is processed
+func (wrk *worker) GetSubroundIdForMessageType(msgType consensus.MessageType) int {
+	switch msgType {
+	case MtBlockBody, MtBlockHeader:
+		return SrBlock
+	case MtSignature:
+		return SrSignature
+	}
+
+	return -1
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (wrk *worker) IsInterfaceNil() bool {
 	if wrk == nil {
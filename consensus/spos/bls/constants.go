@@ -56,6 +56,12 @@ const srEndStartTime = 0.65
 // srEndEndTime specifies the end time, from the total time of the round, of Subround End
 const srEndEndTime = 0.75
 
+// EndRoundDeadline returns the end time, from the total time of the round, of Subround End, which is the deadline
+// until which the block should be committed
+func EndRoundDeadline() float64 {
+	return srEndEndTime
+}
+
 const (
 	BlockBodyStringValue      = "(BLOCK_BODY)"
 	BlockHeaderStringValue    = "(BLOCK_HEADER)"
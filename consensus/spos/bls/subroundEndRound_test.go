@@ -443,6 +443,17 @@ func TestSubroundEndRound_DoEndRoundJobAllOK(t *testing.T) {
 	assert.True(t, r)
 }
 
+func TestSubroundEndRound_DoEndRoundJobNotLeaderShouldReturnFalse(t *testing.T) {
+	t.Parallel()
+
+	sr := *initSubroundEndRound()
+	sr.SetSelfPubKey(sr.ConsensusGroup()[1])
+	sr.Header = &block.Header{}
+
+	r := sr.DoEndRoundJob()
+	assert.False(t, r)
+}
+
 func TestSubroundEndRound_DoEndRoundConsensusCheckShouldReturnFalseWhenRoundIsCanceled(t *testing.T) {
 	t.Parallel()
 
@@ -94,6 +94,8 @@ func (sr *subroundSignature) doSignatureJob() bool {
 
 		log.Info(fmt.Sprintf("%sStep 2: signature has been sent\n", sr.SyncTimer().FormattedCurrentTime()))
 
+		sr.KeepBlockForBackupBroadcast()
+
 		// Validator has finished its job for this round
 		sr.RoundCanceled = true
 	}
@@ -183,6 +185,7 @@ func (sr *subroundSignature) doSignatureConsensusCheck() bool {
 		sr.SetStatus(SrSignature, spos.SsFinished)
 
 		sr.appStatusHandler.SetStringValue(core.MetricConsensusRoundState, "signed")
+		sr.appStatusHandler.SetUInt64Value(core.MetricSignaturesGatheredTime, sr.ElapsedMillisecondsInRound())
 
 		return true
 	}
@@ -2,6 +2,7 @@ package bls_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
@@ -260,6 +261,8 @@ func TestSubroundSignature_DoSignatureJob(t *testing.T) {
 	}
 	container.SetMultiSigner(multiSignerMock)
 
+	lastMember := sr.ConsensusGroup()[len(sr.ConsensusGroup())-1]
+	sr.SetSelfPubKey(lastMember)
 	r = sr.DoSignatureJob()
 	assert.True(t, r)
 	assert.True(t, sr.RoundCanceled)
@@ -272,6 +275,63 @@ func TestSubroundSignature_DoSignatureJob(t *testing.T) {
 	assert.False(t, sr.RoundCanceled)
 }
 
+func TestSubroundSignature_DoSignatureJobBackupShouldKeepBlockForDelayedBroadcast(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	delays := make([]time.Duration, 0)
+	container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+		SetDelayedBroadcastDataCalled: func(data *consensus.DelayedBroadcastData, delay time.Duration) error {
+			delays = append(delays, delay)
+			return nil
+		},
+	})
+	sr := *initSubroundSignatureWithContainer(container)
+	sr.Data = []byte("X")
+	sr.RoundTimeStamp = sr.SyncTimer().CurrentTime()
+
+	sr.SetSelfPubKey(sr.ConsensusGroup()[1])
+	r := sr.DoSignatureJob()
+	assert.True(t, r)
+	assert.True(t, sr.RoundCanceled)
+
+	sr.RoundCanceled = false
+	sr.SetSelfPubKey(sr.ConsensusGroup()[2])
+	r = sr.DoSignatureJob()
+	assert.True(t, r)
+	assert.True(t, sr.RoundCanceled)
+
+	assert.Equal(t, 2, len(delays))
+	assert.True(t, delays[0] > 0)
+	assert.True(t, delays[1] > delays[0])
+}
+
+func TestSubroundSignature_DoSignatureJobLeaderShouldNotBroadcastSignatureNorKeepBlock(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	broadcastCalled := false
+	delayedBroadcastCalled := false
+	container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+		BroadcastConsensusMessageCalled: func(message *consensus.Message) error {
+			broadcastCalled = true
+			return nil
+		},
+		SetDelayedBroadcastDataCalled: func(data *consensus.DelayedBroadcastData, delay time.Duration) error {
+			delayedBroadcastCalled = true
+			return nil
+		},
+	})
+	sr := *initSubroundSignatureWithContainer(container)
+	sr.Data = []byte("X")
+	sr.SetSelfPubKey(sr.ConsensusGroup()[0])
+
+	r := sr.DoSignatureJob()
+	assert.True(t, r)
+	assert.False(t, broadcastCalled)
+	assert.False(t, delayedBroadcastCalled)
+}
+
 func TestSubroundSignature_ReceivedSignature(t *testing.T) {
 	t.Parallel()
 
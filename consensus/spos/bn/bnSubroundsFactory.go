@@ -203,6 +203,11 @@ func (fct *factory) generateBlockSubround() error {
 		return err
 	}
 
+	err = subroundBlock.SetAppStatusHandler(fct.appStatusHandler)
+	if err != nil {
+		return err
+	}
+
 	fct.worker.AddReceivedMessageCall(MtBlockBody, subroundBlock.ReceivedBlockBody)
 	fct.worker.AddReceivedMessageCall(MtBlockHeader, subroundBlock.ReceivedBlockHeader)
 	fct.consensusCore.Chronology().AddSubround(subroundBlock)
@@ -328,6 +333,11 @@ func (fct *factory) generateSignatureSubround() error {
 		return err
 	}
 
+	err = subroundSignature.SetAppStatusHandler(fct.appStatusHandler)
+	if err != nil {
+		return err
+	}
+
 	fct.worker.AddReceivedMessageCall(MtSignature, subroundSignature.receivedSignature)
 	fct.consensusCore.Chronology().AddSubround(subroundSignature)
 
@@ -82,6 +82,29 @@ func (wrk *worker) IsSubroundSignature(subroundId int) bool {
 	return subroundId == SrSignature
 }
 
+//IsMessageTypeValid returns if the messageType is known by the bn consensus type
+func (wrk *worker) IsMessageTypeValid(msgType consensus.MessageType) bool {
+	return msgType >= MtBlockBody && msgType <= MtSignature
+}
+
+//GetSubroundIdForMessageType gets the id of the subround in which the messageType is processed
+func (wrk *worker) GetSubroundIdForMessageType(msgType consensus.MessageType) int {
+	switch msgType {
+	case MtBlockBody, MtBlockHeader:
+		return SrBlock
+	case MtCommitmentHash:
+		return SrCommitmentHash
+	case MtBitmap:
+		return SrBitmap
+	case MtCommitment:
+		return SrCommitment
+	case MtSignature:
+		return SrSignature
+	}
+
+	return -1
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (wrk *worker) IsInterfaceNil() bool {
 	if wrk == nil {
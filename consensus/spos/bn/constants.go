@@ -86,6 +86,12 @@ const srEndStartTime = 0.65
 // srEndEndTime specifies the end time, from the total time of the round, of subround End
 const srEndEndTime = 0.75
 
+// EndRoundDeadline returns the end time, from the total time of the round, of subround End, which is the deadline
+// until which the block should be committed
+func EndRoundDeadline() float64 {
+	return srEndEndTime
+}
+
 func getStringValue(msgType consensus.MessageType) string {
 	switch msgType {
 	case MtBlockBody:
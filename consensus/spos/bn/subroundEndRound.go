@@ -90,6 +90,8 @@ func (sr *subroundEndRound) doEndRoundJob() bool {
 	}
 	timeAfter := time.Now()
 
+	sr.appStatusHandler.SetUInt64Value(core.MetricCommitTime, sr.ElapsedMillisecondsInRound())
+
 	log.Info(fmt.Sprintf("time elapsed to commit block: %v sec\n", timeAfter.Sub(timeBefore).Seconds()))
 
 	sr.SetStatus(SrEndRound, spos.SsFinished)
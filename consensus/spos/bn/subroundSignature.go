@@ -6,10 +6,24 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
 )
 
 type subroundSignature struct {
 	*spos.Subround
+
+	appStatusHandler core.AppStatusHandler
+}
+
+// SetAppStatusHandler method set appStatusHandler
+func (sr *subroundSignature) SetAppStatusHandler(ash core.AppStatusHandler) error {
+	if ash == nil || ash.IsInterfaceNil() {
+		return spos.ErrNilAppStatusHandler
+	}
+
+	sr.appStatusHandler = ash
+	return nil
 }
 
 // NewSubroundSignature creates a subroundSignature object
@@ -26,6 +40,7 @@ func NewSubroundSignature(
 
 	srSignature := subroundSignature{
 		baseSubround,
+		statusHandler.NewNilStatusHandler(),
 	}
 	srSignature.Job = srSignature.doSignatureJob
 	srSignature.Check = srSignature.doSignatureConsensusCheck
@@ -104,6 +119,8 @@ func (sr *subroundSignature) doSignatureJob() bool {
 
 	log.Info(fmt.Sprintf("%sStep 5: signature has been sent\n", sr.SyncTimer().FormattedCurrentTime()))
 
+	sr.KeepBlockForBackupBroadcast()
+
 	err = sr.SetSelfJobDone(SrSignature, true)
 	if err != nil {
 		log.Error(err.Error())
@@ -229,6 +246,9 @@ func (sr *subroundSignature) doSignatureConsensusCheck() bool {
 	if sr.signaturesCollected(threshold) {
 		log.Info(fmt.Sprintf("%sStep 5: subround %s has been finished\n", sr.SyncTimer().FormattedCurrentTime(), sr.Name()))
 		sr.SetStatus(SrSignature, spos.SsFinished)
+
+		sr.appStatusHandler.SetUInt64Value(core.MetricSignaturesGatheredTime, sr.ElapsedMillisecondsInRound())
+
 		return true
 	}
 
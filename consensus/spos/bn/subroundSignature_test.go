@@ -3,6 +3,7 @@ package bn_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
@@ -290,6 +291,74 @@ func TestSubroundSignature_DoSignatureJob(t *testing.T) {
 	assert.True(t, r)
 }
 
+func TestSubroundSignature_DoSignatureJobBackupShouldKeepBlockForDelayedBroadcast(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	delayedBroadcastCalled := false
+	container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+		SetDelayedBroadcastDataCalled: func(data *consensus.DelayedBroadcastData, delay time.Duration) error {
+			delayedBroadcastCalled = true
+			return nil
+		},
+	})
+
+	dta := []byte("X")
+	multiSignerMock := mock.InitMultiSignerMock()
+	multiSignerMock.CommitmentMock = func(uint16) ([]byte, error) {
+		return dta, nil
+	}
+	multiSignerMock.CommitmentHashMock = func(uint16) ([]byte, error) {
+		return mock.HasherMock{}.Compute(string(dta)), nil
+	}
+	container.SetMultiSigner(multiSignerMock)
+
+	sr := *initSubroundSignatureWithContainer(container)
+	sr.Data = dta
+	sr.Header = &block.Header{}
+	sr.SetSelfPubKey(sr.ConsensusGroup()[1])
+	sr.SetJobDone(sr.SelfPubKey(), bn.SrBitmap, true)
+	sr.SetJobDone(sr.SelfPubKey(), bn.SrCommitment, true)
+
+	r := sr.DoSignatureJob()
+	assert.True(t, r)
+	assert.True(t, delayedBroadcastCalled)
+}
+
+func TestSubroundSignature_DoSignatureJobLeaderShouldNotKeepBlock(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	delayedBroadcastCalled := false
+	container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+		SetDelayedBroadcastDataCalled: func(data *consensus.DelayedBroadcastData, delay time.Duration) error {
+			delayedBroadcastCalled = true
+			return nil
+		},
+	})
+
+	dta := []byte("X")
+	multiSignerMock := mock.InitMultiSignerMock()
+	multiSignerMock.CommitmentMock = func(uint16) ([]byte, error) {
+		return dta, nil
+	}
+	multiSignerMock.CommitmentHashMock = func(uint16) ([]byte, error) {
+		return mock.HasherMock{}.Compute(string(dta)), nil
+	}
+	container.SetMultiSigner(multiSignerMock)
+
+	sr := *initSubroundSignatureWithContainer(container)
+	sr.Data = dta
+	sr.Header = &block.Header{}
+	sr.SetSelfPubKey(sr.ConsensusGroup()[0])
+	sr.SetJobDone(sr.SelfPubKey(), bn.SrBitmap, true)
+	sr.SetJobDone(sr.SelfPubKey(), bn.SrCommitment, true)
+
+	r := sr.DoSignatureJob()
+	assert.True(t, r)
+	assert.False(t, delayedBroadcastCalled)
+}
+
 func TestSubroundSignature_CheckCommitmentsValidityShouldErrNilCommitmet(t *testing.T) {
 	t.Parallel()
 
@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
 )
 
 // SubroundBlock defines the data needed by the subround Block
@@ -19,6 +20,8 @@ type SubroundBlock struct {
 	mtBlockHeader                 int
 	processingThresholdPercentage int
 	getSubroundName               func(subroundId int) string
+
+	appStatusHandler core.AppStatusHandler
 }
 
 // NewSubroundBlock creates a SubroundBlock object
@@ -43,6 +46,7 @@ func NewSubroundBlock(
 		mtBlockHeader,
 		processingThresholdPercentage,
 		getSubroundName,
+		statusHandler.NewNilStatusHandler(),
 	}
 
 	srBlock.Job = srBlock.doBlockJob
@@ -68,6 +72,16 @@ func checkNewSubroundBlockParams(
 	return err
 }
 
+// SetAppStatusHandler method set appStatusHandler
+func (sr *SubroundBlock) SetAppStatusHandler(ash core.AppStatusHandler) error {
+	if ash == nil || ash.IsInterfaceNil() {
+		return spos.ErrNilAppStatusHandler
+	}
+
+	sr.appStatusHandler = ash
+	return nil
+}
+
 // doBlockJob method does the job of the subround Block
 func (sr *SubroundBlock) doBlockJob() bool {
 	if !sr.IsSelfLeaderInCurrentRound() { // is NOT self leader in this round?
@@ -365,6 +379,8 @@ func (sr *SubroundBlock) doBlockConsensusCheck() bool {
 	if sr.isBlockReceived(threshold) {
 		log.Info(fmt.Sprintf("%sStep 1: subround %s has been finished\n", sr.SyncTimer().FormattedCurrentTime(), sr.Name()))
 		sr.SetStatus(sr.Current(), spos.SsFinished)
+
+		sr.appStatusHandler.SetUInt64Value(core.MetricBlockReceivedTime, sr.ElapsedMillisecondsInRound())
 		return true
 	}
 
@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/commonSubround"
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/stretchr/testify/assert"
@@ -542,6 +543,43 @@ func TestSubroundBlock_DoBlockConsensusCheckShouldReturnTrueWhenBlockIsReceivedR
 	assert.True(t, sr.DoBlockConsensusCheck())
 }
 
+func TestSubroundBlock_DoBlockConsensusCheckShouldSetBlockReceivedTimeMetric(t *testing.T) {
+	t.Parallel()
+	container := mock.InitConsensusCore()
+	container.SetSyncTimer(&mock.SyncTimerMock{
+		CurrentTimeCalled: func() time.Time {
+			return time.Unix(10, int64(250*time.Millisecond))
+		},
+	})
+	sr := *initSubroundBlock(nil, container)
+	sr.RoundTimeStamp = time.Unix(10, 0)
+
+	metricValue := uint64(0)
+	_ = sr.SetAppStatusHandler(&mock.AppStatusHandlerStub{
+		SetStringValueHandler: func(key string, value string) {},
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			if key == core.MetricBlockReceivedTime {
+				metricValue = value
+			}
+		},
+	})
+	for i := 0; i < sr.Threshold(SrBlock); i++ {
+		sr.SetJobDone(sr.ConsensusGroup()[i], SrBlock, true)
+	}
+
+	assert.True(t, sr.DoBlockConsensusCheck())
+	assert.Equal(t, uint64(250), metricValue)
+}
+
+func TestSubroundBlock_SetAppStatusHandlerNilShouldErr(t *testing.T) {
+	t.Parallel()
+	container := mock.InitConsensusCore()
+	sr := *initSubroundBlock(nil, container)
+
+	err := sr.SetAppStatusHandler(nil)
+	assert.Equal(t, spos.ErrNilAppStatusHandler, err)
+}
+
 func TestSubroundBlock_DoBlockConsensusCheckShouldReturnFalseWhenBlockIsReceivedReturnFalse(t *testing.T) {
 	t.Parallel()
 	container := mock.InitConsensusCore()
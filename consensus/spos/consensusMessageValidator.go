@@ -0,0 +1,202 @@
+package spos
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// consensusMessageValidator checks the received consensus messages before the worker processes them. As the worker
+// is registered as the consensus topic validator, a message rejected here is neither processed nor relayed.
+// The checks are done from the cheapest to the most expensive one, so the signature is verified last
+type consensusMessageValidator struct {
+	consensusService ConsensusService
+	consensusState   *ConsensusState
+	bootstrapper     process.Bootstrapper
+	keyGenerator     crypto.KeyGenerator
+	marshalizer      marshal.Marshalizer
+	singleSigner     crypto.SingleSigner
+	peerReputation   PeerReputationHandler
+}
+
+// NewConsensusMessageValidator creates a new consensus message validator
+func NewConsensusMessageValidator(
+	consensusService ConsensusService,
+	consensusState *ConsensusState,
+	bootstrapper process.Bootstrapper,
+	keyGenerator crypto.KeyGenerator,
+	marshalizer marshal.Marshalizer,
+	singleSigner crypto.SingleSigner,
+	peerReputation PeerReputationHandler,
+) (*consensusMessageValidator, error) {
+
+	if consensusService == nil || consensusService.IsInterfaceNil() {
+		return nil, ErrNilConsensusService
+	}
+	if consensusState == nil {
+		return nil, ErrNilConsensusState
+	}
+	if bootstrapper == nil || bootstrapper.IsInterfaceNil() {
+		return nil, ErrNilBootstrapper
+	}
+	if keyGenerator == nil || keyGenerator.IsInterfaceNil() {
+		return nil, ErrNilKeyGenerator
+	}
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, ErrNilMarshalizer
+	}
+	if singleSigner == nil || singleSigner.IsInterfaceNil() {
+		return nil, ErrNilSingleSigner
+	}
+	if peerReputation == nil || peerReputation.IsInterfaceNil() {
+		return nil, ErrNilPeerReputationHandler
+	}
+
+	return &consensusMessageValidator{
+		consensusService: consensusService,
+		consensusState:   consensusState,
+		bootstrapper:     bootstrapper,
+		keyGenerator:     keyGenerator,
+		marshalizer:      marshalizer,
+		singleSigner:     singleSigner,
+		peerReputation:   peerReputation,
+	}, nil
+}
+
+// ValidateConsensusMessage checks the message type against the current subround, the sender membership in the
+// consensus group and the message signature. The outcome is recorded for the originator peer, whose messages are
+// dropped without verifying them if it recently originated too many invalid ones
+func (cmv *consensusMessageValidator) ValidateConsensusMessage(cnsDta *consensus.Message, originator p2p.PeerID) error {
+	if cmv.peerReputation.HasBadReputation(originator) {
+		return ErrOriginatorWithBadReputation
+	}
+
+	err := cmv.validate(cnsDta)
+	if err != nil {
+		if isOriginatorAccountable(err) {
+			cmv.peerReputation.RecordInvalidMessage(originator, err)
+		}
+		return err
+	}
+
+	cmv.peerReputation.RecordValidMessage(originator)
+
+	return nil
+}
+
+// isOriginatorAccountable returns false for the errors caused by the timing of the message, as honest peers also
+// send late messages
+func isOriginatorAccountable(err error) bool {
+	return err != ErrMessageForPastRound && err != ErrMessageForFinishedSubround
+}
+
+func (cmv *consensusMessageValidator) validate(cnsDta *consensus.Message) error {
+	if cnsDta == nil {
+		return ErrNilConsensusData
+	}
+
+	msgType := consensus.MessageType(cnsDta.MsgType)
+	if !cmv.consensusService.IsMessageTypeValid(msgType) {
+		return ErrInvalidMessageType
+	}
+
+	subroundId := cmv.consensusService.GetSubroundIdForMessageType(msgType)
+	if subroundId < 0 {
+		return ErrInvalidMessageType
+	}
+
+	if cmv.consensusState.RoundIndex > cnsDta.RoundIndex {
+		return ErrMessageForPastRound
+	}
+
+	//while the node is syncing, its consensus state is stale, so the messages can only be checked against the
+	//eligible list
+	isSyncing := cmv.bootstrapper.ShouldSync()
+	isCurrentRound := !isSyncing && cmv.consensusState.RoundIndex == cnsDta.RoundIndex
+	node := string(cnsDta.PubKey)
+
+	senderOK := cmv.isSenderInConsensusGroup(node, isCurrentRound)
+	if !senderOK {
+		return ErrSenderNotOk
+	}
+
+	if isCurrentRound {
+		err := cmv.checkSubround(node, subroundId)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := cmv.checkSignature(cnsDta)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// isSenderInConsensusGroup checks the sender against the consensus group of the current round. The consensus group
+// of a future round is not known yet, so, for early messages, the sender only has to be in the eligible list
+func (cmv *consensusMessageValidator) isSenderInConsensusGroup(node string, isCurrentRound bool) bool {
+	if isCurrentRound {
+		return cmv.consensusState.IsNodeInConsensusGroup(node)
+	}
+
+	return cmv.consensusState.IsNodeInEligibleList(node)
+}
+
+// checkSubround checks a message of the current round against the current subround. The messages for the current
+// and the next subrounds are accepted, as the peers are not perfectly synchronized and the early messages are
+// stored until their subround starts. A message for a subround that already finished is only accepted if it brings
+// the contribution of a sender not known yet, so it can still reach the peers that did not finish that subround
+func (cmv *consensusMessageValidator) checkSubround(node string, subroundId int) error {
+	//own messages pass through this validator before being published, when the self job is already done
+	if cmv.consensusState.IsNodeSelf(node) {
+		return nil
+	}
+
+	isSubroundFinished := cmv.consensusState.Status(subroundId) == SsFinished
+	if isSubroundFinished && cmv.consensusState.IsJobDone(node, subroundId) {
+		return ErrMessageForFinishedSubround
+	}
+
+	return nil
+}
+
+func (cmv *consensusMessageValidator) checkSignature(cnsDta *consensus.Message) error {
+	if cnsDta == nil {
+		return ErrNilConsensusData
+	}
+	if cnsDta.PubKey == nil {
+		return ErrNilPublicKey
+	}
+	if cnsDta.Signature == nil {
+		return ErrNilSignature
+	}
+
+	pubKey, err := cmv.keyGenerator.PublicKeyFromByteArray(cnsDta.PubKey)
+	if err != nil {
+		return err
+	}
+
+	dataNoSig := *cnsDta
+	signature := cnsDta.Signature
+	dataNoSig.Signature = nil
+	dataNoSigString, err := cmv.marshalizer.Marshal(dataNoSig)
+	if err != nil {
+		return err
+	}
+
+	err = cmv.singleSigner.Verify(pubKey, dataNoSigString, signature)
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cmv *consensusMessageValidator) IsInterfaceNil() bool {
+	if cmv == nil {
+		return true
+	}
+	return false
+}
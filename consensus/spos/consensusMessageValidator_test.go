@@ -0,0 +1,571 @@
+package spos_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bn"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/stretchr/testify/assert"
+)
+
+const originatorPeer = p2p.PeerID("originator")
+
+type validatorArgs struct {
+	consensusService spos.ConsensusService
+	consensusState   *spos.ConsensusState
+	bootstrapper     *mock.BootstrapperMock
+	keyGenerator     crypto.KeyGenerator
+	marshalizer      mock.MarshalizerMock
+	singleSigner     crypto.SingleSigner
+	peerReputation   spos.PeerReputationHandler
+}
+
+func createValidatorArgs() validatorArgs {
+	bnService, _ := bn.NewConsensusService()
+	keyGeneratorMock, _, _ := mock.InitKeys()
+
+	return validatorArgs{
+		consensusService: bnService,
+		consensusState:   initConsensusState(),
+		bootstrapper:     &mock.BootstrapperMock{},
+		keyGenerator:     keyGeneratorMock,
+		marshalizer:      mock.MarshalizerMock{},
+		singleSigner: &mock.SingleSignerMock{
+			VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+				return nil
+			},
+		},
+		peerReputation: &mock.PeerReputationStub{},
+	}
+}
+
+func createValidator(args validatorArgs) spos.ConsensusMessageValidator {
+	cmv, _ := spos.NewConsensusMessageValidator(
+		args.consensusService,
+		args.consensusState,
+		args.bootstrapper,
+		args.keyGenerator,
+		args.marshalizer,
+		args.singleSigner,
+		args.peerReputation,
+	)
+
+	return cmv
+}
+
+func createConsensusMessage(pubKey string, msgType consensus.MessageType, roundIndex int64) *consensus.Message {
+	return consensus.NewConsensusMessage(
+		[]byte("header hash"),
+		nil,
+		[]byte(pubKey),
+		[]byte("sig"),
+		int(msgType),
+		0,
+		roundIndex,
+	)
+}
+
+//------- NewConsensusMessageValidator
+
+func TestNewConsensusMessageValidator_NilConsensusServiceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createValidatorArgs()
+	cmv, err := spos.NewConsensusMessageValidator(
+		nil,
+		args.consensusState,
+		args.bootstrapper,
+		args.keyGenerator,
+		args.marshalizer,
+		args.singleSigner,
+		args.peerReputation,
+	)
+
+	assert.Nil(t, cmv)
+	assert.Equal(t, spos.ErrNilConsensusService, err)
+}
+
+func TestNewConsensusMessageValidator_NilConsensusStateShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createValidatorArgs()
+	cmv, err := spos.NewConsensusMessageValidator(
+		args.consensusService,
+		nil,
+		args.bootstrapper,
+		args.keyGenerator,
+		args.marshalizer,
+		args.singleSigner,
+		args.peerReputation,
+	)
+
+	assert.Nil(t, cmv)
+	assert.Equal(t, spos.ErrNilConsensusState, err)
+}
+
+func TestNewConsensusMessageValidator_NilBootstrapperShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createValidatorArgs()
+	cmv, err := spos.NewConsensusMessageValidator(
+		args.consensusService,
+		args.consensusState,
+		nil,
+		args.keyGenerator,
+		args.marshalizer,
+		args.singleSigner,
+		args.peerReputation,
+	)
+
+	assert.Nil(t, cmv)
+	assert.Equal(t, spos.ErrNilBootstrapper, err)
+}
+
+func TestNewConsensusMessageValidator_NilKeyGeneratorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createValidatorArgs()
+	cmv, err := spos.NewConsensusMessageValidator(
+		args.consensusService,
+		args.consensusState,
+		args.bootstrapper,
+		nil,
+		args.marshalizer,
+		args.singleSigner,
+		args.peerReputation,
+	)
+
+	assert.Nil(t, cmv)
+	assert.Equal(t, spos.ErrNilKeyGenerator, err)
+}
+
+func TestNewConsensusMessageValidator_NilSingleSignerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createValidatorArgs()
+	cmv, err := spos.NewConsensusMessageValidator(
+		args.consensusService,
+		args.consensusState,
+		args.bootstrapper,
+		args.keyGenerator,
+		args.marshalizer,
+		nil,
+		args.peerReputation,
+	)
+
+	assert.Nil(t, cmv)
+	assert.Equal(t, spos.ErrNilSingleSigner, err)
+}
+
+func TestNewConsensusMessageValidator_NilPeerReputationShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createValidatorArgs()
+	cmv, err := spos.NewConsensusMessageValidator(
+		args.consensusService,
+		args.consensusState,
+		args.bootstrapper,
+		args.keyGenerator,
+		args.marshalizer,
+		args.singleSigner,
+		nil,
+	)
+
+	assert.Nil(t, cmv)
+	assert.Equal(t, spos.ErrNilPeerReputationHandler, err)
+}
+
+func TestNewConsensusMessageValidator_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	cmv, err := spos.NewConsensusMessageValidator(
+		createValidatorArgs().consensusService,
+		initConsensusState(),
+		&mock.BootstrapperMock{},
+		&mock.KeyGenMock{},
+		mock.MarshalizerMock{},
+		&mock.SingleSignerMock{},
+		&mock.PeerReputationStub{},
+	)
+
+	assert.NotNil(t, cmv)
+	assert.Nil(t, err)
+}
+
+//------- ValidateConsensusMessage
+
+func TestConsensusMessageValidator_ValidateNilMessageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cmv := createValidator(createValidatorArgs())
+
+	err := cmv.ValidateConsensusMessage(nil, originatorPeer)
+
+	assert.Equal(t, spos.ErrNilConsensusData, err)
+}
+
+func TestConsensusMessageValidator_ValidateUnknownMessageTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createValidatorArgs()
+	cmv := createValidator(args)
+	cnsMsg := createConsensusMessage(args.consensusState.ConsensusGroup()[0], bn.MtUnknown, 0)
+
+	err := cmv.ValidateConsensusMessage(cnsMsg, originatorPeer)
+
+	assert.Equal(t, spos.ErrInvalidMessageType, err)
+}
+
+func TestConsensusMessageValidator_ValidateMessageForPastRoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createValidatorArgs()
+	args.consensusState.RoundIndex = 5
+	cmv := createValidator(args)
+	cnsMsg := createConsensusMessage(args.consensusState.ConsensusGroup()[0], bn.MtBlockHeader, 4)
+
+	err := cmv.ValidateConsensusMessage(cnsMsg, originatorPeer)
+
+	assert.Equal(t, spos.ErrMessageForPastRound, err)
+}
+
+func TestConsensusMessageValidator_ValidateSenderNotInConsensusGroupShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createValidatorArgs()
+	eligibleList := args.consensusState.EligibleList()
+	args.consensusState.SetConsensusGroup(eligibleList[:3])
+	cmv := createValidator(args)
+	cnsMsg := createConsensusMessage(eligibleList[5], bn.MtBlockHeader, 0)
+
+	err := cmv.ValidateConsensusMessage(cnsMsg, originatorPeer)
+
+	assert.Equal(t, spos.ErrSenderNotOk, err)
+}
+
+func TestConsensusMessageValidator_ValidateFutureRoundSenderInEligibleListShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := createValidatorArgs()
+	eligibleList := args.consensusState.EligibleList()
+	args.consensusState.SetConsensusGroup(eligibleList[:3])
+	cmv := createValidator(args)
+	cnsMsg := createConsensusMessage(eligibleList[5], bn.MtBlockHeader, 1)
+
+	err := cmv.ValidateConsensusMessage(cnsMsg, originatorPeer)
+
+	assert.Nil(t, err)
+}
+
+func TestConsensusMessageValidator_ValidateFutureRoundSenderNotEligibleShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createValidatorArgs()
+	cmv := createValidator(args)
+	cnsMsg := createConsensusMessage("X", bn.MtBlockHeader, 1)
+
+	err := cmv.ValidateConsensusMessage(cnsMsg, originatorPeer)
+
+	assert.Equal(t, spos.ErrSenderNotOk, err)
+}
+
+func TestConsensusMessageValidator_ValidateMessageForFinishedSubroundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createValidatorArgs()
+	sender := args.consensusState.ConsensusGroup()[0]
+	args.consensusState.SetStatus(bn.SrCommitmentHash, spos.SsFinished)
+	_ = args.consensusState.SetJobDone(sender, bn.SrCommitmentHash, true)
+	cmv := createValidator(args)
+	cnsMsg := createConsensusMessage(sender, bn.MtCommitmentHash, 0)
+
+	err := cmv.ValidateConsensusMessage(cnsMsg, originatorPeer)
+
+	assert.Equal(t, spos.ErrMessageForFinishedSubround, err)
+}
+
+func TestConsensusMessageValidator_ValidateMessageForFinishedSubroundFromNewSenderShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := createValidatorArgs()
+	sender := args.consensusState.ConsensusGroup()[0]
+	args.consensusState.SetStatus(bn.SrCommitmentHash, spos.SsFinished)
+	cmv := createValidator(args)
+	cnsMsg := createConsensusMessage(sender, bn.MtCommitmentHash, 0)
+
+	err := cmv.ValidateConsensusMessage(cnsMsg, originatorPeer)
+
+	assert.Nil(t, err)
+}
+
+func TestConsensusMessageValidator_ValidateOwnMessageForFinishedSubroundShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := createValidatorArgs()
+	sender := args.consensusState.SelfPubKey()
+	args.consensusState.SetStatus(bn.SrBlock, spos.SsFinished)
+	_ = args.consensusState.SetSelfJobDone(bn.SrBlock, true)
+	cmv := createValidator(args)
+	cnsMsg := createConsensusMessage(sender, bn.MtBlockHeader, 0)
+
+	err := cmv.ValidateConsensusMessage(cnsMsg, originatorPeer)
+
+	assert.Nil(t, err)
+}
+
+func TestConsensusMessageValidator_ValidateInvalidSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createValidatorArgs()
+	args.singleSigner = &mock.SingleSignerMock{
+		VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+			return errors.New("invalid signature")
+		},
+	}
+	cmv := createValidator(args)
+	cnsMsg := createConsensusMessage(args.consensusState.ConsensusGroup()[0], bn.MtBlockHeader, 0)
+
+	err := cmv.ValidateConsensusMessage(cnsMsg, originatorPeer)
+
+	assert.Equal(t, spos.ErrInvalidSignature, err)
+}
+
+func TestConsensusMessageValidator_ValidateShouldNotVerifySignatureForInvalidSender(t *testing.T) {
+	t.Parallel()
+
+	args := createValidatorArgs()
+	args.singleSigner = &mock.SingleSignerMock{
+		VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+			assert.Fail(t, "signature should not have been verified")
+			return nil
+		},
+	}
+	cmv := createValidator(args)
+	cnsMsg := createConsensusMessage("X", bn.MtBlockHeader, 0)
+
+	err := cmv.ValidateConsensusMessage(cnsMsg, originatorPeer)
+
+	assert.Equal(t, spos.ErrSenderNotOk, err)
+}
+
+func TestConsensusMessageValidator_ValidateShouldRecordOriginatorReputation(t *testing.T) {
+	t.Parallel()
+
+	args := createValidatorArgs()
+	peerReputation, _ := spos.NewPeerReputation()
+	args.peerReputation = peerReputation
+	cmv := createValidator(args)
+	validMsg := createConsensusMessage(args.consensusState.ConsensusGroup()[0], bn.MtBlockHeader, 0)
+	invalidMsg := createConsensusMessage("X", bn.MtBlockHeader, 0)
+
+	_ = cmv.ValidateConsensusMessage(validMsg, originatorPeer)
+	_ = cmv.ValidateConsensusMessage(validMsg, originatorPeer)
+	_ = cmv.ValidateConsensusMessage(invalidMsg, originatorPeer)
+
+	score := peerReputation.Score(originatorPeer)
+	assert.Equal(t, uint64(2), score.NumValidMessages)
+	assert.Equal(t, uint64(1), score.NumInvalidMessages)
+	assert.Equal(t, spos.ErrSenderNotOk.Error(), score.LastInvalidReason)
+	assert.Equal(t, uint64(0), peerReputation.Score("unknown peer").NumValidMessages)
+}
+
+func TestConsensusMessageValidator_ValidateLateMessageShouldNotCountAgainstOriginator(t *testing.T) {
+	t.Parallel()
+
+	args := createValidatorArgs()
+	args.consensusState.RoundIndex = 1
+	recorded := false
+	args.peerReputation = &mock.PeerReputationStub{
+		RecordInvalidMessageCalled: func(pid p2p.PeerID, reason error) {
+			recorded = true
+		},
+	}
+	cmv := createValidator(args)
+	cnsMsg := createConsensusMessage(args.consensusState.ConsensusGroup()[0], bn.MtBlockHeader, 0)
+
+	err := cmv.ValidateConsensusMessage(cnsMsg, originatorPeer)
+
+	assert.Equal(t, spos.ErrMessageForPastRound, err)
+	assert.False(t, recorded)
+}
+
+func TestConsensusMessageValidator_ValidateOriginatorWithBadReputationShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createValidatorArgs()
+	args.singleSigner = &mock.SingleSignerMock{
+		VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+			assert.Fail(t, "signature should not have been verified")
+			return nil
+		},
+	}
+	args.peerReputation = &mock.PeerReputationStub{
+		HasBadReputationCalled: func(pid p2p.PeerID) bool {
+			return pid == originatorPeer
+		},
+	}
+	cmv := createValidator(args)
+	cnsMsg := createConsensusMessage(args.consensusState.ConsensusGroup()[0], bn.MtBlockHeader, 0)
+
+	err := cmv.ValidateConsensusMessage(cnsMsg, originatorPeer)
+
+	assert.Equal(t, spos.ErrOriginatorWithBadReputation, err)
+}
+
+func TestConsensusMessageValidator_ValidateWhileSyncingShouldCheckOnlyTheEligibleList(t *testing.T) {
+	t.Parallel()
+
+	args := createValidatorArgs()
+	eligibleList := args.consensusState.ConsensusGroup()
+	staleGroupMember := eligibleList[1]
+	args.consensusState.SetConsensusGroup(eligibleList[1:])
+	args.consensusState.SetStatus(bn.SrBlock, spos.SsFinished)
+	_ = args.consensusState.SetJobDone(staleGroupMember, bn.SrBlock, true)
+	args.bootstrapper.ShouldSyncCalled = func() bool {
+		return true
+	}
+	cmv := createValidator(args)
+
+	err := cmv.ValidateConsensusMessage(createConsensusMessage(eligibleList[0], bn.MtBlockHeader, 0), originatorPeer)
+	assert.Nil(t, err)
+
+	err = cmv.ValidateConsensusMessage(createConsensusMessage(staleGroupMember, bn.MtBlockHeader, 0), originatorPeer)
+	assert.Nil(t, err)
+
+	err = cmv.ValidateConsensusMessage(createConsensusMessage("X", bn.MtBlockHeader, 0), originatorPeer)
+	assert.Equal(t, spos.ErrSenderNotOk, err)
+}
+
+//------- checkSignature
+
+func TestConsensusMessageValidator_CheckSignatureShouldReturnErrNilConsensusData(t *testing.T) {
+	t.Parallel()
+
+	args := createValidatorArgs()
+	cmv, _ := spos.NewConsensusMessageValidator(
+		args.consensusService,
+		args.consensusState,
+		args.bootstrapper,
+		args.keyGenerator,
+		args.marshalizer,
+		args.singleSigner,
+		args.peerReputation,
+	)
+
+	err := cmv.CheckSignature(nil)
+
+	assert.Equal(t, spos.ErrNilConsensusData, err)
+}
+
+func TestConsensusMessageValidator_CheckSignatureShouldReturnErrNilPublicKey(t *testing.T) {
+	t.Parallel()
+
+	args := createValidatorArgs()
+	cmv, _ := spos.NewConsensusMessageValidator(
+		args.consensusService,
+		args.consensusState,
+		args.bootstrapper,
+		args.keyGenerator,
+		args.marshalizer,
+		args.singleSigner,
+		args.peerReputation,
+	)
+	cnsMsg := createConsensusMessage("", bn.MtBlockBody, 0)
+	cnsMsg.PubKey = nil
+
+	err := cmv.CheckSignature(cnsMsg)
+
+	assert.Equal(t, spos.ErrNilPublicKey, err)
+}
+
+func TestConsensusMessageValidator_CheckSignatureShouldReturnErrNilSignature(t *testing.T) {
+	t.Parallel()
+
+	args := createValidatorArgs()
+	cmv, _ := spos.NewConsensusMessageValidator(
+		args.consensusService,
+		args.consensusState,
+		args.bootstrapper,
+		args.keyGenerator,
+		args.marshalizer,
+		args.singleSigner,
+		args.peerReputation,
+	)
+	cnsMsg := createConsensusMessage(args.consensusState.ConsensusGroup()[0], bn.MtBlockBody, 0)
+	cnsMsg.Signature = nil
+
+	err := cmv.CheckSignature(cnsMsg)
+
+	assert.Equal(t, spos.ErrNilSignature, err)
+}
+
+func TestConsensusMessageValidator_CheckSignatureShouldReturnPublicKeyFromByteArrayErr(t *testing.T) {
+	t.Parallel()
+
+	args := createValidatorArgs()
+	keyGeneratorMock, _, _ := mock.InitKeys()
+	errExpected := errors.New("error public key from byte array")
+	keyGeneratorMock.PublicKeyFromByteArrayMock = func(b []byte) (crypto.PublicKey, error) {
+		return nil, errExpected
+	}
+	cmv, _ := spos.NewConsensusMessageValidator(
+		args.consensusService,
+		args.consensusState,
+		args.bootstrapper,
+		keyGeneratorMock,
+		args.marshalizer,
+		args.singleSigner,
+		args.peerReputation,
+	)
+	cnsMsg := createConsensusMessage(args.consensusState.ConsensusGroup()[0], bn.MtBlockBody, 0)
+
+	err := cmv.CheckSignature(cnsMsg)
+
+	assert.Equal(t, errExpected, err)
+}
+
+func TestConsensusMessageValidator_CheckSignatureShouldReturnMarshalizerErr(t *testing.T) {
+	t.Parallel()
+
+	args := createValidatorArgs()
+	args.marshalizer.Fail = true
+	cmv, _ := spos.NewConsensusMessageValidator(
+		args.consensusService,
+		args.consensusState,
+		args.bootstrapper,
+		args.keyGenerator,
+		args.marshalizer,
+		args.singleSigner,
+		args.peerReputation,
+	)
+	cnsMsg := createConsensusMessage(args.consensusState.ConsensusGroup()[0], bn.MtBlockBody, 0)
+
+	err := cmv.CheckSignature(cnsMsg)
+
+	assert.Equal(t, mock.ErrMockMarshalizer, err)
+}
+
+func TestConsensusMessageValidator_CheckSignatureShouldReturnNilErr(t *testing.T) {
+	t.Parallel()
+
+	args := createValidatorArgs()
+	cmv, _ := spos.NewConsensusMessageValidator(
+		args.consensusService,
+		args.consensusState,
+		args.bootstrapper,
+		args.keyGenerator,
+		args.marshalizer,
+		args.singleSigner,
+		args.peerReputation,
+	)
+	cnsMsg := createConsensusMessage(args.consensusState.ConsensusGroup()[0], bn.MtBlockBody, 0)
+
+	err := cmv.CheckSignature(cnsMsg)
+
+	assert.Nil(t, err)
+}
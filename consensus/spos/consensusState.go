@@ -93,6 +93,27 @@ func (cns *ConsensusState) GetLeader() (string, error) {
 	return cns.consensusGroup[0], nil
 }
 
+// BackupRank returns the rank, starting from 1, of the given node among the backup broadcasters of the current
+// round. The backups are the numBackups members which follow the leader in the consensus group. For any other
+// node, the leader included, it returns 0
+func (cns *ConsensusState) BackupRank(node string, numBackups int) int {
+	index, err := cns.ConsensusGroupIndex(node)
+	if err != nil {
+		return 0
+	}
+
+	if index == 0 || index > numBackups {
+		return 0
+	}
+
+	return index
+}
+
+// SelfBackupRank returns the rank of the current node among the backup broadcasters of the current round
+func (cns *ConsensusState) SelfBackupRank(numBackups int) int {
+	return cns.BackupRank(cns.selfPubKey, numBackups)
+}
+
 // GetNextConsensusGroup gets the new consensus group for the current round based on current eligible list and a random
 // source for the new selection
 func (cns *ConsensusState) GetNextConsensusGroup(
@@ -262,3 +283,16 @@ func (cns *ConsensusState) SetProcessingBlock(processingBlock bool) {
 func (cns *ConsensusState) GetData() []byte {
 	return cns.Data
 }
+
+// GetHeader gets the block header of the current round held by the consensusState
+func (cns *ConsensusState) GetHeader() data.HeaderHandler {
+	return cns.Header
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cns *ConsensusState) IsInterfaceNil() bool {
+	if cns == nil {
+		return true
+	}
+	return false
+}
@@ -133,6 +133,40 @@ func TestConsensusState_GetLeaderShouldWork(t *testing.T) {
 	assert.Equal(t, cns.ConsensusGroup()[0], leader)
 }
 
+func TestConsensusState_BackupRankShouldReturnZeroForLeader(t *testing.T) {
+	t.Parallel()
+
+	cns := internalInitConsensusState()
+
+	assert.Equal(t, 0, cns.BackupRank("1", 2))
+}
+
+func TestConsensusState_BackupRankShouldReturnZeroForNodeNotInConsensusGroup(t *testing.T) {
+	t.Parallel()
+
+	cns := internalInitConsensusState()
+
+	assert.Equal(t, 0, cns.BackupRank("4", 2))
+}
+
+func TestConsensusState_BackupRankShouldReturnZeroForNodeAfterBackups(t *testing.T) {
+	t.Parallel()
+
+	cns := internalInitConsensusState()
+
+	assert.Equal(t, 0, cns.BackupRank("3", 1))
+}
+
+func TestConsensusState_BackupRankShouldWork(t *testing.T) {
+	t.Parallel()
+
+	cns := internalInitConsensusState()
+
+	assert.Equal(t, 1, cns.BackupRank("2", 2))
+	assert.Equal(t, 2, cns.BackupRank("3", 2))
+	assert.Equal(t, 1, cns.SelfBackupRank(2))
+}
+
 func TestConsensusState_GetNextConsensusGroupShouldFailWhenComputeValidatorsGroupErr(t *testing.T) {
 	t.Parallel()
 
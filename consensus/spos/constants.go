@@ -2,3 +2,11 @@ package spos
 
 // maxThresholdPercent specifies the max allocated time percent for doing Job as a percentage of the total time of one round
 const maxThresholdPercent = 75
+
+// numBackupBroadcasters specifies how many members of the consensus group, following the leader, keep the proposed
+// block data to broadcast its miniblocks and transactions in case the leader fails to do so
+const numBackupBroadcasters = 2
+
+// backupBroadcastStagger specifies, as a part of the total time of the round, the time between the broadcasts of two
+// consecutive backups. The first backup broadcasts at the end of the round
+const backupBroadcastStagger = 0.1
@@ -147,3 +147,35 @@ var ErrNilExecuteStoredMessages = errors.New("executeStoredMessages is nil")
 
 // ErrNilAppStatusHandler defines the error for setting a nil AppStatusHandler
 var ErrNilAppStatusHandler = errors.New("nil AppStatusHandler")
+
+// ErrNilConsensusMessageValidator is raised when a valid consensus message validator is expected but nil used
+var ErrNilConsensusMessageValidator = errors.New("consensus message validator is nil")
+
+// ErrNilPeerReputationHandler is raised when a valid peer reputation handler is expected but nil used
+var ErrNilPeerReputationHandler = errors.New("peer reputation handler is nil")
+
+// ErrInvalidMessageType is raised when a consensus message has a type unknown to the current consensus type
+var ErrInvalidMessageType = errors.New("consensus message type is invalid")
+
+// ErrMessageForFinishedSubround is raised when a sender sends again a message for a subround in which its job
+// is already done
+var ErrMessageForFinishedSubround = errors.New("message is for a finished subround")
+
+// ErrOriginatorWithBadReputation is raised when a consensus message is originated by a peer that recently sent
+// too many invalid messages
+var ErrOriginatorWithBadReputation = errors.New("message originator has a bad reputation")
+
+// ErrNilHeadersPool is raised when a valid headers pool is expected but nil used
+var ErrNilHeadersPool = errors.New("headers pool is nil")
+
+// ErrNilMiniBlocksPool is raised when a valid miniblocks pool is expected but nil used
+var ErrNilMiniBlocksPool = errors.New("miniblocks pool is nil")
+
+// ErrNilDelayedBroadcastData is raised when a valid delayed broadcast data is expected but nil used
+var ErrNilDelayedBroadcastData = errors.New("delayed broadcast data is nil")
+
+// ErrInvalidDelay is raised when a delay lower than zero is provided
+var ErrInvalidDelay = errors.New("invalid delay")
+
+// ErrNilRoundRecorder is raised when a valid round recorder is expected but nil used
+var ErrNilRoundRecorder = errors.New("round recorder is nil")
@@ -1,8 +1,9 @@
 package spos
 
 import (
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go/consensus"
-	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
@@ -51,14 +52,6 @@ func (wrk *Worker) SetForkDetector(forkDetector process.ForkDetector) {
 	wrk.forkDetector = forkDetector
 }
 
-func (wrk *Worker) KeyGenerator() crypto.KeyGenerator {
-	return wrk.keyGenerator
-}
-
-func (wrk *Worker) SetKeyGenerator(keyGenerator crypto.KeyGenerator) {
-	wrk.keyGenerator = keyGenerator
-}
-
 func (wrk *Worker) Marshalizer() marshal.Marshalizer {
 	return wrk.marshalizer
 }
@@ -67,6 +60,10 @@ func (wrk *Worker) SetMarshalizer(marshalizer marshal.Marshalizer) {
 	wrk.marshalizer = marshalizer
 }
 
+func (wrk *Worker) SetMessageValidator(messageValidator ConsensusMessageValidator) {
+	wrk.messageValidator = messageValidator
+}
+
 func (wrk *Worker) Rounder() consensus.Rounder {
 	return wrk.rounder
 }
@@ -75,10 +72,6 @@ func (wrk *Worker) SetRounder(rounder consensus.Rounder) {
 	wrk.rounder = rounder
 }
 
-func (wrk *Worker) CheckSignature(cnsData *consensus.Message) error {
-	return wrk.checkSignature(cnsData)
-}
-
 func (wrk *Worker) ExecuteMessage(cnsDtaList []*consensus.Message) {
 	wrk.executeMessage(cnsDtaList)
 }
@@ -138,3 +131,15 @@ func (wrk *Worker) SetConsensusStateChangedChannel(consensusStateChangedChannel
 func (wrk *Worker) CheckSelfState(cnsDta *consensus.Message) error {
 	return wrk.checkSelfState(cnsDta)
 }
+
+// consensusMessageValidator
+
+func (cmv *consensusMessageValidator) CheckSignature(cnsData *consensus.Message) error {
+	return cmv.checkSignature(cnsData)
+}
+
+// peer reputation
+
+func (pr *peerReputation) SetTimeHandler(handler func() time.Time) {
+	pr.getTimeHandler = handler
+}
@@ -65,6 +65,10 @@ type ConsensusService interface {
 	IsMessageWithSignature(consensus.MessageType) bool
 	//IsSubroundSignature returns if the current subround is about signature
 	IsSubroundSignature(int) bool
+	//IsMessageTypeValid returns if the messageType is known by the current consensus type
+	IsMessageTypeValid(consensus.MessageType) bool
+	//GetSubroundIdForMessageType gets the id of the subround in which the messageType is processed
+	GetSubroundIdForMessageType(consensus.MessageType) int
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 }
@@ -93,3 +97,23 @@ type WorkerHandler interface {
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 }
+
+// ConsensusMessageValidator checks the received consensus messages before they are processed and relayed
+type ConsensusMessageValidator interface {
+	//ValidateConsensusMessage returns nil if the consensus message, originated by the given peer, is valid
+	ValidateConsensusMessage(cnsDta *consensus.Message, originator p2p.PeerID) error
+	// IsInterfaceNil returns true if there is no value under the interface
+	IsInterfaceNil() bool
+}
+
+// PeerReputationHandler records the valid and invalid consensus messages originated by each peer
+type PeerReputationHandler interface {
+	//RecordValidMessage records a valid message originated by the given peer
+	RecordValidMessage(pid p2p.PeerID)
+	//RecordInvalidMessage records an invalid message originated by the given peer and the reason it was rejected
+	RecordInvalidMessage(pid p2p.PeerID, reason error)
+	//HasBadReputation returns true if the messages originated by the given peer should be dropped without verifying them
+	HasBadReputation(pid p2p.PeerID) bool
+	// IsInterfaceNil returns true if there is no value under the interface
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,110 @@
+package spos
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+)
+
+// maxTrackedPeers bounds the number of peers whose score is kept. The least recently seen peers are evicted first
+const maxTrackedPeers = 1000
+
+// scoreWindow is the time after which the score of a peer is reset, so a peer that misbehaved for a while (e.g. it had
+// a stale view of the consensus group) gets its messages accepted again
+const scoreWindow = 10 * time.Minute
+
+// minInvalidMessagesForBadReputation is the minimum number of invalid messages a peer has to originate, in the
+// current score window, before its messages are dropped without verifying them
+const minInvalidMessagesForBadReputation = 100
+
+// PeerScore holds the number of valid and invalid consensus messages originated by a peer in the current score window
+type PeerScore struct {
+	NumValidMessages   uint64
+	NumInvalidMessages uint64
+	LastInvalidReason  string
+	WindowStart        time.Time
+}
+
+// peerReputation keeps, in memory, the score of the peers that recently originated consensus messages
+type peerReputation struct {
+	mutScores      sync.Mutex
+	scores         storage.Cacher
+	getTimeHandler func() time.Time
+}
+
+// NewPeerReputation creates a new peer reputation object
+func NewPeerReputation() (*peerReputation, error) {
+	scores, err := lrucache.NewCache(maxTrackedPeers)
+	if err != nil {
+		return nil, err
+	}
+
+	return &peerReputation{
+		scores:         scores,
+		getTimeHandler: time.Now,
+	}, nil
+}
+
+// RecordValidMessage increments the number of valid messages originated by the provided peer
+func (pr *peerReputation) RecordValidMessage(pid p2p.PeerID) {
+	pr.mutScores.Lock()
+	score := pr.getOrCreateScore(pid)
+	score.NumValidMessages++
+	pr.scores.Put(pid.Bytes(), score)
+	pr.mutScores.Unlock()
+}
+
+// RecordInvalidMessage increments the number of invalid messages originated by the provided peer
+func (pr *peerReputation) RecordInvalidMessage(pid p2p.PeerID, reason error) {
+	pr.mutScores.Lock()
+	score := pr.getOrCreateScore(pid)
+	score.NumInvalidMessages++
+	if reason != nil {
+		score.LastInvalidReason = reason.Error()
+	}
+	pr.scores.Put(pid.Bytes(), score)
+	pr.mutScores.Unlock()
+}
+
+// getOrCreateScore should be called under mutex protection
+func (pr *peerReputation) getOrCreateScore(pid p2p.PeerID) *PeerScore {
+	now := pr.getTimeHandler()
+
+	value, found := pr.scores.Get(pid.Bytes())
+	if found {
+		score, ok := value.(*PeerScore)
+		if ok && now.Sub(score.WindowStart) < scoreWindow {
+			return score
+		}
+	}
+
+	return &PeerScore{WindowStart: now}
+}
+
+// Score returns the score of the provided peer in the current score window
+func (pr *peerReputation) Score(pid p2p.PeerID) PeerScore {
+	pr.mutScores.Lock()
+	defer pr.mutScores.Unlock()
+
+	return *pr.getOrCreateScore(pid)
+}
+
+// HasBadReputation returns true if the provided peer originated, in the current score window, a significant number of
+// invalid messages and more invalid than valid ones
+func (pr *peerReputation) HasBadReputation(pid p2p.PeerID) bool {
+	score := pr.Score(pid)
+
+	return score.NumInvalidMessages >= minInvalidMessagesForBadReputation &&
+		score.NumInvalidMessages > score.NumValidMessages
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pr *peerReputation) IsInterfaceNil() bool {
+	if pr == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package spos_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPeerReputation_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pr, err := spos.NewPeerReputation()
+
+	assert.NotNil(t, pr)
+	assert.Nil(t, err)
+}
+
+func TestPeerReputation_HasBadReputationShouldNeedManyInvalidMessages(t *testing.T) {
+	t.Parallel()
+
+	pr, _ := spos.NewPeerReputation()
+	pid := p2p.PeerID("pid")
+
+	for i := 0; i < 99; i++ {
+		pr.RecordInvalidMessage(pid, errors.New("invalid"))
+	}
+	assert.False(t, pr.HasBadReputation(pid))
+
+	pr.RecordInvalidMessage(pid, errors.New("invalid"))
+	assert.True(t, pr.HasBadReputation(pid))
+}
+
+func TestPeerReputation_HasBadReputationShouldConsiderTheValidMessages(t *testing.T) {
+	t.Parallel()
+
+	pr, _ := spos.NewPeerReputation()
+	pid := p2p.PeerID("pid")
+
+	for i := 0; i < 200; i++ {
+		pr.RecordValidMessage(pid)
+		pr.RecordInvalidMessage(pid, errors.New("invalid"))
+	}
+
+	assert.False(t, pr.HasBadReputation(pid))
+}
+
+func TestPeerReputation_ScoreShouldResetAfterTheWindow(t *testing.T) {
+	t.Parallel()
+
+	pr, _ := spos.NewPeerReputation()
+	now := time.Now()
+	pr.SetTimeHandler(func() time.Time {
+		return now
+	})
+	pid := p2p.PeerID("pid")
+
+	for i := 0; i < 100; i++ {
+		pr.RecordInvalidMessage(pid, errors.New("invalid"))
+	}
+	assert.True(t, pr.HasBadReputation(pid))
+
+	now = now.Add(time.Hour)
+
+	assert.False(t, pr.HasBadReputation(pid))
+	assert.Equal(t, uint64(0), pr.Score(pid).NumInvalidMessages)
+}
+
+func TestPeerReputation_ShouldEvictTheLeastRecentlySeenPeers(t *testing.T) {
+	t.Parallel()
+
+	pr, _ := spos.NewPeerReputation()
+	first := p2p.PeerID("first")
+	pr.RecordValidMessage(first)
+
+	for i := 0; i < 1000; i++ {
+		pr.RecordValidMessage(p2p.PeerID(fmt.Sprintf("pid%d", i)))
+	}
+
+	assert.Equal(t, uint64(0), pr.Score(first).NumValidMessages)
+	assert.Equal(t, uint64(1), pr.Score("pid999").NumValidMessages)
+}
@@ -9,3 +9,6 @@ var ErrInvalidConsensusType = errors.New("invalid consensus type")
 
 // ErrInvalidShardId signals that an invalid shard id has been provided
 var ErrInvalidShardId = errors.New("invalid shard id")
+
+// ErrNilDataPool signals that a nil data pool has been provided
+var ErrNilDataPool = errors.New("nil data pool")
@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
@@ -69,6 +70,19 @@ func GetConsensusCoreFactory(consensusType string) (spos.ConsensusService, error
 	return nil, ErrInvalidConsensusType
 }
 
+// GetEndRoundDeadline returns, from the total time of the round, the deadline until which the block should be
+// committed, depending of the given parameter
+func GetEndRoundDeadline(consensusType string) (float64, error) {
+	switch consensusType {
+	case blsConsensusType:
+		return bls.EndRoundDeadline(), nil
+	case bnConsensusType:
+		return bn.EndRoundDeadline(), nil
+	}
+
+	return 0, ErrInvalidConsensusType
+}
+
 // GetBroadcastMessenger returns a consensus service depending of the given parameter
 func GetBroadcastMessenger(
 	marshalizer marshal.Marshalizer,
@@ -76,14 +90,48 @@ func GetBroadcastMessenger(
 	shardCoordinator sharding.Coordinator,
 	privateKey crypto.PrivateKey,
 	singleSigner crypto.SingleSigner,
+	dataPool dataRetriever.PoolsHolder,
+	roundRecorder consensus.RoundRecorder,
 ) (consensus.BroadcastMessenger, error) {
 
 	if shardCoordinator.SelfId() < shardCoordinator.NumberOfShards() {
-		return broadcast.NewShardChainMessenger(marshalizer, messenger, privateKey, shardCoordinator, singleSigner)
+		if dataPool == nil || dataPool.IsInterfaceNil() {
+			return nil, ErrNilDataPool
+		}
+
+		shardMessenger, err := broadcast.NewShardChainMessenger(
+			marshalizer,
+			messenger,
+			privateKey,
+			shardCoordinator,
+			singleSigner,
+			dataPool.Headers(),
+			dataPool.MiniBlocks(),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		err = shardMessenger.SetRoundRecorder(roundRecorder)
+		if err != nil {
+			return nil, err
+		}
+
+		return shardMessenger, nil
 	}
 
 	if shardCoordinator.SelfId() == sharding.MetachainShardId {
-		return broadcast.NewMetaChainMessenger(marshalizer, messenger, privateKey, shardCoordinator, singleSigner)
+		metaMessenger, err := broadcast.NewMetaChainMessenger(marshalizer, messenger, privateKey, shardCoordinator, singleSigner)
+		if err != nil {
+			return nil, err
+		}
+
+		err = metaMessenger.SetRoundRecorder(roundRecorder)
+		if err != nil {
+			return nil, err
+		}
+
+		return metaMessenger, nil
 	}
 
 	return nil, ErrInvalidShardId
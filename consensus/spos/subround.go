@@ -1,6 +1,7 @@
 package spos
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/consensus"
@@ -155,6 +156,58 @@ func (sr *Subround) EndTime() int64 {
 	return int64(sr.endTime)
 }
 
+// ElapsedMillisecondsInRound method returns the time in milliseconds passed from the start of the current round
+func (sr *Subround) ElapsedMillisecondsInRound() uint64 {
+	elapsedTime := sr.SyncTimer().CurrentTime().Sub(sr.RoundTimeStamp)
+	if elapsedTime < 0 {
+		return 0
+	}
+
+	return uint64(elapsedTime / time.Millisecond)
+}
+
+// KeepBlockForBackupBroadcast method hands the proposed block data to the broadcast messenger if the current node
+// is a backup broadcaster in this round. If a final header of this block is seen on the network, its miniblocks and
+// transactions that were not seen by the end of the round are broadcast, in the order of the backup rank. The
+// backups never build or broadcast a header, so they can not compete with the leader. This also means that they
+// can not replace a leader which did not broadcast its final header
+func (sr *Subround) KeepBlockForBackupBroadcast() {
+	backupRank := sr.SelfBackupRank(numBackupBroadcasters)
+	if backupRank == 0 {
+		return
+	}
+
+	miniBlocks, transactions, err := sr.BlockProcessor().MarshalizedDataToBroadcast(sr.Header, sr.BlockBody)
+	if err != nil {
+		log.Error(err.Error())
+		return
+	}
+
+	delayedData := &consensus.DelayedBroadcastData{
+		Header:       sr.Header,
+		Body:         sr.BlockBody,
+		MiniBlocks:   miniBlocks,
+		Transactions: transactions,
+	}
+
+	roundDuration := sr.Rounder().TimeDuration()
+	roundEnd := sr.RoundTimeStamp.Add(roundDuration)
+	stagger := time.Duration(float64(roundDuration) * backupBroadcastStagger * float64(backupRank-1))
+	delay := roundEnd.Sub(sr.SyncTimer().CurrentTime()) + stagger
+	if delay < 0 {
+		delay = 0
+	}
+
+	err = sr.BroadcastMessenger().SetDelayedBroadcastData(delayedData, delay)
+	if err != nil {
+		log.Error(err.Error())
+		return
+	}
+
+	log.Debug(fmt.Sprintf("%sproposed block data has been kept for a delayed broadcast as backup %d\n",
+		sr.SyncTimer().FormattedCurrentTime(), backupRank))
+}
+
 // Name method returns the name of the Subround
 func (sr *Subround) Name() string {
 	return sr.name
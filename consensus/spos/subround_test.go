@@ -826,3 +826,37 @@ func TestSubround_Name(t *testing.T) {
 
 	assert.Equal(t, "(BLOCK)", sr.Name())
 }
+
+func TestSubround_ElapsedMillisecondsInRound(t *testing.T) {
+	t.Parallel()
+
+	consensusState := initConsensusState()
+	ch := make(chan bool, 1)
+	container := mock.InitConsensusCore()
+
+	roundTimeStamp := time.Unix(10, 0)
+	container.SetSyncTimer(&mock.SyncTimerMock{
+		CurrentTimeCalled: func() time.Time {
+			return roundTimeStamp.Add(1500 * time.Millisecond)
+		},
+	})
+	consensusState.RoundTimeStamp = roundTimeStamp
+
+	sr, _ := spos.NewSubround(
+		int(bls.SrStartRound),
+		int(bls.SrBlock),
+		int(bls.SrSignature),
+		int64(5*roundTimeDuration/100),
+		int64(25*roundTimeDuration/100),
+		"(BLOCK)",
+		consensusState,
+		ch,
+		executeStoredMessages,
+		container,
+	)
+
+	assert.Equal(t, uint64(1500), sr.ElapsedMillisecondsInRound())
+
+	consensusState.RoundTimeStamp = roundTimeStamp.Add(time.Second * 2)
+	assert.Equal(t, uint64(0), sr.ElapsedMillisecondsInRound())
+}
@@ -8,8 +8,8 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/recorder"
 	"github.com/ElrondNetwork/elrond-go/core"
-	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/ntp"
@@ -27,12 +27,12 @@ type Worker struct {
 	broadcastMessenger consensus.BroadcastMessenger
 	consensusState     *ConsensusState
 	forkDetector       process.ForkDetector
-	keyGenerator       crypto.KeyGenerator
 	marshalizer        marshal.Marshalizer
+	messageValidator   ConsensusMessageValidator
 	rounder            consensus.Rounder
 	shardCoordinator   sharding.Coordinator
-	singleSigner       crypto.SingleSigner
 	syncTimer          ntp.SyncTimer
+	roundRecorder      consensus.RoundRecorder
 
 	receivedMessages      map[consensus.MessageType][]*consensus.Message
 	receivedMessagesCalls map[consensus.MessageType]func(*consensus.Message) bool
@@ -56,11 +56,10 @@ func NewWorker(
 	broadcastMessenger consensus.BroadcastMessenger,
 	consensusState *ConsensusState,
 	forkDetector process.ForkDetector,
-	keyGenerator crypto.KeyGenerator,
 	marshalizer marshal.Marshalizer,
+	messageValidator ConsensusMessageValidator,
 	rounder consensus.Rounder,
 	shardCoordinator sharding.Coordinator,
-	singleSigner crypto.SingleSigner,
 	syncTimer ntp.SyncTimer,
 ) (*Worker, error) {
 	err := checkNewWorkerParams(
@@ -71,11 +70,10 @@ func NewWorker(
 		broadcastMessenger,
 		consensusState,
 		forkDetector,
-		keyGenerator,
 		marshalizer,
+		messageValidator,
 		rounder,
 		shardCoordinator,
-		singleSigner,
 		syncTimer,
 	)
 	if err != nil {
@@ -90,12 +88,12 @@ func NewWorker(
 		broadcastMessenger: broadcastMessenger,
 		consensusState:     consensusState,
 		forkDetector:       forkDetector,
-		keyGenerator:       keyGenerator,
 		marshalizer:        marshalizer,
+		messageValidator:   messageValidator,
 		rounder:            rounder,
 		shardCoordinator:   shardCoordinator,
-		singleSigner:       singleSigner,
 		syncTimer:          syncTimer,
+		roundRecorder:      recorder.NewNilRoundRecorder(),
 	}
 
 	wrk.executeMessageChannel = make(chan *consensus.Message)
@@ -119,11 +117,10 @@ func checkNewWorkerParams(
 	broadcastMessenger consensus.BroadcastMessenger,
 	consensusState *ConsensusState,
 	forkDetector process.ForkDetector,
-	keyGenerator crypto.KeyGenerator,
 	marshalizer marshal.Marshalizer,
+	messageValidator ConsensusMessageValidator,
 	rounder consensus.Rounder,
 	shardCoordinator sharding.Coordinator,
-	singleSigner crypto.SingleSigner,
 	syncTimer ntp.SyncTimer,
 ) error {
 	if consensusService == nil || consensusService.IsInterfaceNil() {
@@ -147,21 +144,18 @@ func checkNewWorkerParams(
 	if forkDetector == nil || forkDetector.IsInterfaceNil() {
 		return ErrNilForkDetector
 	}
-	if keyGenerator == nil || keyGenerator.IsInterfaceNil() {
-		return ErrNilKeyGenerator
-	}
 	if marshalizer == nil || marshalizer.IsInterfaceNil() {
 		return ErrNilMarshalizer
 	}
+	if messageValidator == nil || messageValidator.IsInterfaceNil() {
+		return ErrNilConsensusMessageValidator
+	}
 	if rounder == nil || rounder.IsInterfaceNil() {
 		return ErrNilRounder
 	}
 	if shardCoordinator == nil || shardCoordinator.IsInterfaceNil() {
 		return ErrNilShardCoordinator
 	}
-	if singleSigner == nil || singleSigner.IsInterfaceNil() {
-		return ErrNilSingleSigner
-	}
 	if syncTimer == nil || syncTimer.IsInterfaceNil() {
 		return ErrNilSyncTimer
 	}
@@ -169,6 +163,17 @@ func checkNewWorkerParams(
 	return nil
 }
 
+// SetRoundRecorder sets the recorder of the received consensus messages, which is by default a no-op one
+func (wrk *Worker) SetRoundRecorder(roundRecorder consensus.RoundRecorder) error {
+	if roundRecorder == nil || roundRecorder.IsInterfaceNil() {
+		return ErrNilRoundRecorder
+	}
+
+	wrk.roundRecorder = roundRecorder
+
+	return nil
+}
+
 func (wrk *Worker) receivedSyncState(isNodeSynchronized bool) {
 	if isNodeSynchronized {
 		if len(wrk.consensusStateChangedChannel) == 0 {
@@ -240,23 +245,21 @@ func (wrk *Worker) ProcessReceivedMessage(message p2p.MessageP2P, _ func(buffToS
 		cnsDta.RoundIndex,
 	))
 
-	senderOK := wrk.consensusState.IsNodeInEligibleList(string(cnsDta.PubKey))
-	if !senderOK {
-		return ErrSenderNotOk
-	}
-
-	if wrk.consensusState.RoundIndex > cnsDta.RoundIndex {
-		log.Debug(fmt.Sprintf("received late message %s from %s for round %d in round %d\n",
-			wrk.consensusService.GetStringValue(consensus.MessageType(cnsDta.MsgType)),
-			core.GetTrimmedPk(core.ToHex(cnsDta.PubKey)),
-			cnsDta.RoundIndex,
-			wrk.consensusState.RoundIndex))
-		return ErrMessageForPastRound
+	err = wrk.messageValidator.ValidateConsensusMessage(cnsDta, message.Peer())
+	//the own messages are recorded when they are broadcast
+	isSelfMessage := wrk.consensusState.SelfPubKey() == string(cnsDta.PubKey)
+	if !isSelfMessage {
+		wrk.roundRecorder.RecordReceivedMessage(cnsDta, err)
 	}
-
-	sigVerifErr := wrk.checkSignature(cnsDta)
-	if sigVerifErr != nil {
-		return ErrInvalidSignature
+	if err != nil {
+		if err == ErrMessageForPastRound {
+			log.Debug(fmt.Sprintf("received late message %s from %s for round %d in round %d\n",
+				wrk.consensusService.GetStringValue(msgType),
+				core.GetTrimmedPk(core.ToHex(cnsDta.PubKey)),
+				cnsDta.RoundIndex,
+				wrk.consensusState.RoundIndex))
+		}
+		return err
 	}
 
 	if wrk.consensusService.IsMessageWithBlockHeader(msgType) {
@@ -315,34 +318,6 @@ func (wrk *Worker) checkSelfState(cnsDta *consensus.Message) error {
 	return nil
 }
 
-func (wrk *Worker) checkSignature(cnsDta *consensus.Message) error {
-	if cnsDta == nil {
-		return ErrNilConsensusData
-	}
-	if cnsDta.PubKey == nil {
-		return ErrNilPublicKey
-	}
-	if cnsDta.Signature == nil {
-		return ErrNilSignature
-	}
-
-	pubKey, err := wrk.keyGenerator.PublicKeyFromByteArray(cnsDta.PubKey)
-	if err != nil {
-		return err
-	}
-
-	dataNoSig := *cnsDta
-	signature := cnsDta.Signature
-	dataNoSig.Signature = nil
-	dataNoSigString, err := wrk.marshalizer.Marshal(dataNoSig)
-	if err != nil {
-		return err
-	}
-
-	err = wrk.singleSigner.Verify(pubKey, dataNoSigString, signature)
-	return err
-}
-
 func (wrk *Worker) executeReceivedMessages(cnsDta *consensus.Message) {
 	wrk.mutReceivedMessages.Lock()
 
@@ -13,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/stretchr/testify/assert"
 )
@@ -50,6 +51,16 @@ func initWorker() *spos.Worker {
 	syncTimerMock := &mock.SyncTimerMock{}
 
 	bnService, _ := bn.NewConsensusService()
+	peerReputation, _ := spos.NewPeerReputation()
+	messageValidator, _ := spos.NewConsensusMessageValidator(
+		bnService,
+		consensusState,
+		bootstrapperMock,
+		keyGeneratorMock,
+		marshalizerMock,
+		singleSignerMock,
+		peerReputation,
+	)
 
 	sposWorker, _ := spos.NewWorker(
 		bnService,
@@ -59,11 +70,10 @@ func initWorker() *spos.Worker {
 		broadcastMessengerMock,
 		consensusState,
 		forkDetectorMock,
-		keyGeneratorMock,
 		marshalizerMock,
+		messageValidator,
 		rounderMock,
 		shardCoordinatorMock,
-		singleSignerMock,
 		syncTimerMock)
 
 	return sposWorker
@@ -90,11 +100,10 @@ func TestWorker_NewWorkerConsensusServiceNilShouldFail(t *testing.T) {
 	broadcastMessengerMock := &mock.BroadcastMessengerMock{}
 	consensusState := initConsensusState()
 	forkDetectorMock := &mock.ForkDetectorMock{}
-	keyGeneratorMock := &mock.KeyGenMock{}
 	marshalizerMock := mock.MarshalizerMock{}
+	messageValidator := &mock.ConsensusMessageValidatorStub{}
 	rounderMock := initRounderMock()
 	shardCoordinatorMock := mock.ShardCoordinatorMock{}
-	singleSignerMock := &mock.SingleSignerMock{}
 	syncTimerMock := &mock.SyncTimerMock{}
 
 	wrk, err := spos.NewWorker(
@@ -105,11 +114,10 @@ func TestWorker_NewWorkerConsensusServiceNilShouldFail(t *testing.T) {
 		broadcastMessengerMock,
 		consensusState,
 		forkDetectorMock,
-		keyGeneratorMock,
 		marshalizerMock,
+		messageValidator,
 		rounderMock,
 		shardCoordinatorMock,
-		singleSignerMock,
 		syncTimerMock)
 
 	assert.Nil(t, wrk)
@@ -124,11 +132,10 @@ func TestWorker_NewWorkerBlockChainNilShouldFail(t *testing.T) {
 	broadcastMessengerMock := &mock.BroadcastMessengerMock{}
 	consensusState := initConsensusState()
 	forkDetectorMock := &mock.ForkDetectorMock{}
-	keyGeneratorMock := &mock.KeyGenMock{}
 	marshalizerMock := mock.MarshalizerMock{}
+	messageValidator := &mock.ConsensusMessageValidatorStub{}
 	rounderMock := initRounderMock()
 	shardCoordinatorMock := mock.ShardCoordinatorMock{}
-	singleSignerMock := &mock.SingleSignerMock{}
 	syncTimerMock := &mock.SyncTimerMock{}
 	bnService, _ := bn.NewConsensusService()
 
@@ -140,11 +147,10 @@ func TestWorker_NewWorkerBlockChainNilShouldFail(t *testing.T) {
 		broadcastMessengerMock,
 		consensusState,
 		forkDetectorMock,
-		keyGeneratorMock,
 		marshalizerMock,
+		messageValidator,
 		rounderMock,
 		shardCoordinatorMock,
-		singleSignerMock,
 		syncTimerMock)
 
 	assert.Nil(t, wrk)
@@ -159,11 +165,10 @@ func TestWorker_NewWorkerBlockProcessorNilShouldFail(t *testing.T) {
 	broadcastMessengerMock := &mock.BroadcastMessengerMock{}
 	consensusState := initConsensusState()
 	forkDetectorMock := &mock.ForkDetectorMock{}
-	keyGeneratorMock := &mock.KeyGenMock{}
 	marshalizerMock := mock.MarshalizerMock{}
+	messageValidator := &mock.ConsensusMessageValidatorStub{}
 	rounderMock := initRounderMock()
 	shardCoordinatorMock := mock.ShardCoordinatorMock{}
-	singleSignerMock := &mock.SingleSignerMock{}
 	syncTimerMock := &mock.SyncTimerMock{}
 	bnService, _ := bn.NewConsensusService()
 
@@ -175,11 +180,10 @@ func TestWorker_NewWorkerBlockProcessorNilShouldFail(t *testing.T) {
 		broadcastMessengerMock,
 		consensusState,
 		forkDetectorMock,
-		keyGeneratorMock,
 		marshalizerMock,
+		messageValidator,
 		rounderMock,
 		shardCoordinatorMock,
-		singleSignerMock,
 		syncTimerMock)
 
 	assert.Nil(t, wrk)
@@ -194,11 +198,10 @@ func TestWorker_NewWorkerBootstrapperNilShouldFail(t *testing.T) {
 	broadcastMessengerMock := &mock.BroadcastMessengerMock{}
 	consensusState := initConsensusState()
 	forkDetectorMock := &mock.ForkDetectorMock{}
-	keyGeneratorMock := &mock.KeyGenMock{}
 	marshalizerMock := mock.MarshalizerMock{}
+	messageValidator := &mock.ConsensusMessageValidatorStub{}
 	rounderMock := initRounderMock()
 	shardCoordinatorMock := mock.ShardCoordinatorMock{}
-	singleSignerMock := &mock.SingleSignerMock{}
 	syncTimerMock := &mock.SyncTimerMock{}
 	bnService, _ := bn.NewConsensusService()
 
@@ -210,11 +213,10 @@ func TestWorker_NewWorkerBootstrapperNilShouldFail(t *testing.T) {
 		broadcastMessengerMock,
 		consensusState,
 		forkDetectorMock,
-		keyGeneratorMock,
 		marshalizerMock,
+		messageValidator,
 		rounderMock,
 		shardCoordinatorMock,
-		singleSignerMock,
 		syncTimerMock)
 
 	assert.Nil(t, wrk)
@@ -229,11 +231,10 @@ func TestWorker_NewWorkerBroadcastMessengerNilShouldFail(t *testing.T) {
 	bootstrapperMock := &mock.BootstrapperMock{}
 	consensusState := initConsensusState()
 	forkDetectorMock := &mock.ForkDetectorMock{}
-	keyGeneratorMock := &mock.KeyGenMock{}
 	marshalizerMock := mock.MarshalizerMock{}
+	messageValidator := &mock.ConsensusMessageValidatorStub{}
 	rounderMock := initRounderMock()
 	shardCoordinatorMock := mock.ShardCoordinatorMock{}
-	singleSignerMock := &mock.SingleSignerMock{}
 	syncTimerMock := &mock.SyncTimerMock{}
 	bnService, _ := bn.NewConsensusService()
 
@@ -245,11 +246,10 @@ func TestWorker_NewWorkerBroadcastMessengerNilShouldFail(t *testing.T) {
 		nil,
 		consensusState,
 		forkDetectorMock,
-		keyGeneratorMock,
 		marshalizerMock,
+		messageValidator,
 		rounderMock,
 		shardCoordinatorMock,
-		singleSignerMock,
 		syncTimerMock)
 
 	assert.Nil(t, wrk)
@@ -263,11 +263,10 @@ func TestWorker_NewWorkerConsensusStateNilShouldFail(t *testing.T) {
 	bootstrapperMock := &mock.BootstrapperMock{}
 	broadcastMessengerMock := &mock.BroadcastMessengerMock{}
 	forkDetectorMock := &mock.ForkDetectorMock{}
-	keyGeneratorMock := &mock.KeyGenMock{}
 	marshalizerMock := mock.MarshalizerMock{}
+	messageValidator := &mock.ConsensusMessageValidatorStub{}
 	rounderMock := initRounderMock()
 	shardCoordinatorMock := mock.ShardCoordinatorMock{}
-	singleSignerMock := &mock.SingleSignerMock{}
 	syncTimerMock := &mock.SyncTimerMock{}
 	bnService, _ := bn.NewConsensusService()
 
@@ -279,11 +278,10 @@ func TestWorker_NewWorkerConsensusStateNilShouldFail(t *testing.T) {
 		broadcastMessengerMock,
 		nil,
 		forkDetectorMock,
-		keyGeneratorMock,
 		marshalizerMock,
+		messageValidator,
 		rounderMock,
 		shardCoordinatorMock,
-		singleSignerMock,
 		syncTimerMock)
 
 	assert.Nil(t, wrk)
@@ -297,11 +295,10 @@ func TestWorker_NewWorkerForkDetectorNilShouldFail(t *testing.T) {
 	bootstrapperMock := &mock.BootstrapperMock{}
 	broadcastMessengerMock := &mock.BroadcastMessengerMock{}
 	consensusState := initConsensusState()
-	keyGeneratorMock := &mock.KeyGenMock{}
 	marshalizerMock := mock.MarshalizerMock{}
+	messageValidator := &mock.ConsensusMessageValidatorStub{}
 	rounderMock := initRounderMock()
 	shardCoordinatorMock := mock.ShardCoordinatorMock{}
-	singleSignerMock := &mock.SingleSignerMock{}
 	syncTimerMock := &mock.SyncTimerMock{}
 	bnService, _ := bn.NewConsensusService()
 
@@ -313,18 +310,17 @@ func TestWorker_NewWorkerForkDetectorNilShouldFail(t *testing.T) {
 		broadcastMessengerMock,
 		consensusState,
 		nil,
-		keyGeneratorMock,
 		marshalizerMock,
+		messageValidator,
 		rounderMock,
 		shardCoordinatorMock,
-		singleSignerMock,
 		syncTimerMock)
 
 	assert.Nil(t, wrk)
 	assert.Equal(t, spos.ErrNilForkDetector, err)
 }
 
-func TestWorker_NewWorkerKeyGeneratorNilShouldFail(t *testing.T) {
+func TestWorker_NewWorkerMessageValidatorNilShouldFail(t *testing.T) {
 	t.Parallel()
 	blockchainMock := &mock.BlockChainMock{}
 	blockProcessor := &mock.BlockProcessorMock{}
@@ -335,7 +331,6 @@ func TestWorker_NewWorkerKeyGeneratorNilShouldFail(t *testing.T) {
 	marshalizerMock := mock.MarshalizerMock{}
 	rounderMock := initRounderMock()
 	shardCoordinatorMock := mock.ShardCoordinatorMock{}
-	singleSignerMock := &mock.SingleSignerMock{}
 	syncTimerMock := &mock.SyncTimerMock{}
 	bnService, _ := bn.NewConsensusService()
 
@@ -347,15 +342,14 @@ func TestWorker_NewWorkerKeyGeneratorNilShouldFail(t *testing.T) {
 		broadcastMessengerMock,
 		consensusState,
 		forkDetectorMock,
-		nil,
 		marshalizerMock,
+		nil,
 		rounderMock,
 		shardCoordinatorMock,
-		singleSignerMock,
 		syncTimerMock)
 
 	assert.Nil(t, wrk)
-	assert.Equal(t, spos.ErrNilKeyGenerator, err)
+	assert.Equal(t, spos.ErrNilConsensusMessageValidator, err)
 }
 
 func TestWorker_NewWorkerMarshalizerNilShouldFail(t *testing.T) {
@@ -366,10 +360,9 @@ func TestWorker_NewWorkerMarshalizerNilShouldFail(t *testing.T) {
 	broadcastMessengerMock := &mock.BroadcastMessengerMock{}
 	consensusState := initConsensusState()
 	forkDetectorMock := &mock.ForkDetectorMock{}
-	keyGeneratorMock := &mock.KeyGenMock{}
 	rounderMock := initRounderMock()
 	shardCoordinatorMock := mock.ShardCoordinatorMock{}
-	singleSignerMock := &mock.SingleSignerMock{}
+	messageValidator := &mock.ConsensusMessageValidatorStub{}
 	syncTimerMock := &mock.SyncTimerMock{}
 	bnService, _ := bn.NewConsensusService()
 
@@ -381,11 +374,10 @@ func TestWorker_NewWorkerMarshalizerNilShouldFail(t *testing.T) {
 		broadcastMessengerMock,
 		consensusState,
 		forkDetectorMock,
-		keyGeneratorMock,
 		nil,
+		messageValidator,
 		rounderMock,
 		shardCoordinatorMock,
-		singleSignerMock,
 		syncTimerMock)
 
 	assert.Nil(t, wrk)
@@ -400,10 +392,9 @@ func TestWorker_NewWorkerRounderNilShouldFail(t *testing.T) {
 	broadcastMessengerMock := &mock.BroadcastMessengerMock{}
 	consensusState := initConsensusState()
 	forkDetectorMock := &mock.ForkDetectorMock{}
-	keyGeneratorMock := &mock.KeyGenMock{}
 	marshalizerMock := mock.MarshalizerMock{}
 	shardCoordinatorMock := mock.ShardCoordinatorMock{}
-	singleSignerMock := &mock.SingleSignerMock{}
+	messageValidator := &mock.ConsensusMessageValidatorStub{}
 	syncTimerMock := &mock.SyncTimerMock{}
 	bnService, _ := bn.NewConsensusService()
 
@@ -415,11 +406,10 @@ func TestWorker_NewWorkerRounderNilShouldFail(t *testing.T) {
 		broadcastMessengerMock,
 		consensusState,
 		forkDetectorMock,
-		keyGeneratorMock,
 		marshalizerMock,
+		messageValidator,
 		nil,
 		shardCoordinatorMock,
-		singleSignerMock,
 		syncTimerMock)
 
 	assert.Nil(t, wrk)
@@ -434,10 +424,9 @@ func TestWorker_NewWorkerShardCoordinatorNilShouldFail(t *testing.T) {
 	broadcastMessengerMock := &mock.BroadcastMessengerMock{}
 	consensusState := initConsensusState()
 	forkDetectorMock := &mock.ForkDetectorMock{}
-	keyGeneratorMock := &mock.KeyGenMock{}
 	marshalizerMock := mock.MarshalizerMock{}
+	messageValidator := &mock.ConsensusMessageValidatorStub{}
 	rounderMock := initRounderMock()
-	singleSignerMock := &mock.SingleSignerMock{}
 	syncTimerMock := &mock.SyncTimerMock{}
 	bnService, _ := bn.NewConsensusService()
 
@@ -449,51 +438,16 @@ func TestWorker_NewWorkerShardCoordinatorNilShouldFail(t *testing.T) {
 		broadcastMessengerMock,
 		consensusState,
 		forkDetectorMock,
-		keyGeneratorMock,
 		marshalizerMock,
+		messageValidator,
 		rounderMock,
 		nil,
-		singleSignerMock,
 		syncTimerMock)
 
 	assert.Nil(t, wrk)
 	assert.Equal(t, spos.ErrNilShardCoordinator, err)
 }
 
-func TestWorker_NewWorkerSingleSignerNilShouldFail(t *testing.T) {
-	t.Parallel()
-	blockchainMock := &mock.BlockChainMock{}
-	blockProcessor := &mock.BlockProcessorMock{}
-	bootstrapperMock := &mock.BootstrapperMock{}
-	broadcastMessengerMock := &mock.BroadcastMessengerMock{}
-	consensusState := initConsensusState()
-	forkDetectorMock := &mock.ForkDetectorMock{}
-	keyGeneratorMock := &mock.KeyGenMock{}
-	marshalizerMock := mock.MarshalizerMock{}
-	rounderMock := initRounderMock()
-	shardCoordinatorMock := mock.ShardCoordinatorMock{}
-	syncTimerMock := &mock.SyncTimerMock{}
-	bnService, _ := bn.NewConsensusService()
-
-	wrk, err := spos.NewWorker(
-		bnService,
-		blockchainMock,
-		blockProcessor,
-		bootstrapperMock,
-		broadcastMessengerMock,
-		consensusState,
-		forkDetectorMock,
-		keyGeneratorMock,
-		marshalizerMock,
-		rounderMock,
-		shardCoordinatorMock,
-		nil,
-		syncTimerMock)
-
-	assert.Nil(t, wrk)
-	assert.Equal(t, spos.ErrNilSingleSigner, err)
-}
-
 func TestWorker_NewWorkerSyncTimerNilShouldFail(t *testing.T) {
 	t.Parallel()
 	blockchainMock := &mock.BlockChainMock{}
@@ -502,11 +456,10 @@ func TestWorker_NewWorkerSyncTimerNilShouldFail(t *testing.T) {
 	broadcastMessengerMock := &mock.BroadcastMessengerMock{}
 	consensusState := initConsensusState()
 	forkDetectorMock := &mock.ForkDetectorMock{}
-	keyGeneratorMock := &mock.KeyGenMock{}
 	marshalizerMock := mock.MarshalizerMock{}
+	messageValidator := &mock.ConsensusMessageValidatorStub{}
 	rounderMock := initRounderMock()
 	shardCoordinatorMock := mock.ShardCoordinatorMock{}
-	singleSignerMock := &mock.SingleSignerMock{}
 	bnService, _ := bn.NewConsensusService()
 
 	wrk, err := spos.NewWorker(
@@ -517,11 +470,10 @@ func TestWorker_NewWorkerSyncTimerNilShouldFail(t *testing.T) {
 		broadcastMessengerMock,
 		consensusState,
 		forkDetectorMock,
-		keyGeneratorMock,
 		marshalizerMock,
+		messageValidator,
 		rounderMock,
 		shardCoordinatorMock,
-		singleSignerMock,
 		nil)
 
 	assert.Nil(t, wrk)
@@ -536,11 +488,10 @@ func TestWorker_NewWorkerShouldWork(t *testing.T) {
 	broadcastMessengerMock := &mock.BroadcastMessengerMock{}
 	consensusState := initConsensusState()
 	forkDetectorMock := &mock.ForkDetectorMock{}
-	keyGeneratorMock := &mock.KeyGenMock{}
 	marshalizerMock := mock.MarshalizerMock{}
+	messageValidator := &mock.ConsensusMessageValidatorStub{}
 	rounderMock := initRounderMock()
 	shardCoordinatorMock := mock.ShardCoordinatorMock{}
-	singleSignerMock := &mock.SingleSignerMock{}
 	syncTimerMock := &mock.SyncTimerMock{}
 	bnService, _ := bn.NewConsensusService()
 
@@ -552,11 +503,10 @@ func TestWorker_NewWorkerShouldWork(t *testing.T) {
 		broadcastMessengerMock,
 		consensusState,
 		forkDetectorMock,
-		keyGeneratorMock,
 		marshalizerMock,
+		messageValidator,
 		rounderMock,
 		shardCoordinatorMock,
-		singleSignerMock,
 		syncTimerMock)
 
 	assert.NotNil(t, wrk)
@@ -680,7 +630,7 @@ func TestWorker_ProcessReceivedMessageHeaderShouldRetNil(t *testing.T) {
 		nil,
 		[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
 		[]byte("sig"),
-		int(bn.MtUnknown),
+		int(bn.MtBlockHeader),
 		uint64(wrk.Rounder().TimeStamp().Unix()),
 		0,
 	)
@@ -844,164 +794,154 @@ func TestWorker_ProcessReceivedMessageOkValsShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
-func TestWorker_CheckSelfStateShouldErrMessageFromItself(t *testing.T) {
+func TestWorker_ProcessReceivedMessageShouldValidateWithOriginator(t *testing.T) {
 	t.Parallel()
 	wrk := *initWorker()
-	cnsMsg := consensus.NewConsensusMessage(
-		nil,
-		nil,
-		[]byte(wrk.ConsensusState().SelfPubKey()),
-		nil,
-		0,
-		0,
-		0,
-	)
-	err := wrk.CheckSelfState(cnsMsg)
-	assert.Equal(t, spos.ErrMessageFromItself, err)
-}
-
-func TestWorker_CheckSelfStateShouldErrRoundCanceled(t *testing.T) {
-	t.Parallel()
-	wrk := *initWorker()
-	wrk.ConsensusState().RoundCanceled = true
+	errExpected := errors.New("invalid consensus message")
+	var receivedOriginator p2p.PeerID
+	wrk.SetMessageValidator(&mock.ConsensusMessageValidatorStub{
+		ValidateConsensusMessageCalled: func(cnsDta *consensus.Message, originator p2p.PeerID) error {
+			receivedOriginator = originator
+			return errExpected
+		},
+	})
 	cnsMsg := consensus.NewConsensusMessage(
 		nil,
 		nil,
 		[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
-		nil,
-		0,
-		0,
+		[]byte("sig"),
+		int(bn.MtBlockHeader),
+		uint64(wrk.Rounder().TimeStamp().Unix()),
 		0,
 	)
-	err := wrk.CheckSelfState(cnsMsg)
-	assert.Equal(t, spos.ErrRoundCanceled, err)
-}
+	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+	err := wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff, PeerField: "originator"}, nil)
+	time.Sleep(time.Second)
 
-func TestWorker_CheckSelfStateShouldNotErr(t *testing.T) {
-	t.Parallel()
-	wrk := *initWorker()
-	cnsMsg := consensus.NewConsensusMessage(
-		nil,
-		nil,
-		[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
-		nil,
-		0,
-		0,
-		0,
-	)
-	err := wrk.CheckSelfState(cnsMsg)
-	assert.Nil(t, err)
+	assert.Equal(t, errExpected, err)
+	assert.Equal(t, p2p.PeerID("originator"), receivedOriginator)
+	assert.Equal(t, 0, len(wrk.ReceivedMessages()[bn.MtBlockHeader]))
 }
 
-func TestWorker_CheckSignatureShouldReturnErrNilConsensusData(t *testing.T) {
+func TestWorker_SetRoundRecorderNilShouldErr(t *testing.T) {
 	t.Parallel()
 	wrk := *initWorker()
-	err := wrk.CheckSignature(nil)
 
-	assert.Equal(t, spos.ErrNilConsensusData, err)
+	err := wrk.SetRoundRecorder(nil)
+
+	assert.Equal(t, spos.ErrNilRoundRecorder, err)
 }
 
-func TestWorker_CheckSignatureShouldReturnErrNilPublicKey(t *testing.T) {
+func TestWorker_ProcessReceivedMessageShouldRecordMessagesWithTheValidationResult(t *testing.T) {
 	t.Parallel()
 	wrk := *initWorker()
-	blk := make(block.Body, 0)
-	message, _ := mock.MarshalizerMock{}.Marshal(blk)
+	recordedMessages := make([]*consensus.Message, 0)
+	recordedErrors := make([]error, 0)
+	err := wrk.SetRoundRecorder(&mock.RoundRecorderStub{
+		RecordReceivedMessageCalled: func(message *consensus.Message, validationErr error) {
+			recordedMessages = append(recordedMessages, message)
+			recordedErrors = append(recordedErrors, validationErr)
+		},
+	})
+	assert.Nil(t, err)
+
 	cnsMsg := consensus.NewConsensusMessage(
-		message,
 		nil,
 		nil,
+		[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
 		[]byte("sig"),
-		int(bn.MtBlockBody),
+		int(bn.MtBlockHeader),
 		uint64(wrk.Rounder().TimeStamp().Unix()),
 		0,
 	)
-	err := wrk.CheckSignature(cnsMsg)
+	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+	_ = wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff}, nil)
+
+	wrk.SetMessageValidator(&mock.ConsensusMessageValidatorStub{
+		ValidateConsensusMessageCalled: func(cnsDta *consensus.Message, originator p2p.PeerID) error {
+			return spos.ErrInvalidSignature
+		},
+	})
+	_ = wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff}, nil)
 
-	assert.Equal(t, spos.ErrNilPublicKey, err)
+	assert.Equal(t, 2, len(recordedMessages))
+	assert.Equal(t, cnsMsg.PubKey, recordedMessages[0].PubKey)
+	assert.Nil(t, recordedErrors[0])
+	assert.Equal(t, cnsMsg.PubKey, recordedMessages[1].PubKey)
+	assert.Equal(t, spos.ErrInvalidSignature, recordedErrors[1])
 }
 
-func TestWorker_CheckSignatureShouldReturnErrNilSignature(t *testing.T) {
+func TestWorker_ProcessReceivedMessageShouldNotRecordOwnMessages(t *testing.T) {
 	t.Parallel()
 	wrk := *initWorker()
-	blk := make(block.Body, 0)
-	message, _ := mock.MarshalizerMock{}.Marshal(blk)
+	numRecordedMessages := 0
+	_ = wrk.SetRoundRecorder(&mock.RoundRecorderStub{
+		RecordReceivedMessageCalled: func(message *consensus.Message, validationErr error) {
+			numRecordedMessages++
+		},
+	})
+
 	cnsMsg := consensus.NewConsensusMessage(
-		message,
 		nil,
-		[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
 		nil,
-		int(bn.MtBlockBody),
+		[]byte(wrk.ConsensusState().SelfPubKey()),
+		[]byte("sig"),
+		int(bn.MtBlockHeader),
 		uint64(wrk.Rounder().TimeStamp().Unix()),
 		0,
 	)
-	err := wrk.CheckSignature(cnsMsg)
+	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+	_ = wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff}, nil)
 
-	assert.Equal(t, spos.ErrNilSignature, err)
+	assert.Equal(t, 0, numRecordedMessages)
 }
 
-func TestWorker_CheckSignatureShouldReturnPublicKeyFromByteArrayErr(t *testing.T) {
+func TestWorker_CheckSelfStateShouldErrMessageFromItself(t *testing.T) {
 	t.Parallel()
 	wrk := *initWorker()
-	keyGeneratorMock, _, _ := mock.InitKeys()
-	err := errors.New("error public key from byte array")
-	keyGeneratorMock.PublicKeyFromByteArrayMock = func(b []byte) (crypto.PublicKey, error) {
-		return nil, err
-	}
-	wrk.SetKeyGenerator(keyGeneratorMock)
-	blk := make(block.Body, 0)
-	message, _ := mock.MarshalizerMock{}.Marshal(blk)
 	cnsMsg := consensus.NewConsensusMessage(
-		message,
 		nil,
-		[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
-		[]byte("sig"),
-		int(bn.MtBlockBody),
-		uint64(wrk.Rounder().TimeStamp().Unix()),
+		nil,
+		[]byte(wrk.ConsensusState().SelfPubKey()),
+		nil,
+		0,
+		0,
 		0,
 	)
-	err2 := wrk.CheckSignature(cnsMsg)
-
-	assert.Equal(t, err, err2)
+	err := wrk.CheckSelfState(cnsMsg)
+	assert.Equal(t, spos.ErrMessageFromItself, err)
 }
 
-func TestWorker_CheckSignatureShouldReturnMarshalizerErr(t *testing.T) {
+func TestWorker_CheckSelfStateShouldErrRoundCanceled(t *testing.T) {
 	t.Parallel()
 	wrk := *initWorker()
-	marshalizerMock := mock.MarshalizerMock{}
-	marshalizerMock.Fail = true
-	wrk.SetMarshalizer(marshalizerMock)
-	blk := make(block.Body, 0)
-	message, _ := mock.MarshalizerMock{}.Marshal(blk)
+	wrk.ConsensusState().RoundCanceled = true
 	cnsMsg := consensus.NewConsensusMessage(
-		message,
+		nil,
 		nil,
 		[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
-		[]byte("sig"),
-		int(bn.MtBlockBody),
-		uint64(wrk.Rounder().TimeStamp().Unix()),
+		nil,
+		0,
+		0,
 		0,
 	)
-	err := wrk.CheckSignature(cnsMsg)
-
-	assert.Equal(t, mock.ErrMockMarshalizer, err)
+	err := wrk.CheckSelfState(cnsMsg)
+	assert.Equal(t, spos.ErrRoundCanceled, err)
 }
 
-func TestWorker_CheckSignatureShouldReturnNilErr(t *testing.T) {
+func TestWorker_CheckSelfStateShouldNotErr(t *testing.T) {
 	t.Parallel()
 	wrk := *initWorker()
-	blk := make(block.Body, 0)
-	message, _ := mock.MarshalizerMock{}.Marshal(blk)
 	cnsMsg := consensus.NewConsensusMessage(
-		message,
+		nil,
 		nil,
 		[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
-		[]byte("sig"),
-		int(bn.MtBlockBody),
-		uint64(wrk.Rounder().TimeStamp().Unix()),
+		nil,
+		0,
+		0,
 		0,
 	)
-	err := wrk.CheckSignature(cnsMsg)
-
+	err := wrk.CheckSelfState(cnsMsg)
 	assert.Nil(t, err)
 }
 
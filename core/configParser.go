@@ -17,6 +17,16 @@ func LoadP2PConfig(filepath string) (*config.P2PConfig, error) {
 	return cfg, nil
 }
 
+// LoadSeedNodeConfig returns a SeedNodeConfig by reading the config file provided
+func LoadSeedNodeConfig(filepath string) (*config.SeedNodeConfig, error) {
+	cfg := &config.SeedNodeConfig{}
+	err := LoadTomlFile(cfg, filepath, log)
+	if err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
 // LoadServersPConfig returns a ServersConfig by reading the config file provided
 func LoadServersPConfig(filepath string) (*config.ServersConfig, error) {
 	cfg := &config.ServersConfig{}
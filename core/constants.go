@@ -38,6 +38,12 @@ const MetricProbableHighestNonce = "erd_probable_highest_nonce"
 // MetricNumConnectedPeers is the metric for monitoring the number of connected peers
 const MetricNumConnectedPeers = "erd_num_connected_peers"
 
+// MetricNumStaticPeers is the metric for monitoring the number of peers the static list discovery keeps connections with
+const MetricNumStaticPeers = "erd_num_static_peers"
+
+// MetricNumReachableStaticPeers is the metric for monitoring the number of reachable peers from the static list
+const MetricNumReachableStaticPeers = "erd_num_reachable_static_peers"
+
 // MetricSynchronizedRound is the metric for monitoring the synchronized round of a node
 const MetricSynchronizedRound = "erd_synchronized_round"
 
@@ -182,3 +188,35 @@ const MetricLeaderPercentage = "erd_metric_leader_percentage"
 
 //MetricCommunityPercentage is the metric for community rewards percentage
 const MetricCommunityPercentage = "erd_metric_community_percentage"
+
+//MetricBlockReceivedTime is the metric for the time, in milliseconds from the start of the round, in which the proposed
+//block was received and processed, or sent in case of the leader
+const MetricBlockReceivedTime = "erd_consensus_block_received_time"
+
+//MetricSignaturesGatheredTime is the metric for the time, in milliseconds from the start of the round, in which the
+//signatures threshold was reached
+const MetricSignaturesGatheredTime = "erd_consensus_signatures_gathered_time"
+
+//MetricCommitTime is the metric for the time, in milliseconds from the start of the round, in which the block was
+//committed by the consensus
+const MetricCommitTime = "erd_consensus_commit_time"
+
+//MetricNumSigVerifications is the metric for the number of signatures of the intercepted data verified by the node
+const MetricNumSigVerifications = "erd_num_sig_verifications"
+
+//MetricNumSigVerificationCacheHits is the metric for the number of signature verifications of the intercepted data
+//skipped because the same data had already been verified
+const MetricNumSigVerificationCacheHits = "erd_num_sig_verification_cache_hits"
+
+//MetricSigVerificationsPerSecond is the metric for the throughput, in signatures per second, of the last batch of
+//intercepted data verified by the node
+const MetricSigVerificationsPerSecond = "erd_sig_verifications_per_second"
+
+//MetricIndexerQueueDepth is the metric for the number of blocks waiting in the indexing queue
+const MetricIndexerQueueDepth = "erd_indexer_queue_depth"
+
+//MetricIndexerFailedAttempts is the metric for the number of failed attempts to index a block
+const MetricIndexerFailedAttempts = "erd_indexer_failed_attempts"
+
+//MetricIndexerLastIndexedNonce is the metric for the nonce of the last block acknowledged by the indexer
+const MetricIndexerLastIndexedNonce = "erd_indexer_last_indexed_nonce"
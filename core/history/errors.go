@@ -0,0 +1,29 @@
+package history
+
+import (
+	"errors"
+)
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilAddressConverter signals that a nil address converter has been provided
+var ErrNilAddressConverter = errors.New("nil address converter")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrNilHeader signals that a nil header has been provided
+var ErrNilHeader = errors.New("nil header")
+
+// ErrNilHeaderHash signals that a nil header hash has been provided
+var ErrNilHeaderHash = errors.New("nil header hash")
+
+// ErrInvalidLimit signals that an invalid maximum number of records per page has been requested
+var ErrInvalidLimit = errors.New("invalid limit")
+
+// ErrInvalidCursor signals that the requested page starts after the last record of the address
+var ErrInvalidCursor = errors.New("invalid cursor, it exceeds the number of records of the address")
@@ -0,0 +1,12 @@
+package history
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// Handler defines what the block processors need in order to maintain the transaction history of the accounts
+type Handler interface {
+	SaveBlock(header data.HeaderHandler, headerHash []byte, txs map[string]data.TransactionHandler) error
+	RemoveBlock(headerHash []byte) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,419 @@
+package history
+
+import (
+	"encoding/binary"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// DirectionIn marks the records of the transactions received by an address
+const DirectionIn = "in"
+
+// DirectionOut marks the records of the transactions sent by an address
+const DirectionOut = "out"
+
+// DirectionSelf marks the records of the transactions sent by an address to itself
+const DirectionSelf = "self"
+
+var countKeyPrefix = []byte("count_")
+var recordKeyPrefix = []byte("record_")
+var blockKeyPrefix = []byte("block_")
+
+// ArgTransactionHistory holds all dependencies required by the transaction history
+type ArgTransactionHistory struct {
+	Storer           storage.Storer
+	Marshalizer      marshal.Marshalizer
+	AddressConverter state.AddressConverter
+	ShardCoordinator sharding.Coordinator
+}
+
+// Record is an entry of an address' transaction history
+type Record struct {
+	Nonce      uint64
+	TxHash     []byte
+	BlockNonce uint64
+	Direction  string
+}
+
+// Page holds a part of an address' transaction history, ordered from the newest to the oldest record. NextFrom is
+// the cursor of the next page, or 0 if there are no older records
+type Page struct {
+	Records  []*Record
+	NextFrom uint64
+}
+
+// blockRecords holds the records saved for a block, kept under the block's hash so that saving the block again
+// rewrites the same records and reverting the block removes them
+type blockRecords struct {
+	Addresses []*addressRecords
+}
+
+// addressRecords holds the records of a block for an address, numbered starting with FirstPosition
+type addressRecords struct {
+	Address       []byte
+	FirstPosition uint64
+	Records       []*Record
+}
+
+// TransactionHistory maintains, for the addresses of the node's shard, the list of the committed transactions.
+// The records of an address are numbered from 1 in the order they were committed, so that the number of a record
+// can be used as a stable cursor while new records are appended. The records of the reverted blocks are removed,
+// the pages skipping them
+type TransactionHistory struct {
+	storer           storage.Storer
+	marshalizer      marshal.Marshalizer
+	addrConverter    state.AddressConverter
+	shardCoordinator sharding.Coordinator
+
+	mutHistory sync.RWMutex
+}
+
+// NewTransactionHistory creates a new transaction history
+func NewTransactionHistory(args ArgTransactionHistory) (*TransactionHistory, error) {
+	if args.Storer == nil || args.Storer.IsInterfaceNil() {
+		return nil, ErrNilStorer
+	}
+	if args.Marshalizer == nil || args.Marshalizer.IsInterfaceNil() {
+		return nil, ErrNilMarshalizer
+	}
+	if args.AddressConverter == nil || args.AddressConverter.IsInterfaceNil() {
+		return nil, ErrNilAddressConverter
+	}
+	if args.ShardCoordinator == nil || args.ShardCoordinator.IsInterfaceNil() {
+		return nil, ErrNilShardCoordinator
+	}
+
+	return &TransactionHistory{
+		storer:           args.Storer,
+		marshalizer:      args.Marshalizer,
+		addrConverter:    args.AddressConverter,
+		shardCoordinator: args.ShardCoordinator,
+	}, nil
+}
+
+// SaveBlock appends the transactions of a committed block to the history of their sender and receiver, if these
+// belong to the node's shard. Saving a block which was already saved rewrites its records in place
+func (th *TransactionHistory) SaveBlock(
+	header data.HeaderHandler,
+	headerHash []byte,
+	txs map[string]data.TransactionHandler,
+) error {
+	if header == nil || header.IsInterfaceNil() {
+		return ErrNilHeader
+	}
+	if len(headerHash) == 0 {
+		return ErrNilHeaderHash
+	}
+
+	th.mutHistory.Lock()
+	defer th.mutHistory.Unlock()
+
+	saved, err := th.getBlockRecords(headerHash)
+	if err != nil {
+		return err
+	}
+	if saved == nil {
+		saved, err = th.newBlockRecords(header, txs)
+		if err != nil {
+			return err
+		}
+
+		// the block records are written first, so that an interrupted save is completed by saving the block again
+		err = th.putBlockRecords(headerHash, saved)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, addrRecords := range saved.Addresses {
+		err = th.writeRecords(addrRecords)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RemoveBlock removes the records of a reverted block. The count of an address is lowered if the block's records
+// were its newest ones, as it happens when the blocks are reverted starting with the last committed one
+func (th *TransactionHistory) RemoveBlock(headerHash []byte) error {
+	if len(headerHash) == 0 {
+		return ErrNilHeaderHash
+	}
+
+	th.mutHistory.Lock()
+	defer th.mutHistory.Unlock()
+
+	saved, err := th.getBlockRecords(headerHash)
+	if err != nil || saved == nil {
+		return err
+	}
+
+	for _, addrRecords := range saved.Addresses {
+		err = th.removeRecords(addrRecords)
+		if err != nil {
+			return err
+		}
+	}
+
+	return th.storer.Remove(blockKey(headerHash))
+}
+
+func (th *TransactionHistory) newBlockRecords(
+	header data.HeaderHandler,
+	txs map[string]data.TransactionHandler,
+) (*blockRecords, error) {
+
+	txHashes := make([]string, 0, len(txs))
+	for txHash := range txs {
+		txHashes = append(txHashes, txHash)
+	}
+	sort.Strings(txHashes)
+
+	recordsByAddress := make(map[string][]*Record)
+	for _, txHash := range txHashes {
+		tx := txs[txHash]
+		if tx == nil || tx.IsInterfaceNil() {
+			continue
+		}
+
+		newRecord := func(direction string) *Record {
+			return &Record{
+				Nonce:      tx.GetNonce(),
+				TxHash:     []byte(txHash),
+				BlockNonce: header.GetNonce(),
+				Direction:  direction,
+			}
+		}
+
+		sender := string(tx.GetSndAddress())
+		receiver := string(tx.GetRecvAddress())
+		if sender == receiver {
+			if th.isInSelfShard(sender) {
+				recordsByAddress[sender] = append(recordsByAddress[sender], newRecord(DirectionSelf))
+			}
+			continue
+		}
+
+		if th.isInSelfShard(sender) {
+			recordsByAddress[sender] = append(recordsByAddress[sender], newRecord(DirectionOut))
+		}
+		if th.isInSelfShard(receiver) {
+			recordsByAddress[receiver] = append(recordsByAddress[receiver], newRecord(DirectionIn))
+		}
+	}
+
+	addresses := make([]string, 0, len(recordsByAddress))
+	for address := range recordsByAddress {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	saved := &blockRecords{Addresses: make([]*addressRecords, 0, len(addresses))}
+	for _, address := range addresses {
+		count, err := th.getCount([]byte(address))
+		if err != nil {
+			return nil, err
+		}
+
+		saved.Addresses = append(saved.Addresses, &addressRecords{
+			Address:       []byte(address),
+			FirstPosition: count + 1,
+			Records:       recordsByAddress[address],
+		})
+	}
+
+	return saved, nil
+}
+
+// GetTransactions returns at most limit records of the history of the provided hex encoded address, starting with
+// the record numbered from and going towards the older ones. A from value of 0 starts with the newest record
+func (th *TransactionHistory) GetTransactions(address string, from uint64, limit int) (*Page, error) {
+	if limit < 1 {
+		return nil, ErrInvalidLimit
+	}
+
+	addr, err := th.addrConverter.CreateAddressFromHex(address)
+	if err != nil {
+		return nil, err
+	}
+
+	th.mutHistory.RLock()
+	defer th.mutHistory.RUnlock()
+
+	count, err := th.getCount(addr.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	if from > count {
+		return nil, ErrInvalidCursor
+	}
+	if from == 0 {
+		from = count
+	}
+
+	page := &Page{Records: make([]*Record, 0)}
+	position := from
+	for ; position > 0 && len(page.Records) < limit; position-- {
+		key := recordKey(addr.Bytes(), position)
+		if th.storer.Has(key) != nil {
+			// the record belonged to a reverted block
+			continue
+		}
+
+		buff, err := th.storer.Get(key)
+		if err != nil {
+			return nil, err
+		}
+
+		record := &Record{}
+		err = th.marshalizer.Unmarshal(record, buff)
+		if err != nil {
+			return nil, err
+		}
+
+		page.Records = append(page.Records, record)
+	}
+	page.NextFrom = position
+
+	return page, nil
+}
+
+// writeRecords writes the records before the new count, so that an interrupted write leaves the history of the
+// address unchanged
+func (th *TransactionHistory) writeRecords(addrRecords *addressRecords) error {
+	for i, record := range addrRecords.Records {
+		buff, err := th.marshalizer.Marshal(record)
+		if err != nil {
+			return err
+		}
+
+		err = th.storer.Put(recordKey(addrRecords.Address, addrRecords.FirstPosition+uint64(i)), buff)
+		if err != nil {
+			return err
+		}
+	}
+
+	count, err := th.getCount(addrRecords.Address)
+	if err != nil {
+		return err
+	}
+
+	lastPosition := addrRecords.FirstPosition + uint64(len(addrRecords.Records)) - 1
+	if count >= lastPosition {
+		return nil
+	}
+
+	return th.storer.Put(countKey(addrRecords.Address), uint64ToBytes(lastPosition))
+}
+
+func (th *TransactionHistory) removeRecords(addrRecords *addressRecords) error {
+	for i := range addrRecords.Records {
+		err := th.storer.Remove(recordKey(addrRecords.Address, addrRecords.FirstPosition+uint64(i)))
+		if err != nil {
+			return err
+		}
+	}
+
+	count, err := th.getCount(addrRecords.Address)
+	if err != nil {
+		return err
+	}
+
+	lastPosition := addrRecords.FirstPosition + uint64(len(addrRecords.Records)) - 1
+	if count != lastPosition {
+		return nil
+	}
+
+	return th.storer.Put(countKey(addrRecords.Address), uint64ToBytes(addrRecords.FirstPosition-1))
+}
+
+func (th *TransactionHistory) getBlockRecords(headerHash []byte) (*blockRecords, error) {
+	key := blockKey(headerHash)
+	if th.storer.Has(key) != nil {
+		return nil, nil
+	}
+
+	buff, err := th.storer.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	saved := &blockRecords{}
+	err = th.marshalizer.Unmarshal(saved, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return saved, nil
+}
+
+func (th *TransactionHistory) putBlockRecords(headerHash []byte, saved *blockRecords) error {
+	buff, err := th.marshalizer.Marshal(saved)
+	if err != nil {
+		return err
+	}
+
+	return th.storer.Put(blockKey(headerHash), buff)
+}
+
+func (th *TransactionHistory) getCount(address []byte) (uint64, error) {
+	key := countKey(address)
+	if th.storer.Has(key) != nil {
+		return 0, nil
+	}
+
+	buff, err := th.storer.Get(key)
+	if err != nil {
+		return 0, err
+	}
+
+	return binary.BigEndian.Uint64(buff), nil
+}
+
+func (th *TransactionHistory) isInSelfShard(address string) bool {
+	if len(address) == 0 {
+		return false
+	}
+
+	addr, err := th.addrConverter.CreateAddressFromPublicKeyBytes([]byte(address))
+	if err != nil {
+		return false
+	}
+
+	return th.shardCoordinator.ComputeId(addr) == th.shardCoordinator.SelfId()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (th *TransactionHistory) IsInterfaceNil() bool {
+	if th == nil {
+		return true
+	}
+	return false
+}
+
+func countKey(address []byte) []byte {
+	return append(append([]byte{}, countKeyPrefix...), address...)
+}
+
+func recordKey(address []byte, position uint64) []byte {
+	key := append(append([]byte{}, recordKeyPrefix...), address...)
+	return append(key, uint64ToBytes(position)...)
+}
+
+func blockKey(headerHash []byte) []byte {
+	return append(append([]byte{}, blockKeyPrefix...), headerHash...)
+}
+
+func uint64ToBytes(value uint64) []byte {
+	buff := make([]byte, 8)
+	binary.BigEndian.PutUint64(buff, value)
+	return buff
+}
@@ -0,0 +1,228 @@
+package history_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/history"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state/addressConverters"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+)
+
+const addressLen = 32
+
+// with 2 shards, the addresses ending in an even byte belong to shard 0
+var selfShardAddress = bytes.Repeat([]byte{2}, addressLen)
+var otherSelfShardAddress = bytes.Repeat([]byte{4}, addressLen)
+var crossShardAddress = bytes.Repeat([]byte{1}, addressLen)
+
+func createArgTransactionHistory() history.ArgTransactionHistory {
+	cache, _ := lrucache.NewCache(100)
+	persister, _ := memorydb.New()
+	storer, _ := storageUnit.NewStorageUnit(cache, persister)
+	converter, _ := addressConverters.NewPlainAddressConverter(addressLen, "")
+	shardCoordinator, _ := sharding.NewMultiShardCoordinator(2, 0)
+
+	return history.ArgTransactionHistory{
+		Storer:           storer,
+		Marshalizer:      &mock.MarshalizerMock{},
+		AddressConverter: converter,
+		ShardCoordinator: shardCoordinator,
+	}
+}
+
+func createTx(nonce uint64, sender []byte, receiver []byte) data.TransactionHandler {
+	return &transaction.Transaction{Nonce: nonce, SndAddr: sender, RcvAddr: receiver}
+}
+
+func TestNewTransactionHistory_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgTransactionHistory()
+	args.Storer = nil
+	th, err := history.NewTransactionHistory(args)
+	assert.Nil(t, th)
+	assert.Equal(t, history.ErrNilStorer, err)
+
+	args = createArgTransactionHistory()
+	args.Marshalizer = nil
+	_, err = history.NewTransactionHistory(args)
+	assert.Equal(t, history.ErrNilMarshalizer, err)
+
+	args = createArgTransactionHistory()
+	args.AddressConverter = nil
+	_, err = history.NewTransactionHistory(args)
+	assert.Equal(t, history.ErrNilAddressConverter, err)
+
+	args = createArgTransactionHistory()
+	args.ShardCoordinator = nil
+	_, err = history.NewTransactionHistory(args)
+	assert.Equal(t, history.ErrNilShardCoordinator, err)
+}
+
+func TestTransactionHistory_SaveBlockShouldRecordOnlyTheSelfShardAddresses(t *testing.T) {
+	t.Parallel()
+
+	th, _ := history.NewTransactionHistory(createArgTransactionHistory())
+	err := th.SaveBlock(&block.Header{Nonce: 5}, []byte("hash5"), map[string]data.TransactionHandler{
+		"tx1": createTx(1, selfShardAddress, otherSelfShardAddress),
+		"tx2": createTx(2, selfShardAddress, selfShardAddress),
+		"tx3": createTx(3, crossShardAddress, selfShardAddress),
+	})
+	assert.Nil(t, err)
+
+	page, err := th.GetTransactions(hex.EncodeToString(selfShardAddress), 0, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), page.NextFrom)
+	assert.Equal(t, []*history.Record{
+		{Nonce: 3, TxHash: []byte("tx3"), BlockNonce: 5, Direction: history.DirectionIn},
+		{Nonce: 2, TxHash: []byte("tx2"), BlockNonce: 5, Direction: history.DirectionSelf},
+		{Nonce: 1, TxHash: []byte("tx1"), BlockNonce: 5, Direction: history.DirectionOut},
+	}, page.Records)
+
+	page, _ = th.GetTransactions(hex.EncodeToString(otherSelfShardAddress), 0, 10)
+	assert.Equal(t, 1, len(page.Records))
+	assert.Equal(t, history.DirectionIn, page.Records[0].Direction)
+
+	page, _ = th.GetTransactions(hex.EncodeToString(crossShardAddress), 0, 10)
+	assert.Equal(t, 0, len(page.Records))
+}
+
+func TestTransactionHistory_GetTransactionsShouldPaginateWithStableCursors(t *testing.T) {
+	t.Parallel()
+
+	th, _ := history.NewTransactionHistory(createArgTransactionHistory())
+	for nonce := uint64(1); nonce <= 5; nonce++ {
+		_ = th.SaveBlock(&block.Header{Nonce: nonce}, []byte{byte(nonce)}, map[string]data.TransactionHandler{
+			string([]byte{byte(nonce)}): createTx(nonce, selfShardAddress, crossShardAddress),
+		})
+	}
+	address := hex.EncodeToString(selfShardAddress)
+
+	page, err := th.GetTransactions(address, 0, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(page.Records))
+	assert.Equal(t, uint64(5), page.Records[0].Nonce)
+	assert.Equal(t, uint64(4), page.Records[1].Nonce)
+	assert.Equal(t, uint64(3), page.NextFrom)
+
+	// a new record must not shift the following pages
+	_ = th.SaveBlock(&block.Header{Nonce: 6}, []byte("hash6"), map[string]data.TransactionHandler{
+		"tx6": createTx(6, selfShardAddress, crossShardAddress),
+	})
+
+	page, _ = th.GetTransactions(address, page.NextFrom, 2)
+	assert.Equal(t, uint64(3), page.Records[0].Nonce)
+	assert.Equal(t, uint64(2), page.Records[1].Nonce)
+	assert.Equal(t, uint64(1), page.NextFrom)
+
+	page, _ = th.GetTransactions(address, page.NextFrom, 2)
+	assert.Equal(t, 1, len(page.Records))
+	assert.Equal(t, uint64(1), page.Records[0].Nonce)
+	assert.Equal(t, uint64(0), page.NextFrom)
+}
+
+func TestTransactionHistory_GetTransactionsInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	th, _ := history.NewTransactionHistory(createArgTransactionHistory())
+	_ = th.SaveBlock(&block.Header{Nonce: 1}, []byte("hash1"), map[string]data.TransactionHandler{
+		"tx": createTx(1, selfShardAddress, crossShardAddress),
+	})
+	address := hex.EncodeToString(selfShardAddress)
+
+	_, err := th.GetTransactions(address, 0, 0)
+	assert.Equal(t, history.ErrInvalidLimit, err)
+
+	_, err = th.GetTransactions(address, 2, 10)
+	assert.Equal(t, history.ErrInvalidCursor, err)
+
+	_, err = th.GetTransactions("not hex", 0, 10)
+	assert.NotNil(t, err)
+}
+
+func TestTransactionHistory_SaveBlockNilHeaderHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	th, _ := history.NewTransactionHistory(createArgTransactionHistory())
+	err := th.SaveBlock(&block.Header{Nonce: 1}, nil, map[string]data.TransactionHandler{
+		"tx": createTx(1, selfShardAddress, crossShardAddress),
+	})
+
+	assert.Equal(t, history.ErrNilHeaderHash, err)
+}
+
+func TestTransactionHistory_SaveBlockTwiceShouldNotDuplicateTheRecords(t *testing.T) {
+	t.Parallel()
+
+	th, _ := history.NewTransactionHistory(createArgTransactionHistory())
+	txs := map[string]data.TransactionHandler{
+		"tx": createTx(1, selfShardAddress, crossShardAddress),
+	}
+	err := th.SaveBlock(&block.Header{Nonce: 1}, []byte("hash1"), txs)
+	assert.Nil(t, err)
+	err = th.SaveBlock(&block.Header{Nonce: 1}, []byte("hash1"), txs)
+	assert.Nil(t, err)
+
+	page, _ := th.GetTransactions(hex.EncodeToString(selfShardAddress), 0, 10)
+	assert.Equal(t, 1, len(page.Records))
+}
+
+func TestTransactionHistory_RemoveBlockShouldRemoveTheRecordsOfTheRevertedBlock(t *testing.T) {
+	t.Parallel()
+
+	th, _ := history.NewTransactionHistory(createArgTransactionHistory())
+	_ = th.SaveBlock(&block.Header{Nonce: 1}, []byte("hash1"), map[string]data.TransactionHandler{
+		"tx1": createTx(1, selfShardAddress, crossShardAddress),
+	})
+	_ = th.SaveBlock(&block.Header{Nonce: 2}, []byte("hash2"), map[string]data.TransactionHandler{
+		"tx2": createTx(2, selfShardAddress, crossShardAddress),
+	})
+	address := hex.EncodeToString(selfShardAddress)
+
+	err := th.RemoveBlock([]byte("hash2"))
+	assert.Nil(t, err)
+
+	page, _ := th.GetTransactions(address, 0, 10)
+	assert.Equal(t, 1, len(page.Records))
+	assert.Equal(t, []byte("tx1"), page.Records[0].TxHash)
+
+	// the block committed instead of the reverted one takes the freed positions
+	_ = th.SaveBlock(&block.Header{Nonce: 2}, []byte("other hash2"), map[string]data.TransactionHandler{
+		"other tx2": createTx(2, selfShardAddress, crossShardAddress),
+	})
+	page, _ = th.GetTransactions(address, 0, 10)
+	assert.Equal(t, 2, len(page.Records))
+	assert.Equal(t, []byte("other tx2"), page.Records[0].TxHash)
+
+	err = th.RemoveBlock([]byte("unknown hash"))
+	assert.Nil(t, err)
+}
+
+func TestTransactionHistory_GetTransactionsShouldSkipTheRecordsOfTheRevertedBlocks(t *testing.T) {
+	t.Parallel()
+
+	th, _ := history.NewTransactionHistory(createArgTransactionHistory())
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		_ = th.SaveBlock(&block.Header{Nonce: nonce}, []byte{byte(nonce)}, map[string]data.TransactionHandler{
+			string([]byte{byte(nonce)}): createTx(nonce, selfShardAddress, crossShardAddress),
+		})
+	}
+
+	err := th.RemoveBlock([]byte{2})
+	assert.Nil(t, err)
+
+	page, _ := th.GetTransactions(hex.EncodeToString(selfShardAddress), 0, 10)
+	assert.Equal(t, 2, len(page.Records))
+	assert.Equal(t, uint64(3), page.Records[0].Nonce)
+	assert.Equal(t, uint64(1), page.Records[1].Nonce)
+}
@@ -0,0 +1,390 @@
+package indexer
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var backfillStateKey = []byte("backfillState")
+
+const backfillProgressInterval = 100
+
+// backfillState is the persisted cursor of a backfill. A backfill resumes from NextNonce only if it was started
+// again with the same nonce interval
+type backfillState struct {
+	StartNonce uint64 `json:"startNonce"`
+	EndNonce   uint64 `json:"endNonce"`
+	NextNonce  uint64 `json:"nextNonce"`
+}
+
+// ArgBackfiller holds the arguments needed to create a backfiller
+type ArgBackfiller struct {
+	Store            dataRetriever.StorageService
+	Indexer          BlockIndexer
+	Marshalizer      marshal.Marshalizer
+	Uint64Converter  typeConverters.Uint64ByteSliceConverter
+	ShardCoordinator sharding.Coordinator
+	NodesCoordinator sharding.PublicKeysSelector
+	Persister        storage.Persister
+	Logger           *logger.Logger
+	StartNonce       uint64
+	EndNonce         uint64
+}
+
+// backfiller re-indexes the blocks, found in the local storage, having the nonces in [StartNonce, EndNonce].
+// It is used to fill a new indexer database with the blocks committed before the indexer was enabled. The blocks
+// are indexed directly, without going through the path of the committed blocks, and the progress is persisted
+// after each block, so a stopped backfill resumes from the block it was at
+type backfiller struct {
+	store            dataRetriever.StorageService
+	indexer          BlockIndexer
+	marshalizer      marshal.Marshalizer
+	uint64Converter  typeConverters.Uint64ByteSliceConverter
+	shardCoordinator sharding.Coordinator
+	nodesCoordinator sharding.PublicKeysSelector
+	persister        storage.Persister
+	logger           *logger.Logger
+	state            backfillState
+
+	mutMissingNonces sync.Mutex
+	missingNonces    []uint64
+
+	chClose   chan struct{}
+	closeOnce sync.Once
+	wgRun     sync.WaitGroup
+}
+
+// NewBackfiller creates a new backfiller, loading its progress from the persister
+func NewBackfiller(arg ArgBackfiller) (*backfiller, error) {
+	if arg.Store == nil || arg.Store.IsInterfaceNil() {
+		return nil, ErrNilStorageService
+	}
+	if arg.Indexer == nil || arg.Indexer.IsInterfaceNil() {
+		return nil, ErrNilIndexer
+	}
+	if arg.Marshalizer == nil || arg.Marshalizer.IsInterfaceNil() {
+		return nil, core.ErrNilMarshalizer
+	}
+	if arg.Uint64Converter == nil || arg.Uint64Converter.IsInterfaceNil() {
+		return nil, ErrNilUint64Converter
+	}
+	if arg.ShardCoordinator == nil || arg.ShardCoordinator.IsInterfaceNil() {
+		return nil, core.ErrNilCoordinator
+	}
+	if arg.NodesCoordinator == nil {
+		return nil, ErrNilNodesCoordinator
+	}
+	if arg.Persister == nil || arg.Persister.IsInterfaceNil() {
+		return nil, ErrNilBackfillPersister
+	}
+	if arg.Logger == nil {
+		return nil, core.ErrNilLogger
+	}
+	if arg.EndNonce < arg.StartNonce {
+		return nil, ErrInvalidBackfillInterval
+	}
+
+	bf := &backfiller{
+		store:            arg.Store,
+		indexer:          arg.Indexer,
+		marshalizer:      arg.Marshalizer,
+		uint64Converter:  arg.Uint64Converter,
+		shardCoordinator: arg.ShardCoordinator,
+		nodesCoordinator: arg.NodesCoordinator,
+		persister:        arg.Persister,
+		logger:           arg.Logger,
+		state: backfillState{
+			StartNonce: arg.StartNonce,
+			EndNonce:   arg.EndNonce,
+			NextNonce:  arg.StartNonce,
+		},
+		chClose: make(chan struct{}),
+	}
+
+	err := bf.loadState()
+	if err != nil {
+		return nil, err
+	}
+
+	return bf, nil
+}
+
+func (bf *backfiller) loadState() error {
+	err := bf.persister.Has(backfillStateKey)
+	if err != nil {
+		//no backfill was started yet
+		return nil
+	}
+
+	buff, err := bf.persister.Get(backfillStateKey)
+	if err != nil {
+		return err
+	}
+
+	savedState := backfillState{}
+	err = bf.marshalizer.Unmarshal(&savedState, buff)
+	if err != nil {
+		return err
+	}
+
+	isSameInterval := savedState.StartNonce == bf.state.StartNonce && savedState.EndNonce == bf.state.EndNonce
+	if isSameInterval {
+		bf.state.NextNonce = savedState.NextNonce
+	}
+
+	return nil
+}
+
+func (bf *backfiller) saveState() error {
+	buff, err := bf.marshalizer.Marshal(&bf.state)
+	if err != nil {
+		return err
+	}
+
+	return bf.persister.Put(backfillStateKey, buff)
+}
+
+// Start begins the backfill in a separate go routine
+func (bf *backfiller) Start() {
+	bf.wgRun.Add(1)
+	go func() {
+		defer bf.wgRun.Done()
+
+		err := bf.Run()
+		if err != nil {
+			bf.logger.Warn(fmt.Sprintf("backfill stopped at nonce %d: %s", bf.NextNonce(), err.Error()))
+		}
+	}()
+}
+
+// Run indexes all the blocks remaining to be backfilled, returning when done or when the backfiller is closed
+func (bf *backfiller) Run() error {
+	if bf.state.NextNonce > bf.state.EndNonce {
+		bf.logger.Info(fmt.Sprintf("backfill of nonces %d - %d was already done", bf.state.StartNonce, bf.state.EndNonce))
+		return nil
+	}
+
+	bf.logger.Info(fmt.Sprintf("backfill of nonces %d - %d starting from nonce %d",
+		bf.state.StartNonce, bf.state.EndNonce, bf.state.NextNonce))
+
+	for bf.state.NextNonce <= bf.state.EndNonce {
+		select {
+		case <-bf.chClose:
+			return nil
+		default:
+		}
+
+		nonce := bf.state.NextNonce
+		err := bf.indexNonce(nonce)
+		if err != nil {
+			return err
+		}
+
+		bf.state.NextNonce++
+		err = bf.saveState()
+		if err != nil {
+			return err
+		}
+
+		isDone := nonce == bf.state.EndNonce
+		if isDone || (nonce-bf.state.StartNonce+1)%backfillProgressInterval == 0 {
+			bf.logProgress(nonce)
+		}
+	}
+
+	missingNonces := bf.MissingNonces()
+	if len(missingNonces) > 0 {
+		bf.logger.Warn(fmt.Sprintf("backfill: the blocks with nonces %v were not found in storage", missingNonces))
+	}
+
+	return nil
+}
+
+func (bf *backfiller) logProgress(nonce uint64) {
+	total := bf.state.EndNonce - bf.state.StartNonce + 1
+	done := nonce - bf.state.StartNonce + 1
+	bf.logger.Info(fmt.Sprintf("backfill: indexed up to nonce %d, %d/%d blocks (%.2f%%), %d missing from storage",
+		nonce, done, total, float64(done)*100/float64(total), len(bf.MissingNonces())))
+}
+
+func (bf *backfiller) indexNonce(nonce uint64) error {
+	isMetachain := bf.shardCoordinator.SelfId() == sharding.MetachainShardId
+
+	nonceToHashUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(bf.shardCoordinator.SelfId())
+	headerUnit := dataRetriever.BlockHeaderUnit
+	if isMetachain {
+		nonceToHashUnit = dataRetriever.MetaHdrNonceHashDataUnit
+		headerUnit = dataRetriever.MetaBlockUnit
+	}
+
+	headerHash, err := bf.store.Get(nonceToHashUnit, bf.uint64Converter.ToByteSlice(nonce))
+	if err != nil {
+		bf.logger.Warn(fmt.Sprintf("backfill: no block with nonce %d in storage, it is skipped", nonce))
+		bf.mutMissingNonces.Lock()
+		bf.missingNonces = append(bf.missingNonces, nonce)
+		bf.mutMissingNonces.Unlock()
+		return nil
+	}
+
+	headerBuff, err := bf.store.Get(headerUnit, headerHash)
+	if err != nil {
+		return err
+	}
+
+	if isMetachain {
+		metaBlock := &block.MetaBlock{}
+		err = bf.marshalizer.Unmarshal(metaBlock, headerBuff)
+		if err != nil {
+			return err
+		}
+
+		signersIndexes, err := bf.signersIndexes(metaBlock)
+		if err != nil {
+			return err
+		}
+
+		return bf.indexer.IndexMetaBlock(metaBlock, signersIndexes)
+	}
+
+	header := &block.Header{}
+	err = bf.marshalizer.Unmarshal(header, headerBuff)
+	if err != nil {
+		return err
+	}
+
+	body, txPool, err := bf.loadBodyAndTransactions(header)
+	if err != nil {
+		return err
+	}
+
+	signersIndexes, err := bf.signersIndexes(header)
+	if err != nil {
+		return err
+	}
+
+	return bf.indexer.IndexBlock(body, header, txPool, signersIndexes)
+}
+
+// signersIndexes returns the indexes of the consensus group which produced the block, the proposer being the first
+func (bf *backfiller) signersIndexes(header data.HeaderHandler) ([]uint64, error) {
+	pubKeys, err := bf.nodesCoordinator.GetValidatorsPublicKeys(
+		header.GetPrevRandSeed(),
+		header.GetRound(),
+		header.GetShardID(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return bf.nodesCoordinator.GetValidatorsIndexes(pubKeys), nil
+}
+
+func (bf *backfiller) loadBodyAndTransactions(header *block.Header) (block.Body, map[string]data.TransactionHandler, error) {
+	body := make(block.Body, 0, len(header.MiniBlockHeaders))
+	txPool := make(map[string]data.TransactionHandler)
+
+	for _, mbHeader := range header.MiniBlockHeaders {
+		mbBuff, err := bf.store.Get(dataRetriever.MiniBlockUnit, mbHeader.Hash)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		miniBlock := &block.MiniBlock{}
+		err = bf.marshalizer.Unmarshal(miniBlock, mbBuff)
+		if err != nil {
+			return nil, nil, err
+		}
+		body = append(body, miniBlock)
+
+		for _, txHash := range miniBlock.TxHashes {
+			tx, err := bf.loadTransaction(miniBlock.Type, txHash)
+			if err != nil {
+				bf.logger.Warn(fmt.Sprintf("backfill: could not load transaction %x of block with nonce %d: %s",
+					txHash, header.Nonce, err.Error()))
+				continue
+			}
+			if tx != nil {
+				txPool[string(txHash)] = tx
+			}
+		}
+	}
+
+	return body, txPool, nil
+}
+
+func (bf *backfiller) loadTransaction(miniBlockType block.Type, txHash []byte) (data.TransactionHandler, error) {
+	var unit dataRetriever.UnitType
+	var tx data.TransactionHandler
+
+	switch miniBlockType {
+	case block.TxBlock:
+		unit, tx = dataRetriever.TransactionUnit, &transaction.Transaction{}
+	case block.SmartContractResultBlock:
+		unit, tx = dataRetriever.UnsignedTransactionUnit, &smartContractResult.SmartContractResult{}
+	case block.RewardsBlock:
+		unit, tx = dataRetriever.RewardTransactionUnit, &rewardTx.RewardTx{}
+	default:
+		return nil, nil
+	}
+
+	buff, err := bf.store.Get(unit, txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bf.marshalizer.Unmarshal(tx, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// MissingNonces returns the nonces, from the ones backfilled since the backfiller was created, which were not found in
+// the local storage
+func (bf *backfiller) MissingNonces() []uint64 {
+	bf.mutMissingNonces.Lock()
+	defer bf.mutMissingNonces.Unlock()
+
+	missingNonces := make([]uint64, len(bf.missingNonces))
+	copy(missingNonces, bf.missingNonces)
+
+	return missingNonces
+}
+
+// NextNonce returns the nonce of the next block to be backfilled
+func (bf *backfiller) NextNonce() uint64 {
+	return bf.state.NextNonce
+}
+
+// Close stops the backfill and closes the persister. The backfill resumes from the block it was at when it is
+// started again with the same nonces
+func (bf *backfiller) Close() error {
+	bf.closeOnce.Do(func() {
+		close(bf.chClose)
+	})
+	bf.wgRun.Wait()
+
+	return bf.persister.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bf *backfiller) IsInterfaceNil() bool {
+	if bf == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,328 @@
+package indexer_test
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+)
+
+type savedBlock struct {
+	nonce          uint64
+	body           block.Body
+	txPool         map[string]data.TransactionHandler
+	signersIndexes []uint64
+}
+
+type savedBlocksRecorder struct {
+	mut    sync.Mutex
+	blocks []savedBlock
+}
+
+func (sbr *savedBlocksRecorder) indexerStub() *mock.IndexerStub {
+	return &mock.IndexerStub{
+		IndexBlockCalled: func(body data.BodyHandler, header data.HeaderHandler, txPool map[string]data.TransactionHandler, signersIndexes []uint64) error {
+			sbr.mut.Lock()
+			sbr.blocks = append(sbr.blocks, savedBlock{
+				nonce:          header.GetNonce(),
+				body:           body.(block.Body),
+				txPool:         txPool,
+				signersIndexes: signersIndexes,
+			})
+			sbr.mut.Unlock()
+			return nil
+		},
+		IndexMetaBlockCalled: func(header data.HeaderHandler, signersIndexes []uint64) error {
+			sbr.mut.Lock()
+			sbr.blocks = append(sbr.blocks, savedBlock{nonce: header.GetNonce(), signersIndexes: signersIndexes})
+			sbr.mut.Unlock()
+			return nil
+		},
+	}
+}
+
+func (sbr *savedBlocksRecorder) nonces() []uint64 {
+	sbr.mut.Lock()
+	defer sbr.mut.Unlock()
+
+	nonces := make([]uint64, 0, len(sbr.blocks))
+	for _, b := range sbr.blocks {
+		nonces = append(nonces, b.nonce)
+	}
+
+	return nonces
+}
+
+func createTestStorer() storage.Storer {
+	cache, _ := storageUnit.NewCache(storageUnit.LRUCache, 1000, 1)
+	persister, _ := memorydb.New()
+	storer, _ := storageUnit.NewStorageUnit(cache, persister)
+
+	return storer
+}
+
+func createTestStore() *dataRetriever.ChainStorer {
+	store := dataRetriever.NewChainStorer()
+	for _, unit := range []dataRetriever.UnitType{
+		dataRetriever.TransactionUnit,
+		dataRetriever.MiniBlockUnit,
+		dataRetriever.BlockHeaderUnit,
+		dataRetriever.MetaBlockUnit,
+		dataRetriever.UnsignedTransactionUnit,
+		dataRetriever.RewardTransactionUnit,
+		dataRetriever.MetaHdrNonceHashDataUnit,
+		dataRetriever.ShardHdrNonceHashDataUnit,
+	} {
+		store.AddStorer(unit, createTestStorer())
+	}
+
+	return store
+}
+
+func storeMarshalized(store dataRetriever.StorageService, unit dataRetriever.UnitType, obj interface{}) []byte {
+	buff, _ := marshalizer.Marshal(obj)
+	hash := hasher.Compute(string(buff))
+	_ = store.Put(unit, hash, buff)
+
+	return hash
+}
+
+// storeShardBlock saves in the store a block with a miniblock of transactions and a miniblock of rewards
+func storeShardBlock(store dataRetriever.StorageService, nonce uint64) {
+	tx := &transaction.Transaction{Nonce: nonce, Value: big.NewInt(1), Data: "tx"}
+	txHash := storeMarshalized(store, dataRetriever.TransactionUnit, tx)
+	reward := &rewardTx.RewardTx{Round: nonce, Value: big.NewInt(2)}
+	rewardHash := storeMarshalized(store, dataRetriever.RewardTransactionUnit, reward)
+
+	txMiniBlock := &block.MiniBlock{TxHashes: [][]byte{txHash}, Type: block.TxBlock}
+	rewardMiniBlock := &block.MiniBlock{TxHashes: [][]byte{rewardHash}, Type: block.RewardsBlock}
+	header := &block.Header{
+		Nonce: nonce,
+		Round: nonce + 10,
+		MiniBlockHeaders: []block.MiniBlockHeader{
+			{Hash: storeMarshalized(store, dataRetriever.MiniBlockUnit, txMiniBlock), Type: block.TxBlock},
+			{Hash: storeMarshalized(store, dataRetriever.MiniBlockUnit, rewardMiniBlock), Type: block.RewardsBlock},
+		},
+		TxCount: 2,
+	}
+	headerHash := storeMarshalized(store, dataRetriever.BlockHeaderUnit, header)
+
+	converter := uint64ByteSlice.NewBigEndianConverter()
+	_ = store.Put(dataRetriever.ShardHdrNonceHashDataUnit, converter.ToByteSlice(nonce), headerHash)
+}
+
+// createNodesCoordinator returns a nodes coordinator whose consensus group of a round is made of the validators with
+// the indexes round and round + 1, the first one being the proposer
+func createNodesCoordinator() *mock.PublicKeysSelectorStub {
+	return &mock.PublicKeysSelectorStub{
+		GetValidatorsPublicKeysCalled: func(randomness []byte, round uint64, shardId uint32) ([]string, error) {
+			return []string{fmt.Sprintf("%d", round), fmt.Sprintf("%d", round+1)}, nil
+		},
+		GetValidatorsIndexesCalled: func(publicKeys []string) []uint64 {
+			indexes := make([]uint64, 0, len(publicKeys))
+			for _, pubKey := range publicKeys {
+				index, _ := strconv.ParseUint(pubKey, 10, 64)
+				indexes = append(indexes, index)
+			}
+			return indexes
+		},
+	}
+}
+
+func createArgBackfiller(store dataRetriever.StorageService, idx indexer.BlockIndexer, persister storage.Persister) indexer.ArgBackfiller {
+	return indexer.ArgBackfiller{
+		Store:            store,
+		Indexer:          idx,
+		Marshalizer:      marshalizer,
+		Uint64Converter:  uint64ByteSlice.NewBigEndianConverter(),
+		ShardCoordinator: shardCoordinator,
+		NodesCoordinator: createNodesCoordinator(),
+		Persister:        persister,
+		Logger:           log,
+		StartNonce:       1,
+		EndNonce:         3,
+	}
+}
+
+func TestNewBackfiller_NilStoreShouldErr(t *testing.T) {
+	t.Parallel()
+
+	persister, _ := memorydb.New()
+	arg := createArgBackfiller(nil, &mock.IndexerStub{}, persister)
+	bf, err := indexer.NewBackfiller(arg)
+
+	assert.Nil(t, bf)
+	assert.Equal(t, indexer.ErrNilStorageService, err)
+}
+
+func TestNewBackfiller_NilIndexerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	persister, _ := memorydb.New()
+	arg := createArgBackfiller(createTestStore(), nil, persister)
+	bf, err := indexer.NewBackfiller(arg)
+
+	assert.Nil(t, bf)
+	assert.Equal(t, indexer.ErrNilIndexer, err)
+}
+
+func TestNewBackfiller_NilNodesCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	persister, _ := memorydb.New()
+	arg := createArgBackfiller(createTestStore(), &mock.IndexerStub{}, persister)
+	arg.NodesCoordinator = nil
+	bf, err := indexer.NewBackfiller(arg)
+
+	assert.Nil(t, bf)
+	assert.Equal(t, indexer.ErrNilNodesCoordinator, err)
+}
+
+func TestNewBackfiller_NilPersisterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createArgBackfiller(createTestStore(), &mock.IndexerStub{}, nil)
+	bf, err := indexer.NewBackfiller(arg)
+
+	assert.Nil(t, bf)
+	assert.Equal(t, indexer.ErrNilBackfillPersister, err)
+}
+
+func TestNewBackfiller_InvalidIntervalShouldErr(t *testing.T) {
+	t.Parallel()
+
+	persister, _ := memorydb.New()
+	arg := createArgBackfiller(createTestStore(), &mock.IndexerStub{}, persister)
+	arg.StartNonce = 4
+	bf, err := indexer.NewBackfiller(arg)
+
+	assert.Nil(t, bf)
+	assert.Equal(t, indexer.ErrInvalidBackfillInterval, err)
+}
+
+func TestBackfiller_RunShouldIndexBlocksWithTransactionsFromStorage(t *testing.T) {
+	t.Parallel()
+
+	store := createTestStore()
+	storeShardBlock(store, 1)
+	storeShardBlock(store, 3)
+	recorder := &savedBlocksRecorder{}
+	persister, _ := memorydb.New()
+	bf, _ := indexer.NewBackfiller(createArgBackfiller(store, recorder.indexerStub(), persister))
+
+	err := bf.Run()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{1, 3}, recorder.nonces())
+	assert.Equal(t, uint64(4), bf.NextNonce())
+	assert.Equal(t, []uint64{2}, bf.MissingNonces())
+	assert.Equal(t, []uint64{11, 12}, recorder.blocks[0].signersIndexes)
+	assert.Equal(t, 2, len(recorder.blocks[0].body))
+	assert.Equal(t, 2, len(recorder.blocks[0].txPool))
+	for _, mb := range recorder.blocks[0].body {
+		_, found := recorder.blocks[0].txPool[string(mb.TxHashes[0])]
+		assert.True(t, found)
+	}
+}
+
+func TestBackfiller_RunIndexingErrorShouldStopAtTheFailedNonce(t *testing.T) {
+	t.Parallel()
+
+	store := createTestStore()
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		storeShardBlock(store, nonce)
+	}
+	errExpected := errors.New("expected error")
+	idx := &mock.IndexerStub{
+		IndexBlockCalled: func(body data.BodyHandler, header data.HeaderHandler, txPool map[string]data.TransactionHandler, signersIndexes []uint64) error {
+			if header.GetNonce() == 2 {
+				return errExpected
+			}
+			return nil
+		},
+	}
+	persister, _ := memorydb.New()
+	bf, _ := indexer.NewBackfiller(createArgBackfiller(store, idx, persister))
+
+	err := bf.Run()
+
+	assert.Equal(t, errExpected, err)
+	assert.Equal(t, uint64(2), bf.NextNonce())
+}
+
+func TestBackfiller_ShouldResumeFromPersistedNonce(t *testing.T) {
+	t.Parallel()
+
+	store := createTestStore()
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		storeShardBlock(store, nonce)
+	}
+	persister, _ := memorydb.New()
+
+	arg := createArgBackfiller(store, (&savedBlocksRecorder{}).indexerStub(), persister)
+	arg.EndNonce = 2
+	bf, _ := indexer.NewBackfiller(arg)
+	_ = bf.Run()
+
+	recorder := &savedBlocksRecorder{}
+	arg.Indexer = recorder.indexerStub()
+	bf, _ = indexer.NewBackfiller(arg)
+	_ = bf.Run()
+	assert.Equal(t, 0, len(recorder.nonces()))
+
+	// a different interval starts a new backfill
+	arg.EndNonce = 3
+	bf, _ = indexer.NewBackfiller(arg)
+	_ = bf.Run()
+	assert.Equal(t, []uint64{1, 2, 3}, recorder.nonces())
+}
+
+func TestBackfiller_RunOnMetachainShouldIndexMetaBlocks(t *testing.T) {
+	t.Parallel()
+
+	store := createTestStore()
+	converter := uint64ByteSlice.NewBigEndianConverter()
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		metaBlockHash := storeMarshalized(store, dataRetriever.MetaBlockUnit, &block.MetaBlock{Nonce: nonce})
+		_ = store.Put(dataRetriever.MetaHdrNonceHashDataUnit, converter.ToByteSlice(nonce), metaBlockHash)
+	}
+	recorder := &savedBlocksRecorder{}
+	persister, _ := memorydb.New()
+	arg := createArgBackfiller(store, recorder.indexerStub(), persister)
+	arg.ShardCoordinator, _ = sharding.NewMultiShardCoordinator(1, sharding.MetachainShardId)
+	bf, _ := indexer.NewBackfiller(arg)
+
+	err := bf.Run()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{1, 2, 3}, recorder.nonces())
+}
+
+func TestBackfiller_CloseShouldStopTheBackfill(t *testing.T) {
+	t.Parallel()
+
+	store := createTestStore()
+	persister, _ := memorydb.New()
+	bf, _ := indexer.NewBackfiller(createArgBackfiller(store, (&savedBlocksRecorder{}).indexerStub(), persister))
+
+	bf.Start()
+	err := bf.Close()
+
+	assert.Nil(t, err)
+}
@@ -25,6 +25,7 @@ type Transaction struct {
 	Signature     string        `json:"signature"`
 	Timestamp     time.Duration `json:"timestamp"`
 	Status        string        `json:"status"`
+	Type          string        `json:"-"`
 }
 
 // Block is a structure containing all the fields that need
@@ -45,6 +46,17 @@ type Block struct {
 	PrevHash      string        `json:"prevHash"`
 }
 
+// MiniBlock is a structure containing all the fields that need
+//  to be saved for a miniblock
+type MiniBlock struct {
+	Hash            string `json:"hash"`
+	BlockHash       string `json:"blockHash"`
+	SenderShardID   uint32 `json:"senderShardId"`
+	ReceiverShardID uint32 `json:"receiverShardId"`
+	Type            string `json:"type"`
+	TxCount         uint32 `json:"txCount"`
+}
+
 //ValidatorsPublicKeys is a structure containing fields for validators public keys
 type ValidatorsPublicKeys struct {
 	PublicKeys []string `json:"publicKeys"`
@@ -76,3 +88,28 @@ type TPS struct {
 	AverageTPS            *big.Int `json:"averageTPS"`
 	CurrentBlockNonce     uint64   `json:"currentBlockNonce"`
 }
+
+// QueuedBlock is a structure containing the documents of a block, as they are kept in the indexing queue until
+//  the driver acknowledges them. Elasticsearch queues the already serialized documents, while the other drivers
+//  queue the documents themselves
+type QueuedBlock struct {
+	Nonce      uint64          `json:"nonce"`
+	BlockDocID string          `json:"blockDocId"`
+	BlockDoc   []byte          `json:"blockDoc"`
+	TxBulks    [][]byte        `json:"txBulks"`
+	Documents  *BlockDocuments `json:"documents,omitempty"`
+}
+
+// BlockDocuments is a structure containing the documents of a block, its miniblocks and its transactions
+type BlockDocuments struct {
+	Block        *Block              `json:"block"`
+	MiniBlocks   []*MiniBlock        `json:"miniBlocks"`
+	Transactions []*TypedTransaction `json:"transactions"`
+}
+
+// TypedTransaction is a structure holding a transaction document together with its type, which is not part
+//  of the document
+type TypedTransaction struct {
+	Type        string       `json:"type"`
+	Transaction *Transaction `json:"transaction"`
+}
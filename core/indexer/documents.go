@@ -0,0 +1,236 @@
+package indexer
+
+import (
+	"encoding/hex"
+	"math/big"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// createBlockDocument builds the document of a block header, returning it together with the header hash.
+// The documents are shared by all the indexer drivers so that every sink receives the same data
+func createBlockDocument(
+	header data.HeaderHandler,
+	signersIndexes []uint64,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) (*Block, []byte, error) {
+	h, err := marshalizer.Marshal(header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the signers are not known for the blocks loaded from storage, as it happens when backfilling
+	proposer := uint64(0)
+	if len(signersIndexes) > 0 {
+		proposer = signersIndexes[0]
+	}
+
+	headerHash := hasher.Compute(string(h))
+	blockDoc := &Block{
+		Nonce:         header.GetNonce(),
+		Round:         header.GetRound(),
+		ShardID:       header.GetShardID(),
+		Hash:          hex.EncodeToString(headerHash),
+		Proposer:      proposer,
+		Validators:    signersIndexes,
+		PubKeyBitmap:  hex.EncodeToString(header.GetPubKeysBitmap()),
+		Size:          int64(len(h)),
+		Timestamp:     time.Duration(header.GetTimeStamp()),
+		TxCount:       header.GetTxCount(),
+		StateRootHash: hex.EncodeToString(header.GetRootHash()),
+		PrevHash:      hex.EncodeToString(header.GetPrevHash()),
+	}
+
+	return blockDoc, headerHash, nil
+}
+
+// createBlockDocuments builds the documents of a block, of its miniblocks and, if enabled, of its transactions
+func createBlockDocuments(
+	bodyHandler data.BodyHandler,
+	header data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	signersIndexes []uint64,
+	txIndexingEnabled bool,
+	selfShardId uint32,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	logger *logger.Logger,
+) (*BlockDocuments, error) {
+	if header == nil || header.IsInterfaceNil() {
+		return nil, ErrNoHeader
+	}
+
+	body, ok := bodyHandler.(block.Body)
+	if !ok {
+		return nil, ErrBodyTypeAssertion
+	}
+
+	blockDoc, headerHash, err := createBlockDocument(header, signersIndexes, marshalizer, hasher)
+	if err != nil {
+		return nil, err
+	}
+
+	documents := &BlockDocuments{
+		Block:        blockDoc,
+		MiniBlocks:   createMiniBlockDocuments(body, headerHash, marshalizer, hasher, logger),
+		Transactions: make([]*TypedTransaction, 0),
+	}
+	if !txIndexingEnabled {
+		return documents, nil
+	}
+
+	for _, tx := range createTransactionDocuments(body, header, txPool, selfShardId, marshalizer, hasher, logger) {
+		documents.Transactions = append(documents.Transactions, &TypedTransaction{Type: tx.Type, Transaction: tx})
+	}
+
+	return documents, nil
+}
+
+// createMetaBlockDocuments builds the document of a meta block, which is indexed without miniblocks and transactions
+func createMetaBlockDocuments(
+	header data.HeaderHandler,
+	signersIndexes []uint64,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) (*BlockDocuments, error) {
+	if header == nil || header.IsInterfaceNil() {
+		return nil, ErrNoHeader
+	}
+
+	blockDoc, _, err := createBlockDocument(header, signersIndexes, marshalizer, hasher)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BlockDocuments{
+		Block:        blockDoc,
+		MiniBlocks:   make([]*MiniBlock, 0),
+		Transactions: make([]*TypedTransaction, 0),
+	}, nil
+}
+
+// createMiniBlockDocuments builds the documents of the miniblocks contained in a block body
+func createMiniBlockDocuments(
+	body block.Body,
+	headerHash []byte,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	logger *logger.Logger,
+) []*MiniBlock {
+	miniBlocks := make([]*MiniBlock, 0, len(body))
+	for _, mb := range body {
+		mbMarshal, err := marshalizer.Marshal(mb)
+		if err != nil {
+			logger.Warn("could not marshal miniblock")
+			continue
+		}
+
+		miniBlocks = append(miniBlocks, &MiniBlock{
+			Hash:            hex.EncodeToString(hasher.Compute(string(mbMarshal))),
+			BlockHash:       hex.EncodeToString(headerHash),
+			SenderShardID:   mb.SenderShardID,
+			ReceiverShardID: mb.ReceiverShardID,
+			Type:            mb.Type.String(),
+			TxCount:         uint32(len(mb.TxHashes)),
+		})
+	}
+
+	return miniBlocks
+}
+
+// createTransactionDocuments builds the documents of all the transactions, smart contract results and rewards
+// of a block, in the order they appear in the miniblocks. The transactions missing from the pool are skipped
+func createTransactionDocuments(
+	body block.Body,
+	header data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	selfShardId uint32,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	logger *logger.Logger,
+) []*Transaction {
+	transactions := make([]*Transaction, 0, header.GetTxCount())
+	blockMarshal, _ := marshalizer.Marshal(header)
+	blockHash := hasher.Compute(string(blockMarshal))
+
+	for _, mb := range body {
+		mbMarshal, err := marshalizer.Marshal(mb)
+		if err != nil {
+			logger.Warn("could not marshal miniblock")
+			continue
+		}
+		mbHash := hasher.Compute(string(mbMarshal))
+
+		mbTxStatus := "Pending"
+		if selfShardId == mb.ReceiverShardID {
+			mbTxStatus = "Success"
+		}
+
+		for _, txHash := range mb.TxHashes {
+			currentTxHandler, ok := txPool[string(txHash)]
+			if !ok {
+				logger.Warn("indexer could not find tx hash in pool")
+				continue
+			}
+
+			currentTx := getTransactionByType(currentTxHandler, txHash, mbHash, blockHash, mb, header, mbTxStatus)
+			if currentTx == nil {
+				logger.Warn("indexer found tx in pool but of wrong type")
+				continue
+			}
+
+			transactions = append(transactions, currentTx)
+		}
+	}
+
+	return transactions
+}
+
+// createGeneralTPSDocument builds the document holding the network wide statistics
+func createGeneralTPSDocument(tpsBenchmark statistics.TPSBenchmark) *TPS {
+	return &TPS{
+		LiveTPS:    tpsBenchmark.LiveTPS(),
+		PeakTPS:    tpsBenchmark.PeakTPS(),
+		NrOfShards: tpsBenchmark.NrOfShards(),
+		// TODO: This value is still mocked, it should be removed if we cannot populate it correctly
+		NrOfNodes:             100,
+		BlockNumber:           tpsBenchmark.BlockNumber(),
+		RoundNumber:           tpsBenchmark.RoundNumber(),
+		RoundTime:             tpsBenchmark.RoundTime(),
+		AverageBlockTxCount:   tpsBenchmark.AverageBlockTxCount(),
+		LastBlockTxCount:      tpsBenchmark.LastBlockTxCount(),
+		TotalProcessedTxCount: tpsBenchmark.TotalProcessedTxCount(),
+	}
+}
+
+// createShardTPSDocument builds the document holding the statistics of a shard
+func createShardTPSDocument(shardInfo statistics.ShardStatistic) *TPS {
+	bigTxCount := big.NewInt(int64(shardInfo.AverageBlockTxCount()))
+
+	return &TPS{
+		ShardID:               shardInfo.ShardID(),
+		LiveTPS:               shardInfo.LiveTPS(),
+		PeakTPS:               shardInfo.PeakTPS(),
+		AverageTPS:            shardInfo.AverageTPS(),
+		AverageBlockTxCount:   bigTxCount,
+		CurrentBlockNonce:     shardInfo.CurrentBlockNonce(),
+		LastBlockTxCount:      shardInfo.LastBlockTxCount(),
+		TotalProcessedTxCount: shardInfo.TotalProcessedTxCount(),
+	}
+}
+
+func encodePubKeys(pubKeys [][]byte) []string {
+	encodedPubKeys := make([]string, 0, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		encodedPubKeys = append(encodedPubKeys, hex.EncodeToString(pubKey))
+	}
+
+	return encodedPubKeys
+}
@@ -0,0 +1,106 @@
+package indexer
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// ElasticSearchDriver is the name of the driver indexing in elasticsearch
+const ElasticSearchDriver = "elasticsearch"
+
+// SQLDriver is the name of the driver indexing in a SQL database
+const SQLDriver = "sql"
+
+// FileDriver is the name of the driver writing newline-delimited JSON files
+const FileDriver = "file"
+
+// DriverArgs holds the arguments an indexer driver is created with
+type DriverArgs struct {
+	Config           config.IndexerConfig
+	ShardCoordinator sharding.Coordinator
+	Marshalizer      marshal.Marshalizer
+	Hasher           hashing.Hasher
+	Logger           *logger.Logger
+	Options          *Options
+	// CreateQueuePersister creates the persister of the indexing queue of the indexer with the given name
+	CreateQueuePersister func(name string) (storage.Persister, error)
+}
+
+// DriverFactory creates an indexer from the driver arguments
+type DriverFactory func(args DriverArgs) (Indexer, error)
+
+var mutDrivers = sync.RWMutex{}
+var drivers = make(map[string]DriverFactory)
+
+func init() {
+	_ = RegisterDriver(ElasticSearchDriver, createElasticIndexerFromDriverArgs)
+	_ = RegisterDriver(SQLDriver, createSQLIndexerFromDriverArgs)
+	_ = RegisterDriver(FileDriver, createFileIndexerFromDriverArgs)
+}
+
+// RegisterDriver makes an indexer driver available under the provided name
+func RegisterDriver(name string, factory DriverFactory) error {
+	if len(name) == 0 {
+		return ErrEmptyDriverName
+	}
+	if factory == nil {
+		return ErrNilDriverFactory
+	}
+
+	mutDrivers.Lock()
+	defer mutDrivers.Unlock()
+
+	_, exists := drivers[name]
+	if exists {
+		return ErrDriverAlreadyRegistered
+	}
+	drivers[name] = factory
+
+	return nil
+}
+
+// NewIndexerFromDriver creates an indexer using the driver named in the indexer config
+func NewIndexerFromDriver(args DriverArgs) (Indexer, error) {
+	mutDrivers.RLock()
+	factory, ok := drivers[args.Config.Driver]
+	mutDrivers.RUnlock()
+	if !ok {
+		return nil, ErrUnknownDriver
+	}
+
+	return factory(args)
+}
+
+func createElasticIndexerFromDriverArgs(args DriverArgs) (Indexer, error) {
+	if args.Options == nil {
+		return nil, ErrNilOptions
+	}
+	if args.CreateQueuePersister == nil {
+		return nil, ErrNilQueuePersister
+	}
+
+	queuePersister, err := args.CreateQueuePersister(args.Config.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	options := *args.Options
+	options.QueuePersister = queuePersister
+
+	return NewElasticIndexer(
+		args.Config.URL,
+		args.Config.Username,
+		args.Config.Password,
+		args.ShardCoordinator,
+		args.Marshalizer,
+		args.Hasher,
+		args.Logger,
+		&options,
+	)
+}
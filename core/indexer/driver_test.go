@@ -0,0 +1,101 @@
+package indexer_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterDriver_EmptyNameShouldErr(t *testing.T) {
+	t.Parallel()
+
+	err := indexer.RegisterDriver("", func(args indexer.DriverArgs) (indexer.Indexer, error) {
+		return nil, nil
+	})
+
+	assert.Equal(t, indexer.ErrEmptyDriverName, err)
+}
+
+func TestRegisterDriver_NilFactoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	err := indexer.RegisterDriver("nil factory driver", nil)
+
+	assert.Equal(t, indexer.ErrNilDriverFactory, err)
+}
+
+func TestRegisterDriver_BuiltinDriversShouldBeRegistered(t *testing.T) {
+	t.Parallel()
+
+	factory := func(args indexer.DriverArgs) (indexer.Indexer, error) {
+		return nil, nil
+	}
+
+	assert.Equal(t, indexer.ErrDriverAlreadyRegistered, indexer.RegisterDriver(indexer.ElasticSearchDriver, factory))
+	assert.Equal(t, indexer.ErrDriverAlreadyRegistered, indexer.RegisterDriver(indexer.SQLDriver, factory))
+	assert.Equal(t, indexer.ErrDriverAlreadyRegistered, indexer.RegisterDriver(indexer.FileDriver, factory))
+}
+
+func TestNewIndexerFromDriver_UnknownDriverShouldErr(t *testing.T) {
+	t.Parallel()
+
+	idx, err := indexer.NewIndexerFromDriver(indexer.DriverArgs{
+		Config: config.IndexerConfig{Driver: "unknown driver"},
+	})
+
+	assert.Nil(t, idx)
+	assert.Equal(t, indexer.ErrUnknownDriver, err)
+}
+
+func TestNewIndexerFromDriver_ShouldUseRegisteredDriver(t *testing.T) {
+	t.Parallel()
+
+	expectedIndexer := &mock.IndexerStub{}
+	var receivedConfig config.IndexerConfig
+	_ = indexer.RegisterDriver("custom driver", func(args indexer.DriverArgs) (indexer.Indexer, error) {
+		receivedConfig = args.Config
+		return expectedIndexer, nil
+	})
+
+	cfg := config.IndexerConfig{Name: "custom", Driver: "custom driver"}
+	idx, err := indexer.NewIndexerFromDriver(indexer.DriverArgs{Config: cfg})
+
+	assert.Nil(t, err)
+	assert.True(t, idx == expectedIndexer)
+	assert.Equal(t, cfg, receivedConfig)
+}
+
+func TestNewIndexerFromDriver_SQLDriverWithoutDataSourceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	idx, err := indexer.NewIndexerFromDriver(indexer.DriverArgs{
+		Config: config.IndexerConfig{Driver: indexer.SQLDriver},
+	})
+
+	assert.Nil(t, idx)
+	assert.Equal(t, indexer.ErrEmptyDataSourceName, err)
+}
+
+func TestNewIndexerFromDriver_ElasticSearchDriverShouldCreateTheQueuePersister(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	requestedName := ""
+	idx, err := indexer.NewIndexerFromDriver(indexer.DriverArgs{
+		Config:  config.IndexerConfig{Name: "elastic", Driver: indexer.ElasticSearchDriver, URL: url},
+		Options: newTestOptions(),
+		CreateQueuePersister: func(name string) (storage.Persister, error) {
+			requestedName = name
+			return nil, expectedErr
+		},
+	})
+
+	assert.Nil(t, idx)
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, "elastic", requestedName)
+}
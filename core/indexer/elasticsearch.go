@@ -4,9 +4,9 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
-	"math/big"
 	"net/http"
 	"strconv"
 	"strings"
@@ -23,6 +23,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/elastic/go-elasticsearch/v7"
 	"github.com/elastic/go-elasticsearch/v7/esapi"
 	"github.com/gin-gonic/gin/json"
@@ -35,6 +36,10 @@ const tpsIndex = "tps"
 const validatorsIndex = "validators"
 const roundIndex = "rounds"
 
+const txTypeNormal = "normal"
+const txTypeSmartContractResult = "unsigned"
+const txTypeReward = "reward"
+
 const metachainTpsDocID = "meta"
 const shardTpsDocIDPrefix = "shard"
 
@@ -43,6 +48,10 @@ const badRequest = 400
 // Options structure holds the indexer's configuration options
 type Options struct {
 	TxIndexingEnabled bool
+	QueuePersister    storage.Persister
+	StatusHandler     core.AppStatusHandler
+	MinRetryDelay     time.Duration
+	MaxRetryDelay     time.Duration
 }
 
 //TODO refactor this and split in 3: glue code, interface and logic code
@@ -54,6 +63,7 @@ type elasticIndexer struct {
 	logger           *logger.Logger
 	options          *Options
 	isNilIndexer     bool
+	queue            *indexQueue
 }
 
 // NewElasticIndexer creates a new elasticIndexer where the server listens on the url, authentication for the server is
@@ -91,13 +101,13 @@ func NewElasticIndexer(
 	}
 
 	indexer := &elasticIndexer{
-		es,
-		shardCoordinator,
-		marshalizer,
-		hasher,
-		logger,
-		options,
-		false,
+		db:               es,
+		shardCoordinator: shardCoordinator,
+		marshalizer:      marshalizer,
+		hasher:           hasher,
+		logger:           logger,
+		options:          options,
+		isNilIndexer:     false,
 	}
 
 	err = indexer.checkAndCreateIndex(blockIndex, timestampMapping())
@@ -125,6 +135,19 @@ func NewElasticIndexer(
 		return nil, err
 	}
 
+	indexer.queue, err = newIndexQueue(argIndexQueue{
+		Persister:     options.QueuePersister,
+		Marshalizer:   marshalizer,
+		IndexHandler:  indexer.indexQueuedBlock,
+		StatusHandler: options.StatusHandler,
+		Logger:        logger,
+		MinRetryDelay: options.MinRetryDelay,
+		MaxRetryDelay: options.MaxRetryDelay,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return indexer, nil
 }
 
@@ -209,7 +232,8 @@ func (ei *elasticIndexer) createIndex(index string, body io.Reader) error {
 	return nil
 }
 
-// SaveBlock will build
+// SaveBlock will serialize the header and, if enabled, the transactions of the block and will add them to
+// the indexing queue. The queue sends them to elasticsearch in nonce order, retrying until they are indexed
 func (ei *elasticIndexer) SaveBlock(
 	bodyHandler data.BodyHandler,
 	headerhandler data.HeaderHandler,
@@ -217,37 +241,137 @@ func (ei *elasticIndexer) SaveBlock(
 	signersIndexes []uint64,
 ) {
 
-	if headerhandler == nil || headerhandler.IsInterfaceNil() {
-		ei.logger.Warn(ErrNoHeader.Error())
+	queuedBlock, err := ei.createQueuedTxBlock(bodyHandler, headerhandler, txPool, signersIndexes)
+	if err != nil {
+		ei.logger.Warn(err.Error())
+		return
+	}
+
+	ei.enqueue(queuedBlock)
+}
+
+// IndexBlock sends the block directly to elasticsearch, without going through the indexing queue, so that the
+// blocks indexed from the local storage do not rewind the queue of the committed blocks
+func (ei *elasticIndexer) IndexBlock(
+	bodyHandler data.BodyHandler,
+	headerhandler data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	signersIndexes []uint64,
+) error {
+
+	queuedBlock, err := ei.createQueuedTxBlock(bodyHandler, headerhandler, txPool, signersIndexes)
+	if err != nil {
+		return err
+	}
+
+	return ei.indexQueuedBlock(queuedBlock)
+}
+
+// SaveMetaBlock will add a meta block to the indexing queue
+func (ei *elasticIndexer) SaveMetaBlock(header data.HeaderHandler, signersIndexes []uint64) {
+	queuedBlock, err := ei.createQueuedMetaBlock(header, signersIndexes)
+	if err != nil {
+		ei.logger.Warn(err.Error())
 		return
 	}
 
+	ei.enqueue(queuedBlock)
+}
+
+// IndexMetaBlock sends the meta block directly to elasticsearch, without going through the indexing queue
+func (ei *elasticIndexer) IndexMetaBlock(header data.HeaderHandler, signersIndexes []uint64) error {
+	queuedBlock, err := ei.createQueuedMetaBlock(header, signersIndexes)
+	if err != nil {
+		return err
+	}
+
+	return ei.indexQueuedBlock(queuedBlock)
+}
+
+func (ei *elasticIndexer) createQueuedTxBlock(
+	bodyHandler data.BodyHandler,
+	headerhandler data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	signersIndexes []uint64,
+) (*QueuedBlock, error) {
+
+	if headerhandler == nil || headerhandler.IsInterfaceNil() {
+		return nil, ErrNoHeader
+	}
+
 	body, ok := bodyHandler.(block.Body)
 	if !ok {
-		ei.logger.Warn(ErrBodyTypeAssertion.Error())
-		return
+		return nil, ErrBodyTypeAssertion
 	}
 
-	go ei.saveHeader(headerhandler, signersIndexes)
+	queuedBlock, err := ei.createQueuedBlock(headerhandler, signersIndexes)
+	if err != nil {
+		return nil, err
+	}
 
 	if len(body) == 0 {
 		ei.logger.Warn(ErrNoMiniblocks.Error())
-		return
+	} else if ei.options.TxIndexingEnabled {
+		queuedBlock.TxBulks = ei.serializeTransactionBulks(body, headerhandler, txPool)
 	}
 
-	if ei.options.TxIndexingEnabled {
-		go ei.saveTransactions(body, headerhandler, txPool)
-	}
+	return queuedBlock, nil
 }
 
-// SaveMetaBlock will index a meta block in elastic search
-func (ei *elasticIndexer) SaveMetaBlock(header data.HeaderHandler, signersIndexes []uint64) {
+func (ei *elasticIndexer) createQueuedMetaBlock(header data.HeaderHandler, signersIndexes []uint64) (*QueuedBlock, error) {
 	if header == nil || header.IsInterfaceNil() {
-		ei.logger.Warn(ErrNoHeader.Error())
-		return
+		return nil, ErrNoHeader
+	}
+
+	return ei.createQueuedBlock(header, signersIndexes)
+}
+
+func (ei *elasticIndexer) createQueuedBlock(header data.HeaderHandler, signersIndexes []uint64) (*QueuedBlock, error) {
+	serializedBlock, headerHash, err := ei.getSerializedElasticBlockAndHeaderHash(header, signersIndexes)
+	if err != nil {
+		return nil, err
 	}
 
-	go ei.saveHeader(header, signersIndexes)
+	return &QueuedBlock{
+		Nonce:      header.GetNonce(),
+		BlockDocID: hex.EncodeToString(headerHash),
+		BlockDoc:   serializedBlock,
+	}, nil
+}
+
+func (ei *elasticIndexer) enqueue(queuedBlock *QueuedBlock) {
+	err := ei.queue.Enqueue(queuedBlock)
+	if err != nil {
+		ei.logger.Warn(fmt.Sprintf("could not add block with nonce %d to the indexing queue: %s",
+			queuedBlock.Nonce, err.Error()))
+	}
+}
+
+// indexQueuedBlock sends the documents of a queued block to elasticsearch. As the documents have fixed ids,
+// indexing the same block again after a failure only overwrites the documents already indexed
+func (ei *elasticIndexer) indexQueuedBlock(queuedBlock *QueuedBlock) error {
+	err := ei.saveHeader(queuedBlock.BlockDocID, queuedBlock.BlockDoc)
+	if err != nil {
+		return err
+	}
+
+	for _, bulk := range queuedBlock.TxBulks {
+		err = ei.saveTransactionsBulk(bulk)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Close stops the indexing queue. The blocks which were not yet indexed will be indexed after a restart
+func (ei *elasticIndexer) Close() error {
+	if ei.queue == nil {
+		return nil
+	}
+
+	return ei.queue.Close()
 }
 
 // SaveRoundInfo will save data about a round on elastic search
@@ -333,64 +457,44 @@ func (ei *elasticIndexer) saveShardValidatorsPubKeys(shardId uint32, shardValida
 	}
 }
 
-func (ei *elasticIndexer) getSerializedElasticBlockAndHeaderHash(header data.HeaderHandler, signersIndexes []uint64) ([]byte, []byte) {
-	h, err := ei.marshalizer.Marshal(header)
-	if err != nil {
-		ei.logger.Warn("could not marshal header")
-		return nil, nil
-	}
+func (ei *elasticIndexer) getSerializedElasticBlockAndHeaderHash(
+	header data.HeaderHandler,
+	signersIndexes []uint64,
+) ([]byte, []byte, error) {
 
-	headerHash := ei.hasher.Compute(string(h))
-	elasticBlock := Block{
-		Nonce:         header.GetNonce(),
-		Round:         header.GetRound(),
-		ShardID:       header.GetShardID(),
-		Hash:          hex.EncodeToString(headerHash),
-		Proposer:      signersIndexes[0],
-		Validators:    signersIndexes,
-		PubKeyBitmap:  hex.EncodeToString(header.GetPubKeysBitmap()),
-		Size:          int64(len(h)),
-		Timestamp:     time.Duration(header.GetTimeStamp()),
-		TxCount:       header.GetTxCount(),
-		StateRootHash: hex.EncodeToString(header.GetRootHash()),
-		PrevHash:      hex.EncodeToString(header.GetPrevHash()),
+	elasticBlock, headerHash, err := createBlockDocument(header, signersIndexes, ei.marshalizer, ei.hasher)
+	if err != nil {
+		return nil, nil, errors.New("could not marshal header: " + err.Error())
 	}
 
 	serializedBlock, err := json.Marshal(elasticBlock)
 	if err != nil {
-		ei.logger.Warn("could not marshal elastic header")
-		return nil, nil
+		return nil, nil, errors.New("could not marshal elastic header: " + err.Error())
 	}
 
-	return serializedBlock, headerHash
+	return serializedBlock, headerHash, nil
 }
 
-func (ei *elasticIndexer) saveHeader(header data.HeaderHandler, signersIndexes []uint64) {
-	var buff bytes.Buffer
-
-	serializedBlock, headerHash := ei.getSerializedElasticBlockAndHeaderHash(header, signersIndexes)
-
-	buff.Grow(len(serializedBlock))
-	buff.Write(serializedBlock)
-
+func (ei *elasticIndexer) saveHeader(docID string, serializedBlock []byte) error {
 	req := esapi.IndexRequest{
 		Index:      blockIndex,
-		DocumentID: hex.EncodeToString(headerHash),
-		Body:       bytes.NewReader(buff.Bytes()),
+		DocumentID: docID,
+		Body:       bytes.NewReader(serializedBlock),
 		Refresh:    "true",
 	}
 
 	res, err := req.Do(context.Background(), ei.db)
 	if err != nil {
-		ei.logger.Warn(fmt.Sprintf("Could not index block header: %s", err))
-		return
+		return fmt.Errorf("could not index block header: %s", err)
 	}
 
 	defer closeESResponseBody(res)
 
 	if res.IsError() {
-		ei.logger.Warn(res.String())
+		return fmt.Errorf("could not index block header: %s", res.String())
 	}
+
+	return nil
 }
 
 func (ei *elasticIndexer) serializeBulkTx(bulk []*Transaction) bytes.Buffer {
@@ -413,25 +517,53 @@ func (ei *elasticIndexer) serializeBulkTx(bulk []*Transaction) bytes.Buffer {
 	return buff
 }
 
-func (ei *elasticIndexer) saveTransactions(
+// serializeTransactionBulks builds the bulks of transactions of the block and serializes them as bodies
+// for the elasticsearch bulk API
+func (ei *elasticIndexer) serializeTransactionBulks(
 	body block.Body,
 	header data.HeaderHandler,
-	txPool map[string]data.TransactionHandler) {
+	txPool map[string]data.TransactionHandler,
+) [][]byte {
 	bulks := ei.buildTransactionBulks(body, header, txPool)
 
+	serializedBulks := make([][]byte, 0, len(bulks))
 	for _, bulk := range bulks {
-		buff := ei.serializeBulkTx(bulk)
-		res, err := ei.db.Bulk(bytes.NewReader(buff.Bytes()), ei.db.Bulk.WithIndex(txIndex))
-		if err != nil {
-			ei.logger.Warn("error indexing bulk of transactions")
+		if len(bulk) == 0 {
 			continue
 		}
-		if res.IsError() {
-			ei.logger.Warn(res.String())
-		}
 
-		closeESResponseBody(res)
+		buff := ei.serializeBulkTx(bulk)
+		serializedBulks = append(serializedBulks, buff.Bytes())
 	}
+
+	return serializedBulks
+}
+
+func (ei *elasticIndexer) saveTransactionsBulk(serializedBulk []byte) error {
+	res, err := ei.db.Bulk(bytes.NewReader(serializedBulk), ei.db.Bulk.WithIndex(txIndex))
+	if err != nil {
+		return fmt.Errorf("could not index bulk of transactions: %s", err)
+	}
+
+	defer closeESResponseBody(res)
+
+	if res.IsError() {
+		return fmt.Errorf("could not index bulk of transactions: %s", res.String())
+	}
+
+	// a bulk request succeeds even if some of its items were rejected, so the response has to be inspected
+	bulkResponse := struct {
+		Errors bool `json:"errors"`
+	}{}
+	err = json.NewDecoder(res.Body).Decode(&bulkResponse)
+	if err != nil {
+		return fmt.Errorf("could not decode the bulk response: %s", err)
+	}
+	if bulkResponse.Errors {
+		return ErrBulkItemsFailed
+	}
+
+	return nil
 }
 
 // buildTransactionBulks creates bulks of maximum txBulkSize transactions to be indexed together
@@ -441,62 +573,30 @@ func (ei *elasticIndexer) buildTransactionBulks(
 	header data.HeaderHandler,
 	txPool map[string]data.TransactionHandler,
 ) [][]*Transaction {
-	processedTxCount := 0
-	bulks := make([][]*Transaction, (header.GetTxCount()/txBulkSize)+1)
-	blockMarshal, _ := ei.marshalizer.Marshal(header)
-	blockHash := ei.hasher.Compute(string(blockMarshal))
-
-	for _, mb := range body {
-		mbMarshal, err := ei.marshalizer.Marshal(mb)
-		if err != nil {
-			ei.logger.Warn("could not marshal miniblock")
-			continue
-		}
-		mbHash := ei.hasher.Compute(string(mbMarshal))
-
-		mbTxStatus := "Pending"
-		if ei.shardCoordinator.SelfId() == mb.ReceiverShardID {
-			mbTxStatus = "Success"
-		}
-
-		for _, txHash := range mb.TxHashes {
-			processedTxCount++
-
-			currentBulk := processedTxCount / txBulkSize
-			currentTxHandler, ok := txPool[string(txHash)]
-			if !ok {
-				ei.logger.Warn("elasticsearch could not find tx hash in pool")
-				continue
-			}
-
-			currentTx := getTransactionByType(currentTxHandler, txHash, mbHash, blockHash, mb, header, mbTxStatus)
-			if currentTx == nil {
-				ei.logger.Warn("elasticsearch found tx in pool but of wrong type")
-				continue
-			}
+	transactions := createTransactionDocuments(
+		body,
+		header,
+		txPool,
+		ei.shardCoordinator.SelfId(),
+		ei.marshalizer,
+		ei.hasher,
+		ei.logger,
+	)
 
-			bulks[currentBulk] = append(bulks[currentBulk], currentTx)
-		}
+	bulks := make([][]*Transaction, 0, len(transactions)/txBulkSize+1)
+	for len(transactions) > txBulkSize {
+		bulks = append(bulks, transactions[:txBulkSize])
+		transactions = transactions[txBulkSize:]
 	}
 
-	return bulks
+	return append(bulks, transactions)
 }
 
 func (ei *elasticIndexer) serializeShardInfo(shardInfo statistics.ShardStatistic) ([]byte, []byte) {
 	meta := []byte(fmt.Sprintf(`{ "index" : { "_id" : "%s%d", "_type" : "%s" } }%s`,
 		shardTpsDocIDPrefix, shardInfo.ShardID(), tpsIndex, "\n"))
 
-	bigTxCount := big.NewInt(int64(shardInfo.AverageBlockTxCount()))
-	shardTPS := TPS{
-		ShardID:               shardInfo.ShardID(),
-		LiveTPS:               shardInfo.LiveTPS(),
-		PeakTPS:               shardInfo.PeakTPS(),
-		AverageTPS:            shardInfo.AverageTPS(),
-		AverageBlockTxCount:   bigTxCount,
-		CurrentBlockNonce:     shardInfo.CurrentBlockNonce(),
-		LastBlockTxCount:      shardInfo.LastBlockTxCount(),
-		TotalProcessedTxCount: shardInfo.TotalProcessedTxCount(),
-	}
+	shardTPS := createShardTPSDocument(shardInfo)
 
 	serializedInfo, err := json.Marshal(shardTPS)
 	if err != nil {
@@ -519,19 +619,7 @@ func (ei *elasticIndexer) UpdateTPS(tpsBenchmark statistics.TPSBenchmark) {
 	var buff bytes.Buffer
 
 	meta := []byte(fmt.Sprintf(`{ "index" : { "_id" : "%s", "_type" : "%s" } }%s`, metachainTpsDocID, tpsIndex, "\n"))
-	generalInfo := TPS{
-		LiveTPS:    tpsBenchmark.LiveTPS(),
-		PeakTPS:    tpsBenchmark.PeakTPS(),
-		NrOfShards: tpsBenchmark.NrOfShards(),
-		// TODO: This value is still mocked, it should be removed if we cannot populate it correctly
-		NrOfNodes:             100,
-		BlockNumber:           tpsBenchmark.BlockNumber(),
-		RoundNumber:           tpsBenchmark.RoundNumber(),
-		RoundTime:             tpsBenchmark.RoundTime(),
-		AverageBlockTxCount:   tpsBenchmark.AverageBlockTxCount(),
-		LastBlockTxCount:      tpsBenchmark.LastBlockTxCount(),
-		TotalProcessedTxCount: tpsBenchmark.TotalProcessedTxCount(),
-	}
+	generalInfo := createGeneralTPSDocument(tpsBenchmark)
 
 	serializedInfo, err := json.Marshal(generalInfo)
 	if err != nil {
@@ -650,6 +738,7 @@ func buildTransaction(
 		Signature:     hex.EncodeToString(tx.Signature),
 		Timestamp:     time.Duration(header.GetTimeStamp()),
 		Status:        txStatus,
+		Type:          txTypeNormal,
 	}
 }
 
@@ -678,6 +767,7 @@ func buildSmartContractResult(
 		Signature:     "",
 		Timestamp:     time.Duration(header.GetTimeStamp()),
 		Status:        "Success",
+		Type:          txTypeSmartContractResult,
 	}
 }
 
@@ -709,5 +799,6 @@ func buildRewardTransaction(
 		Signature:     "",
 		Timestamp:     time.Duration(header.GetTimeStamp()),
 		Status:        "Success",
+		Type:          txTypeReward,
 	}
 }
@@ -4,9 +4,12 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"io/ioutil"
 	"math/big"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -18,6 +21,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
 	"github.com/gin-gonic/gin/json"
 	"github.com/stretchr/testify/assert"
 )
@@ -32,6 +37,17 @@ var (
 	password         = "password"
 )
 
+func newTestOptions() *indexer.Options {
+	persister, _ := memorydb.New()
+
+	return &indexer.Options{
+		QueuePersister: persister,
+		StatusHandler:  statusHandler.NewNilStatusHandler(),
+		MinRetryDelay:  time.Millisecond,
+		MaxRetryDelay:  10 * time.Millisecond,
+	}
+}
+
 func newTestBlockHeader() *block.Header {
 	return &block.Header{
 		Nonce:            10,
@@ -183,12 +199,134 @@ func TestElasticIndexer_NewIndexerWithCorrectParamsShouldWork(t *testing.T) {
 		}
 	}))
 
-	ei, err := indexer.NewElasticIndexer(ts.URL, username, password, shardCoordinator, marshalizer, hasher, log, &indexer.Options{})
+	ei, err := indexer.NewElasticIndexer(ts.URL, username, password, shardCoordinator, marshalizer, hasher, log, newTestOptions())
 
 	assert.NotNil(t, ei)
 	assert.Nil(t, err)
 }
 
+func TestElasticIndexer_NewIndexerWithNilQueuePersisterShouldError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	options := newTestOptions()
+	options.QueuePersister = nil
+	ei, err := indexer.NewElasticIndexer(ts.URL, username, password, shardCoordinator, marshalizer, hasher, log, options)
+
+	assert.Nil(t, ei)
+	assert.Equal(t, indexer.ErrNilQueuePersister, err)
+}
+
+func TestElasticIndexer_SaveBlockShouldIndexInOrderAndRetry(t *testing.T) {
+	mutRequests := sync.Mutex{}
+	indexedBlocks := make([]string, 0)
+	numBulkRequests := 0
+	numFailedRequests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutRequests.Lock()
+		defer mutRequests.Unlock()
+
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if strings.HasPrefix(r.URL.Path, "/blocks/") {
+			//elasticsearch is unavailable for the first two requests
+			if numFailedRequests < 2 {
+				numFailedRequests++
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+
+			buff, _ := ioutil.ReadAll(r.Body)
+			elasticBlock := indexer.Block{}
+			_ = marshalizer.Unmarshal(&elasticBlock, buff)
+			indexedBlocks = append(indexedBlocks, fmt.Sprintf("%d", elasticBlock.Nonce))
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"result":"created"}`))
+			return
+		}
+		if r.URL.Path == "/transactions/_bulk" {
+			numBulkRequests++
+			_, _ = w.Write([]byte(`{"errors":false,"items":[]}`))
+			return
+		}
+	}))
+	defer ts.Close()
+
+	options := newTestOptions()
+	options.TxIndexingEnabled = true
+	ei, err := indexer.NewElasticIndexer(ts.URL, username, password, shardCoordinator, marshalizer, hasher, log, options)
+	assert.Nil(t, err)
+
+	for nonce := uint64(10); nonce < 13; nonce++ {
+		header := newTestBlockHeader()
+		header.Nonce = nonce
+		ei.SaveBlock(newTestBlockBody(), header, newTestTxPool(), []uint64{0, 1})
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		mutRequests.Lock()
+		done := numBulkRequests == 3
+		mutRequests.Unlock()
+		if done {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	mutRequests.Lock()
+	assert.Equal(t, []string{"10", "11", "12"}, indexedBlocks)
+	assert.Equal(t, 3, numBulkRequests)
+	mutRequests.Unlock()
+}
+
+func TestElasticIndexer_SaveBlockShouldRetryFailedBulkItems(t *testing.T) {
+	mutRequests := sync.Mutex{}
+	numBulkRequests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutRequests.Lock()
+		defer mutRequests.Unlock()
+
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if r.URL.Path == "/transactions/_bulk" {
+			numBulkRequests++
+			//the first bulk request is accepted but one of its items is rejected
+			_, _ = w.Write([]byte(fmt.Sprintf(`{"errors":%v,"items":[]}`, numBulkRequests == 1)))
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"result":"created"}`))
+	}))
+	defer ts.Close()
+
+	options := newTestOptions()
+	options.TxIndexingEnabled = true
+	ei, _ := indexer.NewElasticIndexer(ts.URL, username, password, shardCoordinator, marshalizer, hasher, log, options)
+
+	ei.SaveBlock(newTestBlockBody(), newTestBlockHeader(), newTestTxPool(), []uint64{0})
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		mutRequests.Lock()
+		done := numBulkRequests == 2
+		mutRequests.Unlock()
+		if done {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	mutRequests.Lock()
+	assert.Equal(t, 2, numBulkRequests)
+	mutRequests.Unlock()
+}
+
 func TestElasticIndexer_CheckAndCreateIndexShouldWorkIfIndexExists(t *testing.T) {
 	blocksFunctionCount := 0
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -269,7 +407,8 @@ func TestElasticIndexer_getSerializedElasticBlockAndHeaderHash(t *testing.T) {
 	header := newTestBlockHeader()
 	signersIndexes := []uint64{0, 1, 2, 3}
 
-	serializedBlock, headerHash := ei.GetSerializedElasticBlockAndHeaderHash(header, signersIndexes)
+	serializedBlock, headerHash, err := ei.GetSerializedElasticBlockAndHeaderHash(header, signersIndexes)
+	assert.Nil(t, err)
 
 	h, _ := marshalizer.Marshal(header)
 	expectedHeaderHash := hasher.Compute(string(h))
@@ -15,3 +15,63 @@ var ErrNoHeader = errors.New("elasticsearch - no header")
 
 // ErrNoMiniblocks signals that we could not create an elasticsearch index
 var ErrNoMiniblocks = errors.New("elasticsearch - no miniblocks")
+
+// ErrNilQueuePersister signals that a nil persister has been provided for the indexing queue
+var ErrNilQueuePersister = errors.New("elasticsearch - nil indexing queue persister")
+
+// ErrNilIndexHandler signals that a nil index handler has been provided for the indexing queue
+var ErrNilIndexHandler = errors.New("elasticsearch - nil index handler")
+
+// ErrNilQueuedBlock signals that a nil block has been provided to be queued for indexing
+var ErrNilQueuedBlock = errors.New("elasticsearch - nil queued block")
+
+// ErrInvalidRetryDelay signals that the retry delays of the indexing queue are not valid
+var ErrInvalidRetryDelay = errors.New("elasticsearch - invalid indexing retry delay")
+
+// ErrBulkItemsFailed signals that elasticsearch has rejected some of the items of a bulk request
+var ErrBulkItemsFailed = errors.New("elasticsearch - bulk request contains failed items")
+
+// ErrEmptyDriverName signals that an indexer driver was registered with an empty name
+var ErrEmptyDriverName = errors.New("empty indexer driver name")
+
+// ErrNilDriverFactory signals that a nil factory was provided for an indexer driver
+var ErrNilDriverFactory = errors.New("nil indexer driver factory")
+
+// ErrDriverAlreadyRegistered signals that an indexer driver with the same name was already registered
+var ErrDriverAlreadyRegistered = errors.New("indexer driver already registered")
+
+// ErrUnknownDriver signals that no indexer driver was registered under the requested name
+var ErrUnknownDriver = errors.New("unknown indexer driver")
+
+// ErrNilOptions signals that nil indexer options have been provided
+var ErrNilOptions = errors.New("nil indexer options")
+
+// ErrNilDatabase signals that a nil database handle has been provided
+var ErrNilDatabase = errors.New("nil database")
+
+// ErrNilWriter signals that a nil writer has been provided
+var ErrNilWriter = errors.New("nil writer")
+
+// ErrEmptyDataSourceName signals that the sql driver was configured without a data source name
+var ErrEmptyDataSourceName = errors.New("sql - empty data source name")
+
+// ErrNoIndexers signals that the fan-out indexer was created without any indexer
+var ErrNoIndexers = errors.New("no indexers provided")
+
+// ErrNilIndexer signals that a nil indexer has been provided
+var ErrNilIndexer = errors.New("nil indexer")
+
+// ErrNilStorageService signals that a nil storage service has been provided
+var ErrNilStorageService = errors.New("nil storage service")
+
+// ErrNilUint64Converter signals that a nil uint64 converter has been provided
+var ErrNilUint64Converter = errors.New("nil uint64 converter")
+
+// ErrNilBackfillPersister signals that a nil persister has been provided for the backfill progress
+var ErrNilBackfillPersister = errors.New("nil backfill persister")
+
+// ErrInvalidBackfillInterval signals that the end nonce of a backfill is lower than its start nonce
+var ErrInvalidBackfillInterval = errors.New("invalid backfill nonce interval")
+
+// ErrNilNodesCoordinator signals that a nil nodes coordinator has been provided
+var ErrNilNodesCoordinator = errors.New("nil nodes coordinator")
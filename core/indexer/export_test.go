@@ -3,7 +3,9 @@ package indexer
 import (
 	"bytes"
 	"io"
+	"time"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/logger"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/data"
@@ -11,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/elastic/go-elasticsearch/v7"
 )
 
@@ -36,13 +39,22 @@ func NewTestElasticIndexer(
 	}
 
 	es, _ := elasticsearch.NewClient(cfg)
-	indexer := elasticIndexer{es, shardCoordinator,
-		marshalizer, hasher, logger, options, false}
+	indexer := elasticIndexer{
+		db:               es,
+		shardCoordinator: shardCoordinator,
+		marshalizer:      marshalizer,
+		hasher:           hasher,
+		logger:           logger,
+		options:          options,
+	}
 
 	return ElasticIndexer{indexer}
 }
 
-func (ei *ElasticIndexer) GetSerializedElasticBlockAndHeaderHash(header data.HeaderHandler, signersIndexes []uint64) ([]byte, []byte) {
+func (ei *ElasticIndexer) GetSerializedElasticBlockAndHeaderHash(
+	header data.HeaderHandler,
+	signersIndexes []uint64,
+) ([]byte, []byte, error) {
 	return ei.getSerializedElasticBlockAndHeaderHash(header, signersIndexes)
 }
 
@@ -69,3 +81,43 @@ func (ei *ElasticIndexer) CheckAndCreateIndex(index string, body io.Reader) erro
 func (ei *ElasticIndexer) CreateIndex(index string, body io.Reader) error {
 	return ei.createIndex(index, body)
 }
+
+func NewTestIndexQueue(
+	persister storage.Persister,
+	marshalizer marshal.Marshalizer,
+	indexHandler func(queuedBlock *QueuedBlock) error,
+	statusHandler core.AppStatusHandler,
+	logger *logger.Logger,
+	minRetryDelay time.Duration,
+	maxRetryDelay time.Duration,
+) (*indexQueue, error) {
+	return newIndexQueue(argIndexQueue{
+		Persister:     persister,
+		Marshalizer:   marshalizer,
+		IndexHandler:  indexHandler,
+		StatusHandler: statusHandler,
+		Logger:        logger,
+		MinRetryDelay: minRetryDelay,
+		MaxRetryDelay: maxRetryDelay,
+	})
+}
+
+func (iq *indexQueue) RetryDelay(numFailures int) time.Duration {
+	return iq.retryDelay(numFailures)
+}
+
+func NewTestQueuedFileIndexer(
+	writer io.WriteCloser,
+	shardCoordinator sharding.Coordinator,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	logger *logger.Logger,
+	options *Options,
+) (Indexer, error) {
+	fi, err := NewFileIndexer(writer, shardCoordinator, marshalizer, hasher, logger, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return newQueuedIndexer(fi, options.QueuePersister, marshalizer, logger, options)
+}
@@ -0,0 +1,285 @@
+package indexer
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go/consensus/recorder"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/gin-gonic/gin/json"
+)
+
+const fileIndexerFilePrefix = "indexer"
+const megabyte = 1024 * 1024
+
+const recordTypeBlock = "block"
+const recordTypeMiniBlock = "miniblock"
+const recordTypeRound = "round"
+const recordTypeTPS = "tps"
+const recordTypeValidators = "validators"
+
+var transactionRecordTypes = map[string]string{
+	txTypeNormal:              "transaction",
+	txTypeSmartContractResult: "scr",
+	txTypeReward:              "reward",
+}
+
+// FileRecord is a line of the newline-delimited JSON files written by the file indexer. The id of a record is
+// the id of the same document in elasticsearch
+type FileRecord struct {
+	Type string      `json:"type"`
+	ID   string      `json:"id"`
+	Data interface{} `json:"data"`
+}
+
+// fileIndexer writes all the indexed data as newline-delimited JSON records, to be consumed by data pipelines
+type fileIndexer struct {
+	writer           io.WriteCloser
+	shardCoordinator sharding.Coordinator
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+	logger           *logger.Logger
+	options          *Options
+}
+
+// NewFileIndexer creates a new indexer writing newline-delimited JSON records in the provided writer
+func NewFileIndexer(
+	writer io.WriteCloser,
+	shardCoordinator sharding.Coordinator,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	logger *logger.Logger,
+	options *Options,
+) (*fileIndexer, error) {
+	if writer == nil {
+		return nil, ErrNilWriter
+	}
+	if shardCoordinator == nil || shardCoordinator.IsInterfaceNil() {
+		return nil, core.ErrNilCoordinator
+	}
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, core.ErrNilMarshalizer
+	}
+	if hasher == nil || hasher.IsInterfaceNil() {
+		return nil, core.ErrNilHasher
+	}
+	if logger == nil {
+		return nil, core.ErrNilLogger
+	}
+	if options == nil {
+		return nil, ErrNilOptions
+	}
+
+	return &fileIndexer{
+		writer:           writer,
+		shardCoordinator: shardCoordinator,
+		marshalizer:      marshalizer,
+		hasher:           hasher,
+		logger:           logger,
+		options:          options,
+	}, nil
+}
+
+func createFileIndexerFromDriverArgs(args DriverArgs) (Indexer, error) {
+	writer, err := recorder.NewRotatingFileWriter(
+		args.Config.FolderPath,
+		fileIndexerFilePrefix,
+		int64(args.Config.MaxFileSizeInMB)*megabyte,
+		args.Config.MaxFiles,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	fileIdx, err := NewFileIndexer(writer, args.ShardCoordinator, args.Marshalizer, args.Hasher, args.Logger, args.Options)
+	if err != nil {
+		_ = writer.Close()
+		return nil, err
+	}
+
+	return createQueuedIndexerFromDriverArgs(fileIdx, args)
+}
+
+// SaveBlock writes the block, its miniblocks and, if enabled, its transactions. All the records of a block are
+// written at once, so they always end up in the same file
+func (fi *fileIndexer) SaveBlock(
+	bodyHandler data.BodyHandler,
+	headerHandler data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	signersIndexes []uint64,
+) {
+	err := fi.IndexBlock(bodyHandler, headerHandler, txPool, signersIndexes)
+	if err != nil {
+		fi.logger.Warn(fmt.Sprintf("file indexer could not write block: %s", err.Error()))
+	}
+}
+
+// IndexBlock writes the block as SaveBlock does, returning the error encountered
+func (fi *fileIndexer) IndexBlock(
+	bodyHandler data.BodyHandler,
+	headerHandler data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	signersIndexes []uint64,
+) error {
+	documents, err := fi.createBlockDocuments(bodyHandler, headerHandler, txPool, signersIndexes)
+	if err != nil {
+		return err
+	}
+
+	return fi.saveBlockDocuments(documents)
+}
+
+// SaveMetaBlock writes the meta block
+func (fi *fileIndexer) SaveMetaBlock(header data.HeaderHandler, signersIndexes []uint64) {
+	err := fi.IndexMetaBlock(header, signersIndexes)
+	if err != nil {
+		fi.logger.Warn(fmt.Sprintf("file indexer could not write meta block: %s", err.Error()))
+	}
+}
+
+// IndexMetaBlock writes the meta block as SaveMetaBlock does, returning the error encountered
+func (fi *fileIndexer) IndexMetaBlock(header data.HeaderHandler, signersIndexes []uint64) error {
+	documents, err := fi.createMetaBlockDocuments(header, signersIndexes)
+	if err != nil {
+		return err
+	}
+
+	return fi.saveBlockDocuments(documents)
+}
+
+func (fi *fileIndexer) createBlockDocuments(
+	bodyHandler data.BodyHandler,
+	headerHandler data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	signersIndexes []uint64,
+) (*BlockDocuments, error) {
+	return createBlockDocuments(
+		bodyHandler,
+		headerHandler,
+		txPool,
+		signersIndexes,
+		fi.options.TxIndexingEnabled,
+		fi.shardCoordinator.SelfId(),
+		fi.marshalizer,
+		fi.hasher,
+		fi.logger,
+	)
+}
+
+func (fi *fileIndexer) createMetaBlockDocuments(header data.HeaderHandler, signersIndexes []uint64) (*BlockDocuments, error) {
+	return createMetaBlockDocuments(header, signersIndexes, fi.marshalizer, fi.hasher)
+}
+
+// saveBlockDocuments writes all the records of a block at once, so they always end up in the same file
+func (fi *fileIndexer) saveBlockDocuments(documents *BlockDocuments) error {
+	records := []*FileRecord{{Type: recordTypeBlock, ID: documents.Block.Hash, Data: documents.Block}}
+	for _, mb := range documents.MiniBlocks {
+		records = append(records, &FileRecord{Type: recordTypeMiniBlock, ID: mb.Hash, Data: mb})
+	}
+	for _, tx := range documents.Transactions {
+		records = append(records, &FileRecord{
+			Type: transactionRecordTypes[tx.Type],
+			ID:   tx.Transaction.Hash,
+			Data: tx.Transaction,
+		})
+	}
+
+	return fi.writeRecords(records)
+}
+
+// SaveRoundInfo writes the round info
+func (fi *fileIndexer) SaveRoundInfo(roundInfo RoundInfo) {
+	fi.saveRecords([]*FileRecord{{
+		Type: recordTypeRound,
+		ID:   strconv.FormatUint(uint64(roundInfo.ShardId), 10) + "_" + strconv.FormatUint(roundInfo.Index, 10),
+		Data: roundInfo,
+	}})
+}
+
+// UpdateTPS writes the network and the shards statistics
+func (fi *fileIndexer) UpdateTPS(tpsBenchmark statistics.TPSBenchmark) {
+	if tpsBenchmark == nil {
+		fi.logger.Warn("update tps called, but the tpsBenchmark is nil")
+		return
+	}
+
+	records := []*FileRecord{{Type: recordTypeTPS, ID: metachainTpsDocID, Data: createGeneralTPSDocument(tpsBenchmark)}}
+	for _, shardInfo := range tpsBenchmark.ShardStatistics() {
+		records = append(records, &FileRecord{
+			Type: recordTypeTPS,
+			ID:   fmt.Sprintf("%s%d", shardTpsDocIDPrefix, shardInfo.ShardID()),
+			Data: createShardTPSDocument(shardInfo),
+		})
+	}
+
+	fi.saveRecords(records)
+}
+
+// SaveValidatorsPubKeys writes the public keys of the validators of each shard
+func (fi *fileIndexer) SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte) {
+	records := make([]*FileRecord, 0, len(validatorsPubKeys))
+	for shardId, shardPubKeys := range validatorsPubKeys {
+		records = append(records, &FileRecord{
+			Type: recordTypeValidators,
+			ID:   strconv.FormatUint(uint64(shardId), 10),
+			Data: ValidatorsPublicKeys{PublicKeys: encodePubKeys(shardPubKeys)},
+		})
+	}
+
+	fi.saveRecords(records)
+}
+
+func (fi *fileIndexer) saveRecords(records []*FileRecord) {
+	err := fi.writeRecords(records)
+	if err != nil {
+		fi.logger.Warn(fmt.Sprintf("file indexer could not write records: %s", err.Error()))
+	}
+}
+
+func (fi *fileIndexer) writeRecords(records []*FileRecord) error {
+	var buff bytes.Buffer
+	for _, record := range records {
+		serializedRecord, err := json.Marshal(record)
+		if err != nil {
+			fi.logger.Warn(fmt.Sprintf("file indexer could not serialize a %s record: %s", record.Type, err.Error()))
+			continue
+		}
+
+		buff.Write(serializedRecord)
+		buff.WriteByte('\n')
+	}
+
+	if buff.Len() == 0 {
+		return nil
+	}
+
+	_, err := fi.writer.Write(buff.Bytes())
+
+	return err
+}
+
+// Close closes the underlying writer
+func (fi *fileIndexer) Close() error {
+	return fi.writer.Close()
+}
+
+// IsNilIndexer returns false as the file indexer writes all the data it receives
+func (fi *fileIndexer) IsNilIndexer() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fi *fileIndexer) IsInterfaceNil() bool {
+	if fi == nil {
+		return true
+	}
+	return false
+}
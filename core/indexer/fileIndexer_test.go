@@ -0,0 +1,270 @@
+package indexer_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus/recorder"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type bufferWriteCloser struct {
+	mut      sync.Mutex
+	buff     bytes.Buffer
+	isClosed bool
+}
+
+func (bwc *bufferWriteCloser) Write(p []byte) (int, error) {
+	bwc.mut.Lock()
+	defer bwc.mut.Unlock()
+
+	return bwc.buff.Write(p)
+}
+
+func (bwc *bufferWriteCloser) Close() error {
+	bwc.mut.Lock()
+	bwc.isClosed = true
+	bwc.mut.Unlock()
+
+	return nil
+}
+
+func (bwc *bufferWriteCloser) records(t *testing.T) []map[string]interface{} {
+	bwc.mut.Lock()
+	defer bwc.mut.Unlock()
+
+	records := make([]map[string]interface{}, 0)
+	scanner := bufio.NewScanner(bytes.NewReader(bwc.buff.Bytes()))
+	for scanner.Scan() {
+		record := make(map[string]interface{})
+		err := json.Unmarshal(scanner.Bytes(), &record)
+		assert.Nil(t, err)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// failingWriteCloser fails all the writes until it is repaired
+type failingWriteCloser struct {
+	bufferWriteCloser
+	isFailing bool
+}
+
+func (fwc *failingWriteCloser) Write(p []byte) (int, error) {
+	fwc.mut.Lock()
+	isFailing := fwc.isFailing
+	fwc.mut.Unlock()
+	if isFailing {
+		return 0, errors.New("write failed")
+	}
+
+	return fwc.bufferWriteCloser.Write(p)
+}
+
+func (fwc *failingWriteCloser) repair() {
+	fwc.mut.Lock()
+	fwc.isFailing = false
+	fwc.mut.Unlock()
+}
+
+func recordTypes(records []map[string]interface{}) []string {
+	types := make([]string, 0, len(records))
+	for _, record := range records {
+		types = append(types, record["type"].(string))
+	}
+
+	return types
+}
+
+func TestNewFileIndexer_NilWriterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	fi, err := indexer.NewFileIndexer(nil, shardCoordinator, marshalizer, hasher, log, &indexer.Options{})
+
+	assert.Nil(t, fi)
+	assert.Equal(t, indexer.ErrNilWriter, err)
+}
+
+func TestNewFileIndexer_NilOptionsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	fi, err := indexer.NewFileIndexer(&bufferWriteCloser{}, shardCoordinator, marshalizer, hasher, log, nil)
+
+	assert.Nil(t, fi)
+	assert.Equal(t, indexer.ErrNilOptions, err)
+}
+
+func TestFileIndexer_SaveBlockShouldWriteOneRecordPerLine(t *testing.T) {
+	t.Parallel()
+
+	writer := &bufferWriteCloser{}
+	fi, _ := indexer.NewFileIndexer(writer, shardCoordinator, marshalizer, hasher, log,
+		&indexer.Options{TxIndexingEnabled: true})
+
+	fi.SaveBlock(newTestBlockBodyWithScrsAndRewards(), newTestBlockHeader(), newTestTxPoolWithScrsAndRewards(), []uint64{0})
+
+	records := writer.records(t)
+	assert.Equal(t,
+		[]string{"block", "miniblock", "miniblock", "miniblock", "miniblock",
+			"transaction", "transaction", "transaction", "scr", "reward", "reward"},
+		recordTypes(records),
+	)
+	assert.Equal(t, hex.EncodeToString([]byte("tx1")), records[5]["id"])
+	assert.Equal(t, "tx_data1", records[5]["data"].(map[string]interface{})["data"])
+}
+
+func TestFileIndexer_SaveBlockWithTxIndexingDisabledShouldWriteOnlyBlockAndMiniBlocks(t *testing.T) {
+	t.Parallel()
+
+	writer := &bufferWriteCloser{}
+	fi, _ := indexer.NewFileIndexer(writer, shardCoordinator, marshalizer, hasher, log, &indexer.Options{})
+
+	fi.SaveBlock(newTestBlockBody(), newTestBlockHeader(), newTestTxPool(), []uint64{0})
+
+	assert.Equal(t, []string{"block", "miniblock", "miniblock"}, recordTypes(writer.records(t)))
+}
+
+func TestFileIndexer_SaveRoundInfoAndValidatorsShouldWriteRecords(t *testing.T) {
+	t.Parallel()
+
+	writer := &bufferWriteCloser{}
+	fi, _ := indexer.NewFileIndexer(writer, shardCoordinator, marshalizer, hasher, log, &indexer.Options{})
+
+	fi.SaveRoundInfo(indexer.RoundInfo{Index: 7, ShardId: 1})
+	fi.SaveValidatorsPubKeys(map[uint32][][]byte{0: {[]byte("pk1")}})
+	tpsBench := mock.TpsBenchmarkMock{}
+	tpsBench.Update(newTestMetaBlock())
+	fi.UpdateTPS(&tpsBench)
+
+	records := writer.records(t)
+	assert.Equal(t, []string{"round", "validators", "tps"}, recordTypes(records))
+	assert.Equal(t, "1_7", records[0]["id"])
+	assert.Equal(t, "0", records[1]["id"])
+}
+
+func TestFileIndexer_CloseShouldCloseTheWriter(t *testing.T) {
+	t.Parallel()
+
+	writer := &bufferWriteCloser{}
+	fi, _ := indexer.NewFileIndexer(writer, shardCoordinator, marshalizer, hasher, log, &indexer.Options{})
+
+	err := fi.Close()
+
+	assert.Nil(t, err)
+	assert.True(t, writer.isClosed)
+}
+
+func TestNewIndexerFromDriver_FileDriverShouldWriteRotatedFiles(t *testing.T) {
+	t.Parallel()
+
+	folderPath, _ := ioutil.TempDir("", "fileIndexer")
+	defer func() {
+		_ = os.RemoveAll(folderPath)
+	}()
+
+	idx, err := indexer.NewIndexerFromDriver(indexer.DriverArgs{
+		Config: config.IndexerConfig{
+			Driver:          indexer.FileDriver,
+			FolderPath:      folderPath,
+			MaxFileSizeInMB: 1,
+			MaxFiles:        2,
+		},
+		ShardCoordinator: shardCoordinator,
+		Marshalizer:      marshalizer,
+		Hasher:           hasher,
+		Logger:           log,
+		Options:          newTestOptions(),
+		CreateQueuePersister: func(name string) (storage.Persister, error) {
+			return memorydb.New()
+		},
+	})
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	err = idx.(indexer.BlockIndexer).IndexMetaBlock(newTestMetaBlock(), []uint64{0})
+	assert.Nil(t, err)
+	_ = idx.(io.Closer).Close()
+
+	files, _ := recorder.RecordFiles(folderPath, "indexer")
+	assert.Equal(t, 1, len(files))
+	content, _ := ioutil.ReadFile(files[0])
+	assert.Contains(t, string(content), `"type":"block"`)
+}
+
+func TestNewIndexerFromDriver_FileDriverWithoutQueuePersisterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	folderPath, _ := ioutil.TempDir("", "fileIndexer")
+	defer func() {
+		_ = os.RemoveAll(folderPath)
+	}()
+
+	idx, err := indexer.NewIndexerFromDriver(indexer.DriverArgs{
+		Config: config.IndexerConfig{
+			Driver:          indexer.FileDriver,
+			FolderPath:      folderPath,
+			MaxFileSizeInMB: 1,
+			MaxFiles:        2,
+		},
+		ShardCoordinator: shardCoordinator,
+		Marshalizer:      marshalizer,
+		Hasher:           hasher,
+		Logger:           log,
+		Options:          newTestOptions(),
+	})
+
+	assert.Nil(t, idx)
+	assert.Equal(t, indexer.ErrNilQueuePersister, err)
+}
+
+func TestQueuedFileIndexer_SaveBlockShouldWriteTheBlocksInNonceOrderRetryingTheFailures(t *testing.T) {
+	t.Parallel()
+
+	writer := &failingWriteCloser{isFailing: true}
+	idx, err := indexer.NewTestQueuedFileIndexer(writer, shardCoordinator, marshalizer, hasher, log, newTestOptions())
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	// the first saved block opens the queue, the lower nonce rewinds it
+	header2 := newTestBlockHeader()
+	header2.Nonce = 2
+	header1 := newTestBlockHeader()
+	header1.Nonce = 1
+	idx.SaveBlock(newTestBlockBody(), header2, newTestTxPool(), []uint64{0})
+	idx.SaveBlock(newTestBlockBody(), header1, newTestTxPool(), []uint64{0})
+	time.Sleep(20 * time.Millisecond)
+	writer.repair()
+
+	nonces := make([]float64, 0)
+	for i := 0; i < 100 && len(nonces) < 2; i++ {
+		time.Sleep(10 * time.Millisecond)
+		nonces = make([]float64, 0)
+		for _, record := range writer.records(t) {
+			if record["type"] == "block" {
+				nonces = append(nonces, record["data"].(map[string]interface{})["nonce"].(float64))
+			}
+		}
+	}
+	_ = idx.(io.Closer).Close()
+
+	assert.Equal(t, []float64{1, 2}, nonces)
+	assert.True(t, writer.isClosed)
+}
@@ -0,0 +1,334 @@
+package indexer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var queueStateKey = []byte("indexQueueState")
+
+const queuedBlockKeyPrefix = "block_"
+
+// queueState is the persisted cursor of the indexing queue. The blocks with nonces in [NextNonce, EndNonce)
+// are still waiting to be indexed
+type queueState struct {
+	IsInitialized bool   `json:"isInitialized"`
+	NextNonce     uint64 `json:"nextNonce"`
+	EndNonce      uint64 `json:"endNonce"`
+}
+
+// argIndexQueue holds the arguments needed to create an indexing queue
+type argIndexQueue struct {
+	Persister     storage.Persister
+	Marshalizer   marshal.Marshalizer
+	IndexHandler  func(queuedBlock *QueuedBlock) error
+	StatusHandler core.AppStatusHandler
+	Logger        *logger.Logger
+	MinRetryDelay time.Duration
+	MaxRetryDelay time.Duration
+}
+
+// indexQueue is a persistent work queue which sends the blocks to be indexed strictly in nonce order. A block
+// is removed from the queue only after it was acknowledged by the index handler, failures being retried with an
+// exponential backoff. As the queue state is kept in the persister, the indexing resumes after a restart from
+// the last acknowledged nonce
+type indexQueue struct {
+	persister     storage.Persister
+	marshalizer   marshal.Marshalizer
+	indexHandler  func(queuedBlock *QueuedBlock) error
+	statusHandler core.AppStatusHandler
+	logger        *logger.Logger
+	minRetryDelay time.Duration
+	maxRetryDelay time.Duration
+
+	mutState       sync.Mutex
+	state          queueState
+	failedAttempts uint64
+	chNewBlock     chan struct{}
+	chClose        chan struct{}
+	closeOnce      sync.Once
+	wgProcess      sync.WaitGroup
+}
+
+// newIndexQueue creates a new indexing queue, loads its state from the persister and starts sending
+// the pending blocks to the index handler
+func newIndexQueue(arg argIndexQueue) (*indexQueue, error) {
+	if arg.Persister == nil || arg.Persister.IsInterfaceNil() {
+		return nil, ErrNilQueuePersister
+	}
+	if arg.Marshalizer == nil || arg.Marshalizer.IsInterfaceNil() {
+		return nil, core.ErrNilMarshalizer
+	}
+	if arg.IndexHandler == nil {
+		return nil, ErrNilIndexHandler
+	}
+	if arg.StatusHandler == nil || arg.StatusHandler.IsInterfaceNil() {
+		return nil, core.ErrNilAppStatusHandler
+	}
+	if arg.Logger == nil {
+		return nil, core.ErrNilLogger
+	}
+	if arg.MinRetryDelay <= 0 || arg.MaxRetryDelay < arg.MinRetryDelay {
+		return nil, ErrInvalidRetryDelay
+	}
+
+	iq := &indexQueue{
+		persister:     arg.Persister,
+		marshalizer:   arg.Marshalizer,
+		indexHandler:  arg.IndexHandler,
+		statusHandler: arg.StatusHandler,
+		logger:        arg.Logger,
+		minRetryDelay: arg.MinRetryDelay,
+		maxRetryDelay: arg.MaxRetryDelay,
+		chNewBlock:    make(chan struct{}, 1),
+		chClose:       make(chan struct{}),
+	}
+
+	err := iq.loadState()
+	if err != nil {
+		return nil, err
+	}
+	iq.updateDepthMetric()
+
+	iq.wgProcess.Add(1)
+	go iq.processLoop()
+
+	return iq, nil
+}
+
+func (iq *indexQueue) loadState() error {
+	err := iq.persister.Has(queueStateKey)
+	if err != nil {
+		//nothing was queued yet
+		return nil
+	}
+
+	buff, err := iq.persister.Get(queueStateKey)
+	if err != nil {
+		return err
+	}
+
+	return iq.marshalizer.Unmarshal(&iq.state, buff)
+}
+
+func (iq *indexQueue) saveState() error {
+	buff, err := iq.marshalizer.Marshal(&iq.state)
+	if err != nil {
+		return err
+	}
+
+	return iq.persister.Put(queueStateKey, buff)
+}
+
+// Enqueue persists the block and schedules it for indexing. A block having the nonce of a block already
+// queued replaces it, while a block with a nonce lower than the next one to be indexed (as it happens after
+// a rollback) rewinds the queue so that the blocks are indexed again starting from it
+func (iq *indexQueue) Enqueue(queuedBlock *QueuedBlock) error {
+	if queuedBlock == nil {
+		return ErrNilQueuedBlock
+	}
+
+	buff, err := iq.marshalizer.Marshal(queuedBlock)
+	if err != nil {
+		return err
+	}
+
+	iq.mutState.Lock()
+	err = iq.persister.Put(queuedBlockKey(queuedBlock.Nonce), buff)
+	if err != nil {
+		iq.mutState.Unlock()
+		return err
+	}
+
+	nonce := queuedBlock.Nonce
+	switch {
+	case !iq.state.IsInitialized:
+		iq.state = queueState{IsInitialized: true, NextNonce: nonce, EndNonce: nonce + 1}
+	case nonce < iq.state.NextNonce:
+		iq.state.NextNonce = nonce
+	case nonce >= iq.state.EndNonce:
+		iq.state.EndNonce = nonce + 1
+	}
+
+	err = iq.saveState()
+	iq.mutState.Unlock()
+	if err != nil {
+		return err
+	}
+
+	iq.updateDepthMetric()
+
+	select {
+	case iq.chNewBlock <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+func (iq *indexQueue) processLoop() {
+	defer iq.wgProcess.Done()
+
+	numFailures := 0
+	for {
+		queuedBlock, buff, err := iq.peek()
+		if err == nil && queuedBlock == nil {
+			select {
+			case <-iq.chNewBlock:
+				continue
+			case <-iq.chClose:
+				return
+			}
+		}
+
+		if err == nil {
+			err = iq.indexHandler(queuedBlock)
+		}
+		if err != nil {
+			numFailures++
+			atomic.AddUint64(&iq.failedAttempts, 1)
+			iq.statusHandler.Increment(core.MetricIndexerFailedAttempts)
+			iq.logger.Warn(fmt.Sprintf("indexing queue: attempt %d to index the next block failed: %s",
+				numFailures, err.Error()))
+
+			select {
+			case <-time.After(iq.retryDelay(numFailures)):
+				continue
+			case <-iq.chClose:
+				return
+			}
+		}
+
+		numFailures = 0
+		err = iq.acknowledge(queuedBlock.Nonce, buff)
+		if err != nil {
+			iq.logger.Warn(fmt.Sprintf("indexing queue: could not acknowledge block with nonce %d: %s",
+				queuedBlock.Nonce, err.Error()))
+		}
+	}
+}
+
+// peek returns the block with the lowest nonce waiting to be indexed, skipping the missing nonces
+func (iq *indexQueue) peek() (*QueuedBlock, []byte, error) {
+	iq.mutState.Lock()
+	defer iq.mutState.Unlock()
+
+	for iq.state.NextNonce < iq.state.EndNonce {
+		key := queuedBlockKey(iq.state.NextNonce)
+		err := iq.persister.Has(key)
+		if err != nil {
+			iq.state.NextNonce++
+			continue
+		}
+
+		buff, err := iq.persister.Get(key)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		queuedBlock := &QueuedBlock{}
+		err = iq.marshalizer.Unmarshal(queuedBlock, buff)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return queuedBlock, buff, nil
+	}
+
+	return nil, nil, iq.saveState()
+}
+
+// acknowledge removes the indexed block from the queue. If the block was replaced or the queue was rewound
+// while the block was indexed, the queue is left untouched so that the new block gets indexed as well
+func (iq *indexQueue) acknowledge(nonce uint64, indexedBuff []byte) error {
+	iq.mutState.Lock()
+	defer iq.mutState.Unlock()
+
+	if iq.state.NextNonce != nonce {
+		return nil
+	}
+
+	key := queuedBlockKey(nonce)
+	currentBuff, err := iq.persister.Get(key)
+	if err != nil || !bytes.Equal(currentBuff, indexedBuff) {
+		return nil
+	}
+
+	err = iq.persister.Remove(key)
+	if err != nil {
+		return err
+	}
+
+	iq.state.NextNonce++
+	err = iq.saveState()
+	if err != nil {
+		return err
+	}
+
+	iq.statusHandler.SetUInt64Value(core.MetricIndexerLastIndexedNonce, nonce)
+	iq.statusHandler.SetUInt64Value(core.MetricIndexerQueueDepth, iq.depth())
+
+	return nil
+}
+
+func (iq *indexQueue) retryDelay(numFailures int) time.Duration {
+	delay := iq.minRetryDelay
+	for i := 1; i < numFailures && delay < iq.maxRetryDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > iq.maxRetryDelay {
+		return iq.maxRetryDelay
+	}
+
+	return delay
+}
+
+func (iq *indexQueue) depth() uint64 {
+	return iq.state.EndNonce - iq.state.NextNonce
+}
+
+func (iq *indexQueue) updateDepthMetric() {
+	iq.statusHandler.SetUInt64Value(core.MetricIndexerQueueDepth, iq.Depth())
+}
+
+// Depth returns the number of nonces waiting to be indexed
+func (iq *indexQueue) Depth() uint64 {
+	iq.mutState.Lock()
+	defer iq.mutState.Unlock()
+
+	return iq.depth()
+}
+
+// FailedAttempts returns the number of indexing attempts which have failed since the queue was started
+func (iq *indexQueue) FailedAttempts() uint64 {
+	return atomic.LoadUint64(&iq.failedAttempts)
+}
+
+// Close stops sending the blocks to the index handler and closes the persister. The blocks not yet
+// indexed remain in the persister and will be indexed after a restart
+func (iq *indexQueue) Close() error {
+	iq.closeOnce.Do(func() {
+		close(iq.chClose)
+	})
+	iq.wgProcess.Wait()
+
+	return iq.persister.Close()
+}
+
+func queuedBlockKey(nonce uint64) []byte {
+	key := make([]byte, len(queuedBlockKeyPrefix)+8)
+	copy(key, queuedBlockKeyPrefix)
+	binary.BigEndian.PutUint64(key[len(queuedBlockKeyPrefix):], nonce)
+
+	return key
+}
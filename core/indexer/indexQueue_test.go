@@ -0,0 +1,240 @@
+package indexer_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+)
+
+const minRetryDelay = time.Millisecond
+const maxRetryDelay = 10 * time.Millisecond
+const waitIndexingTimeout = 5 * time.Second
+
+type indexedNoncesRecorder struct {
+	mut    sync.Mutex
+	nonces []uint64
+}
+
+func (inr *indexedNoncesRecorder) record(queuedBlock *indexer.QueuedBlock) error {
+	inr.mut.Lock()
+	inr.nonces = append(inr.nonces, queuedBlock.Nonce)
+	inr.mut.Unlock()
+
+	return nil
+}
+
+func (inr *indexedNoncesRecorder) indexedNonces() []uint64 {
+	inr.mut.Lock()
+	defer inr.mut.Unlock()
+
+	return append([]uint64{}, inr.nonces...)
+}
+
+func waitQueueDepth(queueDepth func() uint64, depth uint64) bool {
+	deadline := time.Now().Add(waitIndexingTimeout)
+	for time.Now().Before(deadline) {
+		if queueDepth() == depth {
+			return true
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	return false
+}
+
+func TestNewIndexQueue_NilPersisterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	iq, err := indexer.NewTestIndexQueue(nil, marshalizer, func(queuedBlock *indexer.QueuedBlock) error {
+		return nil
+	}, statusHandler.NewNilStatusHandler(), log, minRetryDelay, maxRetryDelay)
+
+	assert.Nil(t, iq)
+	assert.Equal(t, indexer.ErrNilQueuePersister, err)
+}
+
+func TestNewIndexQueue_NilIndexHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	persister, _ := memorydb.New()
+	iq, err := indexer.NewTestIndexQueue(persister, marshalizer, nil,
+		statusHandler.NewNilStatusHandler(), log, minRetryDelay, maxRetryDelay)
+
+	assert.Nil(t, iq)
+	assert.Equal(t, indexer.ErrNilIndexHandler, err)
+}
+
+func TestNewIndexQueue_NilStatusHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	persister, _ := memorydb.New()
+	iq, err := indexer.NewTestIndexQueue(persister, marshalizer, func(queuedBlock *indexer.QueuedBlock) error {
+		return nil
+	}, nil, log, minRetryDelay, maxRetryDelay)
+
+	assert.Nil(t, iq)
+	assert.Equal(t, core.ErrNilAppStatusHandler, err)
+}
+
+func TestNewIndexQueue_InvalidRetryDelaysShouldErr(t *testing.T) {
+	t.Parallel()
+
+	persister, _ := memorydb.New()
+	iq, err := indexer.NewTestIndexQueue(persister, marshalizer, func(queuedBlock *indexer.QueuedBlock) error {
+		return nil
+	}, statusHandler.NewNilStatusHandler(), log, maxRetryDelay, minRetryDelay)
+
+	assert.Nil(t, iq)
+	assert.Equal(t, indexer.ErrInvalidRetryDelay, err)
+}
+
+func TestIndexQueue_EnqueueNilBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	persister, _ := memorydb.New()
+	recorder := &indexedNoncesRecorder{}
+	iq, _ := indexer.NewTestIndexQueue(persister, marshalizer, recorder.record,
+		statusHandler.NewNilStatusHandler(), log, minRetryDelay, maxRetryDelay)
+	defer func() {
+		_ = iq.Close()
+	}()
+
+	err := iq.Enqueue(nil)
+
+	assert.Equal(t, indexer.ErrNilQueuedBlock, err)
+}
+
+func TestIndexQueue_ShouldIndexInNonceOrder(t *testing.T) {
+	t.Parallel()
+
+	persister, _ := memorydb.New()
+	recorder := &indexedNoncesRecorder{}
+	iq, _ := indexer.NewTestIndexQueue(persister, marshalizer, recorder.record,
+		statusHandler.NewNilStatusHandler(), log, minRetryDelay, maxRetryDelay)
+	defer func() {
+		_ = iq.Close()
+	}()
+
+	for nonce := uint64(3); nonce <= 7; nonce++ {
+		err := iq.Enqueue(&indexer.QueuedBlock{Nonce: nonce})
+		assert.Nil(t, err)
+	}
+
+	assert.True(t, waitQueueDepth(iq.Depth, 0))
+	assert.Equal(t, []uint64{3, 4, 5, 6, 7}, recorder.indexedNonces())
+}
+
+func TestIndexQueue_FailedIndexingShouldBeRetriedInOrder(t *testing.T) {
+	t.Parallel()
+
+	persister, _ := memorydb.New()
+	recorder := &indexedNoncesRecorder{}
+	numFailures := 0
+	mutFailures := sync.Mutex{}
+	failedAttempts := uint64(0)
+	statusHandlerStub := &mock.AppStatusHandlerStub{
+		IncrementHandler: func(key string) {
+			if key == core.MetricIndexerFailedAttempts {
+				mutFailures.Lock()
+				failedAttempts++
+				mutFailures.Unlock()
+			}
+		},
+		SetUInt64ValueHandler: func(key string, value uint64) {},
+	}
+	iq, _ := indexer.NewTestIndexQueue(persister, marshalizer, func(queuedBlock *indexer.QueuedBlock) error {
+		mutFailures.Lock()
+		defer mutFailures.Unlock()
+
+		if queuedBlock.Nonce == 2 && numFailures < 3 {
+			numFailures++
+			return errors.New("elasticsearch is down")
+		}
+
+		return recorder.record(queuedBlock)
+	}, statusHandlerStub, log, minRetryDelay, maxRetryDelay)
+	defer func() {
+		_ = iq.Close()
+	}()
+
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		_ = iq.Enqueue(&indexer.QueuedBlock{Nonce: nonce})
+	}
+
+	assert.True(t, waitQueueDepth(iq.Depth, 0))
+	assert.Equal(t, []uint64{1, 2, 3}, recorder.indexedNonces())
+	assert.Equal(t, uint64(3), iq.FailedAttempts())
+	mutFailures.Lock()
+	assert.Equal(t, uint64(3), failedAttempts)
+	mutFailures.Unlock()
+}
+
+func TestIndexQueue_ShouldResumeAfterRestart(t *testing.T) {
+	t.Parallel()
+
+	persister, _ := memorydb.New()
+	iq, _ := indexer.NewTestIndexQueue(persister, marshalizer, func(queuedBlock *indexer.QueuedBlock) error {
+		return errors.New("elasticsearch is down")
+	}, statusHandler.NewNilStatusHandler(), log, minRetryDelay, maxRetryDelay)
+
+	_ = iq.Enqueue(&indexer.QueuedBlock{Nonce: 10})
+	_ = iq.Enqueue(&indexer.QueuedBlock{Nonce: 11})
+	_ = iq.Close()
+	assert.Equal(t, uint64(2), iq.Depth())
+
+	recorder := &indexedNoncesRecorder{}
+	iq, _ = indexer.NewTestIndexQueue(persister, marshalizer, recorder.record,
+		statusHandler.NewNilStatusHandler(), log, minRetryDelay, maxRetryDelay)
+	defer func() {
+		_ = iq.Close()
+	}()
+
+	_ = iq.Enqueue(&indexer.QueuedBlock{Nonce: 12})
+
+	assert.True(t, waitQueueDepth(iq.Depth, 0))
+	assert.Equal(t, []uint64{10, 11, 12}, recorder.indexedNonces())
+}
+
+func TestIndexQueue_GapsInNoncesShouldBeSkipped(t *testing.T) {
+	t.Parallel()
+
+	persister, _ := memorydb.New()
+	recorder := &indexedNoncesRecorder{}
+	iq, _ := indexer.NewTestIndexQueue(persister, marshalizer, recorder.record,
+		statusHandler.NewNilStatusHandler(), log, minRetryDelay, maxRetryDelay)
+	defer func() {
+		_ = iq.Close()
+	}()
+
+	_ = iq.Enqueue(&indexer.QueuedBlock{Nonce: 1})
+	_ = iq.Enqueue(&indexer.QueuedBlock{Nonce: 4})
+
+	assert.True(t, waitQueueDepth(iq.Depth, 0))
+	assert.Equal(t, []uint64{1, 4}, recorder.indexedNonces())
+}
+
+func TestIndexQueue_RetryDelayShouldGrowUntilMaximum(t *testing.T) {
+	t.Parallel()
+
+	persister, _ := memorydb.New()
+	iq, _ := indexer.NewTestIndexQueue(persister, marshalizer, func(queuedBlock *indexer.QueuedBlock) error {
+		return nil
+	}, statusHandler.NewNilStatusHandler(), log, minRetryDelay, maxRetryDelay)
+	defer func() {
+		_ = iq.Close()
+	}()
+
+	assert.Equal(t, minRetryDelay, iq.RetryDelay(1))
+	assert.Equal(t, 2*minRetryDelay, iq.RetryDelay(2))
+	assert.Equal(t, 8*minRetryDelay, iq.RetryDelay(4))
+	assert.Equal(t, maxRetryDelay, iq.RetryDelay(5))
+	assert.Equal(t, maxRetryDelay, iq.RetryDelay(100))
+}
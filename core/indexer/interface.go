@@ -16,3 +16,12 @@ type Indexer interface {
 	IsInterfaceNil() bool
 	IsNilIndexer() bool
 }
+
+// BlockIndexer is implemented by the indexers able to index a block synchronously, returning the error encountered,
+// apart from the path of the committed blocks. It is used by the backfill, which must not interfere with the
+// indexing of the committed blocks
+type BlockIndexer interface {
+	IndexBlock(body data.BodyHandler, header data.HeaderHandler, txPool map[string]data.TransactionHandler, signersIndexes []uint64) error
+	IndexMetaBlock(header data.HeaderHandler, signersIndexes []uint64) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,144 @@
+package indexer
+
+import (
+	"io"
+
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// multiIndexer is a fan-out indexer which forwards all the data it receives to each of its indexers
+type multiIndexer struct {
+	indexers []Indexer
+}
+
+// NewMultiIndexer creates a new indexer which feeds all the provided indexers
+func NewMultiIndexer(indexers ...Indexer) (*multiIndexer, error) {
+	if len(indexers) == 0 {
+		return nil, ErrNoIndexers
+	}
+	for _, idx := range indexers {
+		if idx == nil || idx.IsInterfaceNil() {
+			return nil, ErrNilIndexer
+		}
+	}
+
+	return &multiIndexer{
+		indexers: indexers,
+	}, nil
+}
+
+// SaveBlock forwards the block to all the indexers
+func (mi *multiIndexer) SaveBlock(
+	body data.BodyHandler,
+	header data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	signersIndexes []uint64,
+) {
+	for _, idx := range mi.indexers {
+		idx.SaveBlock(body, header, txPool, signersIndexes)
+	}
+}
+
+// SaveMetaBlock forwards the meta block to all the indexers
+func (mi *multiIndexer) SaveMetaBlock(header data.HeaderHandler, signersIndexes []uint64) {
+	for _, idx := range mi.indexers {
+		idx.SaveMetaBlock(header, signersIndexes)
+	}
+}
+
+// IndexBlock forwards the block to all the indexers, returning the first error encountered. The indexers which
+// can not index a block synchronously are given the block through SaveBlock
+func (mi *multiIndexer) IndexBlock(
+	body data.BodyHandler,
+	header data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	signersIndexes []uint64,
+) error {
+	var firstErr error
+	for _, idx := range mi.indexers {
+		blockIndexer, ok := idx.(BlockIndexer)
+		if !ok {
+			idx.SaveBlock(body, header, txPool, signersIndexes)
+			continue
+		}
+
+		err := blockIndexer.IndexBlock(body, header, txPool, signersIndexes)
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
+// IndexMetaBlock forwards the meta block to all the indexers, returning the first error encountered
+func (mi *multiIndexer) IndexMetaBlock(header data.HeaderHandler, signersIndexes []uint64) error {
+	var firstErr error
+	for _, idx := range mi.indexers {
+		blockIndexer, ok := idx.(BlockIndexer)
+		if !ok {
+			idx.SaveMetaBlock(header, signersIndexes)
+			continue
+		}
+
+		err := blockIndexer.IndexMetaBlock(header, signersIndexes)
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
+// SaveRoundInfo forwards the round info to all the indexers
+func (mi *multiIndexer) SaveRoundInfo(roundInfo RoundInfo) {
+	for _, idx := range mi.indexers {
+		idx.SaveRoundInfo(roundInfo)
+	}
+}
+
+// UpdateTPS forwards the tps benchmark to all the indexers
+func (mi *multiIndexer) UpdateTPS(tpsBenchmark statistics.TPSBenchmark) {
+	for _, idx := range mi.indexers {
+		idx.UpdateTPS(tpsBenchmark)
+	}
+}
+
+// SaveValidatorsPubKeys forwards the validators public keys to all the indexers
+func (mi *multiIndexer) SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte) {
+	for _, idx := range mi.indexers {
+		idx.SaveValidatorsPubKeys(validatorsPubKeys)
+	}
+}
+
+// Close closes all the indexers which need to be closed, returning the last error encountered
+func (mi *multiIndexer) Close() error {
+	var lastErr error
+	for _, idx := range mi.indexers {
+		closer, ok := idx.(io.Closer)
+		if !ok {
+			continue
+		}
+
+		err := closer.Close()
+		if err != nil {
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+// IsNilIndexer returns false as the multi indexer always feeds at least one indexer
+func (mi *multiIndexer) IsNilIndexer() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mi *multiIndexer) IsInterfaceNil() bool {
+	if mi == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,111 @@
+package indexer_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMultiIndexer_NoIndexersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mi, err := indexer.NewMultiIndexer()
+
+	assert.Nil(t, mi)
+	assert.Equal(t, indexer.ErrNoIndexers, err)
+}
+
+func TestNewMultiIndexer_NilIndexerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mi, err := indexer.NewMultiIndexer(&mock.IndexerStub{}, nil)
+
+	assert.Nil(t, mi)
+	assert.Equal(t, indexer.ErrNilIndexer, err)
+}
+
+func TestMultiIndexer_ShouldForwardToAllIndexers(t *testing.T) {
+	t.Parallel()
+
+	numSavedBlocks := 0
+	numSavedMetaBlocks := 0
+	numSavedRounds := 0
+	createIndexerStub := func() *mock.IndexerStub {
+		return &mock.IndexerStub{
+			SaveBlockCalled: func(body data.BodyHandler, header data.HeaderHandler, txPool map[string]data.TransactionHandler, signersIndexes []uint64) {
+				numSavedBlocks++
+			},
+			SaveMetaBlockCalled: func(header data.HeaderHandler, signersIndexes []uint64) {
+				numSavedMetaBlocks++
+			},
+			SaveRoundInfoCalled: func(roundInfo indexer.RoundInfo) {
+				numSavedRounds++
+			},
+		}
+	}
+
+	mi, _ := indexer.NewMultiIndexer(createIndexerStub(), createIndexerStub(), createIndexerStub())
+	mi.SaveBlock(newTestBlockBody(), newTestBlockHeader(), newTestTxPool(), []uint64{0})
+	mi.SaveMetaBlock(newTestMetaBlock(), []uint64{0})
+	mi.SaveRoundInfo(indexer.RoundInfo{})
+
+	assert.Equal(t, 3, numSavedBlocks)
+	assert.Equal(t, 3, numSavedMetaBlocks)
+	assert.Equal(t, 3, numSavedRounds)
+	assert.False(t, mi.IsNilIndexer())
+}
+
+func TestMultiIndexer_IndexBlockShouldIndexInAllIndexersAndReturnTheFirstError(t *testing.T) {
+	t.Parallel()
+
+	errFirst := errors.New("first error")
+	numIndexedBlocks := 0
+	createIndexerStub := func(errIndex error) *mock.IndexerStub {
+		return &mock.IndexerStub{
+			IndexBlockCalled: func(body data.BodyHandler, header data.HeaderHandler, txPool map[string]data.TransactionHandler, signersIndexes []uint64) error {
+				numIndexedBlocks++
+				return errIndex
+			},
+		}
+	}
+
+	mi, _ := indexer.NewMultiIndexer(
+		createIndexerStub(nil),
+		createIndexerStub(errFirst),
+		createIndexerStub(errors.New("second error")),
+	)
+	err := mi.IndexBlock(newTestBlockBody(), newTestBlockHeader(), newTestTxPool(), []uint64{0})
+
+	assert.Equal(t, errFirst, err)
+	assert.Equal(t, 3, numIndexedBlocks)
+}
+
+func TestMultiIndexer_CloseShouldCloseAllIndexers(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	numClosed := 0
+	mi, _ := indexer.NewMultiIndexer(
+		&mock.IndexerStub{
+			CloseCalled: func() error {
+				numClosed++
+				return expectedErr
+			},
+		},
+		&mock.IndexerStub{
+			CloseCalled: func() error {
+				numClosed++
+				return nil
+			},
+		},
+	)
+
+	err := mi.Close()
+
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 2, numClosed)
+}
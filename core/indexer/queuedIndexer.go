@@ -0,0 +1,162 @@
+package indexer
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// documentsIndexer is implemented by the drivers which index the documents of a block themselves, as opposed to
+// elasticsearch which queues the already serialized documents
+type documentsIndexer interface {
+	Indexer
+	io.Closer
+	IndexBlock(
+		bodyHandler data.BodyHandler,
+		headerHandler data.HeaderHandler,
+		txPool map[string]data.TransactionHandler,
+		signersIndexes []uint64,
+	) error
+	IndexMetaBlock(header data.HeaderHandler, signersIndexes []uint64) error
+	createBlockDocuments(
+		bodyHandler data.BodyHandler,
+		headerHandler data.HeaderHandler,
+		txPool map[string]data.TransactionHandler,
+		signersIndexes []uint64,
+	) (*BlockDocuments, error)
+	createMetaBlockDocuments(header data.HeaderHandler, signersIndexes []uint64) (*BlockDocuments, error)
+	saveBlockDocuments(documents *BlockDocuments) error
+}
+
+// queuedIndexer sends the blocks of a driver through the persistent indexing queue, so that they are indexed in
+// nonce order and retried until the driver saves them. The blocks indexed directly, as it happens when backfilling,
+// and all the other data go straight to the driver
+type queuedIndexer struct {
+	documentsIndexer
+	queue  *indexQueue
+	logger *logger.Logger
+}
+
+func newQueuedIndexer(
+	driver documentsIndexer,
+	queuePersister storage.Persister,
+	marshalizer marshal.Marshalizer,
+	logger *logger.Logger,
+	options *Options,
+) (*queuedIndexer, error) {
+	qi := &queuedIndexer{
+		documentsIndexer: driver,
+		logger:           logger,
+	}
+
+	var err error
+	qi.queue, err = newIndexQueue(argIndexQueue{
+		Persister:     queuePersister,
+		Marshalizer:   marshalizer,
+		IndexHandler:  qi.indexQueuedBlock,
+		StatusHandler: options.StatusHandler,
+		Logger:        logger,
+		MinRetryDelay: options.MinRetryDelay,
+		MaxRetryDelay: options.MaxRetryDelay,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return qi, nil
+}
+
+// createQueuedIndexerFromDriverArgs wraps the driver in the indexing queue whose persister is created from the
+// driver arguments. The driver is closed if the queue can not be created
+func createQueuedIndexerFromDriverArgs(driver documentsIndexer, args DriverArgs) (Indexer, error) {
+	if args.CreateQueuePersister == nil {
+		_ = driver.Close()
+		return nil, ErrNilQueuePersister
+	}
+
+	queuePersister, err := args.CreateQueuePersister(args.Config.Name)
+	if err != nil {
+		_ = driver.Close()
+		return nil, err
+	}
+
+	qi, err := newQueuedIndexer(driver, queuePersister, args.Marshalizer, args.Logger, args.Options)
+	if err != nil {
+		_ = queuePersister.Close()
+		_ = driver.Close()
+		return nil, err
+	}
+
+	return qi, nil
+}
+
+// SaveBlock builds the documents of the block and adds them to the indexing queue
+func (qi *queuedIndexer) SaveBlock(
+	bodyHandler data.BodyHandler,
+	headerHandler data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	signersIndexes []uint64,
+) {
+	documents, err := qi.createBlockDocuments(bodyHandler, headerHandler, txPool, signersIndexes)
+	if err != nil {
+		qi.logger.Warn(err.Error())
+		return
+	}
+
+	qi.enqueue(documents)
+}
+
+// SaveMetaBlock builds the document of the meta block and adds it to the indexing queue
+func (qi *queuedIndexer) SaveMetaBlock(header data.HeaderHandler, signersIndexes []uint64) {
+	documents, err := qi.createMetaBlockDocuments(header, signersIndexes)
+	if err != nil {
+		qi.logger.Warn(err.Error())
+		return
+	}
+
+	qi.enqueue(documents)
+}
+
+func (qi *queuedIndexer) enqueue(documents *BlockDocuments) {
+	err := qi.queue.Enqueue(&QueuedBlock{Nonce: documents.Block.Nonce, Documents: documents})
+	if err != nil {
+		qi.logger.Warn(fmt.Sprintf("could not add block with nonce %d to the indexing queue: %s",
+			documents.Block.Nonce, err.Error()))
+	}
+}
+
+// indexQueuedBlock saves the documents of a queued block through the driver. A block without documents can never
+// be saved, so it is dropped instead of being retried
+func (qi *queuedIndexer) indexQueuedBlock(queuedBlock *QueuedBlock) error {
+	if queuedBlock.Documents == nil || queuedBlock.Documents.Block == nil {
+		qi.logger.Warn(fmt.Sprintf("indexing queue: dropped block with nonce %d as it has no documents",
+			queuedBlock.Nonce))
+		return nil
+	}
+
+	return qi.saveBlockDocuments(queuedBlock.Documents)
+}
+
+// Close stops the indexing queue and closes the driver. The blocks which were not yet indexed will be indexed
+// after a restart
+func (qi *queuedIndexer) Close() error {
+	errQueue := qi.queue.Close()
+	errDriver := qi.documentsIndexer.Close()
+	if errDriver != nil {
+		return errDriver
+	}
+
+	return errQueue
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (qi *queuedIndexer) IsInterfaceNil() bool {
+	if qi == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,418 @@
+package indexer
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/gin-gonic/gin/json"
+)
+
+const defaultSQLDriverName = "postgres"
+
+// sqlSchema holds the tables the sql indexer writes in. The hashes and the public keys are hex encoded and the
+// lists are kept as JSON arrays, so the same schema works both on PostgreSQL and on SQLite
+var sqlSchema = []string{
+	`CREATE TABLE IF NOT EXISTS blocks (
+		hash TEXT PRIMARY KEY,
+		nonce BIGINT NOT NULL,
+		round BIGINT NOT NULL,
+		shard_id BIGINT NOT NULL,
+		proposer BIGINT NOT NULL,
+		validators TEXT NOT NULL,
+		pub_key_bitmap TEXT NOT NULL,
+		size BIGINT NOT NULL,
+		timestamp BIGINT NOT NULL,
+		tx_count BIGINT NOT NULL,
+		state_root_hash TEXT NOT NULL,
+		prev_hash TEXT NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS miniblocks (
+		hash TEXT PRIMARY KEY,
+		block_hash TEXT NOT NULL,
+		sender_shard_id BIGINT NOT NULL,
+		receiver_shard_id BIGINT NOT NULL,
+		type TEXT NOT NULL,
+		tx_count BIGINT NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS transactions (
+		hash TEXT PRIMARY KEY,
+		miniblock_hash TEXT NOT NULL,
+		block_hash TEXT NOT NULL,
+		nonce BIGINT NOT NULL,
+		round BIGINT NOT NULL,
+		value TEXT NOT NULL,
+		receiver TEXT NOT NULL,
+		sender TEXT NOT NULL,
+		receiver_shard BIGINT NOT NULL,
+		sender_shard BIGINT NOT NULL,
+		gas_price BIGINT NOT NULL,
+		gas_limit BIGINT NOT NULL,
+		data TEXT NOT NULL,
+		signature TEXT NOT NULL,
+		timestamp BIGINT NOT NULL,
+		status TEXT NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS smart_contract_results (
+		hash TEXT PRIMARY KEY,
+		miniblock_hash TEXT NOT NULL,
+		block_hash TEXT NOT NULL,
+		nonce BIGINT NOT NULL,
+		round BIGINT NOT NULL,
+		value TEXT NOT NULL,
+		receiver TEXT NOT NULL,
+		sender TEXT NOT NULL,
+		receiver_shard BIGINT NOT NULL,
+		sender_shard BIGINT NOT NULL,
+		data TEXT NOT NULL,
+		timestamp BIGINT NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS rewards (
+		hash TEXT PRIMARY KEY,
+		miniblock_hash TEXT NOT NULL,
+		block_hash TEXT NOT NULL,
+		round BIGINT NOT NULL,
+		value TEXT NOT NULL,
+		receiver TEXT NOT NULL,
+		sender TEXT NOT NULL,
+		receiver_shard BIGINT NOT NULL,
+		sender_shard BIGINT NOT NULL,
+		timestamp BIGINT NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS rounds (
+		shard_id BIGINT NOT NULL,
+		round_index BIGINT NOT NULL,
+		signers_indexes TEXT NOT NULL,
+		block_was_proposed BOOLEAN NOT NULL,
+		timestamp BIGINT NOT NULL,
+		PRIMARY KEY (shard_id, round_index)
+	)`,
+	`CREATE TABLE IF NOT EXISTS validators (
+		shard_id BIGINT PRIMARY KEY,
+		public_keys TEXT NOT NULL
+	)`,
+}
+
+var blocksColumns = []string{"hash", "nonce", "round", "shard_id", "proposer", "validators", "pub_key_bitmap", "size",
+	"timestamp", "tx_count", "state_root_hash", "prev_hash"}
+var miniBlocksColumns = []string{"hash", "block_hash", "sender_shard_id", "receiver_shard_id", "type", "tx_count"}
+var transactionsColumns = []string{"hash", "miniblock_hash", "block_hash", "nonce", "round", "value", "receiver",
+	"sender", "receiver_shard", "sender_shard", "gas_price", "gas_limit", "data", "signature", "timestamp", "status"}
+var scrsColumns = []string{"hash", "miniblock_hash", "block_hash", "nonce", "round", "value", "receiver", "sender",
+	"receiver_shard", "sender_shard", "data", "timestamp"}
+var rewardsColumns = []string{"hash", "miniblock_hash", "block_hash", "round", "value", "receiver", "sender",
+	"receiver_shard", "sender_shard", "timestamp"}
+var roundsColumns = []string{"shard_id", "round_index", "signers_indexes", "block_was_proposed", "timestamp"}
+var validatorsColumns = []string{"shard_id", "public_keys"}
+
+var upsertBlockQuery = upsertQuery("blocks", blocksColumns, 1)
+var upsertMiniBlockQuery = upsertQuery("miniblocks", miniBlocksColumns, 1)
+var upsertTransactionQuery = upsertQuery("transactions", transactionsColumns, 1)
+var upsertScrQuery = upsertQuery("smart_contract_results", scrsColumns, 1)
+var upsertRewardQuery = upsertQuery("rewards", rewardsColumns, 1)
+var upsertRoundQuery = upsertQuery("rounds", roundsColumns, 2)
+var upsertValidatorsQuery = upsertQuery("validators", validatorsColumns, 1)
+
+// sqlIndexer saves the indexed data in a SQL database. All the rows are upserted, so indexing the same
+// data again only overwrites it
+type sqlIndexer struct {
+	db               *sql.DB
+	shardCoordinator sharding.Coordinator
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+	logger           *logger.Logger
+	options          *Options
+}
+
+// NewSQLIndexer creates a new sql indexer, creating the tables if they do not exist
+func NewSQLIndexer(
+	db *sql.DB,
+	shardCoordinator sharding.Coordinator,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	logger *logger.Logger,
+	options *Options,
+) (*sqlIndexer, error) {
+	if db == nil {
+		return nil, ErrNilDatabase
+	}
+	if shardCoordinator == nil || shardCoordinator.IsInterfaceNil() {
+		return nil, core.ErrNilCoordinator
+	}
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, core.ErrNilMarshalizer
+	}
+	if hasher == nil || hasher.IsInterfaceNil() {
+		return nil, core.ErrNilHasher
+	}
+	if logger == nil {
+		return nil, core.ErrNilLogger
+	}
+	if options == nil {
+		return nil, ErrNilOptions
+	}
+
+	for _, statement := range sqlSchema {
+		_, err := db.Exec(statement)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &sqlIndexer{
+		db:               db,
+		shardCoordinator: shardCoordinator,
+		marshalizer:      marshalizer,
+		hasher:           hasher,
+		logger:           logger,
+		options:          options,
+	}, nil
+}
+
+func createSQLIndexerFromDriverArgs(args DriverArgs) (Indexer, error) {
+	if len(args.Config.DataSourceName) == 0 {
+		return nil, ErrEmptyDataSourceName
+	}
+
+	driverName := args.Config.SQLDriverName
+	if len(driverName) == 0 {
+		driverName = defaultSQLDriverName
+	}
+
+	db, err := sql.Open(driverName, args.Config.DataSourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlIdx, err := NewSQLIndexer(db, args.ShardCoordinator, args.Marshalizer, args.Hasher, args.Logger, args.Options)
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	return createQueuedIndexerFromDriverArgs(sqlIdx, args)
+}
+
+// SaveBlock saves the block, its miniblocks and, if enabled, its transactions, smart contract results and
+// rewards in a single database transaction
+func (si *sqlIndexer) SaveBlock(
+	bodyHandler data.BodyHandler,
+	headerHandler data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	signersIndexes []uint64,
+) {
+	err := si.IndexBlock(bodyHandler, headerHandler, txPool, signersIndexes)
+	if err != nil {
+		si.logger.Warn(fmt.Sprintf("sql indexer could not save block: %s", err.Error()))
+	}
+}
+
+// IndexBlock saves the block as SaveBlock does, returning the error encountered
+func (si *sqlIndexer) IndexBlock(
+	bodyHandler data.BodyHandler,
+	headerHandler data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	signersIndexes []uint64,
+) error {
+	documents, err := si.createBlockDocuments(bodyHandler, headerHandler, txPool, signersIndexes)
+	if err != nil {
+		return err
+	}
+
+	return si.saveBlockDocuments(documents)
+}
+
+func (si *sqlIndexer) createBlockDocuments(
+	bodyHandler data.BodyHandler,
+	headerHandler data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	signersIndexes []uint64,
+) (*BlockDocuments, error) {
+	return createBlockDocuments(
+		bodyHandler,
+		headerHandler,
+		txPool,
+		signersIndexes,
+		si.options.TxIndexingEnabled,
+		si.shardCoordinator.SelfId(),
+		si.marshalizer,
+		si.hasher,
+		si.logger,
+	)
+}
+
+func (si *sqlIndexer) createMetaBlockDocuments(header data.HeaderHandler, signersIndexes []uint64) (*BlockDocuments, error) {
+	return createMetaBlockDocuments(header, signersIndexes, si.marshalizer, si.hasher)
+}
+
+// saveBlockDocuments saves the documents of a block in a single database transaction
+func (si *sqlIndexer) saveBlockDocuments(documents *BlockDocuments) error {
+	dbTx, err := si.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	err = saveBlockRows(dbTx, documents)
+	if err != nil {
+		_ = dbTx.Rollback()
+		return fmt.Errorf("block with nonce %d: %s", documents.Block.Nonce, err.Error())
+	}
+
+	return dbTx.Commit()
+}
+
+func saveBlockRows(dbTx *sql.Tx, documents *BlockDocuments) error {
+	err := insertBlock(dbTx, documents.Block)
+	if err != nil {
+		return err
+	}
+
+	for _, mb := range documents.MiniBlocks {
+		_, err = dbTx.Exec(upsertMiniBlockQuery, mb.Hash, mb.BlockHash, mb.SenderShardID, mb.ReceiverShardID,
+			mb.Type, mb.TxCount)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, tx := range documents.Transactions {
+		err = insertTransaction(dbTx, tx.Type, tx.Transaction)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SaveMetaBlock saves the meta block
+func (si *sqlIndexer) SaveMetaBlock(header data.HeaderHandler, signersIndexes []uint64) {
+	err := si.IndexMetaBlock(header, signersIndexes)
+	if err != nil {
+		si.logger.Warn(fmt.Sprintf("sql indexer could not save meta block: %s", err.Error()))
+	}
+}
+
+// IndexMetaBlock saves the meta block as SaveMetaBlock does, returning the error encountered
+func (si *sqlIndexer) IndexMetaBlock(header data.HeaderHandler, signersIndexes []uint64) error {
+	documents, err := si.createMetaBlockDocuments(header, signersIndexes)
+	if err != nil {
+		return err
+	}
+
+	return si.saveBlockDocuments(documents)
+}
+
+// SaveRoundInfo saves the round info
+func (si *sqlIndexer) SaveRoundInfo(roundInfo RoundInfo) {
+	signersIndexes, err := json.Marshal(roundInfo.SignersIndexes)
+	if err == nil {
+		_, err = si.db.Exec(upsertRoundQuery, roundInfo.ShardId, roundInfo.Index, string(signersIndexes),
+			roundInfo.BlockWasProposed, int64(roundInfo.Timestamp))
+	}
+	if err != nil {
+		si.logger.Warn(fmt.Sprintf("sql indexer could not save round %d: %s", roundInfo.Index, err.Error()))
+	}
+}
+
+// UpdateTPS does nothing as the statistics are not kept in the sql database
+func (si *sqlIndexer) UpdateTPS(tpsBenchmark statistics.TPSBenchmark) {
+}
+
+// SaveValidatorsPubKeys saves the public keys of the validators of each shard
+func (si *sqlIndexer) SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte) {
+	for shardId, shardPubKeys := range validatorsPubKeys {
+		pubKeys, err := json.Marshal(encodePubKeys(shardPubKeys))
+		if err == nil {
+			_, err = si.db.Exec(upsertValidatorsQuery, shardId, string(pubKeys))
+		}
+		if err != nil {
+			si.logger.Warn(fmt.Sprintf("sql indexer could not save the validators of shard %d: %s",
+				shardId, err.Error()))
+		}
+	}
+}
+
+// Close closes the database
+func (si *sqlIndexer) Close() error {
+	return si.db.Close()
+}
+
+// IsNilIndexer returns false as the sql indexer saves the data it receives
+func (si *sqlIndexer) IsNilIndexer() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (si *sqlIndexer) IsInterfaceNil() bool {
+	if si == nil {
+		return true
+	}
+	return false
+}
+
+type sqlExecutor interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}
+
+func insertBlock(executor sqlExecutor, blockDoc *Block) error {
+	validators, err := json.Marshal(blockDoc.Validators)
+	if err != nil {
+		return err
+	}
+
+	_, err = executor.Exec(upsertBlockQuery, blockDoc.Hash, blockDoc.Nonce, blockDoc.Round, blockDoc.ShardID,
+		blockDoc.Proposer, string(validators), blockDoc.PubKeyBitmap, blockDoc.Size, int64(blockDoc.Timestamp),
+		blockDoc.TxCount, blockDoc.StateRootHash, blockDoc.PrevHash)
+
+	return err
+}
+
+func insertTransaction(executor sqlExecutor, txType string, tx *Transaction) error {
+	var err error
+
+	switch txType {
+	case txTypeSmartContractResult:
+		_, err = executor.Exec(upsertScrQuery, tx.Hash, tx.MBHash, tx.BlockHash, tx.Nonce, tx.Round, tx.Value,
+			tx.Receiver, tx.Sender, tx.ReceiverShard, tx.SenderShard, tx.Data, int64(tx.Timestamp))
+	case txTypeReward:
+		_, err = executor.Exec(upsertRewardQuery, tx.Hash, tx.MBHash, tx.BlockHash, tx.Round, tx.Value,
+			tx.Receiver, tx.Sender, tx.ReceiverShard, tx.SenderShard, int64(tx.Timestamp))
+	default:
+		_, err = executor.Exec(upsertTransactionQuery, tx.Hash, tx.MBHash, tx.BlockHash, tx.Nonce, tx.Round,
+			tx.Value, tx.Receiver, tx.Sender, tx.ReceiverShard, tx.SenderShard, tx.GasPrice, tx.GasLimit, tx.Data,
+			tx.Signature, int64(tx.Timestamp), tx.Status)
+	}
+
+	return err
+}
+
+// upsertQuery builds an insert statement which updates the existing row on conflict. The first numKeyColumns
+// columns form the primary key of the table
+func upsertQuery(table string, columns []string, numKeyColumns int) string {
+	placeholders := make([]string, len(columns))
+	for i := range columns {
+		placeholders[i] = "$" + strconv.Itoa(i+1)
+	}
+
+	updates := make([]string, 0, len(columns)-numKeyColumns)
+	for _, column := range columns[numKeyColumns:] {
+		updates = append(updates, column+" = excluded."+column)
+	}
+
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s) ON CONFLICT (%s) DO UPDATE SET %s",
+		table,
+		strings.Join(columns, ", "),
+		strings.Join(placeholders, ", "),
+		strings.Join(columns[:numKeyColumns], ", "),
+		strings.Join(updates, ", "),
+	)
+}
@@ -0,0 +1,334 @@
+package indexer_test
+
+import (
+	"database/sql"
+	"encoding/hex"
+	"io"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+// createSQLiteDB skips the test when the SQLite driver is not available, as it needs cgo
+func createSQLiteDB(t *testing.T) *sql.DB {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Skip("sqlite is not available: " + err.Error())
+	}
+	err = db.Ping()
+	if err != nil {
+		_ = db.Close()
+		t.Skip("sqlite is not available: " + err.Error())
+	}
+	// every connection to an in-memory database opens a new database
+	db.SetMaxOpenConns(1)
+
+	return db
+}
+
+func createTestSQLIndexer(t *testing.T, db *sql.DB) indexer.Indexer {
+	si, err := indexer.NewSQLIndexer(db, shardCoordinator, marshalizer, hasher, log,
+		&indexer.Options{TxIndexingEnabled: true})
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	return si
+}
+
+func newTestBlockBodyWithScrsAndRewards() block.Body {
+	body := newTestBlockBody()
+	body = append(body,
+		&block.MiniBlock{
+			TxHashes:        [][]byte{[]byte("scr1")},
+			ReceiverShardID: 0,
+			SenderShardID:   1,
+			Type:            block.SmartContractResultBlock,
+		},
+		&block.MiniBlock{
+			TxHashes:        [][]byte{[]byte("reward1"), []byte("reward2")},
+			ReceiverShardID: 0,
+			SenderShardID:   0,
+			Type:            block.RewardsBlock,
+		},
+	)
+
+	return body
+}
+
+func newTestTxPoolWithScrsAndRewards() map[string]data.TransactionHandler {
+	txPool := newTestTxPool()
+	txPool["scr1"] = &smartContractResult.SmartContractResult{
+		Nonce:   4,
+		Value:   big.NewInt(4),
+		RcvAddr: []byte("receiver_address4"),
+		SndAddr: []byte("sender_address4"),
+		TxHash:  []byte("tx1"),
+	}
+	txPool["reward1"] = &rewardTx.RewardTx{Round: 6, Value: big.NewInt(5), RcvAddr: []byte("leader")}
+	txPool["reward2"] = &rewardTx.RewardTx{Round: 6, Value: big.NewInt(6), RcvAddr: []byte("validator")}
+
+	return txPool
+}
+
+func countRows(t *testing.T, db *sql.DB, table string) int {
+	count := 0
+	err := db.QueryRow("SELECT COUNT(*) FROM " + table).Scan(&count)
+	assert.Nil(t, err)
+
+	return count
+}
+
+func TestNewSQLIndexer_NilDatabaseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	si, err := indexer.NewSQLIndexer(nil, shardCoordinator, marshalizer, hasher, log, &indexer.Options{})
+
+	assert.Nil(t, si)
+	assert.Equal(t, indexer.ErrNilDatabase, err)
+}
+
+func TestNewSQLIndexer_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	db := createSQLiteDB(t)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	si, err := indexer.NewSQLIndexer(db, shardCoordinator, nil, hasher, log, &indexer.Options{})
+
+	assert.Nil(t, si)
+	assert.Equal(t, core.ErrNilMarshalizer, err)
+}
+
+func TestNewSQLIndexer_NilOptionsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	db := createSQLiteDB(t)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	si, err := indexer.NewSQLIndexer(db, shardCoordinator, marshalizer, hasher, log, nil)
+
+	assert.Nil(t, si)
+	assert.Equal(t, indexer.ErrNilOptions, err)
+}
+
+func TestNewSQLIndexer_ShouldCreateTheSchemaOnce(t *testing.T) {
+	t.Parallel()
+
+	db := createSQLiteDB(t)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	_ = createTestSQLIndexer(t, db)
+	si, err := indexer.NewSQLIndexer(db, shardCoordinator, marshalizer, hasher, log, &indexer.Options{})
+
+	assert.Nil(t, err)
+	if !assert.NotNil(t, si) {
+		return
+	}
+	for _, table := range []string{"blocks", "miniblocks", "transactions", "smart_contract_results", "rewards",
+		"rounds", "validators"} {
+		assert.Equal(t, 0, countRows(t, db, table))
+	}
+}
+
+func TestSQLIndexer_SaveBlockShouldSaveAllRows(t *testing.T) {
+	t.Parallel()
+
+	db := createSQLiteDB(t)
+	defer func() {
+		_ = db.Close()
+	}()
+	si := createTestSQLIndexer(t, db)
+
+	header := newTestBlockHeader()
+	si.SaveBlock(newTestBlockBodyWithScrsAndRewards(), header, newTestTxPoolWithScrsAndRewards(), []uint64{2, 3})
+
+	assert.Equal(t, 1, countRows(t, db, "blocks"))
+	assert.Equal(t, 4, countRows(t, db, "miniblocks"))
+	assert.Equal(t, 3, countRows(t, db, "transactions"))
+	assert.Equal(t, 1, countRows(t, db, "smart_contract_results"))
+	assert.Equal(t, 2, countRows(t, db, "rewards"))
+
+	nonce := uint64(0)
+	proposer := uint64(0)
+	validators := ""
+	err := db.QueryRow("SELECT nonce, proposer, validators FROM blocks").Scan(&nonce, &proposer, &validators)
+	assert.Nil(t, err)
+	assert.Equal(t, header.Nonce, nonce)
+	assert.Equal(t, uint64(2), proposer)
+	assert.Equal(t, "[2,3]", validators)
+
+	value := ""
+	sender := ""
+	err = db.QueryRow("SELECT value, sender FROM transactions WHERE hash = $1",
+		hex.EncodeToString([]byte("tx2"))).Scan(&value, &sender)
+	assert.Nil(t, err)
+	assert.Equal(t, "2", value)
+	assert.Equal(t, hex.EncodeToString([]byte("sender_address2")), sender)
+}
+
+func TestSQLIndexer_SaveBlockTwiceShouldNotDuplicateRows(t *testing.T) {
+	t.Parallel()
+
+	db := createSQLiteDB(t)
+	defer func() {
+		_ = db.Close()
+	}()
+	si := createTestSQLIndexer(t, db)
+
+	body := newTestBlockBodyWithScrsAndRewards()
+	txPool := newTestTxPoolWithScrsAndRewards()
+	si.SaveBlock(body, newTestBlockHeader(), txPool, []uint64{0})
+	si.SaveBlock(body, newTestBlockHeader(), txPool, []uint64{0})
+
+	assert.Equal(t, 1, countRows(t, db, "blocks"))
+	assert.Equal(t, 4, countRows(t, db, "miniblocks"))
+	assert.Equal(t, 3, countRows(t, db, "transactions"))
+	assert.Equal(t, 1, countRows(t, db, "smart_contract_results"))
+	assert.Equal(t, 2, countRows(t, db, "rewards"))
+}
+
+func TestSQLIndexer_SaveBlockWithTxIndexingDisabledShouldNotSaveTransactions(t *testing.T) {
+	t.Parallel()
+
+	db := createSQLiteDB(t)
+	defer func() {
+		_ = db.Close()
+	}()
+	si, err := indexer.NewSQLIndexer(db, shardCoordinator, marshalizer, hasher, log, &indexer.Options{})
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	si.SaveBlock(newTestBlockBodyWithScrsAndRewards(), newTestBlockHeader(), newTestTxPoolWithScrsAndRewards(), []uint64{0})
+
+	assert.Equal(t, 1, countRows(t, db, "blocks"))
+	assert.Equal(t, 4, countRows(t, db, "miniblocks"))
+	assert.Equal(t, 0, countRows(t, db, "transactions"))
+	assert.Equal(t, 0, countRows(t, db, "smart_contract_results"))
+	assert.Equal(t, 0, countRows(t, db, "rewards"))
+}
+
+func TestSQLIndexer_SaveMetaBlockShouldSaveBlock(t *testing.T) {
+	t.Parallel()
+
+	db := createSQLiteDB(t)
+	defer func() {
+		_ = db.Close()
+	}()
+	si := createTestSQLIndexer(t, db)
+
+	si.SaveMetaBlock(newTestMetaBlock(), []uint64{0})
+
+	assert.Equal(t, 1, countRows(t, db, "blocks"))
+}
+
+func TestSQLIndexer_SaveRoundInfoShouldUpsert(t *testing.T) {
+	t.Parallel()
+
+	db := createSQLiteDB(t)
+	defer func() {
+		_ = db.Close()
+	}()
+	si := createTestSQLIndexer(t, db)
+
+	si.SaveRoundInfo(indexer.RoundInfo{Index: 7, ShardId: 1, SignersIndexes: []uint64{1}})
+	si.SaveRoundInfo(indexer.RoundInfo{Index: 7, ShardId: 1, SignersIndexes: []uint64{1, 2}, BlockWasProposed: true})
+	si.SaveRoundInfo(indexer.RoundInfo{Index: 7, ShardId: 2})
+
+	assert.Equal(t, 2, countRows(t, db, "rounds"))
+
+	signersIndexes := ""
+	blockWasProposed := false
+	err := db.QueryRow("SELECT signers_indexes, block_was_proposed FROM rounds WHERE shard_id = 1").
+		Scan(&signersIndexes, &blockWasProposed)
+	assert.Nil(t, err)
+	assert.Equal(t, "[1,2]", signersIndexes)
+	assert.True(t, blockWasProposed)
+}
+
+func TestSQLIndexer_SaveValidatorsPubKeysShouldSaveEachShard(t *testing.T) {
+	t.Parallel()
+
+	db := createSQLiteDB(t)
+	defer func() {
+		_ = db.Close()
+	}()
+	si := createTestSQLIndexer(t, db)
+
+	si.SaveValidatorsPubKeys(map[uint32][][]byte{
+		0: {[]byte("pk1"), []byte("pk2")},
+		1: {[]byte("pk3")},
+	})
+
+	assert.Equal(t, 2, countRows(t, db, "validators"))
+
+	publicKeys := ""
+	err := db.QueryRow("SELECT public_keys FROM validators WHERE shard_id = 1").Scan(&publicKeys)
+	assert.Nil(t, err)
+	assert.Equal(t, `["`+hex.EncodeToString([]byte("pk3"))+`"]`, publicKeys)
+}
+
+func TestNewIndexerFromDriver_SQLDriverShouldSaveTheBlocksThroughTheQueue(t *testing.T) {
+	t.Parallel()
+
+	// the connections to a shared cache in-memory database open the same database
+	dataSourceName := "file:sqlDriverQueue?mode=memory&cache=shared"
+	db := createSQLiteDB(t)
+	_ = db.Close()
+	db, _ = sql.Open("sqlite3", dataSourceName)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	idx, err := indexer.NewIndexerFromDriver(indexer.DriverArgs{
+		Config: config.IndexerConfig{
+			Name:           "sql",
+			Driver:         indexer.SQLDriver,
+			SQLDriverName:  "sqlite3",
+			DataSourceName: dataSourceName,
+		},
+		ShardCoordinator: shardCoordinator,
+		Marshalizer:      marshalizer,
+		Hasher:           hasher,
+		Logger:           log,
+		Options:          newTestOptions(),
+		CreateQueuePersister: func(name string) (storage.Persister, error) {
+			return memorydb.New()
+		},
+	})
+	if !assert.Nil(t, err) {
+		return
+	}
+	defer func() {
+		_ = idx.(io.Closer).Close()
+	}()
+
+	idx.SaveBlock(newTestBlockBody(), newTestBlockHeader(), newTestTxPool(), []uint64{0})
+
+	numBlocks := 0
+	for i := 0; i < 100 && numBlocks == 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+		numBlocks = countRows(t, db, "blocks")
+	}
+	assert.Equal(t, 1, numBlocks)
+	assert.Equal(t, 2, countRows(t, db, "miniblocks"))
+}
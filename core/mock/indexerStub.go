@@ -0,0 +1,62 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+type IndexerStub struct {
+	SaveBlockCalled             func(body data.BodyHandler, header data.HeaderHandler, txPool map[string]data.TransactionHandler, signersIndexes []uint64)
+	SaveMetaBlockCalled         func(header data.HeaderHandler, signersIndexes []uint64)
+	IndexBlockCalled            func(body data.BodyHandler, header data.HeaderHandler, txPool map[string]data.TransactionHandler, signersIndexes []uint64) error
+	IndexMetaBlockCalled        func(header data.HeaderHandler, signersIndexes []uint64) error
+	SaveRoundInfoCalled         func(roundInfo indexer.RoundInfo)
+	UpdateTPSCalled             func(tpsBenchmark statistics.TPSBenchmark)
+	SaveValidatorsPubKeysCalled func(validatorsPubKeys map[uint32][][]byte)
+	CloseCalled                 func() error
+}
+
+func (is *IndexerStub) SaveBlock(body data.BodyHandler, header data.HeaderHandler, txPool map[string]data.TransactionHandler, signersIndexes []uint64) {
+	is.SaveBlockCalled(body, header, txPool, signersIndexes)
+}
+
+func (is *IndexerStub) SaveMetaBlock(header data.HeaderHandler, signersIndexes []uint64) {
+	is.SaveMetaBlockCalled(header, signersIndexes)
+}
+
+func (is *IndexerStub) IndexBlock(body data.BodyHandler, header data.HeaderHandler, txPool map[string]data.TransactionHandler, signersIndexes []uint64) error {
+	return is.IndexBlockCalled(body, header, txPool, signersIndexes)
+}
+
+func (is *IndexerStub) IndexMetaBlock(header data.HeaderHandler, signersIndexes []uint64) error {
+	return is.IndexMetaBlockCalled(header, signersIndexes)
+}
+
+func (is *IndexerStub) SaveRoundInfo(roundInfo indexer.RoundInfo) {
+	is.SaveRoundInfoCalled(roundInfo)
+}
+
+func (is *IndexerStub) UpdateTPS(tpsBenchmark statistics.TPSBenchmark) {
+	is.UpdateTPSCalled(tpsBenchmark)
+}
+
+func (is *IndexerStub) SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte) {
+	is.SaveValidatorsPubKeysCalled(validatorsPubKeys)
+}
+
+func (is *IndexerStub) Close() error {
+	return is.CloseCalled()
+}
+
+func (is *IndexerStub) IsNilIndexer() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (is *IndexerStub) IsInterfaceNil() bool {
+	if is == nil {
+		return true
+	}
+	return false
+}
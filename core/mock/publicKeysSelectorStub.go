@@ -0,0 +1,34 @@
+package mock
+
+type PublicKeysSelectorStub struct {
+	GetValidatorsIndexesCalled          func(publicKeys []string) []uint64
+	GetAllValidatorsPublicKeysCalled    func() map[uint32][][]byte
+	GetSelectedPublicKeysCalled         func(selection []byte, shardId uint32) ([]string, error)
+	GetValidatorsPublicKeysCalled       func(randomness []byte, round uint64, shardId uint32) ([]string, error)
+	GetValidatorsRewardsAddressesCalled func(randomness []byte, round uint64, shardId uint32) ([]string, error)
+	GetOwnPublicKeyCalled               func() []byte
+}
+
+func (pkss *PublicKeysSelectorStub) GetValidatorsIndexes(publicKeys []string) []uint64 {
+	return pkss.GetValidatorsIndexesCalled(publicKeys)
+}
+
+func (pkss *PublicKeysSelectorStub) GetAllValidatorsPublicKeys() map[uint32][][]byte {
+	return pkss.GetAllValidatorsPublicKeysCalled()
+}
+
+func (pkss *PublicKeysSelectorStub) GetSelectedPublicKeys(selection []byte, shardId uint32) ([]string, error) {
+	return pkss.GetSelectedPublicKeysCalled(selection, shardId)
+}
+
+func (pkss *PublicKeysSelectorStub) GetValidatorsPublicKeys(randomness []byte, round uint64, shardId uint32) ([]string, error) {
+	return pkss.GetValidatorsPublicKeysCalled(randomness, round, shardId)
+}
+
+func (pkss *PublicKeysSelectorStub) GetValidatorsRewardsAddresses(randomness []byte, round uint64, shardId uint32) ([]string, error) {
+	return pkss.GetValidatorsRewardsAddressesCalled(randomness, round, shardId)
+}
+
+func (pkss *PublicKeysSelectorStub) GetOwnPublicKey() []byte {
+	return pkss.GetOwnPublicKeyCalled()
+}
@@ -1,8 +1,10 @@
 package serviceContainer
 
 import (
+	"github.com/ElrondNetwork/elrond-go/core/history"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
 )
 
 // Core interface will abstract all the subpackage functionalities and will
@@ -10,5 +12,7 @@ import (
 type Core interface {
 	Indexer() indexer.Indexer
 	TPSBenchmark() statistics.TPSBenchmark
+	SubscriptionNotifier() subscription.Notifier
+	TransactionHistory() history.Handler
 	IsInterfaceNil() bool
 }
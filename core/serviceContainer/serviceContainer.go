@@ -1,13 +1,17 @@
 package serviceContainer
 
 import (
+	"github.com/ElrondNetwork/elrond-go/core/history"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
 )
 
 type serviceContainer struct {
 	indexer      indexer.Indexer
 	tpsBenchmark statistics.TPSBenchmark
+	notifier     subscription.Notifier
+	txHistory    history.Handler
 }
 
 // Option represents a functional configuration parameter that
@@ -37,6 +41,16 @@ func (sc *serviceContainer) TPSBenchmark() statistics.TPSBenchmark {
 	return sc.tpsBenchmark
 }
 
+// SubscriptionNotifier returns the core package's notifier of the subscriptions
+func (sc *serviceContainer) SubscriptionNotifier() subscription.Notifier {
+	return sc.notifier
+}
+
+// TransactionHistory returns the core package's transaction history of the accounts
+func (sc *serviceContainer) TransactionHistory() history.Handler {
+	return sc.txHistory
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (sc *serviceContainer) IsInterfaceNil() bool {
 	if sc == nil {
@@ -60,3 +74,19 @@ func WithTPSBenchmark(tpsBenchmark statistics.TPSBenchmark) Option {
 		return nil
 	}
 }
+
+// WithSubscriptionNotifier sets up the notifier of the subscriptions for the core serviceContainer
+func WithSubscriptionNotifier(notifier subscription.Notifier) Option {
+	return func(sc *serviceContainer) error {
+		sc.notifier = notifier
+		return nil
+	}
+}
+
+// WithTransactionHistory sets up the transaction history of the accounts for the core serviceContainer
+func WithTransactionHistory(txHistory history.Handler) Option {
+	return func(sc *serviceContainer) error {
+		sc.txHistory = txHistory
+		return nil
+	}
+}
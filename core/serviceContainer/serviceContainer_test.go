@@ -5,7 +5,10 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core/mock"
 
+	"github.com/ElrondNetwork/elrond-go/core/history"
 	"github.com/ElrondNetwork/elrond-go/core/serviceContainer"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
+	"github.com/ElrondNetwork/elrond-go/data/state/addressConverters"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -54,3 +57,28 @@ func TestServiceContainer_NewServiceContainerWithNilTPSBenchmark(t *testing.T) {
 	assert.NotNil(t, sc)
 	assert.Nil(t, sc.TPSBenchmark())
 }
+
+func TestServiceContainer_NewServiceContainerWithSubscriptionNotifier(t *testing.T) {
+	converter, _ := addressConverters.NewPlainAddressConverter(32, "")
+	notifier, _ := subscription.NewHub(subscription.ArgHub{
+		AddressConverter:         converter,
+		AccountsTrie:             &mock.TrieStub{},
+		Marshalizer:              &mock.MarshalizerMock{},
+		MaxSubscribers:           1,
+		MaxFiltersPerSubscriber:  10,
+		EventsBufferSize:         1,
+		CommittedBlocksQueueSize: 1,
+	})
+	sc, err := serviceContainer.NewServiceContainer(serviceContainer.WithSubscriptionNotifier(notifier))
+	assert.Nil(t, err)
+	assert.NotNil(t, sc)
+	assert.Equal(t, notifier, sc.SubscriptionNotifier())
+}
+
+func TestServiceContainer_NewServiceContainerWithTransactionHistory(t *testing.T) {
+	txHistory := &history.TransactionHistory{}
+	sc, err := serviceContainer.NewServiceContainer(serviceContainer.WithTransactionHistory(txHistory))
+	assert.Nil(t, err)
+	assert.NotNil(t, sc)
+	assert.Equal(t, txHistory, sc.TransactionHistory())
+}
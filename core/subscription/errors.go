@@ -0,0 +1,51 @@
+package subscription
+
+import (
+	"errors"
+)
+
+// ErrNilAddressConverter signals that a nil address converter has been provided
+var ErrNilAddressConverter = errors.New("nil address converter")
+
+// ErrNilAccountsTrie signals that a nil accounts trie has been provided
+var ErrNilAccountsTrie = errors.New("nil accounts trie")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilTransactionsPool signals that a nil transactions pool has been provided
+var ErrNilTransactionsPool = errors.New("nil transactions pool")
+
+// ErrInvalidMaxSubscribers signals that an invalid maximum number of subscribers has been provided
+var ErrInvalidMaxSubscribers = errors.New("invalid maximum number of subscribers")
+
+// ErrInvalidEventsBufferSize signals that an invalid size of the subscribers' events buffer has been provided
+var ErrInvalidEventsBufferSize = errors.New("invalid events buffer size")
+
+// ErrInvalidMaxFiltersPerSubscriber signals that an invalid maximum number of filters per subscriber has been provided
+var ErrInvalidMaxFiltersPerSubscriber = errors.New("invalid maximum number of filters per subscriber")
+
+// ErrTooManyFilters signals that the subscriber already has the maximum number of filters
+var ErrTooManyFilters = errors.New("too many filters")
+
+// ErrTooManySubscribers signals that the maximum number of subscribers has been reached
+var ErrTooManySubscribers = errors.New("too many subscribers")
+
+// ErrUnknownTopic signals that a subscription was requested for an unknown topic
+var ErrUnknownTopic = errors.New("unknown topic")
+
+// ErrInvalidCommittedBlocksQueueSize signals that the size of the queue of the committed blocks to be published is
+// not positive
+var ErrInvalidCommittedBlocksQueueSize = errors.New("invalid committed blocks queue size")
+
+// ErrMissingAddress signals that a subscription which requires an address was requested without one
+var ErrMissingAddress = errors.New("missing address")
+
+// ErrInvalidLogTopic signals that the provided smart contract log topic is not a valid hex value
+var ErrInvalidLogTopic = errors.New("invalid log topic, could not decode hex value")
+
+// ErrSlowSubscriber signals that a subscriber was dropped because it did not consume its events fast enough
+var ErrSlowSubscriber = errors.New("subscriber dropped because it was too slow consuming the events")
+
+// ErrSubscriberClosed signals that the subscriber has been closed
+var ErrSubscriberClosed = errors.New("subscriber closed")
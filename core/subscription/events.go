@@ -0,0 +1,84 @@
+package subscription
+
+import (
+	"math/big"
+)
+
+// TopicHeaders is the topic of the committed headers
+const TopicHeaders = "headers"
+
+// TopicTransactions is the topic of the transactions sent or received by an address
+const TopicTransactions = "transactions"
+
+// TopicAccounts is the topic of the balance and nonce changes of an account
+const TopicAccounts = "accounts"
+
+// TopicLogs is the topic of the logs generated by the smart contract executions
+const TopicLogs = "logs"
+
+// TxStatusPending is the status of a transaction which was added in the node's pool
+const TxStatusPending = "pending"
+
+// TxStatusCommitted is the status of a transaction which was included in a committed block
+const TxStatusCommitted = "committed"
+
+// Filter holds a subscription request. The address is required for the transactions and accounts topics, while
+// the logs can be filtered by the contract address, by the log topic or by both of them
+type Filter struct {
+	Topic    string `json:"topic"`
+	Address  string `json:"address,omitempty"`
+	LogTopic string `json:"logTopic,omitempty"`
+}
+
+// Event is the unit sent to the subscribers
+type Event struct {
+	Topic string      `json:"topic"`
+	Data  interface{} `json:"data"`
+}
+
+// HeaderEvent holds the details of a committed header
+type HeaderEvent struct {
+	Hash      string `json:"hash"`
+	ShardID   uint32 `json:"shardId"`
+	Nonce     uint64 `json:"nonce"`
+	Round     uint64 `json:"round"`
+	Epoch     uint32 `json:"epoch"`
+	TimeStamp uint64 `json:"timestamp"`
+	PrevHash  string `json:"prevHash"`
+	RootHash  string `json:"rootHash"`
+	TxCount   uint32 `json:"txCount"`
+}
+
+// TransactionEvent holds the details of a pending or a committed transaction
+type TransactionEvent struct {
+	Hash       string   `json:"hash"`
+	Status     string   `json:"status"`
+	Nonce      uint64   `json:"nonce"`
+	Sender     string   `json:"sender"`
+	Receiver   string   `json:"receiver"`
+	Value      *big.Int `json:"value"`
+	Data       string   `json:"data"`
+	GasPrice   uint64   `json:"gasPrice"`
+	GasLimit   uint64   `json:"gasLimit"`
+	BlockHash  string   `json:"blockHash,omitempty"`
+	BlockNonce uint64   `json:"blockNonce,omitempty"`
+}
+
+// AccountEvent holds the balance and the nonce of an account after a committed block changed them
+type AccountEvent struct {
+	Address    string   `json:"address"`
+	Balance    *big.Int `json:"balance"`
+	Nonce      uint64   `json:"nonce"`
+	BlockHash  string   `json:"blockHash"`
+	BlockNonce uint64   `json:"blockNonce"`
+}
+
+// LogEvent holds a log generated by a smart contract execution included in a committed block
+type LogEvent struct {
+	TxHash     string   `json:"txHash"`
+	Address    string   `json:"address"`
+	Topics     []string `json:"topics"`
+	Data       string   `json:"data"`
+	BlockHash  string   `json:"blockHash"`
+	BlockNonce uint64   `json:"blockNonce"`
+}
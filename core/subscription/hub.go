@@ -0,0 +1,581 @@
+package subscription
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var log = logger.DefaultLogger()
+
+// ArgHub holds all dependencies required by the subscriptions hub
+type ArgHub struct {
+	AddressConverter         state.AddressConverter
+	AccountsTrie             data.Trie
+	Marshalizer              marshal.Marshalizer
+	MaxSubscribers           int
+	MaxFiltersPerSubscriber  int
+	EventsBufferSize         int
+	CommittedBlocksQueueSize int
+}
+
+type accountState struct {
+	balance *big.Int
+	nonce   uint64
+}
+
+type committedTx struct {
+	hash []byte
+	tx   data.TransactionHandler
+	logs []*vmcommon.LogEntry
+}
+
+// committedBlock holds the data of a committed block which is published by the hub, copied when the block is
+// committed so that the block processing does not wait for the subscribers
+type committedBlock struct {
+	headerEvent *HeaderEvent
+	hash        []byte
+	nonce       uint64
+	rootHash    []byte
+	txs         []*committedTx
+}
+
+// Hub dispatches the events of the committed blocks and of the pending transactions to the subscribers
+type Hub struct {
+	addrConverter           state.AddressConverter
+	accountsTrie            data.Trie
+	marshalizer             marshal.Marshalizer
+	maxSubscribers          int
+	maxFiltersPerSubscriber int
+	eventsBufferSize        int
+	committedBlocks         chan *committedBlock
+	chanClose               chan struct{}
+	closeOnce               sync.Once
+
+	mutSubscribers sync.RWMutex
+	subscribers    map[*Subscriber]struct{}
+
+	mutPendingLogs sync.Mutex
+	pendingLogs    map[string][]*vmcommon.LogEntry
+
+	// accountStates holds the last published state of the watched accounts, the accounts which are no longer
+	// watched by any subscriber being removed
+	mutAccountStates sync.Mutex
+	accountStates    map[string]accountState
+}
+
+// NewHub creates a new subscriptions hub
+func NewHub(args ArgHub) (*Hub, error) {
+	if args.AddressConverter == nil || args.AddressConverter.IsInterfaceNil() {
+		return nil, ErrNilAddressConverter
+	}
+	if args.AccountsTrie == nil || args.AccountsTrie.IsInterfaceNil() {
+		return nil, ErrNilAccountsTrie
+	}
+	if args.Marshalizer == nil || args.Marshalizer.IsInterfaceNil() {
+		return nil, ErrNilMarshalizer
+	}
+	if args.MaxSubscribers < 1 {
+		return nil, ErrInvalidMaxSubscribers
+	}
+	if args.MaxFiltersPerSubscriber < 1 {
+		return nil, ErrInvalidMaxFiltersPerSubscriber
+	}
+	if args.EventsBufferSize < 1 {
+		return nil, ErrInvalidEventsBufferSize
+	}
+	if args.CommittedBlocksQueueSize < 1 {
+		return nil, ErrInvalidCommittedBlocksQueueSize
+	}
+
+	h := &Hub{
+		addrConverter:           args.AddressConverter,
+		accountsTrie:            args.AccountsTrie,
+		marshalizer:             args.Marshalizer,
+		maxSubscribers:          args.MaxSubscribers,
+		maxFiltersPerSubscriber: args.MaxFiltersPerSubscriber,
+		eventsBufferSize:        args.EventsBufferSize,
+		committedBlocks:         make(chan *committedBlock, args.CommittedBlocksQueueSize),
+		chanClose:               make(chan struct{}),
+		subscribers:             make(map[*Subscriber]struct{}),
+		pendingLogs:             make(map[string][]*vmcommon.LogEntry),
+		accountStates:           make(map[string]accountState),
+	}
+
+	go h.publishCommittedBlocks()
+
+	return h, nil
+}
+
+// NewSubscriber registers a new subscriber, having no filters
+func (h *Hub) NewSubscriber() (*Subscriber, error) {
+	h.mutSubscribers.Lock()
+	defer h.mutSubscribers.Unlock()
+
+	if len(h.subscribers) >= h.maxSubscribers {
+		return nil, ErrTooManySubscribers
+	}
+
+	subscriber := newSubscriber(h, h.eventsBufferSize, h.maxFiltersPerSubscriber)
+	h.subscribers[subscriber] = struct{}{}
+
+	return subscriber, nil
+}
+
+// NumSubscribers returns the number of active subscribers
+func (h *Hub) NumSubscribers() int {
+	h.mutSubscribers.RLock()
+	defer h.mutSubscribers.RUnlock()
+
+	return len(h.subscribers)
+}
+
+// RegisterTransactionsPool feeds the transactions topic with the transactions added in the provided pool
+func (h *Hub) RegisterTransactionsPool(pool dataRetriever.ShardedDataCacherNotifier) error {
+	if pool == nil || pool.IsInterfaceNil() {
+		return ErrNilTransactionsPool
+	}
+
+	pool.RegisterHandler(func(key []byte) {
+		value, ok := pool.SearchFirstData(key)
+		if !ok {
+			return
+		}
+
+		tx, ok := value.(data.TransactionHandler)
+		if !ok {
+			return
+		}
+
+		h.publishTransaction(key, tx, TxStatusPending, nil)
+	})
+
+	return nil
+}
+
+// NotifyCommittedBlock queues the header, the transactions, the smart contract logs and the changes of the watched
+// accounts of a committed block to be published. The block data is copied, so the caller does not wait for the
+// events to be published. The block is dropped if the queue is full
+func (h *Hub) NotifyCommittedBlock(header data.HeaderHandler, headerHash []byte, txs map[string]data.TransactionHandler) {
+	if header == nil || header.IsInterfaceNil() {
+		return
+	}
+
+	pendingLogs := h.takePendingLogs()
+	if !h.hasSubscribers() {
+		return
+	}
+
+	block := &committedBlock{
+		headerEvent: &HeaderEvent{
+			Hash:      hex.EncodeToString(headerHash),
+			ShardID:   header.GetShardID(),
+			Nonce:     header.GetNonce(),
+			Round:     header.GetRound(),
+			Epoch:     header.GetEpoch(),
+			TimeStamp: header.GetTimeStamp(),
+			PrevHash:  hex.EncodeToString(header.GetPrevHash()),
+			RootHash:  hex.EncodeToString(header.GetRootHash()),
+			TxCount:   header.GetTxCount(),
+		},
+		hash:     append([]byte(nil), headerHash...),
+		nonce:    header.GetNonce(),
+		rootHash: append([]byte(nil), header.GetRootHash()...),
+		txs:      make([]*committedTx, 0, len(txs)),
+	}
+	for _, txHash := range sortedTxHashes(txs) {
+		tx := txs[txHash]
+		if tx == nil || tx.IsInterfaceNil() {
+			continue
+		}
+
+		block.txs = append(block.txs, &committedTx{hash: []byte(txHash), tx: tx, logs: pendingLogs[txHash]})
+	}
+
+	select {
+	case h.committedBlocks <- block:
+	default:
+		log.Warn(fmt.Sprintf("subscriptions: dropped the events of block with nonce %d, the queue is full", block.nonce))
+	}
+}
+
+// publishCommittedBlocks publishes the queued committed blocks, in the order they were committed, until the hub is closed
+func (h *Hub) publishCommittedBlocks() {
+	for {
+		select {
+		case <-h.chanClose:
+			return
+		case block := <-h.committedBlocks:
+			h.publishCommittedBlock(block)
+		}
+	}
+}
+
+func (h *Hub) publishCommittedBlock(block *committedBlock) {
+	h.publish(&Event{Topic: TopicHeaders, Data: block.headerEvent}, filterKey{topic: TopicHeaders})
+
+	touchedAddresses := make(map[string]struct{})
+	for _, committed := range block.txs {
+		h.publishTransaction(committed.hash, committed.tx, TxStatusCommitted, block)
+		h.publishLogs(committed.hash, committed.logs, block)
+
+		touchedAddresses[string(committed.tx.GetSndAddress())] = struct{}{}
+		touchedAddresses[string(committed.tx.GetRecvAddress())] = struct{}{}
+	}
+
+	h.publishAccountChanges(touchedAddresses, block)
+}
+
+// Close stops publishing the committed blocks
+func (h *Hub) Close() error {
+	h.closeOnce.Do(func() {
+		close(h.chanClose)
+	})
+
+	return nil
+}
+
+// SaveLogs keeps the logs generated by the execution of a transaction until the block including it is committed
+func (h *Hub) SaveLogs(txHash []byte, logs []*vmcommon.LogEntry) {
+	if len(logs) == 0 {
+		return
+	}
+
+	h.mutPendingLogs.Lock()
+	h.pendingLogs[string(txHash)] = logs
+	h.mutPendingLogs.Unlock()
+}
+
+// takePendingLogs returns the logs saved since the previous commit. The logs of the transactions which were not
+// included in the committed block are dropped, as those transactions will be executed again
+func (h *Hub) takePendingLogs() map[string][]*vmcommon.LogEntry {
+	h.mutPendingLogs.Lock()
+	defer h.mutPendingLogs.Unlock()
+
+	pendingLogs := h.pendingLogs
+	h.pendingLogs = make(map[string][]*vmcommon.LogEntry)
+
+	return pendingLogs
+}
+
+func (h *Hub) publishTransaction(
+	txHash []byte,
+	tx data.TransactionHandler,
+	status string,
+	block *committedBlock,
+) {
+	keys := []filterKey{
+		{topic: TopicTransactions, address: string(tx.GetSndAddress())},
+		{topic: TopicTransactions, address: string(tx.GetRecvAddress())},
+	}
+	if !h.anySubscriberMatches(keys...) {
+		return
+	}
+
+	txEvent := &TransactionEvent{
+		Hash:     hex.EncodeToString(txHash),
+		Status:   status,
+		Nonce:    tx.GetNonce(),
+		Sender:   h.encodeAddress(tx.GetSndAddress()),
+		Receiver: h.encodeAddress(tx.GetRecvAddress()),
+		Value:    tx.GetValue(),
+		Data:     tx.GetData(),
+		GasPrice: tx.GetGasPrice(),
+		GasLimit: tx.GetGasLimit(),
+	}
+	if block != nil {
+		txEvent.BlockHash = hex.EncodeToString(block.hash)
+		txEvent.BlockNonce = block.nonce
+	}
+
+	h.publish(&Event{Topic: TopicTransactions, Data: txEvent}, keys...)
+}
+
+func (h *Hub) publishLogs(txHash []byte, logs []*vmcommon.LogEntry, block *committedBlock) {
+	for _, logEntry := range logs {
+		if logEntry == nil {
+			continue
+		}
+
+		keys := []filterKey{
+			{topic: TopicLogs},
+			{topic: TopicLogs, address: string(logEntry.Address)},
+		}
+		topics := make([]string, 0, len(logEntry.Topics))
+		for _, topic := range logEntry.Topics {
+			if topic == nil {
+				continue
+			}
+
+			topicBytes := string(topic.Bytes())
+			keys = append(keys,
+				filterKey{topic: TopicLogs, logTopic: topicBytes},
+				filterKey{topic: TopicLogs, address: string(logEntry.Address), logTopic: topicBytes},
+			)
+			topics = append(topics, hex.EncodeToString(topic.Bytes()))
+		}
+
+		h.publish(
+			&Event{
+				Topic: TopicLogs,
+				Data: &LogEvent{
+					TxHash:     hex.EncodeToString(txHash),
+					Address:    h.encodeAddress(logEntry.Address),
+					Topics:     topics,
+					Data:       hex.EncodeToString(logEntry.Data),
+					BlockHash:  hex.EncodeToString(block.hash),
+					BlockNonce: block.nonce,
+				},
+			},
+			keys...,
+		)
+	}
+}
+
+// publishAccountChanges reads the states of the watched accounts from the accounts trie committed by the block, so
+// the accounts being changed by the blocks processed meanwhile are not read
+func (h *Hub) publishAccountChanges(touchedAddresses map[string]struct{}, block *committedBlock) {
+	watchedAddresses := h.watchedAddresses(TopicAccounts)
+	// the accounts unwatched while the block was being published are removed here
+	defer h.removeUnwatchedAccountStates()
+
+	var committedTrie data.Trie
+	for _, address := range watchedAddresses {
+		_, ok := touchedAddresses[address]
+		if !ok {
+			continue
+		}
+
+		if committedTrie == nil {
+			var err error
+			committedTrie, err = h.accountsTrie.Recreate(block.rootHash)
+			if err != nil {
+				log.Debug("subscriptions: " + err.Error())
+				return
+			}
+		}
+
+		current, err := h.getAccountState(committedTrie, []byte(address))
+		if err != nil {
+			log.Debug("subscriptions: " + err.Error())
+			continue
+		}
+
+		if !h.updateAccountState(address, current) {
+			continue
+		}
+
+		h.publish(
+			&Event{
+				Topic: TopicAccounts,
+				Data: &AccountEvent{
+					Address:    h.encodeAddress([]byte(address)),
+					Balance:    current.balance,
+					Nonce:      current.nonce,
+					BlockHash:  hex.EncodeToString(block.hash),
+					BlockNonce: block.nonce,
+				},
+			},
+			filterKey{topic: TopicAccounts, address: address},
+		)
+	}
+}
+
+func (h *Hub) getAccountState(accountsTrie data.Trie, address []byte) (accountState, error) {
+	buff, err := accountsTrie.Get(address)
+	if err != nil {
+		return accountState{}, err
+	}
+	if len(buff) == 0 {
+		return accountState{balance: big.NewInt(0)}, nil
+	}
+
+	account := &state.Account{}
+	err = h.marshalizer.Unmarshal(account, buff)
+	if err != nil {
+		return accountState{}, err
+	}
+
+	balance := big.NewInt(0)
+	if account.Balance != nil {
+		balance.Set(account.Balance)
+	}
+
+	return accountState{balance: balance, nonce: account.Nonce}, nil
+}
+
+// updateAccountState saves the last published state of an account, returning false if it did not change
+func (h *Hub) updateAccountState(address string, current accountState) bool {
+	h.mutAccountStates.Lock()
+	defer h.mutAccountStates.Unlock()
+
+	previous, ok := h.accountStates[address]
+	if ok && previous.nonce == current.nonce && previous.balance.Cmp(current.balance) == 0 {
+		return false
+	}
+
+	h.accountStates[address] = current
+	return true
+}
+
+// removeUnwatchedAccountStates forgets the last published state of the accounts which are no longer watched by any
+// subscriber, so the hub does not keep a state for every account ever watched
+func (h *Hub) removeUnwatchedAccountStates() {
+	watched := make(map[string]struct{})
+	for _, address := range h.watchedAddresses(TopicAccounts) {
+		watched[address] = struct{}{}
+	}
+
+	h.mutAccountStates.Lock()
+	defer h.mutAccountStates.Unlock()
+
+	for address := range h.accountStates {
+		_, ok := watched[address]
+		if !ok {
+			delete(h.accountStates, address)
+		}
+	}
+}
+
+// publish delivers the event to the subscribers having at least one of the provided filters. The subscribers
+// whose buffers are full are dropped
+func (h *Hub) publish(event *Event, keys ...filterKey) {
+	slowSubscribers := make([]*Subscriber, 0)
+
+	h.mutSubscribers.RLock()
+	for subscriber := range h.subscribers {
+		if !subscriber.matchesAny(keys...) {
+			continue
+		}
+
+		select {
+		case subscriber.events <- event:
+		default:
+			slowSubscribers = append(slowSubscribers, subscriber)
+		}
+	}
+	h.mutSubscribers.RUnlock()
+
+	for _, subscriber := range slowSubscribers {
+		h.removeSubscriber(subscriber, ErrSlowSubscriber)
+	}
+}
+
+func (h *Hub) anySubscriberMatches(keys ...filterKey) bool {
+	h.mutSubscribers.RLock()
+	defer h.mutSubscribers.RUnlock()
+
+	for subscriber := range h.subscribers {
+		if subscriber.matchesAny(keys...) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (h *Hub) hasSubscribers() bool {
+	return h.NumSubscribers() > 0
+}
+
+func (h *Hub) watchedAddresses(topic string) []string {
+	h.mutSubscribers.RLock()
+	defer h.mutSubscribers.RUnlock()
+
+	addresses := make(map[string]struct{})
+	for subscriber := range h.subscribers {
+		for _, address := range subscriber.watchedAddresses(topic) {
+			addresses[address] = struct{}{}
+		}
+	}
+
+	watched := make([]string, 0, len(addresses))
+	for address := range addresses {
+		watched = append(watched, address)
+	}
+
+	return watched
+}
+
+func (h *Hub) removeSubscriber(subscriber *Subscriber, reason error) {
+	h.mutSubscribers.Lock()
+	delete(h.subscribers, subscriber)
+	h.mutSubscribers.Unlock()
+
+	subscriber.close(reason)
+	h.removeUnwatchedAccountStates()
+}
+
+func (h *Hub) parseFilter(filter Filter) (filterKey, error) {
+	key := filterKey{topic: filter.Topic}
+
+	switch filter.Topic {
+	case TopicHeaders:
+		return key, nil
+	case TopicTransactions, TopicAccounts:
+		if len(filter.Address) == 0 {
+			return filterKey{}, ErrMissingAddress
+		}
+	case TopicLogs:
+		if len(filter.LogTopic) > 0 {
+			logTopic, err := hex.DecodeString(filter.LogTopic)
+			if err != nil {
+				return filterKey{}, ErrInvalidLogTopic
+			}
+			// the topics are compared as the bytes of the big integers generated by the VM
+			key.logTopic = string(big.NewInt(0).SetBytes(logTopic).Bytes())
+		}
+	default:
+		return filterKey{}, ErrUnknownTopic
+	}
+
+	if len(filter.Address) > 0 {
+		address, err := h.addrConverter.CreateAddressFromHex(filter.Address)
+		if err != nil {
+			return filterKey{}, err
+		}
+		key.address = string(address.Bytes())
+	}
+
+	return key, nil
+}
+
+func (h *Hub) encodeAddress(address []byte) string {
+	if len(address) == 0 {
+		return ""
+	}
+
+	encoded, err := h.addrConverter.ConvertToString(state.NewAddress(address))
+	if err != nil {
+		return hex.EncodeToString(address)
+	}
+
+	return encoded
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (h *Hub) IsInterfaceNil() bool {
+	if h == nil {
+		return true
+	}
+	return false
+}
+
+func sortedTxHashes(txs map[string]data.TransactionHandler) []string {
+	hashes := make([]string, 0, len(txs))
+	for hash := range txs {
+		hashes = append(hashes, hash)
+	}
+	sort.Strings(hashes)
+
+	return hashes
+}
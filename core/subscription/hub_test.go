@@ -0,0 +1,419 @@
+package subscription_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/addressConverters"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+)
+
+const addressLen = 32
+
+var senderAddress = bytes.Repeat([]byte{1}, addressLen)
+var receiverAddress = bytes.Repeat([]byte{2}, addressLen)
+
+func createArgHub() subscription.ArgHub {
+	converter, _ := addressConverters.NewPlainAddressConverter(addressLen, "")
+
+	return subscription.ArgHub{
+		AddressConverter:         converter,
+		AccountsTrie:             createAccountsTrie(nil),
+		Marshalizer:              &mock.MarshalizerMock{},
+		MaxSubscribers:           10,
+		MaxFiltersPerSubscriber:  10,
+		EventsBufferSize:         10,
+		CommittedBlocksQueueSize: 10,
+	}
+}
+
+// createAccountsTrie returns an accounts trie holding, under each root hash, the provided account for every address.
+// The accounts of the root hashes which are not provided are not found
+func createAccountsTrie(accounts map[string]*state.Account) *mock.TrieStub {
+	return &mock.TrieStub{
+		RecreateCalled: func(root []byte) (data.Trie, error) {
+			return &mock.TrieStub{
+				GetCalled: func(key []byte) ([]byte, error) {
+					account, ok := accounts[string(root)]
+					if !ok {
+						return nil, nil
+					}
+					return (&mock.MarshalizerMock{}).Marshal(account)
+				},
+			}, nil
+		},
+	}
+}
+
+func createTxs() map[string]data.TransactionHandler {
+	return map[string]data.TransactionHandler{
+		"tx hash": &transaction.Transaction{
+			Nonce:   3,
+			Value:   big.NewInt(100),
+			SndAddr: senderAddress,
+			RcvAddr: receiverAddress,
+		},
+	}
+}
+
+func receiveEvent(t *testing.T, subscriber *subscription.Subscriber) *subscription.Event {
+	select {
+	case event := <-subscriber.Events():
+		return event
+	case <-time.After(time.Second):
+		assert.Fail(t, "timeout waiting for an event")
+		return nil
+	}
+}
+
+// assertNoEvent waits a while as the committed blocks are published in the background
+func assertNoEvent(t *testing.T, subscriber *subscription.Subscriber) {
+	select {
+	case event := <-subscriber.Events():
+		assert.Fail(t, "unexpected event", event)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestNewHub_NilAddressConverterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgHub()
+	args.AddressConverter = nil
+	hub, err := subscription.NewHub(args)
+
+	assert.Nil(t, hub)
+	assert.Equal(t, subscription.ErrNilAddressConverter, err)
+}
+
+func TestNewHub_NilAccountsTrieShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgHub()
+	args.AccountsTrie = nil
+	hub, err := subscription.NewHub(args)
+
+	assert.Nil(t, hub)
+	assert.Equal(t, subscription.ErrNilAccountsTrie, err)
+}
+
+func TestNewHub_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgHub()
+	args.Marshalizer = nil
+	hub, err := subscription.NewHub(args)
+
+	assert.Nil(t, hub)
+	assert.Equal(t, subscription.ErrNilMarshalizer, err)
+}
+
+func TestNewHub_InvalidLimitsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgHub()
+	args.MaxSubscribers = 0
+	_, err := subscription.NewHub(args)
+	assert.Equal(t, subscription.ErrInvalidMaxSubscribers, err)
+
+	args = createArgHub()
+	args.MaxFiltersPerSubscriber = 0
+	_, err = subscription.NewHub(args)
+	assert.Equal(t, subscription.ErrInvalidMaxFiltersPerSubscriber, err)
+
+	args = createArgHub()
+	args.EventsBufferSize = 0
+	_, err = subscription.NewHub(args)
+	assert.Equal(t, subscription.ErrInvalidEventsBufferSize, err)
+
+	args = createArgHub()
+	args.CommittedBlocksQueueSize = 0
+	_, err = subscription.NewHub(args)
+	assert.Equal(t, subscription.ErrInvalidCommittedBlocksQueueSize, err)
+}
+
+func TestHub_NewSubscriberOverTheLimitShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgHub()
+	args.MaxSubscribers = 1
+	hub, _ := subscription.NewHub(args)
+
+	subscriber, err := hub.NewSubscriber()
+	assert.Nil(t, err)
+
+	_, err = hub.NewSubscriber()
+	assert.Equal(t, subscription.ErrTooManySubscribers, err)
+
+	subscriber.Close()
+	assert.Equal(t, subscription.ErrSubscriberClosed, subscriber.Err())
+	assert.Equal(t, 0, hub.NumSubscribers())
+
+	_, err = hub.NewSubscriber()
+	assert.Nil(t, err)
+}
+
+func TestSubscriber_SubscribeInvalidFiltersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := subscription.NewHub(createArgHub())
+	subscriber, _ := hub.NewSubscriber()
+
+	err := subscriber.Subscribe(subscription.Filter{Topic: "unknown"})
+	assert.Equal(t, subscription.ErrUnknownTopic, err)
+
+	err = subscriber.Subscribe(subscription.Filter{Topic: subscription.TopicTransactions})
+	assert.Equal(t, subscription.ErrMissingAddress, err)
+
+	err = subscriber.Subscribe(subscription.Filter{Topic: subscription.TopicLogs, LogTopic: "zz"})
+	assert.Equal(t, subscription.ErrInvalidLogTopic, err)
+
+	err = subscriber.Subscribe(subscription.Filter{Topic: subscription.TopicAccounts, Address: "not hex"})
+	assert.NotNil(t, err)
+}
+
+func TestSubscriber_SubscribeOverTheLimitShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgHub()
+	args.MaxFiltersPerSubscriber = 2
+	hub, _ := subscription.NewHub(args)
+	subscriber, _ := hub.NewSubscriber()
+
+	headersFilter := subscription.Filter{Topic: subscription.TopicHeaders}
+	logsFilter := subscription.Filter{Topic: subscription.TopicLogs}
+	assert.Nil(t, subscriber.Subscribe(headersFilter))
+	assert.Nil(t, subscriber.Subscribe(logsFilter))
+	assert.Nil(t, subscriber.Subscribe(headersFilter))
+
+	err := subscriber.Subscribe(subscription.Filter{Topic: subscription.TopicLogs, LogTopic: "0a"})
+	assert.Equal(t, subscription.ErrTooManyFilters, err)
+
+	_ = subscriber.Unsubscribe(logsFilter)
+	err = subscriber.Subscribe(subscription.Filter{Topic: subscription.TopicLogs, LogTopic: "0a"})
+	assert.Nil(t, err)
+}
+
+func TestHub_NotifyCommittedBlockShouldPublishHeaderAndTransactions(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := subscription.NewHub(createArgHub())
+	headersSubscriber, _ := hub.NewSubscriber()
+	_ = headersSubscriber.Subscribe(subscription.Filter{Topic: subscription.TopicHeaders})
+	txsSubscriber, _ := hub.NewSubscriber()
+	_ = txsSubscriber.Subscribe(subscription.Filter{
+		Topic:   subscription.TopicTransactions,
+		Address: hex.EncodeToString(receiverAddress),
+	})
+
+	header := &block.Header{Nonce: 7, Round: 8, ShardId: 1}
+	hub.NotifyCommittedBlock(header, []byte("hash"), createTxs())
+
+	event := receiveEvent(t, headersSubscriber)
+	assert.Equal(t, subscription.TopicHeaders, event.Topic)
+	headerEvent := event.Data.(*subscription.HeaderEvent)
+	assert.Equal(t, hex.EncodeToString([]byte("hash")), headerEvent.Hash)
+	assert.Equal(t, uint64(7), headerEvent.Nonce)
+	assertNoEvent(t, headersSubscriber)
+
+	event = receiveEvent(t, txsSubscriber)
+	txEvent := event.Data.(*subscription.TransactionEvent)
+	assert.Equal(t, subscription.TxStatusCommitted, txEvent.Status)
+	assert.Equal(t, hex.EncodeToString(senderAddress), txEvent.Sender)
+	assert.Equal(t, uint64(7), txEvent.BlockNonce)
+	assertNoEvent(t, txsSubscriber)
+}
+
+func TestHub_RegisterTransactionsPoolShouldPublishPendingTransactions(t *testing.T) {
+	t.Parallel()
+
+	var handler func(key []byte)
+	txs := createTxs()
+	pool := &mock.ShardedDataStub{
+		RegisterHandlerCalled: func(h func(key []byte)) {
+			handler = h
+		},
+		SearchFirstDataCalled: func(key []byte) (value interface{}, ok bool) {
+			tx, ok := txs[string(key)]
+			return tx, ok
+		},
+	}
+
+	hub, _ := subscription.NewHub(createArgHub())
+	err := hub.RegisterTransactionsPool(pool)
+	assert.Nil(t, err)
+
+	subscriber, _ := hub.NewSubscriber()
+	_ = subscriber.Subscribe(subscription.Filter{
+		Topic:   subscription.TopicTransactions,
+		Address: hex.EncodeToString(senderAddress),
+	})
+
+	handler([]byte("tx hash"))
+
+	event := receiveEvent(t, subscriber)
+	txEvent := event.Data.(*subscription.TransactionEvent)
+	assert.Equal(t, subscription.TxStatusPending, txEvent.Status)
+	assert.Equal(t, hex.EncodeToString([]byte("tx hash")), txEvent.Hash)
+	assert.Equal(t, uint64(3), txEvent.Nonce)
+}
+
+func TestHub_NotifyCommittedBlockShouldPublishOnlyTheAccountChanges(t *testing.T) {
+	t.Parallel()
+
+	args := createArgHub()
+	args.AccountsTrie = createAccountsTrie(map[string]*state.Account{
+		"root1": {Balance: big.NewInt(50), Nonce: 4},
+		"root2": {Balance: big.NewInt(50), Nonce: 4},
+		"root3": {Balance: big.NewInt(40), Nonce: 4},
+	})
+	hub, _ := subscription.NewHub(args)
+	subscriber, _ := hub.NewSubscriber()
+	_ = subscriber.Subscribe(subscription.Filter{
+		Topic:   subscription.TopicAccounts,
+		Address: hex.EncodeToString(senderAddress),
+	})
+
+	hub.NotifyCommittedBlock(&block.Header{Nonce: 1, RootHash: []byte("root1")}, []byte("hash1"), createTxs())
+
+	event := receiveEvent(t, subscriber)
+	accountEvent := event.Data.(*subscription.AccountEvent)
+	assert.Equal(t, hex.EncodeToString(senderAddress), accountEvent.Address)
+	assert.Equal(t, big.NewInt(50), accountEvent.Balance)
+	assert.Equal(t, uint64(4), accountEvent.Nonce)
+
+	hub.NotifyCommittedBlock(&block.Header{Nonce: 2, RootHash: []byte("root2")}, []byte("hash2"), createTxs())
+	assertNoEvent(t, subscriber)
+
+	hub.NotifyCommittedBlock(&block.Header{Nonce: 3, RootHash: []byte("root3")}, []byte("hash3"), createTxs())
+	event = receiveEvent(t, subscriber)
+	assert.Equal(t, big.NewInt(40), event.Data.(*subscription.AccountEvent).Balance)
+}
+
+func TestHub_UnwatchedAccountShouldBeForgotten(t *testing.T) {
+	t.Parallel()
+
+	args := createArgHub()
+	args.AccountsTrie = createAccountsTrie(map[string]*state.Account{
+		"root1": {Balance: big.NewInt(50), Nonce: 4},
+		"root2": {Balance: big.NewInt(50), Nonce: 4},
+		"root3": {Balance: big.NewInt(50), Nonce: 4},
+	})
+	hub, _ := subscription.NewHub(args)
+	accountsFilter := subscription.Filter{
+		Topic:   subscription.TopicAccounts,
+		Address: hex.EncodeToString(senderAddress),
+	}
+	subscriber, _ := hub.NewSubscriber()
+	_ = subscriber.Subscribe(accountsFilter)
+	otherSubscriber, _ := hub.NewSubscriber()
+	_ = otherSubscriber.Subscribe(accountsFilter)
+
+	hub.NotifyCommittedBlock(&block.Header{Nonce: 1, RootHash: []byte("root1")}, []byte("hash1"), createTxs())
+	_ = receiveEvent(t, subscriber)
+	_ = receiveEvent(t, otherSubscriber)
+
+	// the state is kept while the account is watched by the other subscriber
+	_ = subscriber.Unsubscribe(accountsFilter)
+	_ = subscriber.Subscribe(accountsFilter)
+	hub.NotifyCommittedBlock(&block.Header{Nonce: 2, RootHash: []byte("root2")}, []byte("hash2"), createTxs())
+	assertNoEvent(t, subscriber)
+
+	// the state of an account nobody watches is forgotten, so the unchanged state is published again
+	_ = subscriber.Unsubscribe(accountsFilter)
+	otherSubscriber.Close()
+	_ = subscriber.Subscribe(accountsFilter)
+	hub.NotifyCommittedBlock(&block.Header{Nonce: 3, RootHash: []byte("root3")}, []byte("hash3"), createTxs())
+	event := receiveEvent(t, subscriber)
+	if event == nil {
+		return
+	}
+	assert.Equal(t, uint64(3), event.Data.(*subscription.AccountEvent).BlockNonce)
+}
+
+func TestHub_NotifyCommittedBlockShouldPublishTheLogsOfTheCommittedTransactions(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := subscription.NewHub(createArgHub())
+	subscriber, _ := hub.NewSubscriber()
+	_ = subscriber.Subscribe(subscription.Filter{Topic: subscription.TopicLogs, LogTopic: "0a"})
+
+	hub.SaveLogs([]byte("tx hash"), []*vmcommon.LogEntry{
+		{Address: receiverAddress, Topics: []*big.Int{big.NewInt(10)}, Data: []byte("data")},
+		{Address: receiverAddress, Topics: []*big.Int{big.NewInt(11)}},
+	})
+	hub.SaveLogs([]byte("reverted tx hash"), []*vmcommon.LogEntry{
+		{Address: receiverAddress, Topics: []*big.Int{big.NewInt(10)}},
+	})
+
+	hub.NotifyCommittedBlock(&block.Header{Nonce: 1}, []byte("hash"), createTxs())
+
+	event := receiveEvent(t, subscriber)
+	logEvent := event.Data.(*subscription.LogEvent)
+	assert.Equal(t, hex.EncodeToString([]byte("tx hash")), logEvent.TxHash)
+	assert.Equal(t, []string{"0a"}, logEvent.Topics)
+	assert.Equal(t, hex.EncodeToString([]byte("data")), logEvent.Data)
+	assertNoEvent(t, subscriber)
+
+	hub.NotifyCommittedBlock(&block.Header{Nonce: 2}, []byte("hash2"), map[string]data.TransactionHandler{
+		"reverted tx hash": &transaction.Transaction{SndAddr: senderAddress, RcvAddr: receiverAddress},
+	})
+	assertNoEvent(t, subscriber)
+}
+
+func TestHub_NotifyCommittedBlockShouldNotWaitForThePublishing(t *testing.T) {
+	t.Parallel()
+
+	args := createArgHub()
+	args.CommittedBlocksQueueSize = 1
+	hub, _ := subscription.NewHub(args)
+	_, _ = hub.NewSubscriber()
+	_ = hub.Close()
+
+	chanDone := make(chan struct{})
+	go func() {
+		for nonce := uint64(1); nonce <= 3; nonce++ {
+			hub.NotifyCommittedBlock(&block.Header{Nonce: nonce}, []byte("hash"), createTxs())
+		}
+		close(chanDone)
+	}()
+
+	select {
+	case <-chanDone:
+	case <-time.After(time.Second):
+		assert.Fail(t, "notifying the committed blocks waited for the publishing")
+	}
+}
+
+func TestHub_SlowSubscriberShouldBeDropped(t *testing.T) {
+	t.Parallel()
+
+	args := createArgHub()
+	args.EventsBufferSize = 1
+	hub, _ := subscription.NewHub(args)
+	subscriber, _ := hub.NewSubscriber()
+	_ = subscriber.Subscribe(subscription.Filter{Topic: subscription.TopicHeaders})
+
+	hub.NotifyCommittedBlock(&block.Header{Nonce: 1}, []byte("hash1"), nil)
+	assert.Nil(t, subscriber.Err())
+
+	hub.NotifyCommittedBlock(&block.Header{Nonce: 2}, []byte("hash2"), nil)
+
+	select {
+	case <-subscriber.Done():
+	case <-time.After(time.Second):
+		assert.Fail(t, "the slow subscriber was not dropped")
+	}
+	assert.Equal(t, subscription.ErrSlowSubscriber, subscriber.Err())
+	assert.Equal(t, 0, hub.NumSubscribers())
+}
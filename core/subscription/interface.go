@@ -0,0 +1,14 @@
+package subscription
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// Notifier defines what the block processors need in order to feed the subscriptions with the events of the
+// committed blocks
+type Notifier interface {
+	NotifyCommittedBlock(header data.HeaderHandler, headerHash []byte, txs map[string]data.TransactionHandler)
+	SaveLogs(txHash []byte, logs []*vmcommon.LogEntry)
+	IsInterfaceNil() bool
+}
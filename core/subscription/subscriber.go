@@ -0,0 +1,135 @@
+package subscription
+
+import (
+	"sync"
+)
+
+// filterKey is the parsed form of a filter, holding the raw bytes of the address and of the log topic
+type filterKey struct {
+	topic    string
+	address  string
+	logTopic string
+}
+
+// Subscriber receives the events matching its filters through a bounded channel. A subscriber which does not
+// consume its events fast enough is dropped by the hub, so a slow client can not stall the block processing
+type Subscriber struct {
+	hub    *Hub
+	events chan *Event
+
+	mutFilters sync.RWMutex
+	filters    map[filterKey]struct{}
+	maxFilters int
+
+	closeOnce sync.Once
+	done      chan struct{}
+	err       error
+}
+
+func newSubscriber(hub *Hub, bufferSize int, maxFilters int) *Subscriber {
+	return &Subscriber{
+		hub:        hub,
+		events:     make(chan *Event, bufferSize),
+		filters:    make(map[filterKey]struct{}),
+		maxFilters: maxFilters,
+		done:       make(chan struct{}),
+	}
+}
+
+// Events returns the channel on which the matching events are delivered
+func (s *Subscriber) Events() <-chan *Event {
+	return s.events
+}
+
+// Done returns a channel which is closed when the subscriber is closed or dropped by the hub
+func (s *Subscriber) Done() <-chan struct{} {
+	return s.done
+}
+
+// Err returns the reason for which the subscriber was closed, or nil while it is still active
+func (s *Subscriber) Err() error {
+	select {
+	case <-s.done:
+		return s.err
+	default:
+		return nil
+	}
+}
+
+// Subscribe adds a new filter for the events delivered to this subscriber. A subscriber can have at most
+// MaxFiltersPerSubscriber filters
+func (s *Subscriber) Subscribe(filter Filter) error {
+	key, err := s.hub.parseFilter(filter)
+	if err != nil {
+		return err
+	}
+
+	s.mutFilters.Lock()
+	defer s.mutFilters.Unlock()
+
+	_, exists := s.filters[key]
+	if !exists && len(s.filters) >= s.maxFilters {
+		return ErrTooManyFilters
+	}
+	s.filters[key] = struct{}{}
+
+	return nil
+}
+
+// Unsubscribe removes a filter previously added through Subscribe
+func (s *Subscriber) Unsubscribe(filter Filter) error {
+	key, err := s.hub.parseFilter(filter)
+	if err != nil {
+		return err
+	}
+
+	s.mutFilters.Lock()
+	delete(s.filters, key)
+	s.mutFilters.Unlock()
+
+	if key.topic == TopicAccounts {
+		s.hub.removeUnwatchedAccountStates()
+	}
+
+	return nil
+}
+
+// Close removes the subscriber from the hub
+func (s *Subscriber) Close() {
+	s.hub.removeSubscriber(s, ErrSubscriberClosed)
+}
+
+func (s *Subscriber) matchesAny(keys ...filterKey) bool {
+	s.mutFilters.RLock()
+	defer s.mutFilters.RUnlock()
+
+	for _, key := range keys {
+		_, ok := s.filters[key]
+		if ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Subscriber) watchedAddresses(topic string) []string {
+	s.mutFilters.RLock()
+	defer s.mutFilters.RUnlock()
+
+	addresses := make([]string, 0)
+	for key := range s.filters {
+		if key.topic == topic {
+			addresses = append(addresses, key.address)
+		}
+	}
+
+	return addresses
+}
+
+func (s *Subscriber) close(reason error) {
+	s.closeOnce.Do(func() {
+		s.err = reason
+		close(s.done)
+	})
+}
@@ -0,0 +1,150 @@
+package crypto_test
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber/singlesig"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/interceptors/pipeline"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+)
+
+const batchSize = 100
+const numPeers = 4
+
+// signedTx mimics an intercepted transaction, signed with Schnorr, as seen by the verification pipeline
+type signedTx struct {
+	hash   []byte
+	msg    []byte
+	sig    []byte
+	pubKey crypto.PublicKey
+	signer crypto.SingleSigner
+}
+
+func (stx *signedTx) CheckValidity() error {
+	err := stx.CheckIntegrity()
+	if err != nil {
+		return err
+	}
+
+	return stx.VerifySig()
+}
+
+func (stx *signedTx) CheckIntegrity() error {
+	return nil
+}
+
+func (stx *signedTx) VerifySig() error {
+	return stx.signer.Verify(stx.pubKey, stx.msg, stx.sig)
+}
+
+func (stx *signedTx) IsForCurrentShard() bool {
+	return true
+}
+
+func (stx *signedTx) Hash() []byte {
+	return stx.hash
+}
+
+func (stx *signedTx) IsInterfaceNil() bool {
+	return stx == nil
+}
+
+func createSignedTxs(numTxs int) []process.InterceptedData {
+	keyGen := signing.NewKeyGenerator(kyber.NewBlakeSHA256Ed25519())
+	signer := &singlesig.SchnorrSigner{}
+
+	txs := make([]process.InterceptedData, numTxs)
+	for i := 0; i < numTxs; i++ {
+		privKey, pubKey := keyGen.GeneratePair()
+		msg := []byte(RandStringRunes(128))
+		sig, _ := signer.Sign(privKey, msg)
+
+		txs[i] = &signedTx{
+			hash:   []byte(fmt.Sprintf("tx hash %d", i)),
+			msg:    msg,
+			sig:    sig,
+			pubKey: pubKey,
+			signer: signer,
+		}
+	}
+
+	return txs
+}
+
+func createVerificationPipeline(numWorkers int) process.InterceptedDataVerifier {
+	cacher, _ := lrucache.NewCache(batchSize)
+	verifier, _ := pipeline.NewVerificationPipeline(&pipeline.ArgVerificationPipeline{
+		NumWorkers:    uint32(numWorkers),
+		Cacher:        cacher,
+		StatusHandler: statusHandler.NewNilStatusHandler(),
+	})
+
+	return verifier
+}
+
+func verifyPerMessage(txs []process.InterceptedData) {
+	for _, tx := range txs {
+		err := tx.CheckValidity()
+		if err != nil {
+			fmt.Println("error verifying")
+		}
+	}
+}
+
+func verifyOnPipeline(verifier process.InterceptedDataVerifier, txs []process.InterceptedData) {
+	errs := verifier.Verify(txs)
+	for _, err := range errs {
+		if err != nil {
+			fmt.Println("error verifying")
+		}
+	}
+}
+
+/* Benchmarking the intercepted transactions signature verification */
+func BenchmarkTxSigVerification(b *testing.B) {
+	txs := createSignedTxs(batchSize)
+	numWorkers := runtime.NumCPU()
+
+	b.Run("Per message verification", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			verifyPerMessage(txs)
+		}
+	})
+
+	b.Run(fmt.Sprintf("Pipeline verification with %d workers", numWorkers), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			verifier := createVerificationPipeline(numWorkers)
+			b.StartTimer()
+
+			verifyOnPipeline(verifier, txs)
+		}
+	})
+
+	b.Run(fmt.Sprintf("Per message verification, txs received from %d peers", numPeers), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for j := 0; j < numPeers; j++ {
+				verifyPerMessage(txs)
+			}
+		}
+	})
+
+	b.Run(fmt.Sprintf("Pipeline verification with %d workers, txs received from %d peers", numWorkers, numPeers), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			verifier := createVerificationPipeline(numWorkers)
+			b.StartTimer()
+
+			for j := 0; j < numPeers; j++ {
+				verifyOnPipeline(verifier, txs)
+			}
+		}
+	})
+}
@@ -108,3 +108,73 @@ var ErrEmptyPubKeyString = errors.New("public key string is empty")
 
 // ErrInvalidSigner is raised when the signer is invalid
 var ErrInvalidSigner = errors.New("signer is invalid")
+
+// ErrNilSignerBackend is raised when a nil signer backend is provided
+var ErrNilSignerBackend = errors.New("nil signer backend")
+
+// ErrNilHeaderProvider is raised when a nil block header provider is provided or the signer has none set
+var ErrNilHeaderProvider = errors.New("nil header provider")
+
+// ErrNilHeader is raised when there is no block header to sign
+var ErrNilHeader = errors.New("nil header")
+
+// ErrNilProtectionStorer is raised when a nil slashing protection storer is provided
+var ErrNilProtectionStorer = errors.New("nil slashing protection storer")
+
+// ErrDoubleSigning is raised when the signer is asked to sign a different message for an already signed round and shard
+var ErrDoubleSigning = errors.New("refused to sign a different message for an already signed round and shard")
+
+// ErrOldRoundSigning is raised when the signer is asked to sign for a round older than the last signed one in the shard
+var ErrOldRoundSigning = errors.New("refused to sign for a round older than the last signed round of the shard")
+
+// ErrRawSigningRefused is raised when the signer is asked to sign a raw message which could be used as a signature share
+var ErrRawSigningRefused = errors.New("refused to sign a raw message having the size of a block header hash")
+
+// ErrPrivateKeyNotAvailable is raised when the private key is requested but it is kept by a remote signer
+var ErrPrivateKeyNotAvailable = errors.New("private key is not available, it is kept by the remote signer")
+
+// ErrSignerRequestTimeout is raised when the remote signer does not answer in the allotted time
+var ErrSignerRequestTimeout = errors.New("remote signer request timeout")
+
+// ErrNilListener is raised when a nil network listener is provided
+var ErrNilListener = errors.New("nil listener")
+
+// ErrNilMarshalizer is raised when a nil marshalizer is provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrHeaderHashMismatch is raised when the message to be signed as a signature share is not the hash of the block
+// header held by the consensus state
+var ErrHeaderHashMismatch = errors.New("message to be signed is not the hash of the block header")
+
+// ErrUnsupportedSignerNetwork is raised when the signer is asked to listen on a network other than unix or tcp
+var ErrUnsupportedSignerNetwork = errors.New("unsupported signer network, it has to be unix or tcp")
+
+// ErrNonLoopbackSignerAddress is raised when the signer is asked to listen on a tcp address which is not a loopback one
+var ErrNonLoopbackSignerAddress = errors.New("the signer tcp address has to be a loopback ip address")
+
+// ErrWrongPassword is raised when a key file can not be decrypted with the provided password
+var ErrWrongPassword = errors.New("could not decrypt the key file, wrong password")
+
+// ErrUnsupportedCipher is raised when a key file is encrypted with an unsupported cipher
+var ErrUnsupportedCipher = errors.New("unsupported key file cipher")
+
+// ErrUnsupportedKDF is raised when a key file uses an unsupported key derivation function
+var ErrUnsupportedKDF = errors.New("unsupported key file key derivation function")
+
+// ErrKeyFileNotFound is raised when no key file in the keystore matches the requested public key
+var ErrKeyFileNotFound = errors.New("key file not found in keystore")
+
+// ErrEmptyPassword is raised when an empty password is provided
+var ErrEmptyPassword = errors.New("empty password")
+
+// ErrInvalidMnemonic is raised when a mnemonic phrase is not a valid BIP39 phrase
+var ErrInvalidMnemonic = errors.New("invalid mnemonic phrase")
+
+// ErrInvalidDerivationPath is raised when a derivation path can not be parsed
+var ErrInvalidDerivationPath = errors.New("invalid derivation path")
+
+// ErrNonHardenedDerivation is raised when a non hardened derivation is requested for an ed25519 key
+var ErrNonHardenedDerivation = errors.New("ed25519 keys support only hardened derivation")
+
+// ErrInvalidSeedLength is raised when the seed used for the master key derivation has an invalid length
+var ErrInvalidSeedLength = errors.New("invalid seed length")
@@ -0,0 +1,13 @@
+package keystore
+
+func EncryptKeyWithSaltAndNonce(
+	privateKey []byte,
+	publicKey []byte,
+	kind string,
+	password []byte,
+	params ScryptParams,
+	salt []byte,
+	nonce []byte,
+) (*KeyFile, error) {
+	return encryptKey(privateKey, publicKey, kind, password, params, salt, nonce)
+}
@@ -0,0 +1,212 @@
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// KeyFileVersion is the version of the key file format
+	KeyFileVersion = 1
+
+	// KindTx marks the key file of a key used to sign transactions
+	KindTx = "tx"
+	// KindValidator marks the key file of a key used to sign blocks
+	KindValidator = "validator"
+
+	cipherName = "aes-256-gcm"
+	kdfName    = "scrypt"
+	saltLen    = 32
+	keyLen     = 32
+)
+
+// ScryptParams holds the cost parameters of the scrypt key derivation
+type ScryptParams struct {
+	N int
+	R int
+	P int
+}
+
+// StandardScryptParams are the scrypt parameters used for the keys written by the key generator
+var StandardScryptParams = ScryptParams{N: 1 << 18, R: 8, P: 1}
+
+// LightScryptParams are cheaper scrypt parameters, meant for tests
+var LightScryptParams = ScryptParams{N: 1 << 12, R: 8, P: 1}
+
+// KeyFile is the JSON representation of a password encrypted private key. The public key is kept in clear and it is
+// also authenticated by the cipher, so that it can not be swapped without the decryption failing
+type KeyFile struct {
+	Version   int        `json:"version"`
+	Kind      string     `json:"kind"`
+	PublicKey string     `json:"publicKey"`
+	Crypto    CryptoJSON `json:"crypto"`
+}
+
+// CryptoJSON holds the encrypted private key together with the cipher and key derivation parameters
+type CryptoJSON struct {
+	Cipher       string           `json:"cipher"`
+	CipherText   string           `json:"ciphertext"`
+	CipherParams CipherParamsJSON `json:"cipherparams"`
+	KDF          string           `json:"kdf"`
+	KDFParams    KDFParamsJSON    `json:"kdfparams"`
+}
+
+// CipherParamsJSON holds the cipher parameters
+type CipherParamsJSON struct {
+	Nonce string `json:"nonce"`
+}
+
+// KDFParamsJSON holds the scrypt key derivation parameters
+type KDFParamsJSON struct {
+	N     int    `json:"n"`
+	R     int    `json:"r"`
+	P     int    `json:"p"`
+	DKLen int    `json:"dklen"`
+	Salt  string `json:"salt"`
+}
+
+// EncryptKey encrypts the private key with a key derived from the password and returns the resulting key file
+func EncryptKey(
+	privateKey []byte,
+	publicKey []byte,
+	kind string,
+	password []byte,
+	params ScryptParams,
+) (*KeyFile, error) {
+
+	salt := make([]byte, saltLen)
+	_, err := rand.Read(salt)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, 12)
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return encryptKey(privateKey, publicKey, kind, password, params, salt, nonce)
+}
+
+func encryptKey(
+	privateKey []byte,
+	publicKey []byte,
+	kind string,
+	password []byte,
+	params ScryptParams,
+	salt []byte,
+	nonce []byte,
+) (*KeyFile, error) {
+
+	if len(privateKey) == 0 {
+		return nil, crypto.ErrNilPrivateKey
+	}
+	if len(publicKey) == 0 {
+		return nil, crypto.ErrNilPublicKey
+	}
+	if len(password) == 0 {
+		return nil, crypto.ErrEmptyPassword
+	}
+
+	aead, err := createCipher(password, params, salt)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aead.NonceSize() {
+		return nil, crypto.ErrInvalidParam
+	}
+
+	cipherText := aead.Seal(nil, nonce, privateKey, publicKey)
+
+	return &KeyFile{
+		Version:   KeyFileVersion,
+		Kind:      kind,
+		PublicKey: hex.EncodeToString(publicKey),
+		Crypto: CryptoJSON{
+			Cipher:     cipherName,
+			CipherText: hex.EncodeToString(cipherText),
+			CipherParams: CipherParamsJSON{
+				Nonce: hex.EncodeToString(nonce),
+			},
+			KDF: kdfName,
+			KDFParams: KDFParamsJSON{
+				N:     params.N,
+				R:     params.R,
+				P:     params.P,
+				DKLen: keyLen,
+				Salt:  hex.EncodeToString(salt),
+			},
+		},
+	}, nil
+}
+
+// DecryptKey decrypts the private key from the key file. It returns ErrWrongPassword if the password is wrong or if the
+// key file has been tampered with
+func DecryptKey(keyFile *KeyFile, password []byte) ([]byte, error) {
+	if keyFile == nil {
+		return nil, crypto.ErrNilParam
+	}
+	if keyFile.Crypto.Cipher != cipherName {
+		return nil, crypto.ErrUnsupportedCipher
+	}
+	if keyFile.Crypto.KDF != kdfName || keyFile.Crypto.KDFParams.DKLen != keyLen {
+		return nil, crypto.ErrUnsupportedKDF
+	}
+
+	publicKey, err := hex.DecodeString(keyFile.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	salt, err := hex.DecodeString(keyFile.Crypto.KDFParams.Salt)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := hex.DecodeString(keyFile.Crypto.CipherParams.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	cipherText, err := hex.DecodeString(keyFile.Crypto.CipherText)
+	if err != nil {
+		return nil, err
+	}
+
+	params := ScryptParams{
+		N: keyFile.Crypto.KDFParams.N,
+		R: keyFile.Crypto.KDFParams.R,
+		P: keyFile.Crypto.KDFParams.P,
+	}
+	aead, err := createCipher(password, params, salt)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aead.NonceSize() {
+		return nil, crypto.ErrInvalidParam
+	}
+
+	privateKey, err := aead.Open(nil, nonce, cipherText, publicKey)
+	if err != nil {
+		return nil, crypto.ErrWrongPassword
+	}
+
+	return privateKey, nil
+}
+
+func createCipher(password []byte, params ScryptParams, salt []byte) (cipher.AEAD, error) {
+	derivedKey, err := scrypt.Key(password, salt, params.N, params.R, params.P, keyLen)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(derivedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
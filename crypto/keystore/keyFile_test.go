@@ -0,0 +1,187 @@
+package keystore_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/keystore"
+	"github.com/stretchr/testify/assert"
+)
+
+type keyFileTestVector struct {
+	privateKey string
+	publicKey  string
+	password   string
+	params     keystore.ScryptParams
+	salt       string
+	nonce      string
+	cipherText string
+}
+
+var keyFileTestVectors = []keyFileTestVector{
+	{
+		privateKey: hex.EncodeToString([]byte("0123456789abcdef0123456789abcdef")),
+		publicKey:  hex.EncodeToString([]byte("public key bytes")),
+		password:   "elrond",
+		params:     keystore.ScryptParams{N: 4096, R: 8, P: 1},
+		salt:       "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+		nonce:      "a0a1a2a3a4a5a6a7a8a9aaab",
+		cipherText: "bcdfb312554caec9c94c2dacbf5e06a9f31e0cbcf244f565c874bbbc7c57c01813b866f49ab604ddb603d9c4d835c12d",
+	},
+	{
+		privateKey: "deadbeef",
+		publicKey:  "010203",
+		password:   "correct horse battery staple",
+		params:     keystore.ScryptParams{N: 1024, R: 8, P: 16},
+		salt:       "000102030405060708090a0b0c0d0e0f",
+		nonce:      "a0a1a2a3a4a5a6a7a8a9aaab",
+		cipherText: "c1306c6fdb755c778dca8f1cb3f550280fcd4191",
+	},
+}
+
+func decodeHex(s string) []byte {
+	buff, _ := hex.DecodeString(s)
+	return buff
+}
+
+//------- test vectors
+
+func TestKeyFile_EncryptShouldMatchTestVectors(t *testing.T) {
+	t.Parallel()
+
+	for _, vector := range keyFileTestVectors {
+		keyFile, err := keystore.EncryptKeyWithSaltAndNonce(
+			decodeHex(vector.privateKey),
+			decodeHex(vector.publicKey),
+			keystore.KindTx,
+			[]byte(vector.password),
+			vector.params,
+			decodeHex(vector.salt),
+			decodeHex(vector.nonce),
+		)
+
+		assert.Nil(t, err)
+		assert.Equal(t, vector.cipherText, keyFile.Crypto.CipherText)
+		assert.Equal(t, vector.publicKey, keyFile.PublicKey)
+	}
+}
+
+func TestKeyFile_DecryptShouldMatchTestVectors(t *testing.T) {
+	t.Parallel()
+
+	for _, vector := range keyFileTestVectors {
+		keyFile := &keystore.KeyFile{
+			Version:   keystore.KeyFileVersion,
+			Kind:      keystore.KindValidator,
+			PublicKey: vector.publicKey,
+			Crypto: keystore.CryptoJSON{
+				Cipher:       "aes-256-gcm",
+				CipherText:   vector.cipherText,
+				CipherParams: keystore.CipherParamsJSON{Nonce: vector.nonce},
+				KDF:          "scrypt",
+				KDFParams: keystore.KDFParamsJSON{
+					N:     vector.params.N,
+					R:     vector.params.R,
+					P:     vector.params.P,
+					DKLen: 32,
+					Salt:  vector.salt,
+				},
+			},
+		}
+
+		privateKey, err := keystore.DecryptKey(keyFile, []byte(vector.password))
+
+		assert.Nil(t, err)
+		assert.Equal(t, vector.privateKey, hex.EncodeToString(privateKey))
+	}
+}
+
+//------- EncryptKey / DecryptKey
+
+func TestEncryptKey_EmptyPasswordShouldErr(t *testing.T) {
+	t.Parallel()
+
+	keyFile, err := keystore.EncryptKey([]byte("sk"), []byte("pk"), keystore.KindTx, nil, keystore.LightScryptParams)
+
+	assert.Nil(t, keyFile)
+	assert.Equal(t, crypto.ErrEmptyPassword, err)
+}
+
+func TestEncryptKey_EmptyPrivateKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	keyFile, err := keystore.EncryptKey(nil, []byte("pk"), keystore.KindTx, []byte("pass"), keystore.LightScryptParams)
+
+	assert.Nil(t, keyFile)
+	assert.Equal(t, crypto.ErrNilPrivateKey, err)
+}
+
+func TestEncryptKey_ShouldUseRandomSaltAndNonce(t *testing.T) {
+	t.Parallel()
+
+	keyFile1, _ := keystore.EncryptKey([]byte("sk"), []byte("pk"), keystore.KindTx, []byte("pass"), keystore.LightScryptParams)
+	keyFile2, _ := keystore.EncryptKey([]byte("sk"), []byte("pk"), keystore.KindTx, []byte("pass"), keystore.LightScryptParams)
+
+	assert.NotEqual(t, keyFile1.Crypto.KDFParams.Salt, keyFile2.Crypto.KDFParams.Salt)
+	assert.NotEqual(t, keyFile1.Crypto.CipherParams.Nonce, keyFile2.Crypto.CipherParams.Nonce)
+	assert.NotEqual(t, keyFile1.Crypto.CipherText, keyFile2.Crypto.CipherText)
+}
+
+func TestDecryptKey_RoundTripShouldWork(t *testing.T) {
+	t.Parallel()
+
+	keyFile, _ := keystore.EncryptKey([]byte("sk"), []byte("pk"), keystore.KindTx, []byte("pass"), keystore.LightScryptParams)
+
+	privateKey, err := keystore.DecryptKey(keyFile, []byte("pass"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("sk"), privateKey)
+}
+
+func TestDecryptKey_WrongPasswordShouldErr(t *testing.T) {
+	t.Parallel()
+
+	keyFile, _ := keystore.EncryptKey([]byte("sk"), []byte("pk"), keystore.KindTx, []byte("pass"), keystore.LightScryptParams)
+
+	privateKey, err := keystore.DecryptKey(keyFile, []byte("wrong pass"))
+
+	assert.Nil(t, privateKey)
+	assert.Equal(t, crypto.ErrWrongPassword, err)
+}
+
+func TestDecryptKey_SwappedPublicKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	keyFile, _ := keystore.EncryptKey([]byte("sk"), []byte("pk"), keystore.KindTx, []byte("pass"), keystore.LightScryptParams)
+	keyFile.PublicKey = hex.EncodeToString([]byte("other pk"))
+
+	privateKey, err := keystore.DecryptKey(keyFile, []byte("pass"))
+
+	assert.Nil(t, privateKey)
+	assert.Equal(t, crypto.ErrWrongPassword, err)
+}
+
+func TestDecryptKey_UnsupportedCipherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	keyFile, _ := keystore.EncryptKey([]byte("sk"), []byte("pk"), keystore.KindTx, []byte("pass"), keystore.LightScryptParams)
+	keyFile.Crypto.Cipher = "aes-128-ctr"
+
+	privateKey, err := keystore.DecryptKey(keyFile, []byte("pass"))
+
+	assert.Nil(t, privateKey)
+	assert.Equal(t, crypto.ErrUnsupportedCipher, err)
+}
+
+func TestDecryptKey_UnsupportedKDFShouldErr(t *testing.T) {
+	t.Parallel()
+
+	keyFile, _ := keystore.EncryptKey([]byte("sk"), []byte("pk"), keystore.KindTx, []byte("pass"), keystore.LightScryptParams)
+	keyFile.Crypto.KDF = "pbkdf2"
+
+	privateKey, err := keystore.DecryptKey(keyFile, []byte("pass"))
+
+	assert.Nil(t, privateKey)
+	assert.Equal(t, crypto.ErrUnsupportedKDF, err)
+}
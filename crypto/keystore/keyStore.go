@@ -0,0 +1,110 @@
+package keystore
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+)
+
+// keyFileNamePubKeyLen is the number of public key hex characters used in the key file names
+const keyFileNamePubKeyLen = 32
+
+// StoredKey describes a key file found in the keystore
+type StoredKey struct {
+	Path    string
+	KeyFile *KeyFile
+}
+
+// LoadKeyFile reads the key file from the given path
+func LoadKeyFile(path string) (*KeyFile, error) {
+	buff, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	keyFile := &KeyFile{}
+	err = json.Unmarshal(buff, keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return keyFile, nil
+}
+
+// SaveKeyFile writes the key file in the keystore directory, readable only by the owner, and returns its path
+func SaveKeyFile(keystoreDir string, keyFile *KeyFile) (string, error) {
+	if keyFile == nil {
+		return "", crypto.ErrNilParam
+	}
+
+	err := os.MkdirAll(keystoreDir, 0700)
+	if err != nil {
+		return "", err
+	}
+
+	buff, err := json.MarshalIndent(keyFile, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	pubKey := keyFile.PublicKey
+	if len(pubKey) > keyFileNamePubKeyLen {
+		pubKey = pubKey[:keyFileNamePubKeyLen]
+	}
+	path := filepath.Join(keystoreDir, fmt.Sprintf("%s-%s.json", keyFile.Kind, pubKey))
+
+	err = ioutil.WriteFile(path, buff, 0600)
+	if err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+// ListKeyFiles returns the key files found in the keystore directory, sorted by path
+func ListKeyFiles(keystoreDir string) ([]*StoredKey, error) {
+	paths, err := filepath.Glob(filepath.Join(keystoreDir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	storedKeys := make([]*StoredKey, 0, len(paths))
+	for _, path := range paths {
+		keyFile, errLoad := LoadKeyFile(path)
+		if errLoad != nil || keyFile.Version != KeyFileVersion {
+			//not a key file
+			continue
+		}
+
+		storedKeys = append(storedKeys, &StoredKey{
+			Path:    path,
+			KeyFile: keyFile,
+		})
+	}
+
+	return storedKeys, nil
+}
+
+// FindKeyFile returns the key file from the keystore directory whose public key starts with the given hex prefix
+func FindKeyFile(keystoreDir string, publicKeyPrefix string) (*StoredKey, error) {
+	storedKeys, err := ListKeyFiles(keystoreDir)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKeyPrefix = strings.ToLower(publicKeyPrefix)
+	for _, storedKey := range storedKeys {
+		if strings.HasPrefix(storedKey.KeyFile.PublicKey, publicKeyPrefix) {
+			return storedKey, nil
+		}
+	}
+
+	return nil, crypto.ErrKeyFileNotFound
+}
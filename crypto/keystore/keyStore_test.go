@@ -0,0 +1,116 @@
+package keystore_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/keystore"
+	"github.com/stretchr/testify/assert"
+)
+
+func createKeystoreDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "keystore")
+	assert.Nil(t, err)
+
+	return dir
+}
+
+func TestSaveKeyFile_LoadKeyFileShouldReturnTheSameKeyFile(t *testing.T) {
+	t.Parallel()
+
+	dir := createKeystoreDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	keyFile, _ := keystore.EncryptKey([]byte("sk"), []byte("pk"), keystore.KindTx, []byte("pass"), keystore.LightScryptParams)
+	path, err := keystore.SaveKeyFile(dir, keyFile)
+	assert.Nil(t, err)
+
+	loadedKeyFile, err := keystore.LoadKeyFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, keyFile, loadedKeyFile)
+
+	privateKey, err := keystore.DecryptKey(loadedKeyFile, []byte("pass"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("sk"), privateKey)
+}
+
+func TestListKeyFiles_ShouldSkipOtherFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := createKeystoreDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	txKeyFile, _ := keystore.EncryptKey([]byte("sk1"), []byte("pk1"), keystore.KindTx, []byte("pass"), keystore.LightScryptParams)
+	validatorKeyFile, _ := keystore.EncryptKey([]byte("sk2"), []byte("pk2"), keystore.KindValidator, []byte("pass"), keystore.LightScryptParams)
+	_, _ = keystore.SaveKeyFile(dir, txKeyFile)
+	_, _ = keystore.SaveKeyFile(dir, validatorKeyFile)
+	_ = ioutil.WriteFile(filepath.Join(dir, "other.json"), []byte("{}"), 0600)
+
+	storedKeys, err := keystore.ListKeyFiles(dir)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(storedKeys))
+	assert.Equal(t, txKeyFile, storedKeys[0].KeyFile)
+	assert.Equal(t, validatorKeyFile, storedKeys[1].KeyFile)
+}
+
+func TestFindKeyFile_ShouldFindByPublicKeyPrefix(t *testing.T) {
+	t.Parallel()
+
+	dir := createKeystoreDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	keyFile, _ := keystore.EncryptKey([]byte("sk"), []byte("pk"), keystore.KindTx, []byte("pass"), keystore.LightScryptParams)
+	path, _ := keystore.SaveKeyFile(dir, keyFile)
+
+	storedKey, err := keystore.FindKeyFile(dir, keyFile.PublicKey[:2])
+	assert.Nil(t, err)
+	assert.Equal(t, path, storedKey.Path)
+
+	storedKey, err = keystore.FindKeyFile(dir, "ff")
+	assert.Nil(t, storedKey)
+	assert.Equal(t, crypto.ErrKeyFileNotFound, err)
+}
+
+func TestReadPassword_FromFileShouldTrimTheNewLine(t *testing.T) {
+	t.Parallel()
+
+	dir := createKeystoreDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	passwordFile := filepath.Join(dir, "password")
+	_ = ioutil.WriteFile(passwordFile, []byte("pass\n"), 0600)
+
+	password, err := keystore.ReadPassword(passwordFile, "")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("pass"), password)
+}
+
+func TestReadPassword_EmptyFileShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dir := createKeystoreDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	passwordFile := filepath.Join(dir, "password")
+	_ = ioutil.WriteFile(passwordFile, []byte("\n"), 0600)
+
+	password, err := keystore.ReadPassword(passwordFile, "")
+
+	assert.Nil(t, password)
+	assert.Equal(t, crypto.ErrEmptyPassword, err)
+}
@@ -0,0 +1,41 @@
+package keystore
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// ReadPassword returns the password stored in the password file or, if no password file is provided, it asks for
+// the password on the terminal, without echoing it
+func ReadPassword(passwordFile string, prompt string) ([]byte, error) {
+	if passwordFile != "" {
+		buff, err := ioutil.ReadFile(passwordFile)
+		if err != nil {
+			return nil, err
+		}
+
+		password := strings.TrimRight(string(buff), "\r\n")
+		if len(password) == 0 {
+			return nil, crypto.ErrEmptyPassword
+		}
+
+		return []byte(password), nil
+	}
+
+	fmt.Print(prompt)
+	password, err := terminal.ReadPassword(int(os.Stdin.Fd()))
+	fmt.Println()
+	if err != nil {
+		return nil, err
+	}
+	if len(password) == 0 {
+		return nil, crypto.ErrEmptyPassword
+	}
+
+	return password, nil
+}
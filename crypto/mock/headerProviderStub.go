@@ -0,0 +1,21 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+type HeaderProviderStub struct {
+	GetHeaderStub func() data.HeaderHandler
+}
+
+func (hps *HeaderProviderStub) GetHeader() data.HeaderHandler {
+	return hps.GetHeaderStub()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hps *HeaderProviderStub) IsInterfaceNil() bool {
+	if hps == nil {
+		return true
+	}
+	return false
+}
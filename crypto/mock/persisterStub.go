@@ -0,0 +1,47 @@
+package mock
+
+type PersisterStub struct {
+	PutStub     func(key, val []byte) error
+	GetStub     func(key []byte) ([]byte, error)
+	HasStub     func(key []byte) error
+	InitStub    func() error
+	CloseStub   func() error
+	RemoveStub  func(key []byte) error
+	DestroyStub func() error
+}
+
+func (ps *PersisterStub) Put(key, val []byte) error {
+	return ps.PutStub(key, val)
+}
+
+func (ps *PersisterStub) Get(key []byte) ([]byte, error) {
+	return ps.GetStub(key)
+}
+
+func (ps *PersisterStub) Has(key []byte) error {
+	return ps.HasStub(key)
+}
+
+func (ps *PersisterStub) Init() error {
+	return ps.InitStub()
+}
+
+func (ps *PersisterStub) Close() error {
+	return ps.CloseStub()
+}
+
+func (ps *PersisterStub) Remove(key []byte) error {
+	return ps.RemoveStub(key)
+}
+
+func (ps *PersisterStub) Destroy() error {
+	return ps.DestroyStub()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ps *PersisterStub) IsInterfaceNil() bool {
+	if ps == nil {
+		return true
+	}
+	return false
+}
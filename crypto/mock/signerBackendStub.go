@@ -0,0 +1,27 @@
+package mock
+
+type SignerBackendStub struct {
+	PublicKeyStub func() ([]byte, error)
+	SignStub      func(message []byte) ([]byte, error)
+	SignShareStub func(header []byte) ([]byte, error)
+}
+
+func (sbs *SignerBackendStub) PublicKey() ([]byte, error) {
+	return sbs.PublicKeyStub()
+}
+
+func (sbs *SignerBackendStub) Sign(message []byte) ([]byte, error) {
+	return sbs.SignStub(message)
+}
+
+func (sbs *SignerBackendStub) SignShare(header []byte) ([]byte, error) {
+	return sbs.SignShareStub(header)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sbs *SignerBackendStub) IsInterfaceNil() bool {
+	if sbs == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,170 @@
+package remote
+
+import (
+	"net/rpc"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+)
+
+// knownErrors are the errors which are recreated on the client side from the errors returned by the signer server
+var knownErrors = []error{
+	crypto.ErrDoubleSigning,
+	crypto.ErrOldRoundSigning,
+	crypto.ErrRawSigningRefused,
+	crypto.ErrNilMessage,
+	crypto.ErrNilHeader,
+	crypto.ErrNilPrivateKey,
+}
+
+// signerClient is the signer backend which forwards the signing requests to a signer server. A failed connection is
+// dropped and dialed again on the next request
+type signerClient struct {
+	network string
+	address string
+	timeout time.Duration
+
+	mutClient sync.Mutex
+	client    *rpc.Client
+}
+
+// NewSignerClient creates a new signerClient object connected to the signer server listening on the given network
+// ("unix" or "tcp") and address
+func NewSignerClient(network string, address string, timeout time.Duration) (*signerClient, error) {
+	if timeout <= 0 {
+		return nil, crypto.ErrInvalidParam
+	}
+
+	sc := &signerClient{
+		network: network,
+		address: address,
+		timeout: timeout,
+	}
+
+	_, err := sc.getClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return sc, nil
+}
+
+// PublicKey returns the public key of the remote signer
+func (sc *signerClient) PublicKey() ([]byte, error) {
+	reply := &PublicKeyReply{}
+	err := sc.call("PublicKey", &SignArgs{}, reply)
+	if err != nil {
+		return nil, err
+	}
+
+	return reply.PublicKey, nil
+}
+
+// Sign asks the remote signer to create a single signature over the message
+func (sc *signerClient) Sign(message []byte) ([]byte, error) {
+	reply := &SignReply{}
+	err := sc.call("Sign", &SignArgs{Message: message}, reply)
+	if err != nil {
+		return nil, err
+	}
+
+	return reply.Signature, nil
+}
+
+// SignShare asks the remote signer to create a signature share over the hash of the marshalled block header
+func (sc *signerClient) SignShare(header []byte) ([]byte, error) {
+	reply := &SignReply{}
+	err := sc.call("SignShare", &SignArgs{Header: header}, reply)
+	if err != nil {
+		return nil, err
+	}
+
+	return reply.Signature, nil
+}
+
+func (sc *signerClient) call(method string, args *SignArgs, reply interface{}) error {
+	client, err := sc.getClient()
+	if err != nil {
+		return err
+	}
+
+	call := client.Go(serviceName+"."+method, args, reply, make(chan *rpc.Call, 1))
+	select {
+	case <-call.Done:
+		err = call.Error
+	case <-time.After(sc.timeout):
+		sc.dropClient(client)
+		return crypto.ErrSignerRequestTimeout
+	}
+
+	if err == nil {
+		return nil
+	}
+
+	serverErr, isServerErr := err.(rpc.ServerError)
+	if !isServerErr {
+		sc.dropClient(client)
+		return err
+	}
+
+	for _, knownErr := range knownErrors {
+		if knownErr.Error() == string(serverErr) {
+			return knownErr
+		}
+	}
+
+	return err
+}
+
+func (sc *signerClient) getClient() (*rpc.Client, error) {
+	sc.mutClient.Lock()
+	defer sc.mutClient.Unlock()
+
+	if sc.client != nil {
+		return sc.client, nil
+	}
+
+	client, err := rpc.Dial(sc.network, sc.address)
+	if err != nil {
+		return nil, err
+	}
+
+	sc.client = client
+	return client, nil
+}
+
+func (sc *signerClient) dropClient(client *rpc.Client) {
+	sc.mutClient.Lock()
+	defer sc.mutClient.Unlock()
+
+	if sc.client != client {
+		return
+	}
+
+	_ = client.Close()
+	sc.client = nil
+}
+
+// Close closes the connection to the signer server
+func (sc *signerClient) Close() error {
+	sc.mutClient.Lock()
+	defer sc.mutClient.Unlock()
+
+	if sc.client == nil {
+		return nil
+	}
+
+	err := sc.client.Close()
+	sc.client = nil
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sc *signerClient) IsInterfaceNil() bool {
+	if sc == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,211 @@
+package remote_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/mock"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber/multisig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/remote"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/stretchr/testify/assert"
+)
+
+const requestTimeout = time.Second
+
+func startSignerServer(t *testing.T, backend remote.SignerBackend) (address string, closeServer func()) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+
+	server, err := remote.NewSignerServer(backend, listener)
+	assert.Nil(t, err)
+	server.Start()
+
+	return listener.Addr().String(), func() {
+		_ = server.Close()
+	}
+}
+
+//------- NewSignerServer
+
+func TestNewSignerServer_NilBackendShouldErr(t *testing.T) {
+	t.Parallel()
+
+	listener, _ := net.Listen("tcp", "127.0.0.1:0")
+	defer func() {
+		_ = listener.Close()
+	}()
+
+	server, err := remote.NewSignerServer(nil, listener)
+
+	assert.Nil(t, server)
+	assert.Equal(t, crypto.ErrNilSignerBackend, err)
+}
+
+func TestNewSignerServer_NilListenerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	server, err := remote.NewSignerServer(&mock.SignerBackendStub{}, nil)
+
+	assert.Nil(t, server)
+	assert.Equal(t, crypto.ErrNilListener, err)
+}
+
+//------- NewSignerListener
+
+func TestNewSignerListener_UnsupportedNetworkShouldErr(t *testing.T) {
+	t.Parallel()
+
+	listener, err := remote.NewSignerListener("udp", "127.0.0.1:0")
+
+	assert.Nil(t, listener)
+	assert.Equal(t, crypto.ErrUnsupportedSignerNetwork, err)
+}
+
+func TestNewSignerListener_NonLoopbackTcpAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	for _, address := range []string{":0", "0.0.0.0:0", "192.168.0.1:0", "localhost:0"} {
+		listener, err := remote.NewSignerListener("tcp", address)
+
+		assert.Nil(t, listener)
+		assert.Equal(t, crypto.ErrNonLoopbackSignerAddress, err, address)
+	}
+}
+
+func TestNewSignerListener_LoopbackTcpAddressShouldWork(t *testing.T) {
+	t.Parallel()
+
+	listener, err := remote.NewSignerListener("tcp", "127.0.0.1:0")
+
+	assert.Nil(t, err)
+	if listener != nil {
+		_ = listener.Close()
+	}
+}
+
+func TestNewSignerListener_UnixSocketShouldBeAccessibleOnlyToTheOwner(t *testing.T) {
+	t.Parallel()
+
+	folder, err := ioutil.TempDir("", "signer")
+	assert.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(folder)
+	}()
+
+	socketPath := filepath.Join(folder, "signer.sock")
+	listener, err := remote.NewSignerListener("unix", socketPath)
+	if !assert.Nil(t, err) {
+		return
+	}
+	defer func() {
+		_ = listener.Close()
+	}()
+
+	fileInfo, err := os.Stat(socketPath)
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(0600), fileInfo.Mode().Perm())
+}
+
+//------- NewSignerClient
+
+func TestNewSignerClient_InvalidTimeoutShouldErr(t *testing.T) {
+	t.Parallel()
+
+	client, err := remote.NewSignerClient("tcp", "127.0.0.1:0", 0)
+
+	assert.Nil(t, client)
+	assert.Equal(t, crypto.ErrInvalidParam, err)
+}
+
+func TestNewSignerClient_NoServerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	listener, _ := net.Listen("tcp", "127.0.0.1:0")
+	address := listener.Addr().String()
+	_ = listener.Close()
+
+	client, err := remote.NewSignerClient("tcp", address, requestTimeout)
+
+	assert.Nil(t, client)
+	assert.NotNil(t, err)
+}
+
+//------- signing through the server
+
+func TestSignerClient_ShouldSignThroughTheServer(t *testing.T) {
+	t.Parallel()
+
+	_, privKey, pubKey := createKeyPair()
+	address, closeServer := startSignerServer(t, createKeySigner(privKey))
+	defer closeServer()
+
+	client, err := remote.NewSignerClient("tcp", address, requestTimeout)
+	assert.Nil(t, err)
+	defer func() {
+		_ = client.Close()
+	}()
+
+	pubKeyBytes, _ := pubKey.ToByteArray()
+	clientPubKeyBytes, err := client.PublicKey()
+	assert.Nil(t, err)
+	assert.Equal(t, pubKeyBytes, clientPubKeyBytes)
+
+	header := marshalHeader(&block.Header{Round: 3, Nonce: 2, ShardId: 1})
+	sig, err := client.SignShare(header)
+	assert.Nil(t, err)
+	assert.Nil(t, (&multisig.KyberMultiSignerBLS{}).VerifySigShare(pubKey, headerHash(header), sig))
+
+	sig, err = client.SignShare(marshalHeader(&block.Header{Round: 3, Nonce: 3, ShardId: 1}))
+	assert.Nil(t, sig)
+	assert.Equal(t, crypto.ErrDoubleSigning, err)
+}
+
+func TestSignerClient_UnknownServerErrorShouldBeReturned(t *testing.T) {
+	t.Parallel()
+
+	address, closeServer := startSignerServer(t, &mock.SignerBackendStub{
+		SignStub: func(message []byte) ([]byte, error) {
+			return nil, errors.New("expected error")
+		},
+	})
+	defer closeServer()
+
+	client, _ := remote.NewSignerClient("tcp", address, requestTimeout)
+	defer func() {
+		_ = client.Close()
+	}()
+
+	sig, err := client.Sign([]byte("message"))
+
+	assert.Nil(t, sig)
+	assert.Equal(t, "expected error", err.Error())
+}
+
+func TestSignerClient_SlowServerShouldErrTimeout(t *testing.T) {
+	t.Parallel()
+
+	address, closeServer := startSignerServer(t, &mock.SignerBackendStub{
+		SignStub: func(message []byte) ([]byte, error) {
+			time.Sleep(time.Millisecond * 200)
+			return []byte("signature"), nil
+		},
+	})
+	defer closeServer()
+
+	client, _ := remote.NewSignerClient("tcp", address, time.Millisecond*50)
+	defer func() {
+		_ = client.Close()
+	}()
+
+	sig, err := client.Sign([]byte("message"))
+
+	assert.Nil(t, sig)
+	assert.Equal(t, crypto.ErrSignerRequestTimeout, err)
+}
@@ -0,0 +1,30 @@
+package remote
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// SignerBackend defines the operations exposed by a signer which keeps the block signing private key
+type SignerBackend interface {
+	// PublicKey returns the byte array representation of the public key corresponding to the kept private key
+	PublicKey() ([]byte, error)
+	// Sign creates a single signature over the message
+	Sign(message []byte) ([]byte, error)
+	// SignShare creates a signature share over the hash of the marshalled block header
+	SignShare(header []byte) ([]byte, error)
+	// IsInterfaceNil returns true if there is no value under the interface
+	IsInterfaceNil() bool
+}
+
+// HeaderProvider provides the block header whose hash is signed in the current consensus round
+type HeaderProvider interface {
+	GetHeader() data.HeaderHandler
+	IsInterfaceNil() bool
+}
+
+// HeaderProviderSetter defines the signers which need the block header whose hash is signed in the current consensus
+// round
+type HeaderProviderSetter interface {
+	SetHeaderProvider(headerProvider HeaderProvider) error
+	IsInterfaceNil() bool
+}
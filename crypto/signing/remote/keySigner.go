@@ -0,0 +1,162 @@
+package remote
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.DefaultLogger()
+
+// keySigner is the signer backend which holds the private key in its own process. It is used by the signer binary
+// behind the signer server and it can be used directly, in-process, as a fallback for tests
+type keySigner struct {
+	privKey      crypto.PrivateKey
+	pubKey       []byte
+	singleSigner crypto.SingleSigner
+	llSigner     crypto.LowLevelSignerBLS
+	hasher       hashing.Hasher
+	marshalizer  marshal.Marshalizer
+	isMetachain  bool
+	protection   *slashingProtection
+}
+
+// NewKeySigner creates a new keySigner object. The hasher and the marshalizer are the ones used by the node for the
+// block headers, which are meta blocks if isMetachain is set, and the protectionStorer is used to persist the signed
+// shares so that two different block headers will not be signed for the same round and shard
+func NewKeySigner(
+	privKey crypto.PrivateKey,
+	singleSigner crypto.SingleSigner,
+	llSigner crypto.LowLevelSignerBLS,
+	hasher hashing.Hasher,
+	marshalizer marshal.Marshalizer,
+	isMetachain bool,
+	protectionStorer storage.Persister,
+) (*keySigner, error) {
+
+	if privKey == nil || privKey.IsInterfaceNil() {
+		return nil, crypto.ErrNilPrivateKey
+	}
+	if singleSigner == nil || singleSigner.IsInterfaceNil() {
+		return nil, crypto.ErrNilSingleSigner
+	}
+	if llSigner == nil || llSigner.IsInterfaceNil() {
+		return nil, crypto.ErrInvalidSigner
+	}
+	if hasher == nil || hasher.IsInterfaceNil() {
+		return nil, crypto.ErrNilHasher
+	}
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, crypto.ErrNilMarshalizer
+	}
+
+	protection, err := NewSlashingProtection(protectionStorer)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey, err := privKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	return &keySigner{
+		privKey:      privKey,
+		pubKey:       pubKey,
+		singleSigner: singleSigner,
+		llSigner:     llSigner,
+		hasher:       hasher,
+		marshalizer:  marshalizer,
+		isMetachain:  isMetachain,
+		protection:   protection,
+	}, nil
+}
+
+// PublicKey returns the byte array representation of the public key
+func (ks *keySigner) PublicKey() ([]byte, error) {
+	return ks.pubKey, nil
+}
+
+// Sign creates a single signature over the message. As a BLS single signature over a block header hash is also a
+// valid signature share of that header, the messages having the size of a block header hash are refused, so that the
+// shares are created only through SignShare
+func (ks *keySigner) Sign(message []byte) ([]byte, error) {
+	if len(message) == ks.hasher.Size() {
+		log.Info(fmt.Sprintf("refused to sign raw message %s\n", core.ToHex(message)))
+		return nil, crypto.ErrRawSigningRefused
+	}
+
+	sig, err := ks.singleSigner.Sign(ks.privKey, message)
+	if err != nil {
+		log.Info(fmt.Sprintf("could not sign message %s: %s\n", core.ToHex(message), err.Error()))
+		return nil, err
+	}
+
+	log.Info(fmt.Sprintf("signed message %s\n", core.ToHex(message)))
+
+	return sig, nil
+}
+
+// SignShare creates a signature share over the hash of the marshalled block header. The round, the nonce and the
+// shard are read from the header itself, so the node can not make the signer believe that a header belongs to another
+// round. It refuses to sign a different block header for a round and shard in which a signature share has already
+// been created, as well as any block header of a round older than the last signed one in the shard
+func (ks *keySigner) SignShare(header []byte) ([]byte, error) {
+	if len(header) == 0 {
+		return nil, crypto.ErrNilHeader
+	}
+
+	hdr, err := ks.decodeHeader(header)
+	if err != nil {
+		log.Info(fmt.Sprintf("refused to sign share for an undecodable block header: %s\n", err.Error()))
+		return nil, err
+	}
+
+	hash := ks.hasher.Compute(string(header))
+	round := int64(hdr.GetRound())
+	err = ks.protection.CheckAndRecord(hash, round, hdr.GetNonce(), hdr.GetShardID())
+	if err != nil {
+		log.Info(fmt.Sprintf("refused to sign share %s for round %d, nonce %d in shard %d: %s\n",
+			core.ToHex(hash), round, hdr.GetNonce(), hdr.GetShardID(), err.Error()))
+		return nil, err
+	}
+
+	sig, err := ks.llSigner.SignShare(ks.privKey, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info(fmt.Sprintf("signed share %s for round %d, nonce %d in shard %d\n",
+		core.ToHex(hash), round, hdr.GetNonce(), hdr.GetShardID()))
+
+	return sig, nil
+}
+
+func (ks *keySigner) decodeHeader(header []byte) (data.HeaderHandler, error) {
+	var hdr data.HeaderHandler = &block.Header{}
+	if ks.isMetachain {
+		hdr = &block.MetaBlock{}
+	}
+
+	err := ks.marshalizer.Unmarshal(hdr, header)
+	if err != nil {
+		return nil, err
+	}
+
+	return hdr, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ks *keySigner) IsInterfaceNil() bool {
+	if ks == nil {
+		return true
+	}
+	return false
+}
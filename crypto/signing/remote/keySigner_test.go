@@ -0,0 +1,234 @@
+package remote_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber/multisig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber/singlesig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/remote"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+)
+
+func createKeyPair() (crypto.KeyGenerator, crypto.PrivateKey, crypto.PublicKey) {
+	keyGen := signing.NewKeyGenerator(kyber.NewSuitePairingBn256())
+	privKey, pubKey := keyGen.GeneratePair()
+
+	return keyGen, privKey, pubKey
+}
+
+func createKeySigner(privKey crypto.PrivateKey) remote.SignerBackend {
+	storer, _ := memorydb.New()
+	ks, _ := remote.NewKeySigner(privKey, &singlesig.BlsSingleSigner{}, &multisig.KyberMultiSignerBLS{}, blake2b.Blake2b{}, &marshal.JsonMarshalizer{}, false, storer)
+
+	return ks
+}
+
+func marshalHeader(header data.HeaderHandler) []byte {
+	buff, _ := (&marshal.JsonMarshalizer{}).Marshal(header)
+	return buff
+}
+
+func headerHash(header []byte) []byte {
+	return blake2b.Blake2b{}.Compute(string(header))
+}
+
+//------- NewKeySigner
+
+func TestNewKeySigner_NilPrivateKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	storer, _ := memorydb.New()
+	ks, err := remote.NewKeySigner(nil, &singlesig.BlsSingleSigner{}, &multisig.KyberMultiSignerBLS{}, blake2b.Blake2b{}, &marshal.JsonMarshalizer{}, false, storer)
+
+	assert.Nil(t, ks)
+	assert.Equal(t, crypto.ErrNilPrivateKey, err)
+}
+
+func TestNewKeySigner_NilSingleSignerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, privKey, _ := createKeyPair()
+	storer, _ := memorydb.New()
+	ks, err := remote.NewKeySigner(privKey, nil, &multisig.KyberMultiSignerBLS{}, blake2b.Blake2b{}, &marshal.JsonMarshalizer{}, false, storer)
+
+	assert.Nil(t, ks)
+	assert.Equal(t, crypto.ErrNilSingleSigner, err)
+}
+
+func TestNewKeySigner_NilLowLevelSignerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, privKey, _ := createKeyPair()
+	storer, _ := memorydb.New()
+	ks, err := remote.NewKeySigner(privKey, &singlesig.BlsSingleSigner{}, nil, blake2b.Blake2b{}, &marshal.JsonMarshalizer{}, false, storer)
+
+	assert.Nil(t, ks)
+	assert.Equal(t, crypto.ErrInvalidSigner, err)
+}
+
+func TestNewKeySigner_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, privKey, _ := createKeyPair()
+	storer, _ := memorydb.New()
+	ks, err := remote.NewKeySigner(privKey, &singlesig.BlsSingleSigner{}, &multisig.KyberMultiSignerBLS{}, nil, &marshal.JsonMarshalizer{}, false, storer)
+
+	assert.Nil(t, ks)
+	assert.Equal(t, crypto.ErrNilHasher, err)
+}
+
+func TestNewKeySigner_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, privKey, _ := createKeyPair()
+	storer, _ := memorydb.New()
+	ks, err := remote.NewKeySigner(privKey, &singlesig.BlsSingleSigner{}, &multisig.KyberMultiSignerBLS{}, blake2b.Blake2b{}, nil, false, storer)
+
+	assert.Nil(t, ks)
+	assert.Equal(t, crypto.ErrNilMarshalizer, err)
+}
+
+func TestNewKeySigner_NilStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, privKey, _ := createKeyPair()
+	ks, err := remote.NewKeySigner(privKey, &singlesig.BlsSingleSigner{}, &multisig.KyberMultiSignerBLS{}, blake2b.Blake2b{}, &marshal.JsonMarshalizer{}, false, nil)
+
+	assert.Nil(t, ks)
+	assert.Equal(t, crypto.ErrNilProtectionStorer, err)
+}
+
+func TestNewKeySigner_OkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	_, privKey, pubKey := createKeyPair()
+	storer, _ := memorydb.New()
+	ks, err := remote.NewKeySigner(privKey, &singlesig.BlsSingleSigner{}, &multisig.KyberMultiSignerBLS{}, blake2b.Blake2b{}, &marshal.JsonMarshalizer{}, false, storer)
+
+	assert.NotNil(t, ks)
+	assert.Nil(t, err)
+
+	pubKeyBytes, _ := pubKey.ToByteArray()
+	ksPubKeyBytes, err := ks.PublicKey()
+	assert.Nil(t, err)
+	assert.Equal(t, pubKeyBytes, ksPubKeyBytes)
+}
+
+//------- Sign / SignShare
+
+func TestKeySigner_SignShouldCreateValidSignature(t *testing.T) {
+	t.Parallel()
+
+	_, privKey, pubKey := createKeyPair()
+	ks := createKeySigner(privKey)
+	msg := []byte("message")
+
+	sig, err := ks.Sign(msg)
+
+	assert.Nil(t, err)
+	assert.Nil(t, (&singlesig.BlsSingleSigner{}).Verify(pubKey, msg, sig))
+}
+
+func TestKeySigner_SignShareShouldCreateValidSignatureShareOverTheHeaderHash(t *testing.T) {
+	t.Parallel()
+
+	_, privKey, pubKey := createKeyPair()
+	ks := createKeySigner(privKey)
+	llSigner := &multisig.KyberMultiSignerBLS{}
+	header := marshalHeader(&block.Header{Round: 5, Nonce: 4})
+
+	sig, err := ks.SignShare(header)
+
+	assert.Nil(t, err)
+	assert.Nil(t, llSigner.VerifySigShare(pubKey, headerHash(header), sig))
+}
+
+func TestKeySigner_SignShareEmptyHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, privKey, _ := createKeyPair()
+	ks := createKeySigner(privKey)
+
+	sig, err := ks.SignShare(nil)
+
+	assert.Nil(t, sig)
+	assert.Equal(t, crypto.ErrNilHeader, err)
+}
+
+func TestKeySigner_SignShareInvalidHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, privKey, _ := createKeyPair()
+	ks := createKeySigner(privKey)
+
+	sig, err := ks.SignShare([]byte("not a header"))
+
+	assert.Nil(t, sig)
+	assert.NotNil(t, err)
+}
+
+func TestKeySigner_SignShareDifferentHeaderInSameRoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, privKey, _ := createKeyPair()
+	ks := createKeySigner(privKey)
+
+	_, _ = ks.SignShare(marshalHeader(&block.Header{Round: 5, Nonce: 4}))
+	sig, err := ks.SignShare(marshalHeader(&block.Header{Round: 5, Nonce: 4, RootHash: []byte("other root")}))
+
+	assert.Nil(t, sig)
+	assert.Equal(t, crypto.ErrDoubleSigning, err)
+}
+
+func TestKeySigner_SignShareShouldTakeTheRoundAndShardFromTheHeader(t *testing.T) {
+	t.Parallel()
+
+	_, privKey, _ := createKeyPair()
+	ks := createKeySigner(privKey)
+
+	_, err := ks.SignShare(marshalHeader(&block.Header{Round: 5, Nonce: 4, ShardId: 1}))
+	assert.Nil(t, err)
+
+	_, err = ks.SignShare(marshalHeader(&block.Header{Round: 4, Nonce: 4, ShardId: 1}))
+	assert.Equal(t, crypto.ErrOldRoundSigning, err)
+
+	_, err = ks.SignShare(marshalHeader(&block.Header{Round: 4, Nonce: 4, ShardId: 0}))
+	assert.Nil(t, err)
+}
+
+func TestKeySigner_SignShareMetachainShouldDecodeMetaBlocks(t *testing.T) {
+	t.Parallel()
+
+	_, privKey, pubKey := createKeyPair()
+	storer, _ := memorydb.New()
+	ks, _ := remote.NewKeySigner(privKey, &singlesig.BlsSingleSigner{}, &multisig.KyberMultiSignerBLS{}, blake2b.Blake2b{}, &marshal.JsonMarshalizer{}, true, storer)
+	header := marshalHeader(&block.MetaBlock{Round: 5, Nonce: 4})
+
+	sig, err := ks.SignShare(header)
+	assert.Nil(t, err)
+	assert.Nil(t, (&multisig.KyberMultiSignerBLS{}).VerifySigShare(pubKey, headerHash(header), sig))
+
+	_, err = ks.SignShare(marshalHeader(&block.MetaBlock{Round: 5, Nonce: 5}))
+	assert.Equal(t, crypto.ErrDoubleSigning, err)
+}
+
+func TestKeySigner_SignHeaderHashSizedMessageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, privKey, _ := createKeyPair()
+	ks := createKeySigner(privKey)
+	headerHash := blake2b.Blake2b{}.Compute("header")
+
+	sig, err := ks.Sign(headerHash)
+
+	assert.Nil(t, sig)
+	assert.Equal(t, crypto.ErrRawSigningRefused, err)
+}
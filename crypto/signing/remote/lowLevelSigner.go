@@ -0,0 +1,107 @@
+package remote
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// remoteLowLevelSigner creates the BLS signature shares through a signer backend, which keeps the private key, sending
+// the marshalled block header held by the consensus state, so that the backend hashes the header itself and reads
+// from it the round, the nonce and the shard used to refuse double signing. All other operations are done by the
+// wrapped low level signer
+type remoteLowLevelSigner struct {
+	crypto.LowLevelSignerBLS
+	backend     SignerBackend
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+
+	mutHeaderProvider sync.RWMutex
+	headerProvider    HeaderProvider
+}
+
+// NewRemoteLowLevelSigner creates a new remoteLowLevelSigner object. The marshalizer and the hasher are the ones used
+// for the block headers. The signature shares can be created only after the provider of the signed block header is set
+func NewRemoteLowLevelSigner(
+	llSigner crypto.LowLevelSignerBLS,
+	backend SignerBackend,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) (*remoteLowLevelSigner, error) {
+
+	if llSigner == nil || llSigner.IsInterfaceNil() {
+		return nil, crypto.ErrInvalidSigner
+	}
+	if backend == nil || backend.IsInterfaceNil() {
+		return nil, crypto.ErrNilSignerBackend
+	}
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, crypto.ErrNilMarshalizer
+	}
+	if hasher == nil || hasher.IsInterfaceNil() {
+		return nil, crypto.ErrNilHasher
+	}
+
+	return &remoteLowLevelSigner{
+		LowLevelSignerBLS: llSigner,
+		backend:           backend,
+		marshalizer:       marshalizer,
+		hasher:            hasher,
+	}, nil
+}
+
+// SetHeaderProvider sets the provider of the block header whose hash is signed in the current consensus round
+func (rlls *remoteLowLevelSigner) SetHeaderProvider(headerProvider HeaderProvider) error {
+	if headerProvider == nil || headerProvider.IsInterfaceNil() {
+		return crypto.ErrNilHeaderProvider
+	}
+
+	rlls.mutHeaderProvider.Lock()
+	rlls.headerProvider = headerProvider
+	rlls.mutHeaderProvider.Unlock()
+
+	return nil
+}
+
+// SignShare sends the marshalled block header held by the consensus state to the signer backend. The message has to be
+// the hash of that header, as the backend signs the hash it computes itself
+func (rlls *remoteLowLevelSigner) SignShare(_ crypto.PrivateKey, message []byte) ([]byte, error) {
+	if message == nil {
+		return nil, crypto.ErrNilMessage
+	}
+
+	rlls.mutHeaderProvider.RLock()
+	headerProvider := rlls.headerProvider
+	rlls.mutHeaderProvider.RUnlock()
+
+	if headerProvider == nil {
+		return nil, crypto.ErrNilHeaderProvider
+	}
+
+	header := headerProvider.GetHeader()
+	if header == nil || header.IsInterfaceNil() {
+		return nil, crypto.ErrNilHeader
+	}
+
+	headerBytes, err := rlls.marshalizer.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(rlls.hasher.Compute(string(headerBytes)), message) {
+		return nil, crypto.ErrHeaderHashMismatch
+	}
+
+	return rlls.backend.SignShare(headerBytes)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rlls *remoteLowLevelSigner) IsInterfaceNil() bool {
+	if rlls == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,149 @@
+package remote_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/mock"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber/multisig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/remote"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/stretchr/testify/assert"
+)
+
+func createHeaderProvider(header data.HeaderHandler) *mock.HeaderProviderStub {
+	return &mock.HeaderProviderStub{
+		GetHeaderStub: func() data.HeaderHandler {
+			return header
+		},
+	}
+}
+
+func TestNewRemoteLowLevelSigner_NilLowLevelSignerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rlls, err := remote.NewRemoteLowLevelSigner(nil, &mock.SignerBackendStub{}, &marshal.JsonMarshalizer{}, blake2b.Blake2b{})
+
+	assert.Nil(t, rlls)
+	assert.Equal(t, crypto.ErrInvalidSigner, err)
+}
+
+func TestNewRemoteLowLevelSigner_NilBackendShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rlls, err := remote.NewRemoteLowLevelSigner(&multisig.KyberMultiSignerBLS{}, nil, &marshal.JsonMarshalizer{}, blake2b.Blake2b{})
+
+	assert.Nil(t, rlls)
+	assert.Equal(t, crypto.ErrNilSignerBackend, err)
+}
+
+func TestNewRemoteLowLevelSigner_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rlls, err := remote.NewRemoteLowLevelSigner(&multisig.KyberMultiSignerBLS{}, &mock.SignerBackendStub{}, nil, blake2b.Blake2b{})
+
+	assert.Nil(t, rlls)
+	assert.Equal(t, crypto.ErrNilMarshalizer, err)
+}
+
+func TestNewRemoteLowLevelSigner_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rlls, err := remote.NewRemoteLowLevelSigner(&multisig.KyberMultiSignerBLS{}, &mock.SignerBackendStub{}, &marshal.JsonMarshalizer{}, nil)
+
+	assert.Nil(t, rlls)
+	assert.Equal(t, crypto.ErrNilHasher, err)
+}
+
+func TestRemoteLowLevelSigner_SetNilHeaderProviderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rlls, _ := remote.NewRemoteLowLevelSigner(&multisig.KyberMultiSignerBLS{}, &mock.SignerBackendStub{}, &marshal.JsonMarshalizer{}, blake2b.Blake2b{})
+
+	err := rlls.SetHeaderProvider(nil)
+
+	assert.Equal(t, crypto.ErrNilHeaderProvider, err)
+}
+
+func TestRemoteLowLevelSigner_SignShareWithoutHeaderProviderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rlls, _ := remote.NewRemoteLowLevelSigner(&multisig.KyberMultiSignerBLS{}, &mock.SignerBackendStub{}, &marshal.JsonMarshalizer{}, blake2b.Blake2b{})
+
+	sig, err := rlls.SignShare(nil, []byte("header hash"))
+
+	assert.Nil(t, sig)
+	assert.Equal(t, crypto.ErrNilHeaderProvider, err)
+}
+
+func TestRemoteLowLevelSigner_SignShareWithoutHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rlls, _ := remote.NewRemoteLowLevelSigner(&multisig.KyberMultiSignerBLS{}, &mock.SignerBackendStub{}, &marshal.JsonMarshalizer{}, blake2b.Blake2b{})
+	_ = rlls.SetHeaderProvider(createHeaderProvider(nil))
+
+	sig, err := rlls.SignShare(nil, []byte("header hash"))
+
+	assert.Nil(t, sig)
+	assert.Equal(t, crypto.ErrNilHeader, err)
+}
+
+func TestRemoteLowLevelSigner_SignShareOtherMessageThanTheHeaderHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	backend := &mock.SignerBackendStub{
+		SignShareStub: func(header []byte) ([]byte, error) {
+			assert.Fail(t, "the header should not have been sent to the signer")
+			return nil, nil
+		},
+	}
+	rlls, _ := remote.NewRemoteLowLevelSigner(&multisig.KyberMultiSignerBLS{}, backend, &marshal.JsonMarshalizer{}, blake2b.Blake2b{})
+	_ = rlls.SetHeaderProvider(createHeaderProvider(&block.Header{Round: 7, Nonce: 5, ShardId: 2}))
+
+	sig, err := rlls.SignShare(nil, []byte("header hash"))
+
+	assert.Nil(t, sig)
+	assert.Equal(t, crypto.ErrHeaderHashMismatch, err)
+}
+
+func TestRemoteLowLevelSigner_SignShareShouldSendTheMarshalledHeader(t *testing.T) {
+	t.Parallel()
+
+	header := &block.Header{Round: 7, Nonce: 5, ShardId: 2}
+	headerBytes := marshalHeader(header)
+	var sentHeader []byte
+	backend := &mock.SignerBackendStub{
+		SignShareStub: func(header []byte) ([]byte, error) {
+			sentHeader = header
+			return []byte("signature"), nil
+		},
+	}
+	rlls, _ := remote.NewRemoteLowLevelSigner(&multisig.KyberMultiSignerBLS{}, backend, &marshal.JsonMarshalizer{}, blake2b.Blake2b{})
+	_ = rlls.SetHeaderProvider(createHeaderProvider(header))
+
+	sig, err := rlls.SignShare(nil, headerHash(headerBytes))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("signature"), sig)
+	assert.Equal(t, headerBytes, sentHeader)
+}
+
+func TestRemoteLowLevelSigner_ShouldVerifyLocally(t *testing.T) {
+	t.Parallel()
+
+	keyGen, privKey, _ := createKeyPair()
+	backend := createKeySigner(privKey)
+	rlls, _ := remote.NewRemoteLowLevelSigner(&multisig.KyberMultiSignerBLS{}, backend, &marshal.JsonMarshalizer{}, blake2b.Blake2b{})
+	header := &block.Header{Round: 1, Nonce: 1}
+	_ = rlls.SetHeaderProvider(createHeaderProvider(header))
+	remotePrivKey, pubKey, _ := remote.CreateKeyPair(backend, keyGen)
+	msg := headerHash(marshalHeader(header))
+
+	sig, err := rlls.SignShare(remotePrivKey, msg)
+
+	assert.Nil(t, err)
+	assert.Nil(t, rlls.VerifySigShare(pubKey, msg, sig))
+}
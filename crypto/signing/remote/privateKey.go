@@ -0,0 +1,62 @@
+package remote
+
+import (
+	"github.com/ElrondNetwork/elrond-go/crypto"
+)
+
+// remotePrivateKey stands in for the private key kept by a signer backend. It can not be serialized and it has no
+// scalar, it only knows the corresponding public key
+type remotePrivateKey struct {
+	pubKey crypto.PublicKey
+}
+
+// CreateKeyPair fetches the public key from the signer backend and returns it together with a private key which
+// stands in for the one kept by the backend
+func CreateKeyPair(backend SignerBackend, keyGen crypto.KeyGenerator) (crypto.PrivateKey, crypto.PublicKey, error) {
+	if backend == nil || backend.IsInterfaceNil() {
+		return nil, nil, crypto.ErrNilSignerBackend
+	}
+	if keyGen == nil || keyGen.IsInterfaceNil() {
+		return nil, nil, crypto.ErrNilKeyGenerator
+	}
+
+	pubKeyBytes, err := backend.PublicKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pubKey, err := keyGen.PublicKeyFromByteArray(pubKeyBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &remotePrivateKey{pubKey: pubKey}, pubKey, nil
+}
+
+// ToByteArray returns ErrPrivateKeyNotAvailable as the private key is kept by the signer backend
+func (rpk *remotePrivateKey) ToByteArray() ([]byte, error) {
+	return nil, crypto.ErrPrivateKeyNotAvailable
+}
+
+// GeneratePublic returns the public key corresponding to the private key kept by the signer backend
+func (rpk *remotePrivateKey) GeneratePublic() crypto.PublicKey {
+	return rpk.pubKey
+}
+
+// Suite returns the suite used by the key
+func (rpk *remotePrivateKey) Suite() crypto.Suite {
+	return rpk.pubKey.Suite()
+}
+
+// Scalar returns nil as the private key is kept by the signer backend
+func (rpk *remotePrivateKey) Scalar() crypto.Scalar {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rpk *remotePrivateKey) IsInterfaceNil() bool {
+	if rpk == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,154 @@
+package remote
+
+import (
+	"net"
+	"net/rpc"
+	"os"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+)
+
+// serviceName is the name under which the signer service is registered
+const serviceName = "Signer"
+
+// socketFileMode makes the unix socket of the signer accessible only to the user running it
+const socketFileMode = 0600
+
+// SignArgs holds the arguments of a signing request: the message of a single signature or the marshalled block
+// header of a signature share
+type SignArgs struct {
+	Message []byte
+	Header  []byte
+}
+
+// SignReply holds the result of a signing request
+type SignReply struct {
+	Signature []byte
+}
+
+// PublicKeyReply holds the result of a public key request
+type PublicKeyReply struct {
+	PublicKey []byte
+}
+
+// SignerService exposes a SignerBackend as rpc methods
+type SignerService struct {
+	backend SignerBackend
+}
+
+// PublicKey returns the public key of the backend
+func (ss *SignerService) PublicKey(_ *SignArgs, reply *PublicKeyReply) error {
+	pubKey, err := ss.backend.PublicKey()
+	if err != nil {
+		return err
+	}
+
+	reply.PublicKey = pubKey
+	return nil
+}
+
+// Sign creates a single signature over the message
+func (ss *SignerService) Sign(args *SignArgs, reply *SignReply) error {
+	sig, err := ss.backend.Sign(args.Message)
+	if err != nil {
+		return err
+	}
+
+	reply.Signature = sig
+	return nil
+}
+
+// SignShare creates a signature share over the hash of the block header
+func (ss *SignerService) SignShare(args *SignArgs, reply *SignReply) error {
+	sig, err := ss.backend.SignShare(args.Header)
+	if err != nil {
+		return err
+	}
+
+	reply.Signature = sig
+	return nil
+}
+
+// NewSignerListener creates the listener on which the signer server accepts the requests of the local node. The
+// requests are accepted only from the local host, so a tcp address has to be a loopback ip address and the unix
+// socket is made accessible only to the user running the signer
+func NewSignerListener(network string, address string) (net.Listener, error) {
+	switch network {
+	case "unix":
+		//a socket file left behind by a previous run would make the listen fail
+		_ = os.Remove(address)
+
+		listener, err := net.Listen(network, address)
+		if err != nil {
+			return nil, err
+		}
+
+		err = os.Chmod(address, socketFileMode)
+		if err != nil {
+			_ = listener.Close()
+			return nil, err
+		}
+
+		return listener, nil
+	case "tcp":
+		err := checkLoopbackAddress(address)
+		if err != nil {
+			return nil, err
+		}
+
+		return net.Listen(network, address)
+	}
+
+	return nil, crypto.ErrUnsupportedSignerNetwork
+}
+
+func checkLoopbackAddress(address string) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || !ip.IsLoopback() {
+		return crypto.ErrNonLoopbackSignerAddress
+	}
+
+	return nil
+}
+
+// signerServer serves the signing requests received on a listener, a unix socket or a local tcp port
+type signerServer struct {
+	server   *rpc.Server
+	listener net.Listener
+}
+
+// NewSignerServer creates a new signerServer object
+func NewSignerServer(backend SignerBackend, listener net.Listener) (*signerServer, error) {
+	if backend == nil || backend.IsInterfaceNil() {
+		return nil, crypto.ErrNilSignerBackend
+	}
+	if listener == nil {
+		return nil, crypto.ErrNilListener
+	}
+
+	server := rpc.NewServer()
+	err := server.RegisterName(serviceName, &SignerService{backend: backend})
+	if err != nil {
+		return nil, err
+	}
+
+	return &signerServer{
+		server:   server,
+		listener: listener,
+	}, nil
+}
+
+// Start starts accepting connections in a new go routine
+func (ss *signerServer) Start() {
+	go ss.server.Accept(ss.listener)
+}
+
+// Close stops accepting connections
+func (ss *signerServer) Close() error {
+	return ss.listener.Close()
+}
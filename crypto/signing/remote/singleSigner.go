@@ -0,0 +1,49 @@
+package remote
+
+import (
+	"github.com/ElrondNetwork/elrond-go/crypto"
+)
+
+// remoteSingleSigner creates the single signatures through a signer backend, which keeps the private key. The private
+// key provided on signing is not used. The verification of the signatures is done locally
+type remoteSingleSigner struct {
+	backend  SignerBackend
+	verifier crypto.SingleSigner
+}
+
+// NewRemoteSingleSigner creates a new remoteSingleSigner object
+func NewRemoteSingleSigner(backend SignerBackend, verifier crypto.SingleSigner) (*remoteSingleSigner, error) {
+	if backend == nil || backend.IsInterfaceNil() {
+		return nil, crypto.ErrNilSignerBackend
+	}
+	if verifier == nil || verifier.IsInterfaceNil() {
+		return nil, crypto.ErrNilSingleSigner
+	}
+
+	return &remoteSingleSigner{
+		backend:  backend,
+		verifier: verifier,
+	}, nil
+}
+
+// Sign forwards the message to the signer backend
+func (rss *remoteSingleSigner) Sign(_ crypto.PrivateKey, msg []byte) ([]byte, error) {
+	if msg == nil {
+		return nil, crypto.ErrNilMessage
+	}
+
+	return rss.backend.Sign(msg)
+}
+
+// Verify verifies a signature over a message
+func (rss *remoteSingleSigner) Verify(public crypto.PublicKey, msg []byte, sig []byte) error {
+	return rss.verifier.Verify(public, msg, sig)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rss *remoteSingleSigner) IsInterfaceNil() bool {
+	if rss == nil {
+		return true
+	}
+	return false
+}
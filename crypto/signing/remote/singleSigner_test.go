@@ -0,0 +1,73 @@
+package remote_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/mock"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber/singlesig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/remote"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRemoteSingleSigner_NilBackendShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rss, err := remote.NewRemoteSingleSigner(nil, &singlesig.BlsSingleSigner{})
+
+	assert.Nil(t, rss)
+	assert.Equal(t, crypto.ErrNilSignerBackend, err)
+}
+
+func TestNewRemoteSingleSigner_NilVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rss, err := remote.NewRemoteSingleSigner(&mock.SignerBackendStub{}, nil)
+
+	assert.Nil(t, rss)
+	assert.Equal(t, crypto.ErrNilSingleSigner, err)
+}
+
+func TestRemoteSingleSigner_SignNilMessageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rss, _ := remote.NewRemoteSingleSigner(&mock.SignerBackendStub{}, &singlesig.BlsSingleSigner{})
+
+	sig, err := rss.Sign(nil, nil)
+
+	assert.Nil(t, sig)
+	assert.Equal(t, crypto.ErrNilMessage, err)
+}
+
+func TestRemoteSingleSigner_SignAndVerifyShouldWork(t *testing.T) {
+	t.Parallel()
+
+	keyGen, privKey, _ := createKeyPair()
+	backend := createKeySigner(privKey)
+	rss, _ := remote.NewRemoteSingleSigner(backend, &singlesig.BlsSingleSigner{})
+	remotePrivKey, pubKey, _ := remote.CreateKeyPair(backend, keyGen)
+	msg := []byte("message")
+
+	sig, err := rss.Sign(remotePrivKey, msg)
+
+	assert.Nil(t, err)
+	assert.Nil(t, rss.Verify(pubKey, msg, sig))
+}
+
+func TestRemotePrivateKey_ShouldNotExposeThePrivateKey(t *testing.T) {
+	t.Parallel()
+
+	keyGen, privKey, pubKey := createKeyPair()
+	remotePrivKey, remotePubKey, err := remote.CreateKeyPair(createKeySigner(privKey), keyGen)
+	assert.Nil(t, err)
+
+	privKeyBytes, err := remotePrivKey.ToByteArray()
+	assert.Nil(t, privKeyBytes)
+	assert.Equal(t, crypto.ErrPrivateKeyNotAvailable, err)
+	assert.Nil(t, remotePrivKey.Scalar())
+
+	pubKeyBytes, _ := pubKey.ToByteArray()
+	remotePubKeyBytes, _ := remotePrivKey.GeneratePublic().ToByteArray()
+	assert.Equal(t, pubKeyBytes, remotePubKeyBytes)
+	assert.Equal(t, remotePubKey, remotePrivKey.GeneratePublic())
+}
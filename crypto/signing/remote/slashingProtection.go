@@ -0,0 +1,103 @@
+package remote
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// signedShare is the record kept for the last signature share created in a shard
+type signedShare struct {
+	Round   int64
+	Nonce   uint64
+	Message []byte
+}
+
+// slashingProtection remembers, in a persistent storer, the last signed message of each shard together with the round
+// and the nonce of its block header, so that two different messages are never signed for the same round and shard and
+// no message is signed for an older round, not even after a restart. As the rounds only go forward, the record of a
+// shard is overwritten by each newer round, so the storer keeps one record per shard and does not grow with the chain
+type slashingProtection struct {
+	mutProtection sync.Mutex
+	storer        storage.Persister
+}
+
+// NewSlashingProtection creates a new slashingProtection object
+func NewSlashingProtection(storer storage.Persister) (*slashingProtection, error) {
+	if storer == nil || storer.IsInterfaceNil() {
+		return nil, crypto.ErrNilProtectionStorer
+	}
+
+	return &slashingProtection{
+		storer: storer,
+	}, nil
+}
+
+// CheckAndRecord returns ErrDoubleSigning if a different message has already been signed for the given round and
+// shard and ErrOldRoundSigning if a newer round has already been signed in the shard. Otherwise, the message is
+// recorded as the last one signed in the shard. Signing the same message again is allowed
+func (sp *slashingProtection) CheckAndRecord(message []byte, round int64, nonce uint64, shardId uint32) error {
+	sp.mutProtection.Lock()
+	defer sp.mutProtection.Unlock()
+
+	key := []byte(fmt.Sprintf("%d", shardId))
+	lastSigned, err := sp.lastSignedShare(key)
+	if err != nil {
+		return err
+	}
+
+	if lastSigned != nil {
+		if round < lastSigned.Round {
+			return crypto.ErrOldRoundSigning
+		}
+		if round == lastSigned.Round {
+			if !bytes.Equal(lastSigned.Message, message) {
+				return crypto.ErrDoubleSigning
+			}
+
+			return nil
+		}
+	}
+
+	buff, err := json.Marshal(&signedShare{
+		Round:   round,
+		Nonce:   nonce,
+		Message: message,
+	})
+	if err != nil {
+		return err
+	}
+
+	return sp.storer.Put(key, buff)
+}
+
+func (sp *slashingProtection) lastSignedShare(key []byte) (*signedShare, error) {
+	if sp.storer.Has(key) != nil {
+		return nil, nil
+	}
+
+	buff, err := sp.storer.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	lastSigned := &signedShare{}
+	err = json.Unmarshal(buff, lastSigned)
+	if err != nil {
+		return nil, err
+	}
+
+	return lastSigned, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sp *slashingProtection) IsInterfaceNil() bool {
+	if sp == nil {
+		return true
+	}
+	return false
+}
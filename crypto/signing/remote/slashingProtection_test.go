@@ -0,0 +1,124 @@
+package remote_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/mock"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/remote"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSlashingProtection_NilStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sp, err := remote.NewSlashingProtection(nil)
+
+	assert.Nil(t, sp)
+	assert.Equal(t, crypto.ErrNilProtectionStorer, err)
+}
+
+func TestSlashingProtection_CheckAndRecordSameMessageShouldWork(t *testing.T) {
+	t.Parallel()
+
+	storer, _ := memorydb.New()
+	sp, _ := remote.NewSlashingProtection(storer)
+
+	err := sp.CheckAndRecord([]byte("header hash"), 10, 9, 1)
+	assert.Nil(t, err)
+
+	err = sp.CheckAndRecord([]byte("header hash"), 10, 9, 1)
+	assert.Nil(t, err)
+}
+
+func TestSlashingProtection_CheckAndRecordDifferentMessageInSameRoundAndShardShouldErr(t *testing.T) {
+	t.Parallel()
+
+	storer, _ := memorydb.New()
+	sp, _ := remote.NewSlashingProtection(storer)
+
+	_ = sp.CheckAndRecord([]byte("header hash"), 10, 9, 1)
+	err := sp.CheckAndRecord([]byte("other header hash"), 10, 9, 1)
+
+	assert.Equal(t, crypto.ErrDoubleSigning, err)
+}
+
+func TestSlashingProtection_CheckAndRecordDifferentMessageInOtherRoundOrShardShouldWork(t *testing.T) {
+	t.Parallel()
+
+	storer, _ := memorydb.New()
+	sp, _ := remote.NewSlashingProtection(storer)
+
+	_ = sp.CheckAndRecord([]byte("header hash"), 10, 9, 1)
+
+	err := sp.CheckAndRecord([]byte("other header hash"), 11, 10, 1)
+	assert.Nil(t, err)
+
+	err = sp.CheckAndRecord([]byte("other header hash"), 10, 9, 2)
+	assert.Nil(t, err)
+}
+
+func TestSlashingProtection_CheckAndRecordShouldUseTheRecordsFromTheStorer(t *testing.T) {
+	t.Parallel()
+
+	storer, _ := memorydb.New()
+	sp, _ := remote.NewSlashingProtection(storer)
+	_ = sp.CheckAndRecord([]byte("header hash"), 10, 9, 1)
+
+	//a new instance on the same storer mimics a restart of the signer
+	sp, _ = remote.NewSlashingProtection(storer)
+	err := sp.CheckAndRecord([]byte("other header hash"), 10, 9, 1)
+
+	assert.Equal(t, crypto.ErrDoubleSigning, err)
+}
+
+func TestSlashingProtection_CheckAndRecordOlderRoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	storer, _ := memorydb.New()
+	sp, _ := remote.NewSlashingProtection(storer)
+
+	_ = sp.CheckAndRecord([]byte("header hash"), 10, 9, 1)
+	err := sp.CheckAndRecord([]byte("header hash"), 9, 9, 1)
+
+	assert.Equal(t, crypto.ErrOldRoundSigning, err)
+}
+
+func TestSlashingProtection_CheckAndRecordSameNonceInNewerRoundShouldWork(t *testing.T) {
+	t.Parallel()
+
+	storer, _ := memorydb.New()
+	sp, _ := remote.NewSlashingProtection(storer)
+
+	//the block of round 10 was not committed, so the same nonce is proposed again in round 11
+	_ = sp.CheckAndRecord([]byte("header hash"), 10, 9, 1)
+	err := sp.CheckAndRecord([]byte("other header hash"), 11, 9, 1)
+
+	assert.Nil(t, err)
+}
+
+func TestSlashingProtection_CheckAndRecordShouldKeepOneRecordPerShard(t *testing.T) {
+	t.Parallel()
+
+	storer, _ := memorydb.New()
+	keys := make(map[string]struct{})
+	storerStub := &mock.PersisterStub{
+		PutStub: func(key, val []byte) error {
+			keys[string(key)] = struct{}{}
+			return storer.Put(key, val)
+		},
+		GetStub: storer.Get,
+		HasStub: storer.Has,
+	}
+	sp, _ := remote.NewSlashingProtection(storerStub)
+
+	for round := int64(1); round <= 100; round++ {
+		err := sp.CheckAndRecord([]byte(fmt.Sprintf("header hash %d", round)), round, uint64(round), 1)
+		assert.Nil(t, err)
+	}
+	_ = sp.CheckAndRecord([]byte("header hash"), 100, 100, 2)
+
+	assert.Equal(t, 2, len(keys))
+}
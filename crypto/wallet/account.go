@@ -0,0 +1,76 @@
+package wallet
+
+import (
+	"crypto/sha512"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+// Account holds a key pair derived from a seed, along a derivation path, together with the corresponding address
+type Account struct {
+	Path       string
+	PrivateKey crypto.PrivateKey
+	PublicKey  crypto.PublicKey
+	Address    state.AddressContainer
+}
+
+// PrivateKeyFromEd25519Seed creates the private key corresponding to a 32 bytes ed25519 seed, as defined by RFC 8032:
+// the scalar is the clamped first half of the SHA-512 digest of the seed. The key generator should be built on the
+// ed25519 suite, so that the key can be used by the schnorr single signer
+func PrivateKeyFromEd25519Seed(keyGen crypto.KeyGenerator, seed []byte) (crypto.PrivateKey, error) {
+	if keyGen == nil || keyGen.IsInterfaceNil() {
+		return nil, crypto.ErrNilKeyGenerator
+	}
+	if len(seed) != 32 {
+		return nil, crypto.ErrInvalidSeedLength
+	}
+
+	digest := sha512.Sum512(seed)
+	digest[0] &= 0xf8
+	digest[31] &= 0x7f
+	digest[31] |= 0x40
+
+	return keyGen.PrivateKeyFromByteArray(digest[:32])
+}
+
+// DeriveAccount derives the key pair at the given path from the seed and builds its address using the address converter
+func DeriveAccount(
+	keyGen crypto.KeyGenerator,
+	addressConverter state.AddressConverter,
+	seed []byte,
+	path string,
+) (*Account, error) {
+
+	if addressConverter == nil || addressConverter.IsInterfaceNil() {
+		return nil, state.ErrNilAddressConverter
+	}
+
+	key, err := DeriveKey(seed, path)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, err := PrivateKeyFromEd25519Seed(keyGen, key.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey := privateKey.GeneratePublic()
+	publicKeyBytes, err := publicKey.ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := addressConverter.CreateAddressFromPublicKeyBytes(publicKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Account{
+		Path:       path,
+		PrivateKey: privateKey,
+		PublicKey:  publicKey,
+		Address:    address,
+	}, nil
+}
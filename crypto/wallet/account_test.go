@@ -0,0 +1,93 @@
+package wallet_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber/singlesig"
+	"github.com/ElrondNetwork/elrond-go/crypto/wallet"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/addressConverters"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ed25519"
+)
+
+func createKeyGenAndConverter() (crypto.KeyGenerator, state.AddressConverter) {
+	keyGen := signing.NewKeyGenerator(kyber.NewBlakeSHA256Ed25519())
+	addressConverter, _ := addressConverters.NewPlainAddressConverter(32, "")
+
+	return keyGen, addressConverter
+}
+
+func TestPrivateKeyFromEd25519Seed_InvalidSeedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	keyGen, _ := createKeyGenAndConverter()
+	privateKey, err := wallet.PrivateKeyFromEd25519Seed(keyGen, make([]byte, 31))
+
+	assert.Nil(t, privateKey)
+	assert.Equal(t, crypto.ErrInvalidSeedLength, err)
+}
+
+func TestPrivateKeyFromEd25519Seed_ShouldMatchTheEd25519PublicKey(t *testing.T) {
+	t.Parallel()
+
+	keyGen, _ := createKeyGenAndConverter()
+	seed := []byte("0123456789abcdef0123456789abcdef")
+
+	privateKey, err := wallet.PrivateKeyFromEd25519Seed(keyGen, seed)
+	assert.Nil(t, err)
+
+	publicKeyBytes, _ := privateKey.GeneratePublic().ToByteArray()
+	expectedPublicKey := ed25519.NewKeyFromSeed(seed).Public().(ed25519.PublicKey)
+	assert.Equal(t, []byte(expectedPublicKey), publicKeyBytes)
+}
+
+func TestDeriveAccount_NilAddressConverterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	keyGen, _ := createKeyGenAndConverter()
+	seed, _ := wallet.SeedFromMnemonic(testMnemonic, "")
+
+	account, err := wallet.DeriveAccount(keyGen, nil, seed, wallet.DefaultDerivationPath)
+
+	assert.Nil(t, account)
+	assert.Equal(t, state.ErrNilAddressConverter, err)
+}
+
+func TestDeriveAccount_KeysShouldSignAndVerifyWithSchnorr(t *testing.T) {
+	t.Parallel()
+
+	keyGen, addressConverter := createKeyGenAndConverter()
+	seed, _ := wallet.SeedFromMnemonic(testMnemonic, "")
+
+	account, err := wallet.DeriveAccount(keyGen, addressConverter, seed, wallet.DefaultDerivationPath)
+	assert.Nil(t, err)
+
+	publicKeyBytes, _ := account.PublicKey.ToByteArray()
+	assert.Equal(t, publicKeyBytes, account.Address.Bytes())
+
+	//the private key survives a serialization round trip
+	privateKeyBytes, _ := account.PrivateKey.ToByteArray()
+	privateKey, _ := keyGen.PrivateKeyFromByteArray(privateKeyBytes)
+
+	signer := &singlesig.SchnorrSigner{}
+	msg := []byte("message")
+	sig, err := signer.Sign(privateKey, msg)
+	assert.Nil(t, err)
+	assert.Nil(t, signer.Verify(account.PublicKey, msg, sig))
+}
+
+func TestDeriveAccount_DifferentPathsShouldGiveDifferentAccounts(t *testing.T) {
+	t.Parallel()
+
+	keyGen, addressConverter := createKeyGenAndConverter()
+	seed, _ := wallet.SeedFromMnemonic(testMnemonic, "")
+
+	account0, _ := wallet.DeriveAccount(keyGen, addressConverter, seed, "m/44'/508'/0'/0'/0'")
+	account1, _ := wallet.DeriveAccount(keyGen, addressConverter, seed, "m/44'/508'/0'/0'/1'")
+
+	assert.NotEqual(t, account0.Address.Bytes(), account1.Address.Bytes())
+}
@@ -0,0 +1,115 @@
+package wallet
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"strconv"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+)
+
+const (
+	// HardenedKeyStart is the index of the first hardened child key
+	HardenedKeyStart = uint32(0x80000000)
+
+	// DefaultDerivationPath is the BIP44 derivation path of the first account, using the Elrond coin type
+	DefaultDerivationPath = "m/44'/508'/0'/0'/0'"
+
+	// ed25519Curve is the HMAC key used for the master key generation, as defined by SLIP-0010
+	ed25519Curve = "ed25519 seed"
+
+	minSeedLength = 16
+	maxSeedLength = 64
+)
+
+// ExtendedKey is a SLIP-0010 ed25519 key: the 32 bytes private key seed together with its chain code
+type ExtendedKey struct {
+	Key       []byte
+	ChainCode []byte
+}
+
+// NewMasterKey creates the SLIP-0010 ed25519 master key from the seed
+func NewMasterKey(seed []byte) (*ExtendedKey, error) {
+	if len(seed) < minSeedLength || len(seed) > maxSeedLength {
+		return nil, crypto.ErrInvalidSeedLength
+	}
+
+	return newExtendedKey([]byte(ed25519Curve), seed), nil
+}
+
+// Child derives the hardened child key with the given index. Only hardened derivation is defined for ed25519 keys
+func (ek *ExtendedKey) Child(index uint32) (*ExtendedKey, error) {
+	if index < HardenedKeyStart {
+		return nil, crypto.ErrNonHardenedDerivation
+	}
+
+	data := make([]byte, 0, 1+len(ek.Key)+4)
+	data = append(data, 0)
+	data = append(data, ek.Key...)
+	indexBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(indexBytes, index)
+	data = append(data, indexBytes...)
+
+	return newExtendedKey(ek.ChainCode, data), nil
+}
+
+func newExtendedKey(hmacKey []byte, data []byte) *ExtendedKey {
+	mac := hmac.New(sha512.New, hmacKey)
+	_, _ = mac.Write(data)
+	sum := mac.Sum(nil)
+
+	return &ExtendedKey{
+		Key:       sum[:32],
+		ChainCode: sum[32:],
+	}
+}
+
+// ParseDerivationPath parses a path like m/44'/508'/0'/0'/0' into the child indexes. As ed25519 keys support only
+// hardened derivation, all the path elements must be hardened, marked by ' or H
+func ParseDerivationPath(path string) ([]uint32, error) {
+	elements := strings.Split(strings.TrimSpace(path), "/")
+	if len(elements) == 0 || elements[0] != "m" {
+		return nil, crypto.ErrInvalidDerivationPath
+	}
+
+	indexes := make([]uint32, 0, len(elements)-1)
+	for _, element := range elements[1:] {
+		isHardened := strings.HasSuffix(element, "'") || strings.HasSuffix(element, "H")
+		if !isHardened {
+			return nil, crypto.ErrNonHardenedDerivation
+		}
+
+		index, err := strconv.ParseUint(element[:len(element)-1], 10, 32)
+		if err != nil || uint32(index) >= HardenedKeyStart {
+			return nil, crypto.ErrInvalidDerivationPath
+		}
+
+		indexes = append(indexes, uint32(index)+HardenedKeyStart)
+	}
+
+	return indexes, nil
+}
+
+// DeriveKey derives the extended key at the given path from the seed
+func DeriveKey(seed []byte, path string) (*ExtendedKey, error) {
+	indexes, err := ParseDerivationPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := NewMasterKey(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, index := range indexes {
+		key, err = key.Child(index)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return key, nil
+}
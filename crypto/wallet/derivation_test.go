@@ -0,0 +1,121 @@
+package wallet_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/wallet"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ed25519"
+)
+
+type derivationTestVector struct {
+	path       string
+	chainCode  string
+	privateKey string
+	publicKey  string
+}
+
+// SLIP-0010 test vector 1 for ed25519
+var slip10Seed = "000102030405060708090a0b0c0d0e0f"
+var slip10TestVectors = []derivationTestVector{
+	{
+		path:       "m",
+		chainCode:  "90046a93de5380a72b5e45010748567d5ea02bbf6522f979e05c0d8d8ca9fffb",
+		privateKey: "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7",
+		publicKey:  "a4b2856bfec510abab89753fac1ac0e1112364e7d250545963f135f2a33188ed",
+	},
+	{
+		path:       "m/0H",
+		chainCode:  "8b59aa11380b624e81507a27fedda59fea6d0b779a778918a2fd3590e16e9c69",
+		privateKey: "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3",
+		publicKey:  "8c8a13df77a28f3445213a0f432fde644acaa215fc72dcdf300d5efaa85d350c",
+	},
+	{
+		path:       "m/0H/1H",
+		chainCode:  "a320425f77d1b5c2505a6b1b27382b37368ee640e3557c315416801243552f14",
+		privateKey: "b1d0bad404bf35da785a64ca1ac54b2617211d2777696fbffaf208f746ae84f2",
+		publicKey:  "1932a5270f335bed617d5b935c80aedb1a35bd9fc1e31acafd5372c30f5c1187",
+	},
+	{
+		path:       "m/0H/1H/2H",
+		chainCode:  "2e69929e00b5ab250f49c3fb1c12f252de4fed2c1db88387094a0f8c4c9ccd6c",
+		privateKey: "92a5b23c0b8a99e37d07df3fb9966917f5d06e02ddbd909c7e184371463e9fc9",
+		publicKey:  "ae98736566d30ed0e9d2f4486a64bc95740d89c7db33f52121f8ea8f76ff0fc1",
+	},
+	{
+		path:       "m/0H/1H/2H/2H/1000000000H",
+		chainCode:  "68789923a0cac2cd5a29172a475fe9e0fb14cd6adb5ad98a3fa70333e7afa230",
+		privateKey: "8f94d394a8e8fd6b1bc2f3f49f5c47e385281d5c17e65324b0f62483e37e8793",
+		publicKey:  "3c24da049451555d51a7014a37337aa4e12d41e485abccfa46b47dfb2af54b7a",
+	},
+}
+
+func TestDeriveKey_ShouldMatchSlip10TestVectors(t *testing.T) {
+	t.Parallel()
+
+	seed, _ := hex.DecodeString(slip10Seed)
+	for _, vector := range slip10TestVectors {
+		key, err := wallet.DeriveKey(seed, vector.path)
+		assert.Nil(t, err)
+
+		assert.Equal(t, vector.chainCode, hex.EncodeToString(key.ChainCode), vector.path)
+		assert.Equal(t, vector.privateKey, hex.EncodeToString(key.Key), vector.path)
+
+		publicKey := ed25519.NewKeyFromSeed(key.Key).Public().(ed25519.PublicKey)
+		assert.Equal(t, vector.publicKey, hex.EncodeToString(publicKey), vector.path)
+	}
+}
+
+func TestNewMasterKey_InvalidSeedLengthShouldErr(t *testing.T) {
+	t.Parallel()
+
+	key, err := wallet.NewMasterKey(make([]byte, 8))
+
+	assert.Nil(t, key)
+	assert.Equal(t, crypto.ErrInvalidSeedLength, err)
+}
+
+func TestExtendedKey_ChildNonHardenedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	seed, _ := hex.DecodeString(slip10Seed)
+	master, _ := wallet.NewMasterKey(seed)
+
+	child, err := master.Child(1)
+
+	assert.Nil(t, child)
+	assert.Equal(t, crypto.ErrNonHardenedDerivation, err)
+}
+
+func TestParseDerivationPath_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	indexes, err := wallet.ParseDerivationPath(wallet.DefaultDerivationPath)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []uint32{
+		44 + wallet.HardenedKeyStart,
+		508 + wallet.HardenedKeyStart,
+		wallet.HardenedKeyStart,
+		wallet.HardenedKeyStart,
+		wallet.HardenedKeyStart,
+	}, indexes)
+}
+
+func TestParseDerivationPath_InvalidPathsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, err := wallet.ParseDerivationPath("44'/508'")
+	assert.Equal(t, crypto.ErrInvalidDerivationPath, err)
+
+	_, err = wallet.ParseDerivationPath("m/44'/508'/0")
+	assert.Equal(t, crypto.ErrNonHardenedDerivation, err)
+
+	_, err = wallet.ParseDerivationPath("m/44'/abc'")
+	assert.Equal(t, crypto.ErrInvalidDerivationPath, err)
+
+	_, err = wallet.ParseDerivationPath("m/2147483648'")
+	assert.Equal(t, crypto.ErrInvalidDerivationPath, err)
+}
@@ -0,0 +1,49 @@
+package wallet
+
+import (
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// NewMnemonic generates a new BIP39 mnemonic phrase, from the english word list, having the given number of words.
+// The number of words can be 12, 15, 18, 21 or 24
+func NewMnemonic(numWords int) (string, error) {
+	if numWords < 12 || numWords > 24 || numWords%3 != 0 {
+		return "", crypto.ErrInvalidParam
+	}
+
+	//each 3 words encode 32 bits of entropy and 1 bit of checksum
+	entropy, err := bip39.NewEntropy(numWords / 3 * 32)
+	if err != nil {
+		return "", err
+	}
+
+	return bip39.NewMnemonic(entropy)
+}
+
+// ValidateMnemonic returns ErrInvalidMnemonic if the phrase has an invalid number of words, contains words which are not
+// in the word list or has an invalid checksum
+func ValidateMnemonic(mnemonic string) error {
+	_, err := bip39.EntropyFromMnemonic(normalizeMnemonic(mnemonic))
+	if err != nil {
+		return crypto.ErrInvalidMnemonic
+	}
+
+	return nil
+}
+
+// SeedFromMnemonic validates the mnemonic phrase and computes the BIP39 seed from it and from the optional passphrase
+func SeedFromMnemonic(mnemonic string, passphrase string) ([]byte, error) {
+	err := ValidateMnemonic(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+
+	return bip39.NewSeed(normalizeMnemonic(mnemonic), passphrase), nil
+}
+
+func normalizeMnemonic(mnemonic string) string {
+	return strings.Join(strings.Fields(strings.ToLower(mnemonic)), " ")
+}
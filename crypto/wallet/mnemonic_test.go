@@ -0,0 +1,72 @@
+package wallet_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/wallet"
+	"github.com/stretchr/testify/assert"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestNewMnemonic_InvalidNumWordsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	for _, numWords := range []int{0, 11, 13, 27} {
+		mnemonic, err := wallet.NewMnemonic(numWords)
+
+		assert.Equal(t, "", mnemonic)
+		assert.Equal(t, crypto.ErrInvalidParam, err)
+	}
+}
+
+func TestNewMnemonic_ShouldGenerateValidMnemonics(t *testing.T) {
+	t.Parallel()
+
+	for _, numWords := range []int{12, 15, 18, 21, 24} {
+		mnemonic, err := wallet.NewMnemonic(numWords)
+
+		assert.Nil(t, err)
+		assert.Equal(t, numWords, len(strings.Fields(mnemonic)))
+		assert.Nil(t, wallet.ValidateMnemonic(mnemonic))
+	}
+}
+
+func TestValidateMnemonic_InvalidMnemonicsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	//wrong checksum
+	err := wallet.ValidateMnemonic(strings.Repeat("abandon ", 12))
+	assert.Equal(t, crypto.ErrInvalidMnemonic, err)
+
+	//word not in the list
+	err = wallet.ValidateMnemonic(strings.Replace(testMnemonic, "about", "elrond", 1))
+	assert.Equal(t, crypto.ErrInvalidMnemonic, err)
+
+	//wrong number of words
+	err = wallet.ValidateMnemonic("abandon abandon about")
+	assert.Equal(t, crypto.ErrInvalidMnemonic, err)
+}
+
+func TestSeedFromMnemonic_ShouldMatchBip39TestVector(t *testing.T) {
+	t.Parallel()
+
+	seed, err := wallet.SeedFromMnemonic(testMnemonic, "TREZOR")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+		hex.EncodeToString(seed))
+}
+
+func TestSeedFromMnemonic_ShouldNormalizeTheMnemonic(t *testing.T) {
+	t.Parallel()
+
+	seed, _ := wallet.SeedFromMnemonic(testMnemonic, "")
+	seedNotNormalized, err := wallet.SeedFromMnemonic("  "+strings.ToUpper(strings.Replace(testMnemonic, " ", "   ", -1)), "")
+
+	assert.Nil(t, err)
+	assert.Equal(t, seed, seedNotNormalized)
+}
@@ -93,6 +93,7 @@ type Trie interface {
 	Root() ([]byte, error)
 	Prove(key []byte) ([][]byte, error)
 	VerifyProof(proofs [][]byte, key []byte) (bool, error)
+	GetLeaves(startKey []byte, limit int) ([]TrieLeaf, error)
 	Commit() error
 	Recreate(root []byte) (Trie, error)
 	String() string
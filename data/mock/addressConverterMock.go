@@ -37,6 +37,10 @@ func (acm *AddressConverterMock) ConvertToHex(addressContainer state.AddressCont
 	return hex.EncodeToString(addressContainer.Bytes()), nil
 }
 
+func (acm *AddressConverterMock) ConvertToString(addressContainer state.AddressContainer) (string, error) {
+	return acm.ConvertToHex(addressContainer)
+}
+
 func (acm *AddressConverterMock) CreateAddressFromHex(hexAddress string) (state.AddressContainer, error) {
 	if acm.Fail {
 		return nil, errFailure
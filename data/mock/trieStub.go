@@ -15,6 +15,7 @@ type TrieStub struct {
 	RootCalled        func() ([]byte, error)
 	ProveCalled       func(key []byte) ([][]byte, error)
 	VerifyProofCalled func(proofs [][]byte, key []byte) (bool, error)
+	GetLeavesCalled   func(startKey []byte, limit int) ([]data.TrieLeaf, error)
 	CommitCalled      func() error
 	RecreateCalled    func(root []byte) (data.Trie, error)
 	DeepCloneCalled   func() (data.Trie, error)
@@ -68,6 +69,14 @@ func (ts *TrieStub) VerifyProof(proofs [][]byte, key []byte) (bool, error) {
 	return false, errNotImplemented
 }
 
+func (ts *TrieStub) GetLeaves(startKey []byte, limit int) ([]data.TrieLeaf, error) {
+	if ts.GetLeavesCalled != nil {
+		return ts.GetLeavesCalled(startKey, limit)
+	}
+
+	return nil, errNotImplemented
+}
+
 func (ts *TrieStub) Commit() error {
 	if ts != nil {
 		return ts.CommitCalled()
@@ -476,6 +476,24 @@ func (adb *AccountsDB) RecreateTrie(rootHash []byte) error {
 	return nil
 }
 
+// GetProof returns the Merkle proof of an account in the main trie committed under the provided root hash. The
+// current main trie is left unchanged
+func (adb *AccountsDB) GetProof(addressContainer AddressContainer, rootHash []byte) ([][]byte, error) {
+	if addressContainer == nil || addressContainer.IsInterfaceNil() {
+		return nil, ErrNilAddressContainer
+	}
+
+	committedTrie, err := adb.mainTrie.Recreate(rootHash)
+	if err != nil {
+		return nil, err
+	}
+	if committedTrie == nil || committedTrie.IsInterfaceNil() {
+		return nil, ErrNilTrie
+	}
+
+	return committedTrie.Prove(addressContainer.Bytes())
+}
+
 // Journalize adds a new object to entries list. Concurrent safe.
 func (adb *AccountsDB) Journalize(entry JournalEntry) {
 	if entry == nil || entry.IsInterfaceNil() {
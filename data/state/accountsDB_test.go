@@ -751,3 +751,62 @@ func TestAccountsDB_RecreateTrieOkValsShouldWork(t *testing.T) {
 	assert.True(t, wasCalled)
 
 }
+
+//------- GetProof
+
+func TestAccountsDB_GetProofNilAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	adb := generateAccountDBFromTrie(&mock.TrieStub{})
+	proof, err := adb.GetProof(nil, []byte("root hash"))
+
+	assert.Nil(t, proof)
+	assert.Equal(t, state.ErrNilAddressContainer, err)
+}
+
+func TestAccountsDB_GetProofMalfunctionTrieShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("failure")
+	trieStub := mock.TrieStub{}
+	trieStub.RecreateCalled = func(root []byte) (tree data.Trie, e error) {
+		return nil, errExpected
+	}
+
+	adr, _, adb := generateAddressAccountAccountsDB(&trieStub)
+	proof, err := adb.GetProof(adr, []byte("root hash"))
+
+	assert.Nil(t, proof)
+	assert.Equal(t, errExpected, err)
+}
+
+func TestAccountsDB_GetProofShouldProveOnTheRecreatedTrie(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	expectedProof := [][]byte{[]byte("node")}
+	adr, _, _ := generateAddressAccountAccountsDB(nil)
+
+	committedTrie := &mock.TrieStub{
+		ProveCalled: func(key []byte) ([][]byte, error) {
+			assert.Equal(t, adr.Bytes(), key)
+			return expectedProof, nil
+		},
+	}
+	mainTrie := &mock.TrieStub{
+		RecreateCalled: func(root []byte) (data.Trie, error) {
+			assert.Equal(t, rootHash, root)
+			return committedTrie, nil
+		},
+		ProveCalled: func(key []byte) ([][]byte, error) {
+			assert.Fail(t, "the current main trie should not be used")
+			return nil, nil
+		},
+	}
+	adb := generateAccountDBFromTrie(mainTrie)
+
+	proof, err := adb.GetProof(adr, rootHash)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedProof, proof)
+}
@@ -0,0 +1,195 @@
+package addressConverters
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/btcsuite/btcutil/bech32"
+)
+
+const bech32Separator = "1"
+
+// Bech32AddressConverter is used to convert the address from/to different structures. The human readable
+// representation of the address is the bech32 one, hex strings being still accepted as input
+type Bech32AddressConverter struct {
+	addressLen int
+	hrp        string
+	hexPrefix  string
+}
+
+// NewBech32AddressConverter creates a new instance of Bech32AddressConverter
+func NewBech32AddressConverter(addressLen int, hrp string, hexPrefix string) (*Bech32AddressConverter, error) {
+	if addressLen < 0 {
+		return nil, state.ErrNegativeValue
+	}
+	if len(hrp) == 0 {
+		return nil, state.ErrEmptyBech32Hrp
+	}
+
+	return &Bech32AddressConverter{
+		addressLen: addressLen,
+		hrp:        strings.ToLower(hrp),
+		hexPrefix:  hexPrefix,
+	}, nil
+}
+
+// CreateAddressFromPublicKeyBytes returns the bytes received as parameters, trimming if necessary
+// and outputs a new AddressContainer obj
+func (bac *Bech32AddressConverter) CreateAddressFromPublicKeyBytes(pubKey []byte) (state.AddressContainer, error) {
+	if pubKey == nil {
+		return nil, state.ErrNilPubKeysBytes
+	}
+
+	if len(pubKey) < bac.addressLen {
+		return nil, state.NewErrorWrongSize(bac.addressLen, len(pubKey))
+	}
+
+	newPubKey := make([]byte, len(pubKey))
+	copy(newPubKey, pubKey)
+
+	//check size, trimming as necessary
+	if len(newPubKey) > bac.addressLen {
+		newPubKey = newPubKey[len(newPubKey)-bac.addressLen:]
+	}
+
+	return state.NewAddress(newPubKey), nil
+}
+
+// ConvertToHex returns the hex string representation of the address.
+func (bac *Bech32AddressConverter) ConvertToHex(addressContainer state.AddressContainer) (string, error) {
+	if addressContainer == nil || addressContainer.IsInterfaceNil() {
+		return "", state.ErrNilAddressContainer
+	}
+
+	return bac.hexPrefix + hex.EncodeToString(addressContainer.Bytes()), nil
+}
+
+// ConvertToString returns the human readable representation of the address, which is the bech32 one
+func (bac *Bech32AddressConverter) ConvertToString(addressContainer state.AddressContainer) (string, error) {
+	return bac.ConvertToBech32(addressContainer)
+}
+
+// ConvertToBech32 returns the address in bech32 format, using the configured human readable part
+func (bac *Bech32AddressConverter) ConvertToBech32(addressContainer state.AddressContainer) (string, error) {
+	if addressContainer == nil || addressContainer.IsInterfaceNil() {
+		return "", state.ErrNilAddressContainer
+	}
+
+	conv, err := bech32.ConvertBits(addressContainer.Bytes(), 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+
+	return bech32.Encode(bac.hrp, conv)
+}
+
+// CreateAddressFromHex creates the address from a bech32 string. For backwards compatibility, legacy
+// hex strings (with or without the configured prefix) are accepted as well
+func (bac *Bech32AddressConverter) CreateAddressFromHex(address string) (state.AddressContainer, error) {
+	if len(address) == 0 {
+		return nil, state.ErrEmptyAddress
+	}
+
+	if bac.isBech32Candidate(address) {
+		addr, err := bac.CreateAddressFromBech32(address)
+		if err == nil || !bac.isHexCandidate(address) {
+			return addr, err
+		}
+	}
+
+	return bac.createAddressFromLegacyHex(address)
+}
+
+// CreateAddressFromBech32 creates the address from bech32 string, validating the checksum,
+// the human readable part and the resulting address length
+func (bac *Bech32AddressConverter) CreateAddressFromBech32(bech32Address string) (state.AddressContainer, error) {
+	if len(bech32Address) == 0 {
+		return nil, state.ErrEmptyAddress
+	}
+
+	hrp, dec, err := bech32.Decode(bech32Address)
+	if err != nil {
+		return nil, state.ErrBech32WrongAddr
+	}
+	if hrp != bac.hrp {
+		return nil, state.ErrBech32WrongHrp
+	}
+
+	conv, err := bech32.ConvertBits(dec, 5, 8, false)
+	if err != nil {
+		return nil, state.ErrBech32ConvertError
+	}
+	if len(conv) != bac.addressLen {
+		return nil, state.NewErrorWrongSize(bac.addressLen, len(conv))
+	}
+
+	return state.NewAddress(conv), nil
+}
+
+func (bac *Bech32AddressConverter) isBech32Candidate(address string) bool {
+	return strings.HasPrefix(strings.ToLower(address), bac.hrp+bech32Separator)
+}
+
+func (bac *Bech32AddressConverter) isHexCandidate(address string) bool {
+	address = strings.ToLower(address)
+	if strings.HasPrefix(address, strings.ToLower(bac.hexPrefix)) {
+		address = address[len(bac.hexPrefix):]
+	}
+
+	return len(address) == bac.addressLen*2
+}
+
+func (bac *Bech32AddressConverter) createAddressFromLegacyHex(hexAddress string) (state.AddressContainer, error) {
+	//to lower
+	hexAddress = strings.ToLower(hexAddress)
+
+	//check if it has prefix, trimming as necessary
+	if strings.HasPrefix(hexAddress, strings.ToLower(bac.hexPrefix)) {
+		hexAddress = hexAddress[len(bac.hexPrefix):]
+	}
+
+	//check lengths
+	if len(hexAddress) != bac.addressLen*2 {
+		return nil, state.NewErrorWrongSize(bac.addressLen*2, len(hexAddress))
+	}
+
+	//decode hex
+	buff := make([]byte, bac.addressLen)
+	_, err := hex.Decode(buff, []byte(hexAddress))
+	if err != nil {
+		return nil, err
+	}
+
+	return state.NewAddress(buff), nil
+}
+
+// PrepareAddressBytes checks and returns the slice compatible to the address format
+func (bac *Bech32AddressConverter) PrepareAddressBytes(addressBytes []byte) ([]byte, error) {
+	if addressBytes == nil {
+		return nil, state.ErrNilAddressContainer
+	}
+
+	if len(addressBytes) == 0 {
+		return nil, state.ErrEmptyAddress
+	}
+
+	if len(addressBytes) != bac.addressLen {
+		return nil, state.NewErrorWrongSize(bac.addressLen, len(addressBytes))
+	}
+
+	return addressBytes, nil
+}
+
+// AddressLen returns the address length
+func (bac *Bech32AddressConverter) AddressLen() int {
+	return bac.addressLen
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bac *Bech32AddressConverter) IsInterfaceNil() bool {
+	if bac == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,250 @@
+package addressConverters_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/addressConverters"
+	"github.com/stretchr/testify/assert"
+)
+
+const testBech32Address = "erd1ajkdna0mnj56qwl4avp0qpmgc664x4qrdjq3rs6ddpsyfj9gna3s4sjy2n"
+const testHexAddress = "ecacd9f5fb9ca9a03bf5eb02f00768c6b55354036c8111c34d686044c8a89f63"
+
+//------- NewBech32AddressConverter
+
+func TestNewBech32AddressConverter_NegativeSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ac, err := addressConverters.NewBech32AddressConverter(-1, "erd", "")
+
+	assert.Nil(t, ac)
+	assert.Equal(t, state.ErrNegativeValue, err)
+}
+
+func TestNewBech32AddressConverter_EmptyHrpShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ac, err := addressConverters.NewBech32AddressConverter(32, "", "")
+
+	assert.Nil(t, ac)
+	assert.Equal(t, state.ErrEmptyBech32Hrp, err)
+}
+
+func TestNewBech32AddressConverter_OkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ac, err := addressConverters.NewBech32AddressConverter(32, "erd", "0x")
+
+	assert.Nil(t, err)
+	assert.False(t, ac.IsInterfaceNil())
+	assert.Equal(t, 32, ac.AddressLen())
+}
+
+//------- ConvertToString
+
+func TestBech32AddressConverter_ConvertToStringNilAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ac, _ := addressConverters.NewBech32AddressConverter(32, "erd", "")
+
+	str, err := ac.ConvertToString(nil)
+
+	assert.Equal(t, "", str)
+	assert.Equal(t, state.ErrNilAddressContainer, err)
+}
+
+func TestBech32AddressConverter_ConvertToStringShouldReturnBech32(t *testing.T) {
+	t.Parallel()
+
+	ac, _ := addressConverters.NewBech32AddressConverter(32, "erd", "")
+	buff, _ := hex.DecodeString(testHexAddress)
+
+	str, err := ac.ConvertToString(state.NewAddress(buff))
+
+	assert.Nil(t, err)
+	assert.Equal(t, testBech32Address, str)
+}
+
+func TestBech32AddressConverter_ConvertToHexShouldReturnHexWithPrefix(t *testing.T) {
+	t.Parallel()
+
+	ac, _ := addressConverters.NewBech32AddressConverter(32, "erd", "0x")
+	buff, _ := hex.DecodeString(testHexAddress)
+
+	str, err := ac.ConvertToHex(state.NewAddress(buff))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "0x"+testHexAddress, str)
+}
+
+//------- CreateAddressFromBech32
+
+func TestBech32AddressConverter_CreateAddressFromBech32EmptyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ac, _ := addressConverters.NewBech32AddressConverter(32, "erd", "")
+
+	adr, err := ac.CreateAddressFromBech32("")
+
+	assert.Nil(t, adr)
+	assert.Equal(t, state.ErrEmptyAddress, err)
+}
+
+func TestBech32AddressConverter_CreateAddressFromBech32WrongChecksumShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ac, _ := addressConverters.NewBech32AddressConverter(32, "erd", "")
+	//a typo in the last character invalidates the checksum
+	typo := testBech32Address[:len(testBech32Address)-1] + "q"
+
+	adr, err := ac.CreateAddressFromBech32(typo)
+
+	assert.Nil(t, adr)
+	assert.Equal(t, state.ErrBech32WrongAddr, err)
+}
+
+func TestBech32AddressConverter_CreateAddressFromBech32WrongHrpShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ac, _ := addressConverters.NewBech32AddressConverter(32, "test", "")
+
+	adr, err := ac.CreateAddressFromBech32(testBech32Address)
+
+	assert.Nil(t, adr)
+	assert.Equal(t, state.ErrBech32WrongHrp, err)
+}
+
+func TestBech32AddressConverter_CreateAddressFromBech32WrongLengthShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ac, _ := addressConverters.NewBech32AddressConverter(20, "erd", "")
+
+	adr, err := ac.CreateAddressFromBech32(testBech32Address)
+
+	assert.Nil(t, adr)
+	assert.NotNil(t, err)
+}
+
+func TestBech32AddressConverter_CreateAddressFromBech32UppercaseShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ac, _ := addressConverters.NewBech32AddressConverter(32, "ERD", "")
+
+	adr, err := ac.CreateAddressFromBech32(strings.ToUpper(testBech32Address))
+
+	assert.Nil(t, err)
+	assert.Equal(t, testHexAddress, hex.EncodeToString(adr.Bytes()))
+}
+
+//------- CreateAddressFromHex
+
+func TestBech32AddressConverter_CreateAddressFromHexEmptyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ac, _ := addressConverters.NewBech32AddressConverter(32, "erd", "")
+
+	adr, err := ac.CreateAddressFromHex("")
+
+	assert.Nil(t, adr)
+	assert.Equal(t, state.ErrEmptyAddress, err)
+}
+
+func TestBech32AddressConverter_CreateAddressFromHexWithBech32ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ac, _ := addressConverters.NewBech32AddressConverter(32, "erd", "0x")
+
+	adr, err := ac.CreateAddressFromHex(testBech32Address)
+
+	assert.Nil(t, err)
+	assert.Equal(t, testHexAddress, hex.EncodeToString(adr.Bytes()))
+}
+
+func TestBech32AddressConverter_CreateAddressFromHexWithInvalidBech32ShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ac, _ := addressConverters.NewBech32AddressConverter(32, "erd", "0x")
+	typo := strings.Replace(testBech32Address, "ajkd", "ajdk", 1)
+
+	adr, err := ac.CreateAddressFromHex(typo)
+
+	assert.Nil(t, adr)
+	assert.Equal(t, state.ErrBech32WrongAddr, err)
+}
+
+func TestBech32AddressConverter_CreateAddressFromHexWithLegacyHexShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ac, _ := addressConverters.NewBech32AddressConverter(32, "erd", "0x")
+
+	adr, err := ac.CreateAddressFromHex(testHexAddress)
+	assert.Nil(t, err)
+	assert.Equal(t, testHexAddress, hex.EncodeToString(adr.Bytes()))
+
+	adr, err = ac.CreateAddressFromHex("0x" + strings.ToUpper(testHexAddress))
+	assert.Nil(t, err)
+	assert.Equal(t, testHexAddress, hex.EncodeToString(adr.Bytes()))
+}
+
+func TestBech32AddressConverter_CreateAddressFromHexWithHexLikeHrpShouldFallbackToHex(t *testing.T) {
+	t.Parallel()
+
+	//a hrp made only of hex characters can not be told apart from a hex address by looking at the prefix
+	ac, _ := addressConverters.NewBech32AddressConverter(32, "ab", "")
+	hexAddress := "ab1" + testHexAddress[3:]
+
+	adr, err := ac.CreateAddressFromHex(hexAddress)
+
+	assert.Nil(t, err)
+	assert.Equal(t, hexAddress, hex.EncodeToString(adr.Bytes()))
+}
+
+func TestBech32AddressConverter_CreateAddressFromHexWrongSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ac, _ := addressConverters.NewBech32AddressConverter(32, "erd", "")
+
+	adr, err := ac.CreateAddressFromHex(testHexAddress[:10])
+
+	assert.Nil(t, adr)
+	assert.NotNil(t, err)
+}
+
+func TestBech32AddressConverter_ConvertToStringAndBackShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ac, _ := addressConverters.NewBech32AddressConverter(32, "tst", "")
+	buff, _ := hex.DecodeString(testHexAddress)
+
+	str, err := ac.ConvertToString(state.NewAddress(buff))
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(str, "tst1"))
+
+	adr, err := ac.CreateAddressFromHex(str)
+	assert.Nil(t, err)
+	assert.Equal(t, buff, adr.Bytes())
+}
+
+//------- PrepareAddressBytes
+
+func TestBech32AddressConverter_PrepareAddressBytesShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ac, _ := addressConverters.NewBech32AddressConverter(32, "erd", "")
+
+	_, err := ac.PrepareAddressBytes(nil)
+	assert.Equal(t, state.ErrNilAddressContainer, err)
+
+	_, err = ac.PrepareAddressBytes(make([]byte, 0))
+	assert.Equal(t, state.ErrEmptyAddress, err)
+
+	_, err = ac.PrepareAddressBytes(make([]byte, 31))
+	assert.NotNil(t, err)
+
+	buff, err := ac.PrepareAddressBytes(make([]byte, 32))
+	assert.Nil(t, err)
+	assert.Equal(t, make([]byte, 32), buff)
+}
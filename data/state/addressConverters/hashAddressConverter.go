@@ -63,6 +63,11 @@ func (hac *HashAddressConverter) ConvertToHex(addressContainer state.AddressCont
 	return hac.prefix + hex.EncodeToString(addressContainer.Bytes()), nil
 }
 
+// ConvertToString returns the human readable representation of the address, which is the hex one
+func (hac *HashAddressConverter) ConvertToString(addressContainer state.AddressContainer) (string, error) {
+	return hac.ConvertToHex(addressContainer)
+}
+
 // CreateAddressFromHex creates the address from hex string
 func (hac *HashAddressConverter) CreateAddressFromHex(hexAddress string) (state.AddressContainer, error) {
 	if len(hexAddress) == 0 {
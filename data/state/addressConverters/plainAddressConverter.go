@@ -57,6 +57,11 @@ func (pac *PlainAddressConverter) ConvertToHex(addressContainer state.AddressCon
 	return pac.prefix + hex.EncodeToString(addressContainer.Bytes()), nil
 }
 
+// ConvertToString returns the human readable representation of the address, which is the hex one
+func (pac *PlainAddressConverter) ConvertToString(addressContainer state.AddressContainer) (string, error) {
+	return pac.ConvertToHex(addressContainer)
+}
+
 // CreateAddressFromHex creates the address from hex string
 func (pac *PlainAddressConverter) CreateAddressFromHex(hexAddress string) (state.AddressContainer, error) {
 	if len(hexAddress) == 0 {
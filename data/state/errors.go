@@ -142,3 +142,18 @@ var ErrNilBLSPublicKey = errors.New("bls public key is nil")
 
 // ErrUnknownAccountType signals that the provided account type is unknown
 var ErrUnknownAccountType = errors.New("account type is unknown")
+
+// ErrEmptyBech32Hrp signals that an empty bech32 human readable part has been provided
+var ErrEmptyBech32Hrp = errors.New("empty bech32 human readable part")
+
+// ErrBech32WrongHrp signals that the bech32 string has a different human readable part than the one expected
+var ErrBech32WrongHrp = errors.New("wrong bech32 human readable part")
+
+// ErrInvalidSignerSetThreshold signals that the signer set threshold is zero or higher than the number of signers
+var ErrInvalidSignerSetThreshold = errors.New("invalid signer set threshold")
+
+// ErrEmptySignerPubKey signals that an empty public key has been provided in a signer set
+var ErrEmptySignerPubKey = errors.New("empty signer public key")
+
+// ErrDuplicatedSignerPubKey signals that the same public key appears more than once in a signer set
+var ErrDuplicatedSignerPubKey = errors.New("duplicated signer public key")
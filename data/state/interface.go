@@ -12,6 +12,7 @@ type AddressConverter interface {
 	AddressLen() int
 	CreateAddressFromPublicKeyBytes(pubKey []byte) (AddressContainer, error)
 	ConvertToHex(addressContainer AddressContainer) (string, error)
+	ConvertToString(addressContainer AddressContainer) (string, error)
 	CreateAddressFromHex(hexAddress string) (AddressContainer, error)
 	PrepareAddressBytes(addressBytes []byte) ([]byte, error)
 	IsInterfaceNil() bool
@@ -90,6 +91,7 @@ type AccountsAdapter interface {
 	RevertToSnapshot(snapshot int) error
 	RootHash() ([]byte, error)
 	RecreateTrie(rootHash []byte) error
+	GetProof(addressContainer AddressContainer, rootHash []byte) ([][]byte, error)
 	PutCode(accountHandler AccountHandler, code []byte) error
 	RemoveCode(codeHash []byte) error
 	SaveDataTrie(accountHandler AccountHandler) error
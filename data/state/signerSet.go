@@ -0,0 +1,59 @@
+package state
+
+// SignerSetKey is the reserved data trie key under which the signer set of a multisig account is saved
+var SignerSetKey = []byte("ELRONDSignerSet")
+
+// SignerSet holds the public keys allowed to sign on behalf of a multisig account and
+// the minimum number of signatures a transaction from that account must carry
+type SignerSet struct {
+	Threshold uint32   `json:"threshold"`
+	PubKeys   [][]byte `json:"pubKeys"`
+}
+
+// NewSignerSet creates a new signer set after checking the threshold and the public keys
+func NewSignerSet(threshold uint32, pubKeys [][]byte) (*SignerSet, error) {
+	ss := &SignerSet{
+		Threshold: threshold,
+		PubKeys:   pubKeys,
+	}
+
+	err := ss.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	return ss, nil
+}
+
+// Check returns an error if the threshold can not be reached or the public keys are empty or duplicated
+func (ss *SignerSet) Check() error {
+	if ss.Threshold == 0 || int(ss.Threshold) > len(ss.PubKeys) {
+		return ErrInvalidSignerSetThreshold
+	}
+
+	uniqueKeys := make(map[string]struct{}, len(ss.PubKeys))
+	for _, pk := range ss.PubKeys {
+		if len(pk) == 0 {
+			return ErrEmptySignerPubKey
+		}
+
+		_, exists := uniqueKeys[string(pk)]
+		if exists {
+			return ErrDuplicatedSignerPubKey
+		}
+		uniqueKeys[string(pk)] = struct{}{}
+	}
+
+	return nil
+}
+
+// Contains returns true if the provided public key belongs to the signer set
+func (ss *SignerSet) Contains(pubKey []byte) bool {
+	for _, pk := range ss.PubKeys {
+		if string(pk) == string(pubKey) {
+			return true
+		}
+	}
+
+	return false
+}
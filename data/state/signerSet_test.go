@@ -0,0 +1,55 @@
+package state_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSignerSet_ZeroThresholdShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ss, err := state.NewSignerSet(0, [][]byte{[]byte("pk1")})
+
+	assert.Nil(t, ss)
+	assert.Equal(t, state.ErrInvalidSignerSetThreshold, err)
+}
+
+func TestNewSignerSet_ThresholdHigherThanKeysShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ss, err := state.NewSignerSet(3, [][]byte{[]byte("pk1"), []byte("pk2")})
+
+	assert.Nil(t, ss)
+	assert.Equal(t, state.ErrInvalidSignerSetThreshold, err)
+}
+
+func TestNewSignerSet_EmptyPubKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ss, err := state.NewSignerSet(1, [][]byte{[]byte("pk1"), make([]byte, 0)})
+
+	assert.Nil(t, ss)
+	assert.Equal(t, state.ErrEmptySignerPubKey, err)
+}
+
+func TestNewSignerSet_DuplicatedPubKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ss, err := state.NewSignerSet(1, [][]byte{[]byte("pk1"), []byte("pk1")})
+
+	assert.Nil(t, ss)
+	assert.Equal(t, state.ErrDuplicatedSignerPubKey, err)
+}
+
+func TestNewSignerSet_OkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ss, err := state.NewSignerSet(2, [][]byte{[]byte("pk1"), []byte("pk2")})
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(2), ss.Threshold)
+	assert.True(t, ss.Contains([]byte("pk2")))
+	assert.False(t, ss.Contains([]byte("pk3")))
+}
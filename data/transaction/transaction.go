@@ -10,15 +10,17 @@ import (
 
 // Transaction holds all the data needed for a value transfer or SC call
 type Transaction struct {
-	Nonce     uint64   `capid:"0" json:"nonce"`
-	Value     *big.Int `capid:"1" json:"value"`
-	RcvAddr   []byte   `capid:"2" json:"receiver"`
-	SndAddr   []byte   `capid:"3" json:"sender"`
-	GasPrice  uint64   `capid:"4" json:"gasPrice,omitempty"`
-	GasLimit  uint64   `capid:"5" json:"gasLimit,omitempty"`
-	Data      string   `capid:"6" json:"data,omitempty"`
-	Signature []byte   `capid:"7" json:"signature,omitempty"`
-	Challenge []byte   `capid:"8" json:"challenge,omitempty"`
+	Nonce         uint64   `capid:"0" json:"nonce"`
+	Value         *big.Int `capid:"1" json:"value"`
+	RcvAddr       []byte   `capid:"2" json:"receiver"`
+	SndAddr       []byte   `capid:"3" json:"sender"`
+	GasPrice      uint64   `capid:"4" json:"gasPrice,omitempty"`
+	GasLimit      uint64   `capid:"5" json:"gasLimit,omitempty"`
+	Data          string   `capid:"6" json:"data,omitempty"`
+	Signature     []byte   `capid:"7" json:"signature,omitempty"`
+	Challenge     []byte   `capid:"8" json:"challenge,omitempty"`
+	SignerPubKeys [][]byte `capid:"9" json:"signerPubKeys,omitempty"`
+	Signatures    [][]byte `capid:"10" json:"signatures,omitempty"`
 }
 
 // Save saves the serialized data of a Transaction into a stream through Capnp protocol
@@ -73,6 +75,10 @@ func TransactionCapnToGo(src capnp.TransactionCapn, dest *Transaction) *Transact
 	dest.Signature = src.Signature()
 	// Challenge
 	dest.Challenge = src.Challenge()
+	// SignerPubKeys
+	dest.SignerPubKeys = dataListCapnToGo(src.SignerPubKeys())
+	// Signatures
+	dest.Signatures = dataListCapnToGo(src.Signatures())
 
 	return dest
 }
@@ -91,10 +97,41 @@ func TransactionGoToCapn(seg *capn.Segment, src *Transaction) capnp.TransactionC
 	dest.SetData(src.Data)
 	dest.SetSignature(src.Signature)
 	dest.SetChallenge(src.Challenge)
+	dest.SetSignerPubKeys(dataListGoToCapn(seg, src.SignerPubKeys))
+	dest.SetSignatures(dataListGoToCapn(seg, src.Signatures))
 
 	return dest
 }
 
+func dataListCapnToGo(src capn.DataList) [][]byte {
+	n := src.Len()
+	if n == 0 {
+		return nil
+	}
+
+	dest := make([][]byte, n)
+	for i := 0; i < n; i++ {
+		dest[i] = src.At(i)
+	}
+
+	return dest
+}
+
+func dataListGoToCapn(seg *capn.Segment, src [][]byte) capn.DataList {
+	dest := seg.NewDataList(len(src))
+	for i := range src {
+		dest.Set(i, src[i])
+	}
+
+	return dest
+}
+
+// IsMultiSigned returns true if the transaction carries the signatures of a multisig account signer set
+// instead of a single signature
+func (tx *Transaction) IsMultiSigned() bool {
+	return len(tx.Signatures) > 0
+}
+
 // IsInterfaceNil verifies if underlying object is nil
 func (tx *Transaction) IsInterfaceNil() bool {
 	return tx == nil
@@ -31,6 +31,35 @@ func TestTransaction_SaveLoad(t *testing.T) {
 	assert.Equal(t, loadTx, tx)
 }
 
+func TestTransaction_SaveLoadMultiSigned(t *testing.T) {
+	tx := transaction.Transaction{
+		Nonce:         uint64(1),
+		Value:         big.NewInt(1),
+		RcvAddr:       []byte("receiver_address"),
+		SndAddr:       []byte("sender_address"),
+		SignerPubKeys: [][]byte{[]byte("pk1"), []byte("pk2")},
+		Signatures:    [][]byte{[]byte("sig1"), []byte("sig2")},
+	}
+
+	var b bytes.Buffer
+	_ = tx.Save(&b)
+
+	loadTx := transaction.Transaction{}
+	_ = loadTx.Load(&b)
+
+	assert.Equal(t, tx.SignerPubKeys, loadTx.SignerPubKeys)
+	assert.Equal(t, tx.Signatures, loadTx.Signatures)
+	assert.True(t, loadTx.IsMultiSigned())
+}
+
+func TestTransaction_IsMultiSigned(t *testing.T) {
+	tx := transaction.Transaction{Signature: []byte("signature")}
+	assert.False(t, tx.IsMultiSigned())
+
+	tx.Signatures = [][]byte{[]byte("sig1")}
+	assert.True(t, tx.IsMultiSigned())
+}
+
 func TestTransaction_GetData(t *testing.T) {
 	t.Parallel()
 
@@ -365,6 +365,34 @@ func (bn *branchNode) getNext(key []byte, db data.DBWriteCacher, marshalizer mar
 	return bn.children[childPos], key, nil
 }
 
+func (bn *branchNode) getLeaves(path []byte, collector *leavesCollector, db data.DBWriteCacher, marshalizer marshal.Marshalizer) error {
+	err := bn.isEmptyOrNil()
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < nrOfChildren && !collector.isFull(); i++ {
+		childPath := concat(path, byte(i))
+		if collector.skipsPath(childPath) {
+			continue
+		}
+		err = resolveIfCollapsed(bn, byte(i), db, marshalizer)
+		if err != nil {
+			return err
+		}
+		if bn.children[i] == nil {
+			continue
+		}
+
+		err = bn.children[i].getLeaves(childPath, collector, db, marshalizer)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (bn *branchNode) insert(n *leafNode, db data.DBWriteCacher, marshalizer marshal.Marshalizer) (bool, node, error) {
 	err := bn.isEmptyOrNil()
 	if err != nil {
@@ -33,3 +33,12 @@ var ErrEmptyNode = errors.New("the node is empty")
 
 // ErrNilNode is raised when we reach a nil node
 var ErrNilNode = errors.New("the node is nil")
+
+// ErrInvalidNibbles is raised when a sequence of hex nibbles can not be transformed back into a key
+var ErrInvalidNibbles = errors.New("invalid hex nibbles")
+
+// ErrInvalidLimit is raised when an invalid maximum number of leaves is requested
+var ErrInvalidLimit = errors.New("invalid limit")
+
+// ErrInvalidProof is raised when a Merkle proof does not prove the given key against the given root hash
+var ErrInvalidProof = errors.New("invalid proof")
@@ -272,6 +272,23 @@ func (en *extensionNode) getNext(key []byte, db data.DBWriteCacher, marshalizer
 	return en.child, key, nil
 }
 
+func (en *extensionNode) getLeaves(path []byte, collector *leavesCollector, db data.DBWriteCacher, marshalizer marshal.Marshalizer) error {
+	err := en.isEmptyOrNil()
+	if err != nil {
+		return err
+	}
+	childPath := concat(path, en.Key...)
+	if collector.skipsPath(childPath) {
+		return nil
+	}
+	err = resolveIfCollapsed(en, 0, db, marshalizer)
+	if err != nil {
+		return err
+	}
+
+	return en.child.getLeaves(childPath, collector, db, marshalizer)
+}
+
 func (en *extensionNode) insert(n *leafNode, db data.DBWriteCacher, marshalizer marshal.Marshalizer) (bool, node, error) {
 	err := en.isEmptyOrNil()
 	if err != nil {
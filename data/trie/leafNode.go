@@ -176,6 +176,15 @@ func (ln *leafNode) getNext(key []byte, dbw data.DBWriteCacher, marshalizer mars
 	return nil, nil, ErrNodeNotFound
 }
 
+func (ln *leafNode) getLeaves(path []byte, collector *leavesCollector, db data.DBWriteCacher, marshalizer marshal.Marshalizer) error {
+	err := ln.isEmptyOrNil()
+	if err != nil {
+		return err
+	}
+
+	return collector.addLeaf(concat(path, ln.Key...), ln.Value)
+}
+
 func (ln *leafNode) insert(n *leafNode, db data.DBWriteCacher, marshalizer marshal.Marshalizer) (bool, node, error) {
 	err := ln.isEmptyOrNil()
 	if err != nil {
@@ -0,0 +1,61 @@
+package trie
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// leavesCollector gathers, in the order of their keys, the leaves having the keys greater than startKey
+type leavesCollector struct {
+	startKey []byte
+	limit    int
+	leaves   []data.TrieLeaf
+}
+
+func newLeavesCollector(startKey []byte, limit int) *leavesCollector {
+	collector := &leavesCollector{
+		limit:  limit,
+		leaves: make([]data.TrieLeaf, 0),
+	}
+	if startKey != nil {
+		collector.startKey = keyBytesToHex(startKey)
+	}
+
+	return collector
+}
+
+func (lc *leavesCollector) isFull() bool {
+	return len(lc.leaves) >= lc.limit
+}
+
+// skipsPath returns true if all the keys starting with the provided path come before startKey
+func (lc *leavesCollector) skipsPath(path []byte) bool {
+	if lc.startKey == nil {
+		return false
+	}
+
+	prefix := lc.startKey
+	if len(prefix) > len(path) {
+		prefix = prefix[:len(path)]
+	}
+
+	return bytes.Compare(path, prefix) < 0
+}
+
+func (lc *leavesCollector) addLeaf(hexKey []byte, value []byte) error {
+	if lc.isFull() {
+		return nil
+	}
+	if lc.startKey != nil && bytes.Compare(hexKey, lc.startKey) <= 0 {
+		return nil
+	}
+
+	key, err := hexToKeyBytes(hexKey)
+	if err != nil {
+		return err
+	}
+
+	lc.leaves = append(lc.leaves, data.TrieLeaf{Key: key, Value: value})
+	return nil
+}
@@ -31,6 +31,7 @@ type node interface {
 	hashChildren(marshalizer marshal.Marshalizer, hasher hashing.Hasher) error
 	tryGet(key []byte, dbw data.DBWriteCacher, marshalizer marshal.Marshalizer) ([]byte, error)
 	getNext(key []byte, dbw data.DBWriteCacher, marshalizer marshal.Marshalizer) (node, []byte, error)
+	getLeaves(path []byte, collector *leavesCollector, dbw data.DBWriteCacher, marshalizer marshal.Marshalizer) error
 	insert(n *leafNode, dbw data.DBWriteCacher, marshalizer marshal.Marshalizer) (bool, node, error)
 	delete(key []byte, dbw data.DBWriteCacher, marshalizer marshal.Marshalizer) (bool, node, error)
 	reduceNode(pos int) node
@@ -215,6 +216,24 @@ func keyBytesToHex(str []byte) []byte {
 	return nibbles
 }
 
+// hexToKeyBytes transforms hex nibbles, ending with the terminator, back into key bytes
+func hexToKeyBytes(hex []byte) ([]byte, error) {
+	if len(hex) == 0 || hex[len(hex)-1] != hexTerminator {
+		return nil, ErrInvalidNibbles
+	}
+	hex = hex[:len(hex)-1]
+	if len(hex)%2 != 0 {
+		return nil, ErrInvalidNibbles
+	}
+
+	key := make([]byte, len(hex)/2)
+	for i := range key {
+		key[i] = hex[i*2]*hexTerminator + hex[i*2+1]
+	}
+
+	return key, nil
+}
+
 // prefixLen returns the length of the common prefix of a and b.
 func prefixLen(a, b []byte) int {
 	i := 0
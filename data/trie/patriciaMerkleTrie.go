@@ -168,40 +168,98 @@ func (tr *patriciaMerkleTrie) VerifyProof(proofs [][]byte, key []byte) (bool, er
 		return false, err
 	}
 
+	_, err = VerifyProofAndGetValue(wantHash, proofs, key, tr.marshalizer, tr.hasher)
+	if err == ErrInvalidProof {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// VerifyProofAndGetValue checks the Merkle proof of the given key against the provided root hash, without needing
+// access to the trie. If the proof is valid, it returns the value stored under the key
+func VerifyProofAndGetValue(
+	rootHash []byte,
+	proofs [][]byte,
+	key []byte,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) ([]byte, error) {
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, ErrNilMarshalizer
+	}
+	if hasher == nil || hasher.IsInterfaceNil() {
+		return nil, ErrNilHasher
+	}
+
+	wantHash := rootHash
 	key = keyBytesToHex(key)
 	for i := range proofs {
 		encNode := proofs[i]
 		if encNode == nil {
-			return false, nil
+			return nil, ErrInvalidProof
 		}
 
-		hash := tr.hasher.Compute(string(encNode))
+		hash := hasher.Compute(string(encNode))
 		if !bytes.Equal(wantHash, hash) {
-			return false, nil
+			return nil, ErrInvalidProof
 		}
 
-		n, err := decodeNode(encNode, tr.marshalizer)
+		n, err := decodeNode(encNode, marshalizer)
 		if err != nil {
-			return false, err
+			return nil, err
 		}
 
 		switch n := n.(type) {
 		case nil:
-			return false, nil
+			return nil, ErrInvalidProof
 		case *extensionNode:
+			if len(key) < len(n.Key) || !bytes.Equal(n.Key, key[:len(n.Key)]) {
+				return nil, ErrInvalidProof
+			}
 			key = key[len(n.Key):]
 			wantHash = n.EncodedChild
 		case *branchNode:
-			wantHash = n.EncodedChildren[key[0]]
+			if len(key) == 0 || childPosOutOfRange(key[firstByte]) {
+				return nil, ErrInvalidProof
+			}
+			wantHash = n.EncodedChildren[key[firstByte]]
 			key = key[1:]
 		case *leafNode:
 			if bytes.Equal(key, n.Key) {
-				return true, nil
+				return n.Value, nil
 			}
-			return false, nil
+			return nil, ErrInvalidProof
 		}
 	}
-	return false, nil
+
+	return nil, ErrInvalidProof
+}
+
+// GetLeaves returns, in the order of their keys, at most limit leaves having the keys greater than startKey.
+// A nil startKey returns the leaves starting with the first one
+func (tr *patriciaMerkleTrie) GetLeaves(startKey []byte, limit int) ([]data.TrieLeaf, error) {
+	tr.mutOperation.RLock()
+	defer tr.mutOperation.RUnlock()
+
+	if limit < 1 {
+		return nil, ErrInvalidLimit
+	}
+
+	collector := newLeavesCollector(startKey, limit)
+	if tr.root == nil {
+		return collector.leaves, nil
+	}
+
+	err := tr.root.getLeaves(make([]byte, 0), collector, tr.db, tr.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	return collector.leaves, nil
 }
 
 // Commit adds all the dirty nodes to the database
@@ -662,3 +662,114 @@ func BenchmarkPatriciaMerkleTrie_RootHashAfterChanging30000NodesInBatchesOf200(b
 		}
 	}
 }
+
+func TestPatriciaMerkleTrie_GetLeavesInvalidLimitShouldErr(t *testing.T) {
+	tr := initTrie()
+
+	leaves, err := tr.GetLeaves(nil, 0)
+	assert.Nil(t, leaves)
+	assert.Equal(t, trie.ErrInvalidLimit, err)
+}
+
+func TestPatriciaMerkleTrie_GetLeavesEmptyTrieShouldReturnEmpty(t *testing.T) {
+	db, _ := mock.NewMemDbMock()
+	tr, _ := trie.NewTrie(db, marshalizer, hasher)
+
+	leaves, err := tr.GetLeaves(nil, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(leaves))
+}
+
+func TestPatriciaMerkleTrie_GetLeavesShouldReturnTheLeavesAfterTheStartKey(t *testing.T) {
+	tr := initTrie()
+
+	leaves, err := tr.GetLeaves(nil, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, []data.TrieLeaf{
+		{Key: []byte("doe"), Value: []byte("reindeer")},
+		{Key: []byte("dogglesworth"), Value: []byte("cat")},
+		{Key: []byte("dog"), Value: []byte("puppy")},
+	}, leaves)
+
+	leaves, err = tr.GetLeaves([]byte("doe"), 1)
+	assert.Nil(t, err)
+	assert.Equal(t, []data.TrieLeaf{{Key: []byte("dogglesworth"), Value: []byte("cat")}}, leaves)
+
+	leaves, err = tr.GetLeaves([]byte("dog"), 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(leaves))
+}
+
+func TestPatriciaMerkleTrie_GetLeavesPagingThroughRecreatedTrieShouldReturnAllLeaves(t *testing.T) {
+	nrValues := 100
+	tr, values := initTrieMultipleValues(nrValues)
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+	recreatedTrie, _ := tr.Recreate(rootHash)
+
+	expected := make(map[string]struct{})
+	for _, value := range values {
+		expected[string(value)] = struct{}{}
+	}
+
+	var startKey []byte
+	for {
+		leaves, err := recreatedTrie.GetLeaves(startKey, 7)
+		assert.Nil(t, err)
+		if len(leaves) == 0 {
+			break
+		}
+
+		for _, leaf := range leaves {
+			_, ok := expected[string(leaf.Key)]
+			assert.True(t, ok)
+			assert.Equal(t, leaf.Key, leaf.Value)
+			delete(expected, string(leaf.Key))
+		}
+		startKey = leaves[len(leaves)-1].Key
+	}
+
+	assert.Equal(t, 0, len(expected))
+}
+
+func TestVerifyProofAndGetValue_NilArgumentsShouldErr(t *testing.T) {
+	value, err := trie.VerifyProofAndGetValue(emptyTrieHash, nil, []byte("dog"), nil, hasher)
+	assert.Nil(t, value)
+	assert.Equal(t, trie.ErrNilMarshalizer, err)
+
+	value, err = trie.VerifyProofAndGetValue(emptyTrieHash, nil, []byte("dog"), marshalizer, nil)
+	assert.Nil(t, value)
+	assert.Equal(t, trie.ErrNilHasher, err)
+}
+
+func TestVerifyProofAndGetValue_ShouldReturnTheProvenValue(t *testing.T) {
+	tr := initTrie()
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+
+	proof, _ := tr.Prove([]byte("dogglesworth"))
+
+	value, err := trie.VerifyProofAndGetValue(rootHash, proof, []byte("dogglesworth"), marshalizer, hasher)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("cat"), value)
+}
+
+func TestVerifyProofAndGetValue_WrongRootHashOrKeyShouldErr(t *testing.T) {
+	tr := initTrie()
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+
+	proof, _ := tr.Prove([]byte("dog"))
+
+	value, err := trie.VerifyProofAndGetValue(emptyTrieHash, proof, []byte("dog"), marshalizer, hasher)
+	assert.Nil(t, value)
+	assert.Equal(t, trie.ErrInvalidProof, err)
+
+	value, err = trie.VerifyProofAndGetValue(rootHash, proof, []byte("doe"), marshalizer, hasher)
+	assert.Nil(t, value)
+	assert.Equal(t, trie.ErrInvalidProof, err)
+
+	value, err = trie.VerifyProofAndGetValue(rootHash, proof[:len(proof)-1], []byte("dog"), marshalizer, hasher)
+	assert.Nil(t, value)
+	assert.Equal(t, trie.ErrInvalidProof, err)
+}
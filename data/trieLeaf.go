@@ -0,0 +1,7 @@
+package data
+
+// TrieLeaf holds the key and the value stored in a leaf of a trie
+type TrieLeaf struct {
+	Key   []byte
+	Value []byte
+}
@@ -8,8 +8,12 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/api"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/history"
 	"github.com/ElrondNetwork/elrond-go/core/logger"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/node/external"
@@ -31,6 +35,8 @@ type ElrondNodeFacade struct {
 	syncer                 ntp.SyncTimer
 	log                    *logger.Logger
 	tpsBenchmark           *statistics.TpsBenchmark
+	subscriptionHub        SubscriptionHub
+	txHistory              TransactionHistory
 	config                 *config.FacadeConfig
 	restAPIServerDebugMode bool
 }
@@ -71,6 +77,34 @@ func (ef *ElrondNodeFacade) TpsBenchmark() *statistics.TpsBenchmark {
 	return ef.tpsBenchmark
 }
 
+// SetSubscriptionHub sets the hub dispatching the node's events to the subscribers
+func (ef *ElrondNodeFacade) SetSubscriptionHub(hub SubscriptionHub) {
+	ef.subscriptionHub = hub
+}
+
+// Subscribe creates a new subscriber to the node's events
+func (ef *ElrondNodeFacade) Subscribe() (*subscription.Subscriber, error) {
+	if ef.subscriptionHub == nil || ef.subscriptionHub.IsInterfaceNil() {
+		return nil, ErrSubscriptionsNotEnabled
+	}
+
+	return ef.subscriptionHub.NewSubscriber()
+}
+
+// SetTransactionHistory sets the local index of the accounts' transactions
+func (ef *ElrondNodeFacade) SetTransactionHistory(txHistory TransactionHistory) {
+	ef.txHistory = txHistory
+}
+
+// GetTransactionHistory returns a page of the transaction history of the provided address
+func (ef *ElrondNodeFacade) GetTransactionHistory(address string, from uint64, limit int) (*history.Page, error) {
+	if ef.txHistory == nil || ef.txHistory.IsInterfaceNil() {
+		return nil, ErrTransactionHistoryNotEnabled
+	}
+
+	return ef.txHistory.GetTransactions(address, from, limit)
+}
+
 // SetConfig sets the configuration options for the facade
 func (ef *ElrondNodeFacade) SetConfig(facadeConfig *config.FacadeConfig) {
 	ef.config = facadeConfig
@@ -130,6 +164,15 @@ func (ef *ElrondNodeFacade) RestApiPort() string {
 	return ef.config.RestApiPort
 }
 
+// ApiConfig returns the rate limits, the credentials and the route group access levels of the REST API
+func (ef *ElrondNodeFacade) ApiConfig() config.ApiConfig {
+	if ef.config == nil {
+		return config.ApiConfig{}
+	}
+
+	return ef.config.Api
+}
+
 // PrometheusMonitoring returns if prometheus is enabled for monitoring by the flag
 func (ef *ElrondNodeFacade) PrometheusMonitoring() bool {
 	return ef.config.Prometheus
@@ -197,6 +240,21 @@ func (ef *ElrondNodeFacade) SendTransaction(
 	return ef.node.SendTransaction(nonce, senderHex, receiverHex, value, gasPrice, gasLimit, transactionData, signature)
 }
 
+// SimulateTransaction checks, without broadcasting it, whether the transaction would be accepted by the node and
+// returns the hash it would have
+func (ef *ElrondNodeFacade) SimulateTransaction(
+	nonce uint64,
+	senderHex string,
+	receiverHex string,
+	value *big.Int,
+	gasPrice uint64,
+	gasLimit uint64,
+	transactionData string,
+	signature []byte,
+) (string, error) {
+	return ef.node.SimulateTransaction(nonce, senderHex, receiverHex, value, gasPrice, gasLimit, transactionData, signature)
+}
+
 // SendBulkTransactions will send a bulk of transactions on the topic channel
 func (ef *ElrondNodeFacade) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
 	return ef.node.SendBulkTransactions(txs)
@@ -213,6 +271,62 @@ func (ef *ElrondNodeFacade) GetAccount(address string) (*state.Account, error) {
 	return ef.node.GetAccount(address)
 }
 
+// GetAccountStorageValue returns the value stored under the provided key in the data trie of an account
+func (ef *ElrondNodeFacade) GetAccountStorageValue(address string, key []byte) ([]byte, error) {
+	return ef.node.GetAccountStorageValue(address, key)
+}
+
+// GetAccountStorage returns, in the order of their keys, at most limit key/value pairs of the data trie of an
+// account, having the keys greater than startKey
+func (ef *ElrondNodeFacade) GetAccountStorage(address string, startKey []byte, limit int) ([]data.TrieLeaf, error) {
+	return ef.node.GetAccountStorage(address, startKey, limit)
+}
+
+// GetAccountProof returns the Merkle proof of an account in the accounts trie committed under the provided root hash
+func (ef *ElrondNodeFacade) GetAccountProof(address string, rootHash []byte) ([][]byte, error) {
+	return ef.node.GetAccountProof(address, rootHash)
+}
+
+// EncodeAddress returns the human readable representation of the provided address bytes
+func (ef *ElrondNodeFacade) EncodeAddress(address []byte) (string, error) {
+	return ef.node.EncodeAddress(address)
+}
+
+// NumberOfShards returns the number of shards of the network, the metachain excluded
+func (ef *ElrondNodeFacade) NumberOfShards() uint32 {
+	return ef.node.NumberOfShards()
+}
+
+// GetBlockByNonce returns the committed block of a shard having the provided nonce, together with its hash
+func (ef *ElrondNodeFacade) GetBlockByNonce(shardId uint32, nonce uint64) (*block.Header, []byte, error) {
+	return ef.node.GetBlockByNonce(shardId, nonce)
+}
+
+// GetBlockByHash returns the block having the provided hash
+func (ef *ElrondNodeFacade) GetBlockByHash(hash []byte) (*block.Header, error) {
+	return ef.node.GetBlockByHash(hash)
+}
+
+// GetMetaBlockByNonce returns the committed meta block having the provided nonce, together with its hash
+func (ef *ElrondNodeFacade) GetMetaBlockByNonce(nonce uint64) (*block.MetaBlock, []byte, error) {
+	return ef.node.GetMetaBlockByNonce(nonce)
+}
+
+// GetMetaBlockByHash returns the meta block having the provided hash
+func (ef *ElrondNodeFacade) GetMetaBlockByHash(hash []byte) (*block.MetaBlock, error) {
+	return ef.node.GetMetaBlockByHash(hash)
+}
+
+// GetMiniBlock returns the miniblock having the provided hash
+func (ef *ElrondNodeFacade) GetMiniBlock(hash []byte) (*block.MiniBlock, error) {
+	return ef.node.GetMiniBlock(hash)
+}
+
+// GetMiniBlockTransactions returns the transactions of a miniblock, mapped by their hashes
+func (ef *ElrondNodeFacade) GetMiniBlockTransactions(miniBlock *block.MiniBlock) (map[string]data.TransactionHandler, error) {
+	return ef.node.GetMiniBlockTransactions(miniBlock)
+}
+
 // GetCurrentPublicKey gets the current nodes public Key
 func (ef *ElrondNodeFacade) GetCurrentPublicKey() string {
 	return ef.node.GetCurrentPublicKey()
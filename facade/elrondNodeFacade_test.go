@@ -8,10 +8,16 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/history"
 	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/addressConverters"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/facade/mock"
+	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/node/heartbeat"
 	"github.com/stretchr/testify/assert"
 )
@@ -295,6 +301,18 @@ func TestElrondNodeFacade_GetAccount(t *testing.T) {
 	assert.Equal(t, called, 1)
 }
 
+func TestElrondNodeFacade_EncodeAddress(t *testing.T) {
+	called := 0
+	node := &mock.NodeMock{}
+	node.EncodeAddressHandler = func(address []byte) (string, error) {
+		called++
+		return "", nil
+	}
+	ef := createElrondNodeFacadeWithMockResolver(node)
+	_, _ = ef.EncodeAddress([]byte("test"))
+	assert.Equal(t, called, 1)
+}
+
 func TestElrondNodeFacade_GetCurrentPublicKey(t *testing.T) {
 	called := 0
 	node := &mock.NodeMock{}
@@ -369,6 +387,177 @@ func TestElrondNodeFacade_GetDataValue(t *testing.T) {
 	assert.True(t, wasCalled)
 }
 
+func TestElrondNodeFacade_NumberOfShards(t *testing.T) {
+	t.Parallel()
+
+	node := &mock.NodeMock{
+		NumberOfShardsHandler: func() uint32 {
+			return 3
+		},
+	}
+	ef := createElrondNodeFacadeWithMockResolver(node)
+
+	assert.Equal(t, uint32(3), ef.NumberOfShards())
+}
+
+func TestElrondNodeFacade_GetBlockByNonce(t *testing.T) {
+	t.Parallel()
+
+	testHash := []byte("hash")
+	testHeader := &block.Header{Nonce: 7, ShardId: 1}
+	node := &mock.NodeMock{
+		GetBlockByNonceHandler: func(shardId uint32, nonce uint64) (*block.Header, []byte, error) {
+			if shardId == testHeader.ShardId && nonce == testHeader.Nonce {
+				return testHeader, testHash, nil
+			}
+			return nil, nil, nil
+		},
+	}
+
+	ef := createElrondNodeFacadeWithMockResolver(node)
+
+	header, hash, err := ef.GetBlockByNonce(1, 7)
+	assert.Nil(t, err)
+	assert.Equal(t, testHash, hash)
+	assert.Equal(t, testHeader, header)
+}
+
+func TestElrondNodeFacade_GetAccountStorage(t *testing.T) {
+	t.Parallel()
+
+	testLeaves := []data.TrieLeaf{{Key: []byte("key"), Value: []byte("value")}}
+	node := &mock.NodeMock{
+		GetAccountStorageHandler: func(address string, startKey []byte, limit int) ([]data.TrieLeaf, error) {
+			if address == "address" && string(startKey) == "start" && limit == 3 {
+				return testLeaves, nil
+			}
+			return nil, errors.New("unexpected arguments")
+		},
+	}
+
+	ef := createElrondNodeFacadeWithMockResolver(node)
+
+	leaves, err := ef.GetAccountStorage("address", []byte("start"), 3)
+	assert.Nil(t, err)
+	assert.Equal(t, testLeaves, leaves)
+}
+
+func TestElrondNodeFacade_SimulateTransaction(t *testing.T) {
+	t.Parallel()
+
+	node := &mock.NodeMock{
+		SimulateTransactionHandler: func(nonce uint64, sender string, receiver string, value *big.Int, gasPrice uint64, gasLimit uint64, transactionData string, signature []byte) (string, error) {
+			if nonce == 1 && sender == "sender" && receiver == "receiver" && value.Int64() == 2 &&
+				gasPrice == 3 && gasLimit == 4 && transactionData == "data" && string(signature) == "signature" {
+				return "hash", nil
+			}
+			return "", errors.New("unexpected arguments")
+		},
+	}
+	ef := createElrondNodeFacadeWithMockResolver(node)
+
+	txHash, err := ef.SimulateTransaction(1, "sender", "receiver", big.NewInt(2), 3, 4, "data", []byte("signature"))
+	assert.Nil(t, err)
+	assert.Equal(t, "hash", txHash)
+}
+
+func TestElrondNodeFacade_GetAccountProof(t *testing.T) {
+	t.Parallel()
+
+	testProof := [][]byte{[]byte("node")}
+	node := &mock.NodeMock{
+		GetAccountProofHandler: func(address string, rootHash []byte) ([][]byte, error) {
+			if address == "address" && string(rootHash) == "root hash" {
+				return testProof, nil
+			}
+			return nil, errors.New("unexpected arguments")
+		},
+	}
+	ef := createElrondNodeFacadeWithMockResolver(node)
+
+	proof, err := ef.GetAccountProof("address", []byte("root hash"))
+	assert.Nil(t, err)
+	assert.Equal(t, testProof, proof)
+}
+
+func TestElrondNodeFacade_GetMetaBlockByHash(t *testing.T) {
+	t.Parallel()
+
+	testMetaBlock := &block.MetaBlock{Nonce: 3}
+	node := &mock.NodeMock{
+		GetMetaBlockByHashHandler: func(hash []byte) (*block.MetaBlock, error) {
+			return testMetaBlock, nil
+		},
+	}
+
+	ef := createElrondNodeFacadeWithMockResolver(node)
+
+	metaBlock, err := ef.GetMetaBlockByHash([]byte("hash"))
+	assert.Nil(t, err)
+	assert.Equal(t, testMetaBlock, metaBlock)
+}
+
+func TestElrondNodeFacade_SubscribeWithoutHubShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ef := createElrondNodeFacadeWithMockNodeAndResolver()
+
+	subscriber, err := ef.Subscribe()
+	assert.Nil(t, subscriber)
+	assert.Equal(t, ErrSubscriptionsNotEnabled, err)
+}
+
+func TestElrondNodeFacade_SubscribeShouldCreateSubscriber(t *testing.T) {
+	t.Parallel()
+
+	converter, _ := addressConverters.NewPlainAddressConverter(32, "")
+	hub, _ := subscription.NewHub(subscription.ArgHub{
+		AddressConverter:         converter,
+		AccountsTrie:             &mock.TrieStub{},
+		Marshalizer:              &marshal.JsonMarshalizer{},
+		MaxSubscribers:           1,
+		MaxFiltersPerSubscriber:  10,
+		EventsBufferSize:         1,
+		CommittedBlocksQueueSize: 1,
+	})
+	ef := createElrondNodeFacadeWithMockNodeAndResolver()
+	ef.SetSubscriptionHub(hub)
+
+	subscriber, err := ef.Subscribe()
+	assert.Nil(t, err)
+	assert.NotNil(t, subscriber)
+	assert.Equal(t, 1, hub.NumSubscribers())
+}
+
+func TestElrondNodeFacade_GetTransactionHistoryWithoutHistoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ef := createElrondNodeFacadeWithMockNodeAndResolver()
+
+	page, err := ef.GetTransactionHistory("address", 0, 10)
+	assert.Nil(t, page)
+	assert.Equal(t, ErrTransactionHistoryNotEnabled, err)
+}
+
+func TestElrondNodeFacade_GetTransactionHistoryShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedPage := &history.Page{NextFrom: 3}
+	ef := createElrondNodeFacadeWithMockNodeAndResolver()
+	ef.SetTransactionHistory(&mock.TransactionHistoryStub{
+		GetTransactionsCalled: func(address string, from uint64, limit int) (*history.Page, error) {
+			if address == "address" && from == 5 && limit == 2 {
+				return expectedPage, nil
+			}
+			return nil, errors.New("unexpected arguments")
+		},
+	})
+
+	page, err := ef.GetTransactionHistory("address", 5, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedPage, page)
+}
+
 func TestElrondNodeFacade_RestApiPortNilConfig(t *testing.T) {
 	ef := createElrondNodeFacadeWithMockNodeAndResolver()
 	ef.SetConfig(nil)
@@ -403,3 +592,18 @@ func TestElrondNodeFacade_RestApiPortCorrectPortSpecified(t *testing.T) {
 
 	assert.Equal(t, port, ef.RestApiPort())
 }
+
+func TestElrondNodeFacade_ApiConfig(t *testing.T) {
+	ef := createElrondNodeFacadeWithMockNodeAndResolver()
+	ef.SetConfig(nil)
+
+	assert.Equal(t, config.ApiConfig{}, ef.ApiConfig())
+
+	apiConfig := config.ApiConfig{
+		Auth:        config.ApiAuthConfig{Required: true},
+		RouteGroups: []config.ApiRouteGroupConfig{{Name: "transaction", Access: "admin"}},
+	}
+	ef.SetConfig(&config.FacadeConfig{Api: apiConfig})
+
+	assert.Equal(t, apiConfig, ef.ApiConfig())
+}
@@ -4,3 +4,9 @@ import "github.com/pkg/errors"
 
 // ErrHeartbeatsNotActive signals that the heartbeat system is not active
 var ErrHeartbeatsNotActive = errors.New("heartbeat system not active")
+
+// ErrSubscriptionsNotEnabled signals that the subscriptions to the node's events are not enabled
+var ErrSubscriptionsNotEnabled = errors.New("subscriptions not enabled")
+
+// ErrTransactionHistoryNotEnabled signals that the local index of the accounts' transactions is not enabled
+var ErrTransactionHistoryNotEnabled = errors.New("transaction history not enabled")
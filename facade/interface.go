@@ -3,6 +3,10 @@ package facade
 import (
 	"math/big"
 
+	"github.com/ElrondNetwork/elrond-go/core/history"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/node/external"
@@ -39,6 +43,9 @@ type NodeWrapper interface {
 	//SendTransaction will send a new transaction on the 'send transactions pipe' channel
 	SendTransaction(nonce uint64, senderHex string, receiverHex string, value *big.Int, gasPrice uint64, gasLimit uint64, transactionData string, signature []byte) (string, error)
 
+	// SimulateTransaction checks, without broadcasting it, whether the transaction would be accepted by the node
+	SimulateTransaction(nonce uint64, senderHex string, receiverHex string, value *big.Int, gasPrice uint64, gasLimit uint64, transactionData string, signature []byte) (string, error)
+
 	//SendBulkTransactions will send a bulk of transactions on the 'send transactions pipe' channel
 	SendBulkTransactions(txs []*transaction.Transaction) (uint64, error)
 
@@ -60,9 +67,43 @@ type NodeWrapper interface {
 	//  about the account corelated with provided address
 	GetAccount(address string) (*state.Account, error)
 
+	// EncodeAddress returns the human readable representation of the provided address bytes
+	EncodeAddress(address []byte) (string, error)
+
+	// GetAccountStorageValue returns the value stored under the provided key in the data trie of an account
+	GetAccountStorageValue(address string, key []byte) ([]byte, error)
+
+	// GetAccountStorage returns, in the order of their keys, at most limit key/value pairs of the data trie of an
+	// account, having the keys greater than startKey
+	GetAccountStorage(address string, startKey []byte, limit int) ([]data.TrieLeaf, error)
+
+	// GetAccountProof returns the Merkle proof of an account in the accounts trie committed under the provided root hash
+	GetAccountProof(address string, rootHash []byte) ([][]byte, error)
+
 	// GetHeartbeats returns the heartbeat status for each public key defined in genesis.json
 	GetHeartbeats() []heartbeat.PubKeyHeartbeat
 
+	// NumberOfShards returns the number of shards of the network, the metachain excluded
+	NumberOfShards() uint32
+
+	// GetBlockByNonce returns the committed block of a shard having the provided nonce, together with its hash
+	GetBlockByNonce(shardId uint32, nonce uint64) (*block.Header, []byte, error)
+
+	// GetBlockByHash returns the block having the provided hash
+	GetBlockByHash(hash []byte) (*block.Header, error)
+
+	// GetMetaBlockByNonce returns the committed meta block having the provided nonce, together with its hash
+	GetMetaBlockByNonce(nonce uint64) (*block.MetaBlock, []byte, error)
+
+	// GetMetaBlockByHash returns the meta block having the provided hash
+	GetMetaBlockByHash(hash []byte) (*block.MetaBlock, error)
+
+	// GetMiniBlock returns the miniblock having the provided hash
+	GetMiniBlock(hash []byte) (*block.MiniBlock, error)
+
+	// GetMiniBlockTransactions returns the transactions of a miniblock, mapped by their hashes
+	GetMiniBlockTransactions(miniBlock *block.MiniBlock) (map[string]data.TransactionHandler, error)
+
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 }
@@ -73,3 +114,15 @@ type ApiResolver interface {
 	StatusMetrics() external.StatusMetricsHandler
 	IsInterfaceNil() bool
 }
+
+// SubscriptionHub defines a structure capable of creating subscribers to the node's events
+type SubscriptionHub interface {
+	NewSubscriber() (*subscription.Subscriber, error)
+	IsInterfaceNil() bool
+}
+
+// TransactionHistory defines a structure capable of serving the transaction history of the accounts
+type TransactionHistory interface {
+	GetTransactions(address string, from uint64, limit int) (*history.Page, error)
+	IsInterfaceNil() bool
+}
@@ -3,6 +3,8 @@ package mock
 import (
 	"math/big"
 
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/node/heartbeat"
@@ -22,9 +24,21 @@ type NodeMock struct {
 		gasLimit uint64, data string, signatureHex string, challenge string) (*transaction.Transaction, error)
 	GetTransactionHandler                          func(hash string) (*transaction.Transaction, error)
 	SendTransactionHandler                         func(nonce uint64, sender string, receiver string, amount *big.Int, code string, signature []byte) (string, error)
+	SimulateTransactionHandler                     func(nonce uint64, sender string, receiver string, value *big.Int, gasPrice uint64, gasLimit uint64, transactionData string, signature []byte) (string, error)
 	SendBulkTransactionsHandler                    func(txs []*transaction.Transaction) (uint64, error)
 	GetAccountHandler                              func(address string) (*state.Account, error)
 	GetCurrentPublicKeyHandler                     func() string
+	EncodeAddressHandler                           func(address []byte) (string, error)
+	GetAccountStorageValueHandler                  func(address string, key []byte) ([]byte, error)
+	GetAccountStorageHandler                       func(address string, startKey []byte, limit int) ([]data.TrieLeaf, error)
+	GetAccountProofHandler                         func(address string, rootHash []byte) ([][]byte, error)
+	NumberOfShardsHandler                          func() uint32
+	GetBlockByNonceHandler                         func(shardId uint32, nonce uint64) (*block.Header, []byte, error)
+	GetBlockByHashHandler                          func(hash []byte) (*block.Header, error)
+	GetMetaBlockByNonceHandler                     func(nonce uint64) (*block.MetaBlock, []byte, error)
+	GetMetaBlockByHashHandler                      func(hash []byte) (*block.MetaBlock, error)
+	GetMiniBlockHandler                            func(hash []byte) (*block.MiniBlock, error)
+	GetMiniBlockTransactionsHandler                func(miniBlock *block.MiniBlock) (map[string]data.TransactionHandler, error)
 	GenerateAndSendBulkTransactionsHandler         func(destination string, value *big.Int, nrTransactions uint64) error
 	GenerateAndSendBulkTransactionsOneByOneHandler func(destination string, value *big.Int, nrTransactions uint64) error
 	GetHeartbeatsHandler                           func() []heartbeat.PubKeyHeartbeat
@@ -80,6 +94,10 @@ func (nm *NodeMock) SendTransaction(nonce uint64, sender string, receiver string
 	return nm.SendTransactionHandler(nonce, sender, receiver, value, transactionData, signature)
 }
 
+func (nm *NodeMock) SimulateTransaction(nonce uint64, sender string, receiver string, value *big.Int, gasPrice uint64, gasLimit uint64, transactionData string, signature []byte) (string, error) {
+	return nm.SimulateTransactionHandler(nonce, sender, receiver, value, gasPrice, gasLimit, transactionData, signature)
+}
+
 func (nm *NodeMock) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
 	return nm.SendBulkTransactionsHandler(txs)
 }
@@ -100,6 +118,50 @@ func (nm *NodeMock) GetAccount(address string) (*state.Account, error) {
 	return nm.GetAccountHandler(address)
 }
 
+func (nm *NodeMock) GetAccountStorageValue(address string, key []byte) ([]byte, error) {
+	return nm.GetAccountStorageValueHandler(address, key)
+}
+
+func (nm *NodeMock) GetAccountStorage(address string, startKey []byte, limit int) ([]data.TrieLeaf, error) {
+	return nm.GetAccountStorageHandler(address, startKey, limit)
+}
+
+func (nm *NodeMock) GetAccountProof(address string, rootHash []byte) ([][]byte, error) {
+	return nm.GetAccountProofHandler(address, rootHash)
+}
+
+func (nm *NodeMock) EncodeAddress(address []byte) (string, error) {
+	return nm.EncodeAddressHandler(address)
+}
+
+func (nm *NodeMock) NumberOfShards() uint32 {
+	return nm.NumberOfShardsHandler()
+}
+
+func (nm *NodeMock) GetBlockByNonce(shardId uint32, nonce uint64) (*block.Header, []byte, error) {
+	return nm.GetBlockByNonceHandler(shardId, nonce)
+}
+
+func (nm *NodeMock) GetBlockByHash(hash []byte) (*block.Header, error) {
+	return nm.GetBlockByHashHandler(hash)
+}
+
+func (nm *NodeMock) GetMetaBlockByNonce(nonce uint64) (*block.MetaBlock, []byte, error) {
+	return nm.GetMetaBlockByNonceHandler(nonce)
+}
+
+func (nm *NodeMock) GetMetaBlockByHash(hash []byte) (*block.MetaBlock, error) {
+	return nm.GetMetaBlockByHashHandler(hash)
+}
+
+func (nm *NodeMock) GetMiniBlock(hash []byte) (*block.MiniBlock, error) {
+	return nm.GetMiniBlockHandler(hash)
+}
+
+func (nm *NodeMock) GetMiniBlockTransactions(miniBlock *block.MiniBlock) (map[string]data.TransactionHandler, error) {
+	return nm.GetMiniBlockTransactionsHandler(miniBlock)
+}
+
 func (nm *NodeMock) GetHeartbeats() []heartbeat.PubKeyHeartbeat {
 	return nm.GetHeartbeatsHandler()
 }
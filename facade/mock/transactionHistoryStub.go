@@ -0,0 +1,21 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/history"
+)
+
+type TransactionHistoryStub struct {
+	GetTransactionsCalled func(address string, from uint64, limit int) (*history.Page, error)
+}
+
+func (ths *TransactionHistoryStub) GetTransactions(address string, from uint64, limit int) (*history.Page, error) {
+	return ths.GetTransactionsCalled(address, from, limit)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ths *TransactionHistoryStub) IsInterfaceNil() bool {
+	if ths == nil {
+		return true
+	}
+	return false
+}
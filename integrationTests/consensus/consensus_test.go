@@ -3,6 +3,7 @@ package consensus
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"sync"
 	"testing"
@@ -10,8 +11,12 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
+	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -255,3 +260,258 @@ func TestConsensusBLSNotEnoughValidators(t *testing.T) {
 
 	runConsensusWithNotEnoughValidators(t, blsConsensusType)
 }
+
+// leaderDisconnection disconnects the first node that commits a block, at the moment chosen by the test
+type leaderDisconnection struct {
+	mutDisconnected sync.Mutex
+	disconnected    *testNode
+	round           uint64
+}
+
+func (ld *leaderDisconnection) disconnect(tn *testNode, round uint64) bool {
+	ld.mutDisconnected.Lock()
+	defer ld.mutDisconnected.Unlock()
+
+	if ld.disconnected != nil {
+		return false
+	}
+
+	ld.disconnected = tn
+	ld.round = round
+	fmt.Printf("leader of round %d disconnects\n", round)
+	_ = tn.mesenger.Close()
+
+	return true
+}
+
+func (ld *leaderDisconnection) disconnectedRound() (uint64, bool) {
+	ld.mutDisconnected.Lock()
+	defer ld.mutDisconnected.Unlock()
+
+	return ld.round, ld.disconnected != nil
+}
+
+func (ld *leaderDisconnection) isDisconnected(tn *testNode) bool {
+	ld.mutDisconnected.Lock()
+	defer ld.mutDisconnected.Unlock()
+
+	return ld.disconnected == tn
+}
+
+// prepareNodesForLeaderDisconnection makes every proposed block have a cross shard miniblock, whose payload is
+// recorded by the returned observer registered on the advertiser, and gives every node a headers interceptor
+func prepareNodesForLeaderDisconnection(
+	t *testing.T,
+	nodes []*testNode,
+	advertiser p2p.Messenger,
+	consensusType string,
+) *topicObserver {
+
+	crossShardId := uint32(1)
+	shardCoordinator, _ := sharding.NewMultiShardCoordinator(uint32(1), uint32(0))
+	observer := &topicObserver{
+		payloads: make(map[string]struct{}),
+	}
+	miniBlocksTopic := factory.MiniBlocksTopic + shardCoordinator.CommunicationIdentifier(crossShardId)
+	err := advertiser.CreateTopic(miniBlocksTopic, false)
+	assert.Nil(t, err)
+	err = advertiser.RegisterMessageProcessor(miniBlocksTopic, observer)
+	assert.Nil(t, err)
+
+	headersTopic := factory.HeadersTopic + shardCoordinator.CommunicationIdentifier(0)
+	for _, n := range nodes {
+		err = n.mesenger.CreateTopic(headersTopic, false)
+		assert.Nil(t, err)
+		err = n.mesenger.RegisterMessageProcessor(headersTopic, &headerInterceptor{
+			marshalizer: &marshal.JsonMarshalizer{},
+			hasher:      createHasher(consensusType),
+			headersPool: n.dPool.Headers(),
+		})
+		assert.Nil(t, err)
+
+		n.blkProcessor.CreateBlockHeaderCalled = func(body data.BodyHandler, round uint64, haveTime func() bool) (data.HeaderHandler, error) {
+			return &dataBlock.Header{
+				Round: round,
+				MiniBlockHeaders: []dataBlock.MiniBlockHeader{
+					{Hash: []byte(fmt.Sprintf("miniblock hash %d", round)), ReceiverShardID: crossShardId},
+				},
+			}, nil
+		}
+		n.blkProcessor.MarshalizedDataToBroadcastCalled = func(header data.HeaderHandler, body data.BodyHandler) (map[uint32][]byte, map[string][][]byte, error) {
+			miniBlocks := map[uint32][]byte{crossShardId: miniBlockPayload(header.GetRound())}
+			return miniBlocks, make(map[string][][]byte), nil
+		}
+	}
+
+	return observer
+}
+
+func runConsensusWithLeaderDisconnectedAfterHeaderBroadcast(t *testing.T, consensusType string) {
+	numNodes := uint32(4)
+	consensusSize := uint32(4)
+	numInvalid := uint32(0)
+	roundTime := uint64(4000)
+	nodes, advertiser, _ := initNodesAndTest(numNodes, consensusSize, numInvalid, roundTime, consensusType)
+
+	defer func() {
+		_ = advertiser.Close()
+		for _, n := range nodes {
+			_ = n.node.Stop()
+		}
+	}()
+
+	observer := prepareNodesForLeaderDisconnection(t, nodes, advertiser, consensusType)
+
+	// delay for bootstrapping and topic announcement
+	fmt.Println("Start consensus...")
+	time.Sleep(time.Second)
+
+	// the first node which commits a block broadcasts its final header, which reaches the other nodes through their
+	// headers interceptors, and disconnects before sending its miniblocks. The miniblocks data is requested from the
+	// committing node only after the header was broadcast. In bls only the leader commits, so the miniblocks should be
+	// sent by the backups, while in bn all the consensus group members commit and broadcast the block
+	disconnection := &leaderDisconnection{}
+	for _, n := range nodes {
+		tn := n
+		mutCommitted := &sync.Mutex{}
+		committedRound := int64(-1)
+		tn.blkProcessor.CommitBlockCalled = func(blockChain data.ChainHandler, header data.HeaderHandler, body data.BodyHandler) error {
+			mutCommitted.Lock()
+			committedRound = int64(header.GetRound())
+			mutCommitted.Unlock()
+
+			return nil
+		}
+
+		marshalizedDataToBroadcast := tn.blkProcessor.MarshalizedDataToBroadcastCalled
+		tn.blkProcessor.MarshalizedDataToBroadcastCalled = func(header data.HeaderHandler, body data.BodyHandler) (map[uint32][]byte, map[string][][]byte, error) {
+			mutCommitted.Lock()
+			isCommitted := committedRound == int64(header.GetRound())
+			mutCommitted.Unlock()
+
+			if isCommitted {
+				// let the final header leave before disconnecting
+				time.Sleep(time.Millisecond * 500)
+				if disconnection.disconnect(tn, header.GetRound()) {
+					return nil, nil, errors.New("disconnected")
+				}
+			}
+
+			return marshalizedDataToBroadcast(header, body)
+		}
+
+		err := tn.node.StartConsensus()
+		assert.Nil(t, err)
+	}
+
+	maxRounds := uint64(10)
+	endTime := time.Now().Add(time.Duration(roundTime*maxRounds) * time.Millisecond)
+	for time.Now().Before(endTime) {
+		time.Sleep(time.Second)
+
+		round, isDisconnected := disconnection.disconnectedRound()
+		if isDisconnected && observer.payloadReceived(miniBlockPayload(round)) {
+			return
+		}
+	}
+
+	assert.Fail(t, "miniblocks were not broadcast by the backup consensus members")
+}
+
+// runConsensusWithLeaderDisconnectedAfterSignature checks that the backups do not replace a bls leader which
+// disconnects right after the signature subround, before broadcasting anything: there is no final header whose
+// miniblocks could be sent, so the round is lost and a block is proposed again by the next leaders
+func runConsensusWithLeaderDisconnectedAfterSignature(t *testing.T) {
+	numNodes := uint32(4)
+	consensusSize := uint32(4)
+	numInvalid := uint32(0)
+	roundTime := uint64(4000)
+	nodes, advertiser, _ := initNodesAndTest(numNodes, consensusSize, numInvalid, roundTime, blsConsensusType)
+
+	defer func() {
+		_ = advertiser.Close()
+		for _, n := range nodes {
+			_ = n.node.Stop()
+		}
+	}()
+
+	observer := prepareNodesForLeaderDisconnection(t, nodes, advertiser, blsConsensusType)
+
+	// delay for bootstrapping and topic announcement
+	fmt.Println("Start consensus...")
+	time.Sleep(time.Second)
+
+	// in bls the leader commits right after the signature subround, before broadcasting the block
+	disconnection := &leaderDisconnection{}
+	mutCommits := &sync.Mutex{}
+	roundsCommittedLater := 0
+	for _, n := range nodes {
+		tn := n
+		tn.blkProcessor.CommitBlockCalled = func(blockChain data.ChainHandler, header data.HeaderHandler, body data.BodyHandler) error {
+			if disconnection.disconnect(tn, header.GetRound()) || disconnection.isDisconnected(tn) {
+				return nil
+			}
+
+			mutCommits.Lock()
+			roundsCommittedLater++
+			mutCommits.Unlock()
+
+			return nil
+		}
+
+		err := tn.node.StartConsensus()
+		assert.Nil(t, err)
+	}
+
+	maxRounds := uint64(10)
+	endTime := time.Now().Add(time.Duration(roundTime*maxRounds) * time.Millisecond)
+	for time.Now().Before(endTime) {
+		time.Sleep(time.Second)
+
+		mutCommits.Lock()
+		numCommits := roundsCommittedLater
+		mutCommits.Unlock()
+
+		if numCommits > 0 {
+			break
+		}
+	}
+
+	round, isDisconnected := disconnection.disconnectedRound()
+	assert.True(t, isDisconnected)
+	mutCommits.Lock()
+	assert.True(t, roundsCommittedLater > 0, "no block was committed after the leader disconnected")
+	mutCommits.Unlock()
+
+	// the backups broadcast by the end of the round, staggered by their rank
+	time.Sleep(time.Duration(roundTime) * time.Millisecond)
+	assert.False(t, observer.payloadReceived(miniBlockPayload(round)))
+}
+
+func miniBlockPayload(round uint64) []byte {
+	return []byte(fmt.Sprintf("miniblock %d", round))
+}
+
+func TestConsensusBNLeaderDisconnectedAfterHeaderBroadcast(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	runConsensusWithLeaderDisconnectedAfterHeaderBroadcast(t, bnConsensusType)
+}
+
+func TestConsensusBLSLeaderDisconnectedAfterHeaderBroadcast(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	runConsensusWithLeaderDisconnectedAfterHeaderBroadcast(t, blsConsensusType)
+}
+
+func TestConsensusBLSLeaderDisconnectedAfterSignature(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	runConsensusWithLeaderDisconnectedAfterSignature(t)
+}
@@ -76,6 +76,67 @@ type testNode struct {
 	metachainHdrRecv int32
 }
 
+// topicObserver records the payloads received on a topic
+type topicObserver struct {
+	mutPayloads sync.Mutex
+	payloads    map[string]struct{}
+}
+
+// ProcessReceivedMessage records the payload of the received message
+func (to *topicObserver) ProcessReceivedMessage(message p2p.MessageP2P, _ func(buffToSend []byte)) error {
+	to.mutPayloads.Lock()
+	to.payloads[string(message.Data())] = struct{}{}
+	to.mutPayloads.Unlock()
+
+	return nil
+}
+
+// payloadReceived returns true if the given payload was received
+func (to *topicObserver) payloadReceived(payload []byte) bool {
+	to.mutPayloads.Lock()
+	defer to.mutPayloads.Unlock()
+
+	_, found := to.payloads[string(payload)]
+	return found
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (to *topicObserver) IsInterfaceNil() bool {
+	if to == nil {
+		return true
+	}
+	return false
+}
+
+// headerInterceptor stands for the headers interceptor, which the consensus only nodes do not have: it adds the
+// headers received on the shard headers topic in the headers pool
+type headerInterceptor struct {
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+	headersPool storage.Cacher
+}
+
+// ProcessReceivedMessage adds the received header in the headers pool
+func (hi *headerInterceptor) ProcessReceivedMessage(message p2p.MessageP2P, _ func(buffToSend []byte)) error {
+	header := &dataBlock.Header{}
+	err := hi.marshalizer.Unmarshal(header, message.Data())
+	if err != nil {
+		return err
+	}
+
+	hi.headersPool.HasOrAdd(hi.hasher.Compute(string(message.Data())), header)
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hi *headerInterceptor) IsInterfaceNil() bool {
+	if hi == nil {
+		return true
+	}
+	return false
+}
+
 type keyPair struct {
 	sk crypto.PrivateKey
 	pk crypto.PublicKey
@@ -294,7 +355,8 @@ func createConsensusOnlyNode(
 	*node.Node,
 	p2p.Messenger,
 	*mock.BlockProcessorMock,
-	data.ChainHandler) {
+	data.ChainHandler,
+	dataRetriever.PoolsHolder) {
 
 	testHasher := createHasher(consensusType)
 	testMarshalizer := &marshal.JsonMarshalizer{}
@@ -332,6 +394,7 @@ func createConsensusOnlyNode(
 	}
 	blockProcessor.Marshalizer = testMarshalizer
 	blockChain := createTestBlockChain()
+	dPool := createTestShardDataPool()
 
 	header := &dataBlock.Header{
 		Nonce:         0,
@@ -412,7 +475,7 @@ func createConsensusOnlyNode(
 		node.WithTxSignPrivKey(privKey),
 		node.WithPubKey(privKey.GeneratePublic()),
 		node.WithBlockProcessor(blockProcessor),
-		node.WithDataPool(createTestShardDataPool()),
+		node.WithDataPool(dPool),
 		node.WithDataStore(createTestStore()),
 		node.WithResolversFinder(resolverFinder),
 		node.WithConsensusType(consensusType),
@@ -422,7 +485,7 @@ func createConsensusOnlyNode(
 		fmt.Println(err.Error())
 	}
 
-	return n, messenger, blockProcessor, blockChain
+	return n, messenger, blockProcessor, blockChain, dPool
 }
 
 func createNodes(
@@ -462,7 +525,7 @@ func createNodes(
 		}
 		nodesCoordinator, _ := sharding.NewIndexHashedNodesCoordinator(argumentsNodesCoordinator)
 
-		n, mes, blkProcessor, blkc := createConsensusOnlyNode(
+		n, mes, blkProcessor, blkc, dPool := createConsensusOnlyNode(
 			shardCoordinator,
 			nodesCoordinator,
 			testNode.shardId,
@@ -483,6 +546,7 @@ func createNodes(
 		testNode.pk = kp.pk
 		testNode.blkProcessor = blkProcessor
 		testNode.blkc = blkc
+		testNode.dPool = dPool
 
 		nodesList[i] = testNode
 	}
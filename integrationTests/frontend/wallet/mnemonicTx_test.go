@@ -0,0 +1,70 @@
+package wallet
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber/singlesig"
+	"github.com/ElrondNetwork/elrond-go/crypto/wallet"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/integrationTests"
+	"github.com/stretchr/testify/assert"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+// TestInterceptedTxSignedWithKeyDerivedFromMnemonic tests that a tx signed with a key derived from a mnemonic phrase
+// passes through an interceptor and ends up in the datapool
+func TestInterceptedTxSignedWithKeyDerivedFromMnemonic(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	chDone := make(chan struct{})
+
+	node := integrationTests.NewTestProcessorNode(1, 0, 0, "nodeAddr")
+
+	seed, err := wallet.SeedFromMnemonic(testMnemonic, "")
+	assert.Nil(t, err)
+
+	keyGen := signing.NewKeyGenerator(kyber.NewBlakeSHA256Ed25519())
+	sender, err := wallet.DeriveAccount(keyGen, integrationTests.TestAddressConverter, seed, "m/44'/508'/0'/0'/0'")
+	assert.Nil(t, err)
+	receiver, err := wallet.DeriveAccount(keyGen, integrationTests.TestAddressConverter, seed, "m/44'/508'/0'/0'/1'")
+	assert.Nil(t, err)
+
+	integrationTests.MintAddress(node.AccntState, sender.Address.Bytes(), big.NewInt(20000))
+
+	tx := &transaction.Transaction{
+		Nonce:    0,
+		Value:    big.NewInt(10),
+		RcvAddr:  receiver.Address.Bytes(),
+		SndAddr:  sender.Address.Bytes(),
+		GasPrice: 10,
+		GasLimit: 1000,
+	}
+	txBuff, _ := integrationTests.TestMarshalizer.Marshal(tx)
+	tx.Signature, err = (&singlesig.SchnorrSigner{}).Sign(sender.PrivateKey, txBuff)
+	assert.Nil(t, err)
+
+	node.ShardDataPool.Transactions().RegisterHandler(func(key []byte) {
+		dataRecovered, _ := node.ShardDataPool.Transactions().SearchFirstData(key)
+		txRecovered, ok := dataRecovered.(*transaction.Transaction)
+		assert.True(t, ok)
+		assert.Equal(t, tx.Signature, txRecovered.Signature)
+
+		chDone <- struct{}{}
+	})
+
+	_, err = node.SendTransaction(tx)
+	assert.Nil(t, err)
+
+	select {
+	case <-chDone:
+	case <-time.After(time.Second * 2):
+		assert.Fail(t, "timeout getting transaction")
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/recorder"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
 	"github.com/ElrondNetwork/elrond-go/core/partitioning"
 	"github.com/ElrondNetwork/elrond-go/crypto"
@@ -36,6 +37,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/requestHandlers"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/shardedData"
 	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/integrationTests"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/node"
@@ -54,6 +56,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/rewardTransaction"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/process/throttle"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
@@ -329,11 +332,12 @@ func createNetNode(
 		testHasher,
 		params.keyGen,
 		params.singleSigner,
-		testMultiSig,
+		&mock.HeaderSigVerifierStub{},
 		dPool,
 		testAddressConverter,
 		maxTxNonceDeltaAllowed,
 		createMockTxFeeHandler(),
+		integrationTests.CreateInterceptedDataVerifier(),
 	)
 	interceptorsContainer, err := interceptorContainerFactory.Create()
 	if err != nil {
@@ -453,6 +457,7 @@ func createNetNode(
 	)
 
 	genesisBlocks := createGenesisBlocks(shardCoordinator)
+	blockSizeThrottler, _ := throttle.NewBlockSizeThrottle()
 
 	arguments := block.ArgShardProcessor{
 		ArgBaseProcessor: block.ArgBaseProcessor{
@@ -478,10 +483,12 @@ func createNetNode(
 				shardCoordinator,
 				nodesCoordinator,
 			),
-			Uint64Converter: uint64Converter,
-			StartHeaders:    genesisBlocks,
-			RequestHandler:  requestHandler,
-			Core:            &mock.ServiceContainerMock{},
+			Uint64Converter:    uint64Converter,
+			StartHeaders:       genesisBlocks,
+			RequestHandler:     requestHandler,
+			Core:               &mock.ServiceContainerMock{},
+			BlockSizeThrottler: blockSizeThrottler,
+			HeaderSigVerifier:  &mock.HeaderSigVerifierStub{},
 		},
 		DataPool:        dPool,
 		TxCoordinator:   tc,
@@ -654,6 +661,8 @@ func createNodes(
 				shardCoordinator,
 				KeyPair.sk,
 				&singlesig.SchnorrSigner{},
+				testNode.dPool,
+				recorder.NewNilRoundRecorder(),
 			)
 
 			shardNodes[j] = testNode
@@ -789,7 +798,7 @@ func createMetaNetNode(
 		store,
 		testMarshalizer,
 		testHasher,
-		testMultiSig,
+		&mock.HeaderSigVerifierStub{},
 		dPool,
 		accntAdapter,
 		testAddressConverter,
@@ -797,6 +806,7 @@ func createMetaNetNode(
 		params.keyGen,
 		maxTxNonceDeltaAllowed,
 		feeHandler,
+		integrationTests.CreateInterceptedDataVerifier(),
 	)
 	interceptorsContainer, err := interceptorContainerFactory.Create()
 	if err != nil {
@@ -827,6 +837,7 @@ func createMetaNetNode(
 	)
 
 	genesisBlocks := createGenesisBlocks(shardCoordinator)
+	blockSizeThrottler, _ := throttle.NewBlockSizeThrottle()
 
 	arguments := block.ArgMetaProcessor{
 		ArgBaseProcessor: block.ArgBaseProcessor{
@@ -852,10 +863,12 @@ func createMetaNetNode(
 				shardCoordinator,
 				nodesCoordinator,
 			),
-			Uint64Converter: uint64Converter,
-			StartHeaders:    genesisBlocks,
-			RequestHandler:  requestHandler,
-			Core:            &mock.ServiceContainerMock{},
+			Uint64Converter:    uint64Converter,
+			StartHeaders:       genesisBlocks,
+			RequestHandler:     requestHandler,
+			Core:               &mock.ServiceContainerMock{},
+			BlockSizeThrottler: blockSizeThrottler,
+			HeaderSigVerifier:  &mock.HeaderSigVerifierStub{},
 		},
 		DataPool: dPool,
 	}
@@ -871,6 +884,8 @@ func createMetaNetNode(
 		shardCoordinator,
 		keyPair.sk,
 		params.singleSigner,
+		nil,
+		recorder.NewNilRoundRecorder(),
 	)
 
 	n, err := node.NewNode(
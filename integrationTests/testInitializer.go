@@ -40,10 +40,13 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p/loadBalancer"
 	"github.com/ElrondNetwork/elrond-go/process"
 	procFactory "github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/process/interceptors/pipeline"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
 	txProc "github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
 	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
@@ -464,6 +467,18 @@ func CreateNewDefaultTrie() data.Trie {
 	return tr
 }
 
+// CreateInterceptedDataVerifier returns a new verification pipeline used by the multi data interceptors
+func CreateInterceptedDataVerifier() process.InterceptedDataVerifier {
+	cacher, _ := lrucache.NewCache(10000)
+	verifier, _ := pipeline.NewVerificationPipeline(&pipeline.ArgVerificationPipeline{
+		NumWorkers:    4,
+		Cacher:        cacher,
+		StatusHandler: statusHandler.NewNilStatusHandler(),
+	})
+
+	return verifier
+}
+
 // GenerateRandomSlice returns a random byte slice with the given size
 func GenerateRandomSlice(size int) []byte {
 	buff := make([]byte, size)
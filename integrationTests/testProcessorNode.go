@@ -10,6 +10,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/recorder"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/partitioning"
@@ -42,6 +43,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/rewardTransaction"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/process/throttle"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-vm-common"
@@ -225,6 +227,8 @@ func (tpn *TestProcessorNode) initTestNode() {
 		tpn.ShardCoordinator,
 		tpn.OwnAccount.SkTxSign,
 		tpn.OwnAccount.SingleSigner,
+		tpn.ShardDataPool,
+		recorder.NewNilRoundRecorder(),
 	)
 	tpn.setGenesisBlock()
 	tpn.initNode()
@@ -294,7 +298,7 @@ func (tpn *TestProcessorNode) initInterceptors() {
 			tpn.Storage,
 			TestMarshalizer,
 			TestHasher,
-			TestMultiSig,
+			&mock.HeaderSigVerifierStub{},
 			tpn.MetaDataPool,
 			tpn.AccntState,
 			TestAddressConverter,
@@ -302,6 +306,7 @@ func (tpn *TestProcessorNode) initInterceptors() {
 			tpn.OwnAccount.KeygenTxSign,
 			maxTxNonceDeltaAllowed,
 			tpn.EconomicsData,
+			CreateInterceptedDataVerifier(),
 		)
 
 		tpn.InterceptorsContainer, err = interceptorContainerFactory.Create()
@@ -319,11 +324,12 @@ func (tpn *TestProcessorNode) initInterceptors() {
 			TestHasher,
 			tpn.OwnAccount.KeygenTxSign,
 			tpn.OwnAccount.SingleSigner,
-			TestMultiSig,
+			&mock.HeaderSigVerifierStub{},
 			tpn.ShardDataPool,
 			TestAddressConverter,
 			maxTxNonceDeltaAllowed,
 			tpn.EconomicsData,
+			CreateInterceptedDataVerifier(),
 		)
 
 		tpn.InterceptorsContainer, err = interceptorContainerFactory.Create()
@@ -494,6 +500,7 @@ func (tpn *TestProcessorNode) initBlockProcessor() {
 		},
 	}
 
+	blockSizeThrottler, _ := throttle.NewBlockSizeThrottle()
 	argumentsBase := block.ArgBaseProcessor{
 		Accounts:              tpn.AccntState,
 		ForkDetector:          tpn.ForkDetector,
@@ -507,6 +514,8 @@ func (tpn *TestProcessorNode) initBlockProcessor() {
 		StartHeaders:          tpn.GenesisBlocks,
 		RequestHandler:        tpn.RequestHandler,
 		Core:                  nil,
+		BlockSizeThrottler:    blockSizeThrottler,
+		HeaderSigVerifier:     &mock.HeaderSigVerifierStub{},
 	}
 
 	if tpn.ShardCoordinator.SelfId() == sharding.MetachainShardId {
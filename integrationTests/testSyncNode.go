@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/ElrondNetwork/elrond-go/consensus/recorder"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
 	"github.com/ElrondNetwork/elrond-go/process/block"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/sync"
+	"github.com/ElrondNetwork/elrond-go/process/throttle"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
@@ -68,6 +70,8 @@ func (tpn *TestProcessorNode) initTestNodeWithSync() {
 		tpn.ShardCoordinator,
 		tpn.OwnAccount.SkTxSign,
 		tpn.OwnAccount.SingleSigner,
+		tpn.ShardDataPool,
+		recorder.NewNilRoundRecorder(),
 	)
 	tpn.initBootstrapper()
 	tpn.setGenesisBlock()
@@ -79,6 +83,7 @@ func (tpn *TestProcessorNode) initTestNodeWithSync() {
 func (tpn *TestProcessorNode) initBlockProcessorWithSync() {
 	var err error
 
+	blockSizeThrottler, _ := throttle.NewBlockSizeThrottle()
 	argumentsBase := block.ArgBaseProcessor{
 		Accounts:              tpn.AccntState,
 		ForkDetector:          nil,
@@ -92,6 +97,8 @@ func (tpn *TestProcessorNode) initBlockProcessorWithSync() {
 		StartHeaders:          tpn.GenesisBlocks,
 		RequestHandler:        tpn.RequestHandler,
 		Core:                  nil,
+		BlockSizeThrottler:    blockSizeThrottler,
+		HeaderSigVerifier:     &mock.HeaderSigVerifierStub{},
 	}
 
 	if tpn.ShardCoordinator.SelfId() == sharding.MetachainShardId {
@@ -0,0 +1,17 @@
+package lightClient
+
+import (
+	"errors"
+)
+
+// ErrNilArguments signals that nil arguments have been provided
+var ErrNilArguments = errors.New("nil arguments")
+
+// ErrNilHeader signals that a nil header has been provided
+var ErrNilHeader = errors.New("nil header")
+
+// ErrNilPreviousHeader signals that a nil previous header has been provided
+var ErrNilPreviousHeader = errors.New("nil previous header")
+
+// ErrHeaderNotChained signals that a header does not follow the provided previous header
+var ErrHeaderNotChained = errors.New("header does not follow the previous header")
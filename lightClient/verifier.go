@@ -0,0 +1,140 @@
+package lightClient
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process/headerCheck"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// ArgVerifier holds all dependencies required by the light client verifier. NodesCoordinator holds the validator
+// set the consensus groups are selected from, for example an index hashed nodes coordinator created with the
+// validators of the chain. MultiSigVerifier must be set up with the same hasher the validators are using
+type ArgVerifier struct {
+	Marshalizer       marshal.Marshalizer
+	Hasher            hashing.Hasher
+	NodesCoordinator  sharding.NodesCoordinator
+	MultiSigVerifier  crypto.MultiSigVerifier
+	SingleSigVerifier crypto.SingleSigner
+	KeyGen            crypto.KeyGenerator
+}
+
+// headerSigVerifier defines the checks made on the signatures of a header
+type headerSigVerifier interface {
+	VerifyRandSeed(header data.HeaderHandler) error
+	VerifySignature(header data.HeaderHandler) error
+}
+
+// Verifier allows a client that does not hold the state of the chain to check the headers it receives against the
+// validator set and the accounts it receives against the root hash of a verified header, without trusting the node
+// providing them
+type Verifier struct {
+	marshalizer    marshal.Marshalizer
+	hasher         hashing.Hasher
+	headerVerifier headerSigVerifier
+}
+
+// NewVerifier creates a new light client verifier
+func NewVerifier(args *ArgVerifier) (*Verifier, error) {
+	if args == nil {
+		return nil, ErrNilArguments
+	}
+
+	headerVerifier, err := headerCheck.NewHeaderSigVerifier(&headerCheck.ArgsHeaderSigVerifier{
+		Marshalizer:       args.Marshalizer,
+		Hasher:            args.Hasher,
+		NodesCoordinator:  args.NodesCoordinator,
+		MultiSigVerifier:  args.MultiSigVerifier,
+		SingleSigVerifier: args.SingleSigVerifier,
+		KeyGen:            args.KeyGen,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Verifier{
+		marshalizer:    args.Marshalizer,
+		hasher:         args.Hasher,
+		headerVerifier: headerVerifier,
+	}, nil
+}
+
+// VerifyHeader checks that the header was signed by the consensus group selected from the validator set for its
+// round and shard, and that its rand seed was produced by the block proposer. As the consensus group is selected
+// using the previous rand seed of the header, this value should come from an already trusted header, which is
+// what VerifyHeaderChain does
+func (v *Verifier) VerifyHeader(header data.HeaderHandler) error {
+	if check.IfNil(header) {
+		return ErrNilHeader
+	}
+
+	err := v.headerVerifier.VerifyRandSeed(header)
+	if err != nil {
+		return err
+	}
+
+	return v.headerVerifier.VerifySignature(header)
+}
+
+// VerifyHeaderChain checks that the header directly follows the already trusted previous header and verifies it
+// with VerifyHeader
+func (v *Verifier) VerifyHeaderChain(prevHeader data.HeaderHandler, header data.HeaderHandler) error {
+	if check.IfNil(prevHeader) {
+		return ErrNilPreviousHeader
+	}
+	if check.IfNil(header) {
+		return ErrNilHeader
+	}
+
+	prevHash, err := core.CalculateHash(v.marshalizer, v.hasher, prevHeader)
+	if err != nil {
+		return err
+	}
+
+	isChained := bytes.Equal(header.GetPrevHash(), prevHash) &&
+		bytes.Equal(header.GetPrevRandSeed(), prevHeader.GetRandSeed()) &&
+		header.GetNonce() == prevHeader.GetNonce()+1 &&
+		header.GetShardID() == prevHeader.GetShardID()
+	if !isChained {
+		return ErrHeaderNotChained
+	}
+
+	return v.VerifyHeader(header)
+}
+
+// VerifyAccount checks the Merkle proof of the account at the provided address against the root hash of the header
+// and returns the proven account. The header itself should have been checked with VerifyHeader
+func (v *Verifier) VerifyAccount(header data.HeaderHandler, address []byte, proof [][]byte) (*state.Account, error) {
+	if check.IfNil(header) {
+		return nil, ErrNilHeader
+	}
+
+	value, err := trie.VerifyProofAndGetValue(header.GetRootHash(), proof, address, v.marshalizer, v.hasher)
+	if err != nil {
+		return nil, err
+	}
+
+	account := &state.Account{}
+	err = v.marshalizer.Unmarshal(account, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (v *Verifier) IsInterfaceNil() bool {
+	if v == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,267 @@
+package lightClient_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber"
+	llsig "github.com/ElrondNetwork/elrond-go/crypto/signing/kyber/multisig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber/singlesig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/multisig"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/lightClient"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+)
+
+const numValidators = 6
+const consensusSize = 4
+
+var testMarshalizer = &marshal.JsonMarshalizer{}
+var testHasher = blake2b.Blake2b{}
+var multiSigHasher = blake2b.Blake2b{HashSize: 16}
+var keyGen = signing.NewKeyGenerator(kyber.NewSuitePairingBn256())
+
+type validatorSet struct {
+	privKeys         map[string]crypto.PrivateKey
+	nodesCoordinator sharding.NodesCoordinator
+}
+
+func createValidatorSet() *validatorSet {
+	set := &validatorSet{privKeys: make(map[string]crypto.PrivateKey)}
+
+	validators := make([]sharding.Validator, 0, numValidators)
+	for i := 0; i < numValidators; i++ {
+		privKey, pubKey := keyGen.GeneratePair()
+		pubKeyBytes, _ := pubKey.ToByteArray()
+		set.privKeys[string(pubKeyBytes)] = privKey
+
+		v, _ := sharding.NewValidator(big.NewInt(0), 1, pubKeyBytes, pubKeyBytes)
+		validators = append(validators, v)
+	}
+
+	set.nodesCoordinator, _ = sharding.NewIndexHashedNodesCoordinator(sharding.ArgNodesCoordinator{
+		ShardConsensusGroupSize: consensusSize,
+		MetaConsensusGroupSize:  1,
+		Hasher:                  testHasher,
+		NbShards:                1,
+		Nodes:                   map[uint32][]sharding.Validator{0: validators},
+		SelfPublicKey:           []byte("light client"),
+	})
+
+	return set
+}
+
+func createMultiSigner(pubKeys []string, privKey crypto.PrivateKey, index uint16) crypto.MultiSigner {
+	multiSigner, _ := multisig.NewBLSMultisig(&llsig.KyberMultiSignerBLS{}, multiSigHasher, pubKeys, privKey, keyGen, index)
+	return multiSigner
+}
+
+func createArguments(set *validatorSet) *lightClient.ArgVerifier {
+	// the light client is not a validator so any key pair can back its multi signature verifier
+	privKey, pubKey := keyGen.GeneratePair()
+	pubKeyBytes, _ := pubKey.ToByteArray()
+
+	return &lightClient.ArgVerifier{
+		Marshalizer:       testMarshalizer,
+		Hasher:            testHasher,
+		NodesCoordinator:  set.nodesCoordinator,
+		MultiSigVerifier:  createMultiSigner([]string{string(pubKeyBytes)}, privKey, 0),
+		SingleSigVerifier: &singlesig.BlsSingleSigner{},
+		KeyGen:            keyGen,
+	}
+}
+
+// signHeader fills the rand seed and the aggregated signature of the first numSigners members of the consensus
+// group, as the validators do
+func signHeader(set *validatorSet, header *block.Header, numSigners int) {
+	group, _ := set.nodesCoordinator.GetValidatorsPublicKeys(header.PrevRandSeed, header.Round, header.ShardId)
+
+	header.RandSeed, _ = (&singlesig.BlsSingleSigner{}).Sign(set.privKeys[group[0]], header.PrevRandSeed)
+	hash, _ := core.CalculateHash(testMarshalizer, testHasher, header)
+
+	bitmap := make([]byte, (len(group)+7)/8)
+	aggregator := createMultiSigner(group, set.privKeys[group[0]], 0)
+	for i := 0; i < numSigners; i++ {
+		bitmap[i/8] |= 1 << uint8(i%8)
+
+		sigShare, _ := createMultiSigner(group, set.privKeys[group[i]], uint16(i)).CreateSignatureShare(hash, nil)
+		_ = aggregator.StoreSignatureShare(uint16(i), sigShare)
+	}
+
+	header.Signature, _ = aggregator.AggregateSigs(bitmap)
+	header.PubKeysBitmap = bitmap
+}
+
+func createSignedHeader(set *validatorSet, rootHash []byte) *block.Header {
+	header := &block.Header{
+		Nonce:        5,
+		Round:        7,
+		PrevHash:     []byte("prev hash"),
+		PrevRandSeed: []byte("prev rand seed"),
+		RootHash:     rootHash,
+	}
+	signHeader(set, header, consensusSize)
+
+	return header
+}
+
+func createAccountsTrie(address []byte, account *state.Account) ([]byte, [][]byte) {
+	cache, _ := lrucache.NewCache(100)
+	persister, _ := memorydb.New()
+	storer, _ := storageUnit.NewStorageUnit(cache, persister)
+	tr, _ := trie.NewTrie(storer, testMarshalizer, testHasher)
+
+	buff, _ := testMarshalizer.Marshal(account)
+	_ = tr.Update(address, buff)
+	_ = tr.Update([]byte("other address"), buff)
+	_ = tr.Commit()
+
+	rootHash, _ := tr.Root()
+	proof, _ := tr.Prove(address)
+
+	return rootHash, proof
+}
+
+//------- NewVerifier
+
+func TestNewVerifier_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	v, err := lightClient.NewVerifier(nil)
+	assert.Nil(t, v)
+	assert.Equal(t, lightClient.ErrNilArguments, err)
+
+	args := createArguments(createValidatorSet())
+	args.NodesCoordinator = nil
+	v, err = lightClient.NewVerifier(args)
+	assert.Nil(t, v)
+	assert.Equal(t, process.ErrNilNodesCoordinator, err)
+}
+
+func TestNewVerifier_OkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	v, err := lightClient.NewVerifier(createArguments(createValidatorSet()))
+	assert.Nil(t, err)
+	assert.False(t, v.IsInterfaceNil())
+}
+
+//------- VerifyHeader
+
+func TestVerifier_VerifyHeaderSignedByTheConsensusGroupShouldWork(t *testing.T) {
+	t.Parallel()
+
+	set := createValidatorSet()
+	v, _ := lightClient.NewVerifier(createArguments(set))
+
+	err := v.VerifyHeader(createSignedHeader(set, []byte("root hash")))
+	assert.Nil(t, err)
+}
+
+func TestVerifier_VerifyHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	set := createValidatorSet()
+	v, _ := lightClient.NewVerifier(createArguments(set))
+
+	err := v.VerifyHeader(nil)
+	assert.Equal(t, lightClient.ErrNilHeader, err)
+
+	header := createSignedHeader(set, []byte("root hash"))
+	header.RootHash = []byte("forged root hash")
+	err = v.VerifyHeader(header)
+	assert.NotNil(t, err)
+
+	header = createSignedHeader(set, []byte("root hash"))
+	signHeader(set, header, consensusSize*2/3)
+	err = v.VerifyHeader(header)
+	assert.Equal(t, process.ErrNotEnoughSignaturesInBitmap, err)
+
+	otherSet := createValidatorSet()
+	err = v.VerifyHeader(createSignedHeader(otherSet, []byte("root hash")))
+	assert.NotNil(t, err)
+}
+
+//------- VerifyHeaderChain
+
+func TestVerifier_VerifyHeaderChain(t *testing.T) {
+	t.Parallel()
+
+	set := createValidatorSet()
+	v, _ := lightClient.NewVerifier(createArguments(set))
+
+	prevHeader := createSignedHeader(set, []byte("root hash"))
+	prevHash, _ := core.CalculateHash(testMarshalizer, testHasher, prevHeader)
+
+	header := &block.Header{
+		Nonce:        prevHeader.Nonce + 1,
+		Round:        prevHeader.Round + 1,
+		PrevHash:     prevHash,
+		PrevRandSeed: prevHeader.RandSeed,
+		RootHash:     []byte("next root hash"),
+	}
+	signHeader(set, header, consensusSize)
+
+	err := v.VerifyHeaderChain(prevHeader, header)
+	assert.Nil(t, err)
+
+	err = v.VerifyHeaderChain(nil, header)
+	assert.Equal(t, lightClient.ErrNilPreviousHeader, err)
+
+	header.PrevRandSeed = []byte("chosen rand seed")
+	signHeader(set, header, consensusSize)
+	err = v.VerifyHeaderChain(prevHeader, header)
+	assert.Equal(t, lightClient.ErrHeaderNotChained, err)
+}
+
+//------- VerifyAccount
+
+func TestVerifier_VerifyAccountShouldReturnTheProvenAccount(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("account address")
+	rootHash, proof := createAccountsTrie(address, &state.Account{Nonce: 3, Balance: big.NewInt(1000)})
+
+	set := createValidatorSet()
+	v, _ := lightClient.NewVerifier(createArguments(set))
+	header := createSignedHeader(set, rootHash)
+
+	account, err := v.VerifyAccount(header, address, proof)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(3), account.Nonce)
+	assert.Equal(t, big.NewInt(1000), account.Balance)
+}
+
+func TestVerifier_VerifyAccountInvalidProofShouldErr(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("account address")
+	rootHash, proof := createAccountsTrie(address, &state.Account{Nonce: 3, Balance: big.NewInt(1000)})
+
+	set := createValidatorSet()
+	v, _ := lightClient.NewVerifier(createArguments(set))
+
+	account, err := v.VerifyAccount(nil, address, proof)
+	assert.Nil(t, account)
+	assert.Equal(t, lightClient.ErrNilHeader, err)
+
+	account, err = v.VerifyAccount(createSignedHeader(set, []byte("other root hash")), address, proof)
+	assert.Nil(t, account)
+	assert.Equal(t, trie.ErrInvalidProof, err)
+
+	account, err = v.VerifyAccount(createSignedHeader(set, rootHash), []byte("other address"), proof)
+	assert.Nil(t, account)
+	assert.Equal(t, trie.ErrInvalidProof, err)
+}
@@ -0,0 +1,18 @@
+package node
+
+// GetAccountProof returns the Merkle proof of an account in the accounts trie committed under the provided root hash
+func (n *Node) GetAccountProof(address string, rootHash []byte) ([][]byte, error) {
+	if n.addrConverter == nil || n.addrConverter.IsInterfaceNil() {
+		return nil, ErrNilAddressConverter
+	}
+	if n.accounts == nil || n.accounts.IsInterfaceNil() {
+		return nil, ErrNilAccountsAdapter
+	}
+
+	addr, err := n.addrConverter.CreateAddressFromHex(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.accounts.GetProof(addr, rootHash)
+}
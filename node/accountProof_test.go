@@ -0,0 +1,60 @@
+package node_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNode_GetAccountProofNilAccountsAdapterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(node.WithAddressConverter(mock.NewAddressConverterFake(32, "")))
+
+	proof, err := n.GetAccountProof(createDummyHexAddress(64), []byte("root hash"))
+	assert.Nil(t, proof)
+	assert.Equal(t, node.ErrNilAccountsAdapter, err)
+}
+
+func TestNode_GetAccountProofInvalidAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithAccountsAdapter(&mock.AccountsStub{}),
+		node.WithAddressConverter(mock.NewAddressConverterFake(32, "")),
+	)
+
+	proof, err := n.GetAccountProof("not hex", []byte("root hash"))
+	assert.Nil(t, proof)
+	assert.NotNil(t, err)
+}
+
+func TestNode_GetAccountProofShouldProveAgainstTheProvidedRootHash(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	expectedProof := [][]byte{[]byte("node")}
+	errExpected := errors.New("expected error")
+	n, _ := node.NewNode(
+		node.WithAccountsAdapter(&mock.AccountsStub{
+			GetProofCalled: func(addressContainer state.AddressContainer, root []byte) ([][]byte, error) {
+				if string(root) != string(rootHash) {
+					return nil, errExpected
+				}
+				return expectedProof, nil
+			},
+		}),
+		node.WithAddressConverter(mock.NewAddressConverterFake(32, "")),
+	)
+
+	proof, err := n.GetAccountProof(createDummyHexAddress(64), rootHash)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedProof, proof)
+
+	_, err = n.GetAccountProof(createDummyHexAddress(64), []byte("other root hash"))
+	assert.Equal(t, errExpected, err)
+}
@@ -0,0 +1,61 @@
+package node
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+// GetAccountStorageValue returns the value stored under the provided key in the data trie of an account. An empty
+// value is returned if the key is missing or if the account has no data trie
+func (n *Node) GetAccountStorageValue(address string, key []byte) ([]byte, error) {
+	dataTrie, err := n.getDataTrie(address)
+	if err != nil || dataTrie == nil {
+		return nil, err
+	}
+
+	return dataTrie.Get(key)
+}
+
+// GetAccountStorage returns, in the order of their keys, at most limit key/value pairs of the data trie of an
+// account, having the keys greater than startKey. A nil startKey starts with the first pair
+func (n *Node) GetAccountStorage(address string, startKey []byte, limit int) ([]data.TrieLeaf, error) {
+	dataTrie, err := n.getDataTrie(address)
+	if err != nil {
+		return nil, err
+	}
+	if dataTrie == nil {
+		return make([]data.TrieLeaf, 0), nil
+	}
+
+	return dataTrie.GetLeaves(startKey, limit)
+}
+
+// getDataTrie returns the data trie of an account, or nil if the account does not exist or has no data trie
+func (n *Node) getDataTrie(address string) (data.Trie, error) {
+	if n.addrConverter == nil || n.addrConverter.IsInterfaceNil() {
+		return nil, ErrNilAddressConverter
+	}
+	if n.accounts == nil || n.accounts.IsInterfaceNil() {
+		return nil, ErrNilAccountsAdapter
+	}
+
+	addr, err := n.addrConverter.CreateAddressFromHex(address)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := n.accounts.GetExistingAccount(addr)
+	if err == state.ErrAccNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	dataTrie := account.DataTrie()
+	if dataTrie == nil || dataTrie.IsInterfaceNil() {
+		return nil, nil
+	}
+
+	return dataTrie, nil
+}
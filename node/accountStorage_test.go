@@ -0,0 +1,92 @@
+package node_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createNodeWithAccount(account state.AccountHandler, err error) *node.Node {
+	accDB := &mock.AccountsStub{
+		GetExistingAccountCalled: func(addressContainer state.AddressContainer) (state.AccountHandler, error) {
+			return account, err
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithAccountsAdapter(accDB),
+		node.WithAddressConverter(mock.NewAddressConverterFake(32, "")),
+	)
+
+	return n
+}
+
+func TestNode_GetAccountStorageValueNilAccountsAdapterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(node.WithAddressConverter(mock.NewAddressConverterFake(32, "")))
+
+	value, err := n.GetAccountStorageValue(createDummyHexAddress(64), []byte("key"))
+	assert.Nil(t, value)
+	assert.Equal(t, node.ErrNilAccountsAdapter, err)
+}
+
+func TestNode_GetAccountStorageValueMissingAccountShouldReturnEmpty(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithAccount(nil, state.ErrAccNotFound)
+
+	value, err := n.GetAccountStorageValue(createDummyHexAddress(64), []byte("key"))
+	assert.Nil(t, err)
+	assert.Nil(t, value)
+
+	pairs, err := n.GetAccountStorage(createDummyHexAddress(64), nil, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(pairs))
+}
+
+func TestNode_GetAccountStorageAccountsAdapterFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("expected error")
+	n := createNodeWithAccount(nil, errExpected)
+
+	_, err := n.GetAccountStorageValue(createDummyHexAddress(64), []byte("key"))
+	assert.Equal(t, errExpected, err)
+
+	_, err = n.GetAccountStorage(createDummyHexAddress(64), nil, 10)
+	assert.Equal(t, errExpected, err)
+}
+
+func TestNode_GetAccountStorageShouldReadTheDataTrie(t *testing.T) {
+	t.Parallel()
+
+	leaves := []data.TrieLeaf{{Key: []byte("key"), Value: []byte("value")}}
+	address, _ := mock.NewAddressConverterFake(32, "").CreateAddressFromPublicKeyBytes(make([]byte, 32))
+	account, _ := state.NewAccount(address, &mock.AccountTrackerStub{})
+	account.SetDataTrie(&mock.TrieStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			return append([]byte("value of "), key...), nil
+		},
+		GetLeavesCalled: func(startKey []byte, limit int) ([]data.TrieLeaf, error) {
+			if string(startKey) == "start" && limit == 5 {
+				return leaves, nil
+			}
+			return nil, errors.New("unexpected arguments")
+		},
+	})
+	n := createNodeWithAccount(account, nil)
+
+	value, err := n.GetAccountStorageValue(createDummyHexAddress(64), []byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value of key"), value)
+
+	pairs, err := n.GetAccountStorage(createDummyHexAddress(64), []byte("start"), 5)
+	assert.Nil(t, err)
+	assert.Equal(t, leaves, pairs)
+}
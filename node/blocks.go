@@ -0,0 +1,196 @@
+package node
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// NumberOfShards returns the number of shards of the network, the metachain excluded
+func (n *Node) NumberOfShards() uint32 {
+	if n.shardCoordinator == nil || n.shardCoordinator.IsInterfaceNil() {
+		return 0
+	}
+
+	return n.shardCoordinator.NumberOfShards()
+}
+
+// GetBlockByNonce returns the committed block of the given shard having the provided nonce, together with its hash.
+// A nil block is returned if no such block is found in the node's storage. The meta blocks are returned by
+// GetMetaBlockByNonce
+func (n *Node) GetBlockByNonce(shardId uint32, nonce uint64) (*block.Header, []byte, error) {
+	nonceToHashUnit, err := n.shardHdrNonceHashDataUnit(shardId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hash, err := n.getHashByNonce(nonceToHashUnit, nonce)
+	if err != nil || hash == nil {
+		return nil, nil, err
+	}
+
+	header, err := n.GetBlockByHash(hash)
+	if err != nil || header == nil {
+		return nil, nil, err
+	}
+
+	return header, hash, nil
+}
+
+// GetBlockByHash returns the block having the provided hash or nil if it is not found in the node's storage
+func (n *Node) GetBlockByHash(hash []byte) (*block.Header, error) {
+	header := &block.Header{}
+	found, err := n.getFromStorage(dataRetriever.BlockHeaderUnit, hash, header)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	return header, nil
+}
+
+// GetMetaBlockByNonce returns the committed meta block having the provided nonce, together with its hash.
+// A nil meta block is returned if no such block is found in the node's storage
+func (n *Node) GetMetaBlockByNonce(nonce uint64) (*block.MetaBlock, []byte, error) {
+	hash, err := n.getHashByNonce(dataRetriever.MetaHdrNonceHashDataUnit, nonce)
+	if err != nil || hash == nil {
+		return nil, nil, err
+	}
+
+	metaBlock, err := n.GetMetaBlockByHash(hash)
+	if err != nil || metaBlock == nil {
+		return nil, nil, err
+	}
+
+	return metaBlock, hash, nil
+}
+
+// GetMetaBlockByHash returns the meta block having the provided hash or nil if it is not found in the node's storage
+func (n *Node) GetMetaBlockByHash(hash []byte) (*block.MetaBlock, error) {
+	metaBlock := &block.MetaBlock{}
+	found, err := n.getFromStorage(dataRetriever.MetaBlockUnit, hash, metaBlock)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	return metaBlock, nil
+}
+
+// GetMiniBlock returns the miniblock having the provided hash or nil if it is not found in the node's storage
+func (n *Node) GetMiniBlock(hash []byte) (*block.MiniBlock, error) {
+	miniBlock := &block.MiniBlock{}
+	found, err := n.getFromStorage(dataRetriever.MiniBlockUnit, hash, miniBlock)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	return miniBlock, nil
+}
+
+// GetMiniBlockTransactions returns, mapped by their hashes, the transactions of a miniblock which are found in the
+// node's storage. Only the miniblocks of transactions, smart contract results and rewards hold transactions
+func (n *Node) GetMiniBlockTransactions(miniBlock *block.MiniBlock) (map[string]data.TransactionHandler, error) {
+	if miniBlock == nil {
+		return nil, ErrNilMiniBlock
+	}
+
+	txs := make(map[string]data.TransactionHandler, len(miniBlock.TxHashes))
+	for _, txHash := range miniBlock.TxHashes {
+		var unit dataRetriever.UnitType
+		var tx data.TransactionHandler
+
+		switch miniBlock.Type {
+		case block.TxBlock:
+			unit, tx = dataRetriever.TransactionUnit, &transaction.Transaction{}
+		case block.SmartContractResultBlock:
+			unit, tx = dataRetriever.UnsignedTransactionUnit, &smartContractResult.SmartContractResult{}
+		case block.RewardsBlock:
+			unit, tx = dataRetriever.RewardTransactionUnit, &rewardTx.RewardTx{}
+		default:
+			return txs, nil
+		}
+
+		found, err := n.getFromStorage(unit, txHash, tx)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			txs[string(txHash)] = tx
+		}
+	}
+
+	return txs, nil
+}
+
+// shardHdrNonceHashDataUnit returns the storage unit mapping the nonces of a shard's blocks to their hashes. Each
+// shard has its own unit, so the shard id has to be one of the network's shards
+func (n *Node) shardHdrNonceHashDataUnit(shardId uint32) (dataRetriever.UnitType, error) {
+	if n.shardCoordinator == nil || n.shardCoordinator.IsInterfaceNil() {
+		return 0, ErrNilShardCoordinator
+	}
+	if shardId >= n.shardCoordinator.NumberOfShards() {
+		return 0, ErrInvalidShardId
+	}
+
+	return dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(shardId), nil
+}
+
+func (n *Node) getHashByNonce(nonceToHashUnit dataRetriever.UnitType, nonce uint64) ([]byte, error) {
+	if n.uint64ByteSliceConverter == nil || n.uint64ByteSliceConverter.IsInterfaceNil() {
+		return nil, ErrNilUint64ByteSliceConverter
+	}
+
+	storer, err := n.getStorer(nonceToHashUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := storer.Get(n.uint64ByteSliceConverter.ToByteSlice(nonce))
+	if err != nil {
+		// the storers signal the missing keys through errors
+		return nil, nil
+	}
+
+	return hash, nil
+}
+
+// getFromStorage loads and unmarshals the object saved under the provided key, returning false if the key is not found
+func (n *Node) getFromStorage(unit dataRetriever.UnitType, key []byte, obj interface{}) (bool, error) {
+	if n.marshalizer == nil || n.marshalizer.IsInterfaceNil() {
+		return false, ErrNilMarshalizer
+	}
+
+	storer, err := n.getStorer(unit)
+	if err != nil {
+		return false, err
+	}
+
+	buff, err := storer.Get(key)
+	if err != nil {
+		// the storers signal the missing keys through errors
+		return false, nil
+	}
+
+	err = n.marshalizer.Unmarshal(obj, buff)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (n *Node) getStorer(unit dataRetriever.UnitType) (storage.Storer, error) {
+	if n.store == nil || n.store.IsInterfaceNil() {
+		return nil, ErrNilStore
+	}
+
+	storer := n.store.GetStorer(unit)
+	if storer == nil || storer.IsInterfaceNil() {
+		return nil, dataRetriever.ErrNoSuchStorageUnit
+	}
+
+	return storer, nil
+}
@@ -0,0 +1,200 @@
+package node_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+)
+
+func createBlocksStore() dataRetriever.StorageService {
+	store := dataRetriever.NewChainStorer()
+	store.AddStorer(dataRetriever.BlockHeaderUnit, mock.NewStorerMock())
+	store.AddStorer(dataRetriever.MetaBlockUnit, mock.NewStorerMock())
+	store.AddStorer(dataRetriever.MiniBlockUnit, mock.NewStorerMock())
+	store.AddStorer(dataRetriever.TransactionUnit, mock.NewStorerMock())
+	store.AddStorer(dataRetriever.RewardTransactionUnit, mock.NewStorerMock())
+	store.AddStorer(dataRetriever.ShardHdrNonceHashDataUnit+dataRetriever.UnitType(1), mock.NewStorerMock())
+	store.AddStorer(dataRetriever.MetaHdrNonceHashDataUnit, mock.NewStorerMock())
+
+	return store
+}
+
+func createNodeForBlocks(store dataRetriever.StorageService) *node.Node {
+	n, _ := node.NewNode(
+		node.WithDataStore(store),
+		node.WithMarshalizer(&mock.MarshalizerFake{}),
+		node.WithUint64ByteSliceConverter(uint64ByteSlice.NewBigEndianConverter()),
+		node.WithShardCoordinator(mock.NewMultiShardsCoordinatorMock(2)),
+	)
+
+	return n
+}
+
+func putInStore(t *testing.T, store dataRetriever.StorageService, unit dataRetriever.UnitType, key []byte, obj interface{}) {
+	buff, err := (&mock.MarshalizerFake{}).Marshal(obj)
+	assert.Nil(t, err)
+
+	err = store.Put(unit, key, buff)
+	assert.Nil(t, err)
+}
+
+func TestNode_GetBlockByNonceShouldWork(t *testing.T) {
+	t.Parallel()
+
+	store := createBlocksStore()
+	hash := []byte("hash")
+	header := &block.Header{Nonce: 7, ShardId: 1, Round: 8}
+	putInStore(t, store, dataRetriever.BlockHeaderUnit, hash, header)
+	converter := uint64ByteSlice.NewBigEndianConverter()
+	_ = store.Put(dataRetriever.ShardHdrNonceHashDataUnit+dataRetriever.UnitType(1), converter.ToByteSlice(7), hash)
+
+	n := createNodeForBlocks(store)
+	recovered, recoveredHash, err := n.GetBlockByNonce(1, 7)
+
+	assert.Nil(t, err)
+	assert.Equal(t, hash, recoveredHash)
+	assert.Equal(t, header, recovered)
+}
+
+func TestNode_GetBlockByNonceNotFoundShouldReturnNil(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForBlocks(createBlocksStore())
+	recovered, recoveredHash, err := n.GetBlockByNonce(1, 7)
+
+	assert.Nil(t, err)
+	assert.Nil(t, recoveredHash)
+	assert.Nil(t, recovered)
+}
+
+func TestNode_GetBlockByNonceUnknownShardShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForBlocks(createBlocksStore())
+	recovered, _, err := n.GetBlockByNonce(5, 7)
+
+	assert.Equal(t, node.ErrInvalidShardId, err)
+	assert.Nil(t, recovered)
+}
+
+func TestNode_GetBlockByNonceMetachainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	store := createBlocksStore()
+	hash := []byte("hash")
+	putInStore(t, store, dataRetriever.MetaBlockUnit, hash, &block.MetaBlock{Nonce: 7})
+	converter := uint64ByteSlice.NewBigEndianConverter()
+	_ = store.Put(dataRetriever.MetaHdrNonceHashDataUnit, converter.ToByteSlice(7), hash)
+
+	n := createNodeForBlocks(store)
+	recovered, _, err := n.GetBlockByNonce(sharding.MetachainShardId, 7)
+
+	assert.Equal(t, node.ErrInvalidShardId, err)
+	assert.Nil(t, recovered)
+}
+
+func TestNode_GetBlockByNonceNilShardCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithDataStore(createBlocksStore()),
+		node.WithMarshalizer(&mock.MarshalizerFake{}),
+		node.WithUint64ByteSliceConverter(uint64ByteSlice.NewBigEndianConverter()),
+	)
+	recovered, _, err := n.GetBlockByNonce(0, 7)
+
+	assert.Equal(t, node.ErrNilShardCoordinator, err)
+	assert.Nil(t, recovered)
+}
+
+func TestNode_GetBlockByHashNilStoreShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(node.WithMarshalizer(getMarshalizer()))
+	recovered, err := n.GetBlockByHash([]byte("hash"))
+
+	assert.Equal(t, node.ErrNilStore, err)
+	assert.Nil(t, recovered)
+}
+
+func TestNode_GetMetaBlockByNonceShouldWork(t *testing.T) {
+	t.Parallel()
+
+	store := createBlocksStore()
+	hash := []byte("meta hash")
+	metaBlock := &block.MetaBlock{
+		Nonce: 3,
+		ShardInfo: []block.ShardData{
+			{ShardId: 1, HeaderHash: []byte("hdr hash"), TxCount: 2},
+		},
+	}
+	putInStore(t, store, dataRetriever.MetaBlockUnit, hash, metaBlock)
+	converter := uint64ByteSlice.NewBigEndianConverter()
+	_ = store.Put(dataRetriever.MetaHdrNonceHashDataUnit, converter.ToByteSlice(3), hash)
+
+	n := createNodeForBlocks(store)
+	recovered, recoveredHash, err := n.GetMetaBlockByNonce(3)
+
+	assert.Nil(t, err)
+	assert.Equal(t, hash, recoveredHash)
+	assert.Equal(t, metaBlock, recovered)
+}
+
+func TestNode_GetMiniBlockTransactionsNilMiniBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForBlocks(createBlocksStore())
+	txs, err := n.GetMiniBlockTransactions(nil)
+
+	assert.Equal(t, node.ErrNilMiniBlock, err)
+	assert.Nil(t, txs)
+}
+
+func TestNode_GetMiniBlockTransactionsShouldReturnTheStoredTransactions(t *testing.T) {
+	t.Parallel()
+
+	store := createBlocksStore()
+	tx := &transaction.Transaction{Nonce: 1, Value: big.NewInt(10), SndAddr: []byte("snd"), RcvAddr: []byte("rcv")}
+	putInStore(t, store, dataRetriever.TransactionUnit, []byte("tx1"), tx)
+	miniBlock := &block.MiniBlock{
+		TxHashes: [][]byte{[]byte("tx1"), []byte("missing tx")},
+		Type:     block.TxBlock,
+	}
+
+	n := createNodeForBlocks(store)
+	txs, err := n.GetMiniBlockTransactions(miniBlock)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(txs))
+	assert.Equal(t, tx, txs["tx1"])
+}
+
+func TestNode_GetMiniBlockTransactionsShouldReadRewardsFromTheirUnit(t *testing.T) {
+	t.Parallel()
+
+	store := createBlocksStore()
+	reward := &rewardTx.RewardTx{Round: 2, Value: big.NewInt(5), RcvAddr: []byte("rcv")}
+	putInStore(t, store, dataRetriever.RewardTransactionUnit, []byte("reward"), reward)
+	putInStore(t, store, dataRetriever.MiniBlockUnit, []byte("mb"), &block.MiniBlock{
+		TxHashes: [][]byte{[]byte("reward")},
+		Type:     block.RewardsBlock,
+	})
+
+	n := createNodeForBlocks(store)
+	miniBlock, err := n.GetMiniBlock([]byte("mb"))
+	assert.Nil(t, err)
+
+	txs, err := n.GetMiniBlockTransactions(miniBlock)
+
+	assert.Nil(t, err)
+	assert.Equal(t, reward, txs["reward"])
+}
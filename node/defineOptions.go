@@ -1,6 +1,7 @@
 package node
 
 import (
+	"io"
 	"math/big"
 	"time"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/remote"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
@@ -140,6 +142,17 @@ func WithKeyGen(keyGen crypto.KeyGenerator) Option {
 	}
 }
 
+// WithTxSignKeyGen sets up the key generator of the transaction signing keys option for the Node
+func WithTxSignKeyGen(keyGen crypto.KeyGenerator) Option {
+	return func(n *Node) error {
+		if keyGen == nil || keyGen.IsInterfaceNil() {
+			return ErrNilSingleSignKeyGen
+		}
+		n.txSignKeyGen = keyGen
+		return nil
+	}
+}
+
 // WithInitialNodesPubKeys sets up the initial nodes public key option for the Node
 func WithInitialNodesPubKeys(pubKeys map[uint32][]string) Option {
 	return func(n *Node) error {
@@ -398,3 +411,36 @@ func WithIndexer(indexer indexer.Indexer) Option {
 		return nil
 	}
 }
+
+// WithConsensusRecordWriter sets up the writer in which the consensus rounds will be recorded
+func WithConsensusRecordWriter(consensusRecordWriter io.WriteCloser) Option {
+	return func(n *Node) error {
+		if consensusRecordWriter == nil {
+			return ErrNilConsensusRecordWriter
+		}
+		n.consensusRecordWriter = consensusRecordWriter
+		return nil
+	}
+}
+
+// WithHeaderProviderSetter sets up the block signer which has to be given the block header held by the consensus state
+func WithHeaderProviderSetter(headerProviderSetter remote.HeaderProviderSetter) Option {
+	return func(n *Node) error {
+		if headerProviderSetter == nil || headerProviderSetter.IsInterfaceNil() {
+			return ErrNilHeaderProviderSetter
+		}
+		n.headerProviderSetter = headerProviderSetter
+		return nil
+	}
+}
+
+// WithFeeHandler sets up the fee handler checking the gas values of the transactions
+func WithFeeHandler(feeHandler process.FeeHandler) Option {
+	return func(n *Node) error {
+		if feeHandler == nil || feeHandler.IsInterfaceNil() {
+			return ErrNilFeeHandler
+		}
+		n.feeHandler = feeHandler
+		return nil
+	}
+}
@@ -273,6 +273,32 @@ func TestWithSingleSignKeyGenerator_ShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestWithTxSignKeyGen_NilKeyGenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithTxSignKeyGen(nil)
+	err := opt(node)
+
+	assert.Nil(t, node.txSignKeyGen)
+	assert.Equal(t, ErrNilSingleSignKeyGen, err)
+}
+
+func TestWithTxSignKeyGen_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	keyGen := &mock.KeyGenMock{}
+
+	opt := WithTxSignKeyGen(keyGen)
+	err := opt(node)
+
+	assert.True(t, node.txSignKeyGen == keyGen)
+	assert.Nil(t, err)
+}
+
 func TestWithInitialNodesPubKeys(t *testing.T) {
 	t.Parallel()
 
@@ -802,3 +828,78 @@ func TestWithIndexer_ShouldWork(t *testing.T) {
 	assert.Equal(t, indexer, node.indexer)
 	assert.Nil(t, err)
 }
+
+func TestWithConsensusRecordWriter_NilWriterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithConsensusRecordWriter(nil)
+	err := opt(node)
+
+	assert.Nil(t, node.consensusRecordWriter)
+	assert.Equal(t, ErrNilConsensusRecordWriter, err)
+}
+
+func TestWithConsensusRecordWriter_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	writer := &mock.WriteCloserStub{}
+	opt := WithConsensusRecordWriter(writer)
+	err := opt(node)
+
+	assert.Equal(t, writer, node.consensusRecordWriter)
+	assert.Nil(t, err)
+}
+
+func TestWithHeaderProviderSetter_NilSetterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithHeaderProviderSetter(nil)
+	err := opt(node)
+
+	assert.Nil(t, node.headerProviderSetter)
+	assert.Equal(t, ErrNilHeaderProviderSetter, err)
+}
+
+func TestWithHeaderProviderSetter_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	headerProviderSetter := &mock.HeaderProviderSetterStub{}
+	opt := WithHeaderProviderSetter(headerProviderSetter)
+	err := opt(node)
+
+	assert.Equal(t, headerProviderSetter, node.headerProviderSetter)
+	assert.Nil(t, err)
+}
+
+func TestWithFeeHandler_NilFeeHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithFeeHandler(nil)
+	err := opt(node)
+
+	assert.Nil(t, node.feeHandler)
+	assert.Equal(t, ErrNilFeeHandler, err)
+}
+
+func TestWithFeeHandler_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	feeHandler := &mock.FeeHandlerStub{}
+	opt := WithFeeHandler(feeHandler)
+	err := opt(node)
+
+	assert.Equal(t, feeHandler, node.feeHandler)
+	assert.Nil(t, err)
+}
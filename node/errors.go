@@ -123,3 +123,21 @@ var ErrNilStatusHandler = errors.New("nil AppStatusHandler")
 
 // ErrNoTxToProcess signals that no transaction were sent for processing
 var ErrNoTxToProcess = errors.New("no transaction to process")
+
+// ErrInvalidHandshakeRetryInterval signals that an invalid peer identity handshake retry interval has been provided
+var ErrInvalidHandshakeRetryInterval = errors.New("invalid peer identity handshake retry interval")
+
+// ErrNilConsensusRecordWriter is returned when the writer of the consensus recordings is nil
+var ErrNilConsensusRecordWriter = errors.New("nil consensus record writer")
+
+// ErrNilHeaderProviderSetter is returned when the signer which needs the block header of the consensus state is nil
+var ErrNilHeaderProviderSetter = errors.New("nil header provider setter")
+
+// ErrNilMiniBlock signals that a nil miniblock has been provided
+var ErrNilMiniBlock = errors.New("nil miniblock")
+
+// ErrInvalidShardId signals that a shard id which is not one of the network's shards has been provided
+var ErrInvalidShardId = errors.New("invalid shard id")
+
+// ErrNilFeeHandler signals that a nil fee handler has been provided
+var ErrNilFeeHandler = errors.New("nil fee handler")
@@ -58,3 +58,9 @@ var ErrUnmarshalGenesisTime = errors.New("monitor: can't unmarshal genesis time"
 
 // ErrMarshalGenesisTime signals that the marshaling of the genesis time didn't work
 var ErrMarshalGenesisTime = errors.New("monitor: can't marshal genesis time")
+
+// ErrNilPeerIdentityProvider signals that a nil peer identity provider has been provided
+var ErrNilPeerIdentityProvider = errors.New("nil peer identity provider")
+
+// ErrPeerIdentityMismatch signals that a heartbeat was originated by a peer bound to another public key
+var ErrPeerIdentityMismatch = errors.New("heartbeat public key does not match the originator's verified identity")
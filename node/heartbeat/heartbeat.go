@@ -16,17 +16,19 @@ type Heartbeat struct {
 
 // PubKeyHeartbeat returns the heartbeat status for a public key
 type PubKeyHeartbeat struct {
-	HexPublicKey    string    `json:"hexPublicKey"`
-	TimeStamp       time.Time `json:"timeStamp"`
-	MaxInactiveTime Duration  `json:"maxInactiveTime"`
-	IsActive        bool      `json:"isActive"`
-	ReceivedShardID uint32    `json:"receivedShardID"`
-	ComputedShardID uint32    `json:"computedShardID"`
-	TotalUpTime     int       `json:"totalUpTimeSec"`
-	TotalDownTime   int       `json:"totalDownTimeSec"`
-	VersionNumber   string    `json:"versionNumber"`
-	IsValidator     bool      `json:"isValidator"`
-	NodeDisplayName string    `json:"nodeDisplayName"`
+	HexPublicKey       string    `json:"hexPublicKey"`
+	TimeStamp          time.Time `json:"timeStamp"`
+	MaxInactiveTime    Duration  `json:"maxInactiveTime"`
+	IsActive           bool      `json:"isActive"`
+	ReceivedShardID    uint32    `json:"receivedShardID"`
+	ComputedShardID    uint32    `json:"computedShardID"`
+	TotalUpTime        int       `json:"totalUpTimeSec"`
+	TotalDownTime      int       `json:"totalDownTimeSec"`
+	VersionNumber      string    `json:"versionNumber"`
+	IsValidator        bool      `json:"isValidator"`
+	NodeDisplayName    string    `json:"nodeDisplayName"`
+	PeerID             string    `json:"peerID"`
+	IsIdentityVerified bool      `json:"isIdentityVerified"`
 }
 
 // HeartbeatDTO is the struct used for handling DB operations for heartbeatMessageInfo struct
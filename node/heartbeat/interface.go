@@ -3,6 +3,7 @@ package heartbeat
 import (
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/node/peerIdentity"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 )
 
@@ -34,3 +35,10 @@ type HeartbeatStorageHandler interface {
 	SaveKeys(peersSlice [][]byte) error
 	IsInterfaceNil() bool
 }
+
+// PeerIdentityProvider defines what a component holding the verified pid <-> public key bindings should do
+type PeerIdentityProvider interface {
+	ByPeerID(pid p2p.PeerID) (*peerIdentity.PeerIdentity, bool)
+	ByPublicKey(pubKey []byte) (*peerIdentity.PeerIdentity, bool)
+	IsInterfaceNil() bool
+}
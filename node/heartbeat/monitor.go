@@ -33,6 +33,8 @@ type Monitor struct {
 	messageHandler              MessageHandler
 	storer                      HeartbeatStorageHandler
 	timer                       Timer
+	mutIdentityProvider         sync.RWMutex
+	identityProvider            PeerIdentityProvider
 }
 
 // NewMonitor returns a new monitor instance
@@ -160,6 +162,46 @@ func (m *Monitor) SetAppStatusHandler(ash core.AppStatusHandler) error {
 	return nil
 }
 
+// SetPeerIdentityProvider sets the component holding the verified peer identities. Once set, heartbeats
+// originated by a peer bound to another public key, or carrying a public key bound to another peer, are rejected
+func (m *Monitor) SetPeerIdentityProvider(provider PeerIdentityProvider) error {
+	if provider == nil || provider.IsInterfaceNil() {
+		return ErrNilPeerIdentityProvider
+	}
+
+	m.mutIdentityProvider.Lock()
+	m.identityProvider = provider
+	m.mutIdentityProvider.Unlock()
+
+	return nil
+}
+
+func (m *Monitor) getIdentityProvider() PeerIdentityProvider {
+	m.mutIdentityProvider.RLock()
+	defer m.mutIdentityProvider.RUnlock()
+
+	return m.identityProvider
+}
+
+func (m *Monitor) checkOriginatorIdentity(pid p2p.PeerID, pubKey []byte) error {
+	provider := m.getIdentityProvider()
+	if provider == nil {
+		return nil
+	}
+
+	identity, found := provider.ByPeerID(pid)
+	if found && !bytes.Equal(identity.PubKey, pubKey) {
+		return ErrPeerIdentityMismatch
+	}
+
+	identity, found = provider.ByPublicKey(pubKey)
+	if found && identity.PeerID != pid {
+		return ErrPeerIdentityMismatch
+	}
+
+	return nil
+}
+
 // ProcessReceivedMessage satisfies the p2p.MessageProcessor interface so it can be called
 // by the p2p subsystem each time a new heartbeat message arrives
 func (m *Monitor) ProcessReceivedMessage(message p2p.MessageP2P, _ func(buffToSend []byte)) error {
@@ -168,6 +210,11 @@ func (m *Monitor) ProcessReceivedMessage(message p2p.MessageP2P, _ func(buffToSe
 		return err
 	}
 
+	err = m.checkOriginatorIdentity(message.Peer(), hbRecv.Pubkey)
+	if err != nil {
+		return err
+	}
+
 	//message is validated, process should be done async, method can return nil
 	go m.addHeartbeatMessageToMap(hbRecv)
 
@@ -269,6 +316,8 @@ func (m *Monitor) computeAllHeartbeatMessages() {
 func (m *Monitor) GetHeartbeats() []PubKeyHeartbeat {
 	m.computeAllHeartbeatMessages()
 
+	provider := m.getIdentityProvider()
+
 	m.mutHeartbeatMessages.Lock()
 	status := make([]PubKeyHeartbeat, len(m.heartbeatMessages))
 	idx := 0
@@ -286,6 +335,13 @@ func (m *Monitor) GetHeartbeats() []PubKeyHeartbeat {
 			IsValidator:     v.isValidator,
 			NodeDisplayName: v.nodeDisplayName,
 		}
+		if provider != nil {
+			identity, found := provider.ByPublicKey([]byte(k))
+			if found {
+				status[idx].PeerID = identity.PeerID.Pretty()
+				status[idx].IsIdentityVerified = true
+			}
+		}
 		idx++
 	}
 	m.mutHeartbeatMessages.Unlock()
@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/node/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/node/heartbeat/storage"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/node/peerIdentity"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/stretchr/testify/assert"
 )
@@ -455,3 +456,85 @@ func sendHbMessageFromPubKey(pubKey string, mon *heartbeat.Monitor) error {
 	err := mon.ProcessReceivedMessage(&mock.P2PMessageStub{DataField: buffToSend}, nil)
 	return err
 }
+
+func createMonitorForIdentityChecks(pubKey string) *heartbeat.Monitor {
+	mon, _ := heartbeat.NewMonitor(
+		&mock.MarshalizerMock{},
+		time.Second*1000,
+		map[uint32][]string{0: {pubKey}},
+		time.Now(),
+		&mock.MessageHandlerStub{
+			CreateHeartbeatFromP2pMessageCalled: func(message p2p.MessageP2P) (*heartbeat.Heartbeat, error) {
+				var rcvHb heartbeat.Heartbeat
+				_ = json.Unmarshal(message.Data(), &rcvHb)
+				return &rcvHb, nil
+			},
+		},
+		&mock.HeartbeatStorerStub{
+			UpdateGenesisTimeCalled: func(genesisTime time.Time) error {
+				return nil
+			},
+			LoadHbmiDTOCalled: func(pubKey string) (*heartbeat.HeartbeatDTO, error) {
+				return nil, errors.New("not found")
+			},
+			LoadKeysCalled: func() ([][]byte, error) {
+				return nil, nil
+			},
+			SavePubkeyDataCalled: func(pubkey []byte, heartbeat *heartbeat.HeartbeatDTO) error {
+				return nil
+			},
+			SaveKeysCalled: func(peersSlice [][]byte) error {
+				return nil
+			},
+		},
+		&mock.MockTimer{},
+	)
+
+	return mon
+}
+
+func TestMonitor_SetPeerIdentityProviderNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mon := createMonitorForIdentityChecks("pk1")
+
+	err := mon.SetPeerIdentityProvider(nil)
+	assert.Equal(t, heartbeat.ErrNilPeerIdentityProvider, err)
+}
+
+func TestMonitor_ProcessReceivedMessageFromPeerBoundToAnotherKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mon := createMonitorForIdentityChecks("pk1")
+	identities := peerIdentity.NewIdentities()
+	identities.Add("pid1", []byte("pk1"), 0)
+	identities.Add("pid2", []byte("pk2"), 0)
+	_ = mon.SetPeerIdentityProvider(identities)
+
+	hbBytes, _ := json.Marshal(heartbeat.Heartbeat{Pubkey: []byte("pk1")})
+
+	//a verified peer sending the heartbeat of another validator
+	err := mon.ProcessReceivedMessage(&mock.P2PMessageStub{DataField: hbBytes, PeerField: "pid2"}, nil)
+	assert.Equal(t, heartbeat.ErrPeerIdentityMismatch, err)
+
+	//an unverified peer sending the heartbeat of a verified validator
+	err = mon.ProcessReceivedMessage(&mock.P2PMessageStub{DataField: hbBytes, PeerField: "pid3"}, nil)
+	assert.Equal(t, heartbeat.ErrPeerIdentityMismatch, err)
+
+	err = mon.ProcessReceivedMessage(&mock.P2PMessageStub{DataField: hbBytes, PeerField: "pid1"}, nil)
+	assert.Nil(t, err)
+}
+
+func TestMonitor_GetHeartbeatsShouldReportTheVerifiedIdentities(t *testing.T) {
+	t.Parallel()
+
+	mon := createMonitorForIdentityChecks("pk1")
+	identities := peerIdentity.NewIdentities()
+	identities.Add("pid1", []byte("pk1"), 0)
+	_ = mon.SetPeerIdentityProvider(identities)
+
+	hbStatus := mon.GetHeartbeats()
+	assert.Equal(t, 1, len(hbStatus))
+	assert.True(t, hbStatus[0].IsIdentityVerified)
+	assert.Equal(t, p2p.PeerID("pid1").Pretty(), hbStatus[0].PeerID)
+}
@@ -18,5 +18,10 @@ type P2PMessenger interface {
 	HasTopicValidator(name string) bool
 	RegisterMessageProcessor(topic string, handler p2p.MessageProcessor) error
 	PeerAddress(pid p2p.PeerID) string
+	ID() p2p.PeerID
+	IsConnected(peerID p2p.PeerID) bool
+	ConnectedPeers() []p2p.PeerID
+	SendToConnectedPeer(topic string, buff []byte, peerID p2p.PeerID) error
+	AddConnectionNotifiee(notifiee p2p.ConnectionNotifiee) error
 	IsInterfaceNil() bool
 }
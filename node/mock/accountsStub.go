@@ -19,6 +19,7 @@ type AccountsStub struct {
 	SaveDataTrieCalled          func(acountWrapper state.AccountHandler) error
 	RootHashCalled              func() ([]byte, error)
 	RecreateTrieCalled          func(rootHash []byte) error
+	GetProofCalled              func(addressContainer state.AddressContainer, rootHash []byte) ([][]byte, error)
 }
 
 func (aam *AccountsStub) AddJournalEntry(je state.JournalEntry) {
@@ -77,6 +78,10 @@ func (aam *AccountsStub) RecreateTrie(rootHash []byte) error {
 	return aam.RecreateTrieCalled(rootHash)
 }
 
+func (aam *AccountsStub) GetProof(addressContainer state.AddressContainer, rootHash []byte) ([][]byte, error) {
+	return aam.GetProofCalled(addressContainer, rootHash)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (aam *AccountsStub) IsInterfaceNil() bool {
 	if aam == nil {
@@ -36,6 +36,10 @@ func (acf *AddressConverterFake) ConvertToHex(addressContainer state.AddressCont
 	return acf.prefix + hex.EncodeToString(addressContainer.Bytes()), nil
 }
 
+func (acf *AddressConverterFake) ConvertToString(addressContainer state.AddressContainer) (string, error) {
+	return acf.ConvertToHex(addressContainer)
+}
+
 func (acf *AddressConverterFake) CreateAddressFromHex(hexAddress string) (state.AddressContainer, error) {
 
 	//to lower
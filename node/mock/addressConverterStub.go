@@ -7,6 +7,7 @@ import (
 type AddressConverterStub struct {
 	CreateAddressFromPublicKeyBytesHandler func(pubKey []byte) (state.AddressContainer, error)
 	ConvertToHexHandler                    func(addressContainer state.AddressContainer) (string, error)
+	ConvertToStringHandler                 func(addressContainer state.AddressContainer) (string, error)
 	CreateAddressFromHexHandler            func(hexAddress string) (state.AddressContainer, error)
 	PrepareAddressBytesHandler             func(addressBytes []byte) ([]byte, error)
 	AddressLenHandler                      func() int
@@ -18,6 +19,9 @@ func (ac AddressConverterStub) CreateAddressFromPublicKeyBytes(pubKey []byte) (s
 func (ac AddressConverterStub) ConvertToHex(addressContainer state.AddressContainer) (string, error) {
 	return ac.ConvertToHexHandler(addressContainer)
 }
+func (ac AddressConverterStub) ConvertToString(addressContainer state.AddressContainer) (string, error) {
+	return ac.ConvertToStringHandler(addressContainer)
+}
 func (ac AddressConverterStub) CreateAddressFromHex(hexAddress string) (state.AddressContainer, error) {
 	return ac.CreateAddressFromHexHandler(hexAddress)
 }
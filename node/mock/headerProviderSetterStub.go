@@ -0,0 +1,21 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/remote"
+)
+
+type HeaderProviderSetterStub struct {
+	SetHeaderProviderCalled func(headerProvider remote.HeaderProvider) error
+}
+
+func (hpss *HeaderProviderSetterStub) SetHeaderProvider(headerProvider remote.HeaderProvider) error {
+	return hpss.SetHeaderProviderCalled(headerProvider)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hpss *HeaderProviderSetterStub) IsInterfaceNil() bool {
+	if hpss == nil {
+		return true
+	}
+	return false
+}
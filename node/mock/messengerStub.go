@@ -15,6 +15,11 @@ type MessengerStub struct {
 	BootstrapCalled                  func() error
 	PeerAddressCalled                func(pid p2p.PeerID) string
 	BroadcastOnChannelBlockingCalled func(channel string, topic string, buff []byte) error
+	IDCalled                         func() p2p.PeerID
+	IsConnectedCalled                func(peerID p2p.PeerID) bool
+	ConnectedPeersCalled             func() []p2p.PeerID
+	SendToConnectedPeerCalled        func(topic string, buff []byte, peerID p2p.PeerID) error
+	AddConnectionNotifieeCalled      func(notifiee p2p.ConnectionNotifiee) error
 }
 
 func (ms *MessengerStub) RegisterMessageProcessor(topic string, handler p2p.MessageProcessor) error {
@@ -57,6 +62,26 @@ func (ms *MessengerStub) BroadcastOnChannelBlocking(channel string, topic string
 	return ms.BroadcastOnChannelBlockingCalled(channel, topic, buff)
 }
 
+func (ms *MessengerStub) ID() p2p.PeerID {
+	return ms.IDCalled()
+}
+
+func (ms *MessengerStub) IsConnected(peerID p2p.PeerID) bool {
+	return ms.IsConnectedCalled(peerID)
+}
+
+func (ms *MessengerStub) ConnectedPeers() []p2p.PeerID {
+	return ms.ConnectedPeersCalled()
+}
+
+func (ms *MessengerStub) SendToConnectedPeer(topic string, buff []byte, peerID p2p.PeerID) error {
+	return ms.SendToConnectedPeerCalled(topic, buff, peerID)
+}
+
+func (ms *MessengerStub) AddConnectionNotifiee(notifiee p2p.ConnectionNotifiee) error {
+	return ms.AddConnectionNotifieeCalled(notifiee)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ms *MessengerStub) IsInterfaceNil() bool {
 	if ms == nil {
@@ -0,0 +1,22 @@
+package mock
+
+type WriteCloserStub struct {
+	WriteCalled func(p []byte) (int, error)
+	CloseCalled func() error
+}
+
+func (wcs *WriteCloserStub) Write(p []byte) (int, error) {
+	if wcs.WriteCalled != nil {
+		return wcs.WriteCalled(p)
+	}
+
+	return len(p), nil
+}
+
+func (wcs *WriteCloserStub) Close() error {
+	if wcs.CloseCalled != nil {
+		return wcs.CloseCalled()
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
 	"math/big"
 	"math/rand"
 	"sync/atomic"
@@ -13,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/chronology"
+	"github.com/ElrondNetwork/elrond-go/consensus/recorder"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -20,6 +22,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/logger"
 	"github.com/ElrondNetwork/elrond-go/core/partitioning"
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/remote"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
@@ -29,6 +32,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/node/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/node/heartbeat/storage"
+	"github.com/ElrondNetwork/elrond-go/node/peerIdentity"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -44,6 +48,9 @@ const SendTransactionsPipe = "send transactions pipe"
 // HeartbeatTopic is the topic used for heartbeat signaling
 const HeartbeatTopic = "heartbeat"
 
+// PeerIdentityTopic is the topic used for the direct peer identity handshake messages
+const PeerIdentityTopic = "peerIdentity"
+
 var log = logger.DefaultLogger()
 
 // Option represents a functional configuration parameter that can operate
@@ -72,17 +79,24 @@ type Node struct {
 	resolversFinder          dataRetriever.ResolversFinder
 	heartbeatMonitor         *heartbeat.Monitor
 	heartbeatSender          *heartbeat.Sender
+	peerIdentities           *peerIdentity.Identities
+	identityHandshaker       *peerIdentity.Handshaker
 	appStatusHandler         core.AppStatusHandler
+	consensusRecordWriter    io.WriteCloser
+	roundRecorder            consensus.RoundRecorder
+	headerProviderSetter     remote.HeaderProviderSetter
 
 	txSignPrivKey  crypto.PrivateKey
 	txSignPubKey   crypto.PublicKey
 	pubKey         crypto.PublicKey
 	privKey        crypto.PrivateKey
 	keyGen         crypto.KeyGenerator
+	txSignKeyGen   crypto.KeyGenerator
 	singleSigner   crypto.SingleSigner
 	txSingleSigner crypto.SingleSigner
 	multiSigner    crypto.MultiSigner
 	forkDetector   process.ForkDetector
+	feeHandler     process.FeeHandler
 
 	blkc             data.ChainHandler
 	dataPool         dataRetriever.PoolsHolder
@@ -122,6 +136,7 @@ func NewNode(opts ...Option) (*Node, error) {
 		ctx:                      context.Background(),
 		currentSendingGoRoutines: 0,
 		appStatusHandler:         statusHandler.NewNilStatusHandler(),
+		roundRecorder:            recorder.NewNilRoundRecorder(),
 	}
 	for _, opt := range opts {
 		err := opt(node)
@@ -218,11 +233,6 @@ func (n *Node) StartConsensus() error {
 		return ErrGenesisBlockNotInitialized
 	}
 
-	chronologyHandler, err := n.createChronologyHandler(n.rounder, n.appStatusHandler)
-	if err != nil {
-		return err
-	}
-
 	bootstrapper, err := n.createBootstrapper(n.rounder)
 	if err != nil {
 		return err
@@ -240,6 +250,21 @@ func (n *Node) StartConsensus() error {
 		return err
 	}
 
+	err = n.createRoundRecorder(consensusState)
+	if err != nil {
+		return err
+	}
+
+	err = n.setSigningHeaderProvider(consensusState)
+	if err != nil {
+		return err
+	}
+
+	chronologyHandler, err := n.createChronologyHandler(n.rounder, n.appStatusHandler, n.roundRecorder)
+	if err != nil {
+		return err
+	}
+
 	consensusService, err := sposFactory.GetConsensusCoreFactory(n.consensusType)
 	if err != nil {
 		return err
@@ -250,8 +275,28 @@ func (n *Node) StartConsensus() error {
 		n.messenger,
 		n.shardCoordinator,
 		n.privKey,
-		n.singleSigner)
+		n.singleSigner,
+		n.dataPool,
+		n.roundRecorder)
+
+	if err != nil {
+		return err
+	}
 
+	peerReputation, err := spos.NewPeerReputation()
+	if err != nil {
+		return err
+	}
+
+	messageValidator, err := spos.NewConsensusMessageValidator(
+		consensusService,
+		consensusState,
+		bootstrapper,
+		n.keyGen,
+		n.marshalizer,
+		n.singleSigner,
+		peerReputation,
+	)
 	if err != nil {
 		return err
 	}
@@ -264,17 +309,21 @@ func (n *Node) StartConsensus() error {
 		broadcastMessenger,
 		consensusState,
 		n.forkDetector,
-		n.keyGen,
 		n.marshalizer,
+		messageValidator,
 		n.rounder,
 		n.shardCoordinator,
-		n.singleSigner,
 		n.syncTimer,
 	)
 	if err != nil {
 		return err
 	}
 
+	err = worker.SetRoundRecorder(n.roundRecorder)
+	if err != nil {
+		return err
+	}
+
 	err = n.createConsensusTopic(worker, n.shardCoordinator)
 	if err != nil {
 		return err
@@ -343,7 +392,11 @@ func (n *Node) GetBalance(addressHex string) (*big.Int, error) {
 }
 
 // createChronologyHandler method creates a chronology object
-func (n *Node) createChronologyHandler(rounder consensus.Rounder, appStatusHandler core.AppStatusHandler) (consensus.ChronologyHandler, error) {
+func (n *Node) createChronologyHandler(
+	rounder consensus.Rounder,
+	appStatusHandler core.AppStatusHandler,
+	roundRecorder consensus.RoundRecorder,
+) (consensus.ChronologyHandler, error) {
 	chr, err := chronology.NewChronology(
 		n.genesisTime,
 		rounder,
@@ -358,9 +411,44 @@ func (n *Node) createChronologyHandler(rounder consensus.Rounder, appStatusHandl
 		return nil, err
 	}
 
+	err = chr.SetRoundRecorder(roundRecorder)
+	if err != nil {
+		return nil, err
+	}
+
 	return chr, nil
 }
 
+// createRoundRecorder creates the recorder of the consensus rounds, if a writer for the recordings was provided
+func (n *Node) createRoundRecorder(consensusState *spos.ConsensusState) error {
+	if n.consensusRecordWriter == nil {
+		return nil
+	}
+
+	roundRecorder, err := recorder.NewRoundRecorder(consensusState, n.syncTimer, n.consensusRecordWriter)
+	if err != nil {
+		return err
+	}
+
+	n.roundRecorder = roundRecorder
+
+	return nil
+}
+
+// setSigningHeaderProvider gives the consensus state to the block signer, if it needs the block header being signed
+func (n *Node) setSigningHeaderProvider(consensusState *spos.ConsensusState) error {
+	if n.headerProviderSetter == nil {
+		return nil
+	}
+
+	return n.headerProviderSetter.SetHeaderProvider(consensusState)
+}
+
+// CloseConsensusRecorder writes the rounds not yet recorded and closes the recordings writer
+func (n *Node) CloseConsensusRecorder() error {
+	return n.roundRecorder.Close()
+}
+
 //TODO move this func in structs.go
 func (n *Node) createBootstrapper(rounder consensus.Rounder) (process.Bootstrapper, error) {
 	if n.shardCoordinator.SelfId() < n.shardCoordinator.NumberOfShards() {
@@ -458,6 +546,14 @@ func (n *Node) createConsensusTopic(messageProcessor p2p.MessageProcessor, shard
 		return ErrNilMessenger
 	}
 
+	if n.peerIdentities != nil {
+		var err error
+		messageProcessor, err = peerIdentity.NewVerifiedPeersFilter(messageProcessor, n.peerIdentities, n.messenger)
+		if err != nil {
+			return err
+		}
+	}
+
 	n.consensusTopic = core.ConsensusTopic + shardCoordinator.CommunicationIdentifier(shardCoordinator.SelfId())
 	if n.messenger.HasTopicValidator(n.consensusTopic) {
 		return ErrValidatorAlreadySet
@@ -661,6 +757,15 @@ func (n *Node) GetTransaction(hash string) (*transaction.Transaction, error) {
 	return nil, fmt.Errorf("not yet implemented")
 }
 
+// EncodeAddress returns the human readable representation of the provided address bytes
+func (n *Node) EncodeAddress(address []byte) (string, error) {
+	if n.addrConverter == nil || n.addrConverter.IsInterfaceNil() {
+		return "", ErrNilAddressConverter
+	}
+
+	return n.addrConverter.ConvertToString(state.NewAddress(address))
+}
+
 // GetCurrentPublicKey will return the current node's public key
 func (n *Node) GetCurrentPublicKey() string {
 	if n.txSignPubKey != nil {
@@ -770,6 +875,13 @@ func (n *Node) StartHeartbeat(hbConfig config.HeartbeatConfig, versionNumber str
 		return err
 	}
 
+	if n.peerIdentities != nil {
+		err = n.heartbeatMonitor.SetPeerIdentityProvider(n.peerIdentities)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = n.messenger.RegisterMessageProcessor(HeartbeatTopic, n.heartbeatMonitor)
 	if err != nil {
 		return err
@@ -816,6 +928,76 @@ func (n *Node) startSendingHeartbeats(config config.HeartbeatConfig) {
 	}
 }
 
+// StartPeerIdentityHandshake starts the handshake that binds the connected peers' IDs to their validator public keys
+func (n *Node) StartPeerIdentityHandshake(piConfig config.PeerIdentityConfig) error {
+	if !piConfig.Enabled {
+		return nil
+	}
+	if piConfig.HandshakeRetryIntervalSec < 1 {
+		return ErrInvalidHandshakeRetryInterval
+	}
+
+	if n.messenger.HasTopicValidator(PeerIdentityTopic) {
+		return ErrValidatorAlreadySet
+	}
+
+	if !n.messenger.HasTopic(PeerIdentityTopic) {
+		err := n.messenger.CreateTopic(PeerIdentityTopic, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	identities := peerIdentity.NewIdentities()
+	handshaker, err := peerIdentity.NewHandshaker(
+		n.messenger,
+		n.singleSigner,
+		n.keyGen,
+		n.privKey,
+		n.marshalizer,
+		n.shardCoordinator,
+		n.initialNodesPubkeys,
+		identities,
+		PeerIdentityTopic,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = n.messenger.RegisterMessageProcessor(PeerIdentityTopic, handshaker)
+	if err != nil {
+		return err
+	}
+
+	err = n.messenger.AddConnectionNotifiee(handshaker)
+	if err != nil {
+		return err
+	}
+
+	n.peerIdentities = identities
+	n.identityHandshaker = handshaker
+
+	if n.heartbeatMonitor != nil {
+		err = n.heartbeatMonitor.SetPeerIdentityProvider(identities)
+		if err != nil {
+			return err
+		}
+	}
+
+	handshaker.StartRetrying(time.Second * time.Duration(piConfig.HandshakeRetryIntervalSec))
+
+	return nil
+}
+
+// ClosePeerIdentityHandshake stops the peer identity handshake retries
+func (n *Node) ClosePeerIdentityHandshake() error {
+	if n.identityHandshaker == nil {
+		return nil
+	}
+
+	return n.identityHandshaker.Close()
+}
+
 // GetHeartbeats returns the heartbeat status for each public key defined in genesis.json
 func (n *Node) GetHeartbeats() []heartbeat.PubKeyHeartbeat {
 	if n.heartbeatMonitor == nil {
@@ -1591,6 +1591,37 @@ func TestNode_GetAccountAccountExistsShouldReturn(t *testing.T) {
 	assert.Equal(t, accnt, recovAccnt)
 }
 
+//------- EncodeAddress
+
+func TestNode_EncodeAddressWithNilAddressConverterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	encoded, err := n.EncodeAddress([]byte("address"))
+
+	assert.Equal(t, "", encoded)
+	assert.Equal(t, node.ErrNilAddressConverter, err)
+}
+
+func TestNode_EncodeAddressShouldUseAddressConverter(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("address")
+	n, _ := node.NewNode(
+		node.WithAddressConverter(&mock.AddressConverterStub{
+			ConvertToStringHandler: func(addressContainer state.AddressContainer) (string, error) {
+				return "erd1" + string(addressContainer.Bytes()), nil
+			},
+		}),
+	)
+
+	encoded, err := n.EncodeAddress(address)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "erd1address", encoded)
+}
+
 func TestNode_AppStatusHandlersShouldIncrement(t *testing.T) {
 	t.Parallel()
 
@@ -1834,3 +1865,94 @@ func TestNode_SendBulkTransactionsMultiShardTxsShouldBeMappedCorrectly(t *testin
 	assert.Equal(t, len(txsToSend), recTxsSize)
 	mutRecoveredTransactions.RUnlock()
 }
+
+//------- StartPeerIdentityHandshake
+
+func TestNode_StartPeerIdentityHandshakeDisabledShouldNotCreateObjects(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+	err := n.StartPeerIdentityHandshake(config.PeerIdentityConfig{
+		Enabled:                   false,
+		HandshakeRetryIntervalSec: 1,
+	})
+
+	assert.Nil(t, err)
+	assert.Nil(t, n.PeerIdentities())
+}
+
+func TestNode_StartPeerIdentityHandshakeInvalidRetryIntervalShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+	err := n.StartPeerIdentityHandshake(config.PeerIdentityConfig{
+		Enabled:                   true,
+		HandshakeRetryIntervalSec: 0,
+	})
+
+	assert.Equal(t, node.ErrInvalidHandshakeRetryInterval, err)
+}
+
+func TestNode_StartPeerIdentityHandshakeHasTopicValidatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithMessenger(&mock.MessengerStub{
+			HasTopicValidatorCalled: func(name string) bool {
+				return true
+			},
+		}),
+	)
+	err := n.StartPeerIdentityHandshake(config.PeerIdentityConfig{
+		Enabled:                   true,
+		HandshakeRetryIntervalSec: 1,
+	})
+
+	assert.Equal(t, node.ErrValidatorAlreadySet, err)
+}
+
+func TestNode_StartPeerIdentityHandshakeShouldWork(t *testing.T) {
+	t.Parallel()
+
+	registeredTopic := ""
+	notifieeAdded := false
+	n, _ := node.NewNode(
+		node.WithMarshalizer(getMarshalizer()),
+		node.WithSingleSigner(&mock.SinglesignMock{}),
+		node.WithKeyGen(&mock.KeyGenMock{}),
+		node.WithPrivKey(&mock.PrivateKeyStub{}),
+		node.WithInitialNodesPubKeys(map[uint32][]string{0: {"pk1"}}),
+		node.WithShardCoordinator(mock.NewOneShardCoordinatorMock()),
+		node.WithMessenger(&mock.MessengerStub{
+			HasTopicValidatorCalled: func(name string) bool {
+				return false
+			},
+			HasTopicCalled: func(name string) bool {
+				return false
+			},
+			CreateTopicCalled: func(name string, createChannelForTopic bool) error {
+				return nil
+			},
+			RegisterMessageProcessorCalled: func(topic string, handler p2p.MessageProcessor) error {
+				registeredTopic = topic
+				return nil
+			},
+			AddConnectionNotifieeCalled: func(notifiee p2p.ConnectionNotifiee) error {
+				notifieeAdded = true
+				return nil
+			},
+			ConnectedPeersCalled: func() []p2p.PeerID {
+				return make([]p2p.PeerID, 0)
+			},
+		}),
+	)
+	err := n.StartPeerIdentityHandshake(config.PeerIdentityConfig{
+		Enabled:                   true,
+		HandshakeRetryIntervalSec: 1,
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, node.PeerIdentityTopic, registeredTopic)
+	assert.True(t, notifieeAdded)
+	assert.NotNil(t, n.PeerIdentities())
+}
@@ -0,0 +1,60 @@
+package peerIdentity
+
+import "errors"
+
+// ErrNilMessenger signals that a nil p2p messenger has been provided
+var ErrNilMessenger = errors.New("nil P2P Messenger")
+
+// ErrNilSingleSigner signals that a nil single signer has been provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilKeyGenerator signals that a nil key generator has been provided
+var ErrNilKeyGenerator = errors.New("nil key generator")
+
+// ErrNilPrivateKey signals that a nil private key has been provided
+var ErrNilPrivateKey = errors.New("nil private key")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrEmptyPublicKeysMap signals that a nil or empty public keys map has been provided
+var ErrEmptyPublicKeysMap = errors.New("nil or empty public keys map")
+
+// ErrNilIdentities signals that a nil identities holder has been provided
+var ErrNilIdentities = errors.New("nil identities holder")
+
+// ErrNilMessageProcessor signals that a nil message processor has been provided
+var ErrNilMessageProcessor = errors.New("nil message processor")
+
+// ErrEmptyTopic signals that an empty topic has been provided
+var ErrEmptyTopic = errors.New("empty topic")
+
+// ErrNilMessage signals that a nil message has been received
+var ErrNilMessage = errors.New("nil message")
+
+// ErrNilDataToProcess signals that nil data was provided
+var ErrNilDataToProcess = errors.New("nil data to process")
+
+// ErrHandshakeNotDirect signals that a handshake message was received through broadcast instead of direct send
+var ErrHandshakeNotDirect = errors.New("handshake messages should be sent directly")
+
+// ErrInvalidChallenge signals that a challenge of an unexpected size has been received
+var ErrInvalidChallenge = errors.New("invalid challenge")
+
+// ErrUnexpectedChallenge signals that the response carries a challenge that was not issued for the responding peer
+var ErrUnexpectedChallenge = errors.New("unexpected challenge")
+
+// ErrPeerIDMismatch signals that the signed peer ID does not match the peer that sent the response
+var ErrPeerIDMismatch = errors.New("signed peer ID does not match the sender")
+
+// ErrNotAValidator signals that the provided public key is not part of the nodes setup
+var ErrNotAValidator = errors.New("public key does not belong to a validator")
+
+// ErrShardIDMismatch signals that the shard ID claimed by the peer does not match the nodes setup
+var ErrShardIDMismatch = errors.New("shard ID does not match the nodes setup")
+
+// ErrUnverifiedPeer signals that a message originated from a directly connected peer with no verified identity
+var ErrUnverifiedPeer = errors.New("message originated from an unverified peer")
@@ -0,0 +1,11 @@
+package peerIdentity
+
+import (
+	"time"
+)
+
+func (h *Handshaker) SetTimeHandler(handler func() time.Time) {
+	h.mutChallenges.Lock()
+	h.getTimeHandler = handler
+	h.mutChallenges.Unlock()
+}
@@ -0,0 +1,23 @@
+package peerIdentity
+
+import (
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// Handshake is the message directly exchanged by two connected peers. A handshake that only holds the
+// Challenge is a request, while a complete one is the response in which the peer binds its p2p ID to its
+// validator public key by signing the challenge together with its own ID
+type Handshake struct {
+	Challenge []byte
+	PeerID    []byte
+	PubKey    []byte
+	ShardID   uint32
+	Signature []byte
+}
+
+// PeerIdentity holds the validator information bound to a p2p peer ID
+type PeerIdentity struct {
+	PeerID  p2p.PeerID
+	PubKey  []byte
+	ShardID uint32
+}
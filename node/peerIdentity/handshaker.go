@@ -0,0 +1,391 @@
+package peerIdentity
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var log = logger.DefaultLogger()
+
+const challengeSize = 32
+
+// challengeTimeToLive is the time a challenge can be answered. Challenges sent by retries do not replace the older
+// ones, so a late response to an earlier challenge is still accepted while it did not expire
+const challengeTimeToLive = time.Minute * 5
+
+// maxPendingChallenges is the maximum number of unanswered challenges kept for a peer
+const maxPendingChallenges = 10
+
+type pendingChallenge struct {
+	challenge []byte
+	expiry    time.Time
+}
+
+// Handshaker challenges each newly connected peer to prove that it owns a validator key from the nodes setup and
+// answers the challenges received from the other peers. Verified peers are stored in the shared identities holder
+type Handshaker struct {
+	messenger        PeerMessenger
+	singleSigner     crypto.SingleSigner
+	keyGen           crypto.KeyGenerator
+	privKey          crypto.PrivateKey
+	marshalizer      marshal.Marshalizer
+	shardCoordinator sharding.Coordinator
+	identities       *Identities
+	topic            string
+	validators       map[string]uint32
+
+	mutChallenges  sync.Mutex
+	challenges     map[p2p.PeerID][]*pendingChallenge
+	rejected       map[p2p.PeerID]struct{}
+	getTimeHandler func() time.Time
+
+	closeOnce sync.Once
+	chClose   chan struct{}
+}
+
+// NewHandshaker creates a new peer identity handshaker
+func NewHandshaker(
+	messenger PeerMessenger,
+	singleSigner crypto.SingleSigner,
+	keyGen crypto.KeyGenerator,
+	privKey crypto.PrivateKey,
+	marshalizer marshal.Marshalizer,
+	shardCoordinator sharding.Coordinator,
+	initialPubKeys map[uint32][]string,
+	identities *Identities,
+	topic string,
+) (*Handshaker, error) {
+
+	if messenger == nil || messenger.IsInterfaceNil() {
+		return nil, ErrNilMessenger
+	}
+	if singleSigner == nil || singleSigner.IsInterfaceNil() {
+		return nil, ErrNilSingleSigner
+	}
+	if keyGen == nil || keyGen.IsInterfaceNil() {
+		return nil, ErrNilKeyGenerator
+	}
+	if privKey == nil || privKey.IsInterfaceNil() {
+		return nil, ErrNilPrivateKey
+	}
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, ErrNilMarshalizer
+	}
+	if shardCoordinator == nil || shardCoordinator.IsInterfaceNil() {
+		return nil, ErrNilShardCoordinator
+	}
+	if len(initialPubKeys) == 0 {
+		return nil, ErrEmptyPublicKeysMap
+	}
+	if identities == nil || identities.IsInterfaceNil() {
+		return nil, ErrNilIdentities
+	}
+	if len(topic) == 0 {
+		return nil, ErrEmptyTopic
+	}
+
+	validators := make(map[string]uint32)
+	for shardId, pubKeys := range initialPubKeys {
+		for _, pubKey := range pubKeys {
+			validators[pubKey] = shardId
+		}
+	}
+
+	return &Handshaker{
+		messenger:        messenger,
+		singleSigner:     singleSigner,
+		keyGen:           keyGen,
+		privKey:          privKey,
+		marshalizer:      marshalizer,
+		shardCoordinator: shardCoordinator,
+		identities:       identities,
+		topic:            topic,
+		validators:       validators,
+		challenges:       make(map[p2p.PeerID][]*pendingChallenge),
+		rejected:         make(map[p2p.PeerID]struct{}),
+		getTimeHandler:   time.Now,
+		chClose:          make(chan struct{}),
+	}, nil
+}
+
+// PeerConnected starts the handshake with a newly connected peer
+func (h *Handshaker) PeerConnected(pid p2p.PeerID) {
+	if !h.shouldChallenge(pid) {
+		return
+	}
+
+	go func() {
+		err := h.sendChallenge(pid)
+		if err != nil {
+			log.Debug(fmt.Sprintf("peer identity challenge to %s: %s", pid.Pretty(), err.Error()))
+		}
+	}()
+}
+
+// PeerDisconnected drops the identity of a peer that is no longer connected
+func (h *Handshaker) PeerDisconnected(pid p2p.PeerID) {
+	if h.messenger.IsConnected(pid) {
+		return
+	}
+
+	h.mutChallenges.Lock()
+	delete(h.challenges, pid)
+	delete(h.rejected, pid)
+	h.mutChallenges.Unlock()
+
+	h.identities.Remove(pid)
+}
+
+// HandshakeUnverifiedPeers challenges again all connected peers that did not complete the handshake so far.
+// Peers that answered with a non validator key are not challenged again until they reconnect
+func (h *Handshaker) HandshakeUnverifiedPeers() {
+	for _, pid := range h.messenger.ConnectedPeers() {
+		if !h.shouldChallenge(pid) {
+			continue
+		}
+
+		err := h.sendChallenge(pid)
+		if err != nil {
+			log.Debug(fmt.Sprintf("peer identity challenge to %s: %s", pid.Pretty(), err.Error()))
+		}
+	}
+}
+
+// StartRetrying challenges again, at each interval, the connected peers that did not complete the handshake,
+// until the handshaker is closed
+func (h *Handshaker) StartRetrying(interval time.Duration) {
+	go func() {
+		for {
+			select {
+			case <-h.chClose:
+				return
+			case <-time.After(interval):
+				h.HandshakeUnverifiedPeers()
+			}
+		}
+	}()
+}
+
+// Close stops the handshake retries
+func (h *Handshaker) Close() error {
+	h.closeOnce.Do(func() {
+		close(h.chClose)
+	})
+
+	return nil
+}
+
+func (h *Handshaker) shouldChallenge(pid p2p.PeerID) bool {
+	if pid == h.messenger.ID() || h.identities.IsVerified(pid) {
+		return false
+	}
+
+	h.mutChallenges.Lock()
+	_, isRejected := h.rejected[pid]
+	h.mutChallenges.Unlock()
+
+	return !isRejected
+}
+
+func (h *Handshaker) sendChallenge(pid p2p.PeerID) error {
+	challenge := make([]byte, challengeSize)
+	_, err := rand.Read(challenge)
+	if err != nil {
+		return err
+	}
+
+	buff, err := h.marshalizer.Marshal(&Handshake{Challenge: challenge})
+	if err != nil {
+		return err
+	}
+
+	h.addChallenge(pid, challenge)
+
+	return h.messenger.SendToConnectedPeer(h.topic, buff, pid)
+}
+
+// ProcessReceivedMessage satisfies the p2p.MessageProcessor interface so it can be called
+// by the p2p subsystem each time a new handshake message arrives
+func (h *Handshaker) ProcessReceivedMessage(message p2p.MessageP2P, broadcastHandler func(buffToSend []byte)) error {
+	if message == nil || message.IsInterfaceNil() {
+		return ErrNilMessage
+	}
+	if message.Data() == nil {
+		return ErrNilDataToProcess
+	}
+	//direct messages come without a broadcast handler, anything else has been published on the topic
+	if broadcastHandler != nil {
+		return ErrHandshakeNotDirect
+	}
+
+	hs := &Handshake{}
+	err := h.marshalizer.Unmarshal(hs, message.Data())
+	if err != nil {
+		return err
+	}
+
+	if len(hs.PubKey) == 0 {
+		return h.respond(message.Peer(), hs.Challenge)
+	}
+
+	return h.verify(message.Peer(), hs)
+}
+
+func (h *Handshaker) respond(pid p2p.PeerID, challenge []byte) error {
+	if len(challenge) != challengeSize {
+		return ErrInvalidChallenge
+	}
+
+	pubKey, err := h.privKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return err
+	}
+
+	hs := &Handshake{
+		Challenge: challenge,
+		PeerID:    h.messenger.ID().Bytes(),
+		PubKey:    pubKey,
+		ShardID:   h.shardCoordinator.SelfId(),
+	}
+
+	buffToSign, err := h.marshalizer.Marshal(hs)
+	if err != nil {
+		return err
+	}
+
+	hs.Signature, err = h.singleSigner.Sign(h.privKey, buffToSign)
+	if err != nil {
+		return err
+	}
+
+	buffToSend, err := h.marshalizer.Marshal(hs)
+	if err != nil {
+		return err
+	}
+
+	return h.messenger.SendToConnectedPeer(h.topic, buffToSend, pid)
+}
+
+func (h *Handshaker) addChallenge(pid p2p.PeerID, challenge []byte) {
+	h.mutChallenges.Lock()
+	defer h.mutChallenges.Unlock()
+
+	pending := h.unexpiredChallenges(pid)
+	if len(pending) >= maxPendingChallenges {
+		pending = pending[1:]
+	}
+	h.challenges[pid] = append(pending, &pendingChallenge{
+		challenge: challenge,
+		expiry:    h.getTimeHandler().Add(challengeTimeToLive),
+	})
+}
+
+// unexpiredChallenges returns the pending challenges of a peer, from the oldest to the newest, dropping the expired
+// ones. It should be called under the challenges mutex
+func (h *Handshaker) unexpiredChallenges(pid p2p.PeerID) []*pendingChallenge {
+	now := h.getTimeHandler()
+	pending := make([]*pendingChallenge, 0, len(h.challenges[pid]))
+	for _, pc := range h.challenges[pid] {
+		if now.Before(pc.expiry) {
+			pending = append(pending, pc)
+		}
+	}
+
+	if len(pending) == 0 {
+		delete(h.challenges, pid)
+	} else {
+		h.challenges[pid] = pending
+	}
+
+	return pending
+}
+
+func (h *Handshaker) isPendingChallenge(pid p2p.PeerID, challenge []byte) bool {
+	h.mutChallenges.Lock()
+	defer h.mutChallenges.Unlock()
+
+	for _, pc := range h.unexpiredChallenges(pid) {
+		if bytes.Equal(pc.challenge, challenge) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (h *Handshaker) verify(pid p2p.PeerID, hs *Handshake) error {
+	if !h.isPendingChallenge(pid, hs.Challenge) {
+		return ErrUnexpectedChallenge
+	}
+	if !bytes.Equal(pid.Bytes(), hs.PeerID) {
+		return ErrPeerIDMismatch
+	}
+
+	//the membership checks are cheap so they are done before the signature verification. Rejecting here only
+	//affects the sender, as the direct sender guarantees that the message comes from pid
+	shardId, isValidator := h.validators[string(hs.PubKey)]
+	if !isValidator {
+		h.reject(pid)
+		return ErrNotAValidator
+	}
+	if shardId != hs.ShardID {
+		h.reject(pid)
+		return ErrShardIDMismatch
+	}
+
+	err := h.verifySignature(hs)
+	if err != nil {
+		return err
+	}
+
+	h.mutChallenges.Lock()
+	delete(h.challenges, pid)
+	h.mutChallenges.Unlock()
+
+	h.identities.Add(pid, hs.PubKey, shardId)
+	log.Debug(fmt.Sprintf("peer %s verified as validator in shard %d", pid.Pretty(), shardId))
+
+	return nil
+}
+
+func (h *Handshaker) verifySignature(hs *Handshake) error {
+	pubKey, err := h.keyGen.PublicKeyFromByteArray(hs.PubKey)
+	if err != nil {
+		return err
+	}
+
+	copiedHandshake := *hs
+	copiedHandshake.Signature = nil
+	buffCopiedHandshake, err := h.marshalizer.Marshal(&copiedHandshake)
+	if err != nil {
+		return err
+	}
+
+	return h.singleSigner.Verify(pubKey, buffCopiedHandshake, hs.Signature)
+}
+
+func (h *Handshaker) reject(pid p2p.PeerID) {
+	h.mutChallenges.Lock()
+	delete(h.challenges, pid)
+	h.rejected[pid] = struct{}{}
+	h.mutChallenges.Unlock()
+
+	h.identities.Remove(pid)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (h *Handshaker) IsInterfaceNil() bool {
+	if h == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,379 @@
+package peerIdentity_test
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber/singlesig"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/node/peerIdentity"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/stretchr/testify/assert"
+)
+
+const testTopic = "peerIdentity"
+
+type testPeer struct {
+	pid        p2p.PeerID
+	privKey    crypto.PrivateKey
+	pubKey     []byte
+	identities *peerIdentity.Identities
+	handshaker *peerIdentity.Handshaker
+	messenger  *mock.MessengerStub
+}
+
+var keyGen = signing.NewKeyGenerator(kyber.NewSuitePairingBn256())
+
+func createTestPeer(t *testing.T, pid p2p.PeerID) *testPeer {
+	sk, pk := keyGen.GeneratePair()
+	pkBytes, _ := pk.ToByteArray()
+
+	return &testPeer{
+		pid:        pid,
+		privKey:    sk,
+		pubKey:     pkBytes,
+		identities: peerIdentity.NewIdentities(),
+		messenger: &mock.MessengerStub{
+			IDCalled: func() p2p.PeerID {
+				return pid
+			},
+		},
+	}
+}
+
+func (tp *testPeer) createHandshaker(t *testing.T, shardId uint32, initialPubKeys map[uint32][]string) {
+	var err error
+	tp.handshaker, err = peerIdentity.NewHandshaker(
+		tp.messenger,
+		&singlesig.BlsSingleSigner{},
+		keyGen,
+		tp.privKey,
+		&mock.MarshalizerFake{},
+		&mock.ShardCoordinatorMock{SelfShardId: shardId},
+		initialPubKeys,
+		tp.identities,
+		testTopic,
+	)
+	assert.Nil(t, err)
+}
+
+// connectPeers wires the two messengers so that direct sends are synchronously delivered to the other handshaker
+func connectPeers(first *testPeer, second *testPeer) {
+	wire := func(from *testPeer, to *testPeer) {
+		from.messenger.ConnectedPeersCalled = func() []p2p.PeerID {
+			return []p2p.PeerID{to.pid}
+		}
+		from.messenger.IsConnectedCalled = func(peerID p2p.PeerID) bool {
+			return peerID == to.pid
+		}
+		from.messenger.SendToConnectedPeerCalled = func(topic string, buff []byte, peerID p2p.PeerID) error {
+			msg := &mock.P2PMessageStub{
+				DataField: buff,
+				PeerField: from.pid,
+			}
+
+			return to.handshaker.ProcessReceivedMessage(msg, nil)
+		}
+	}
+
+	wire(first, second)
+	wire(second, first)
+}
+
+func createConnectedPeers(t *testing.T, secondIsValidator bool) (*testPeer, *testPeer) {
+	first := createTestPeer(t, "first")
+	second := createTestPeer(t, "second")
+
+	initialPubKeys := map[uint32][]string{0: {string(first.pubKey)}}
+	if secondIsValidator {
+		initialPubKeys[1] = []string{string(second.pubKey)}
+	}
+
+	first.createHandshaker(t, 0, initialPubKeys)
+	second.createHandshaker(t, 1, initialPubKeys)
+	connectPeers(first, second)
+
+	return first, second
+}
+
+//------- NewHandshaker
+
+func TestNewHandshaker_NilMessengerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hs, err := peerIdentity.NewHandshaker(
+		nil,
+		&singlesig.BlsSingleSigner{},
+		keyGen,
+		&mock.PrivateKeyStub{},
+		&mock.MarshalizerFake{},
+		&mock.ShardCoordinatorMock{},
+		map[uint32][]string{0: {"pk"}},
+		peerIdentity.NewIdentities(),
+		testTopic,
+	)
+
+	assert.Nil(t, hs)
+	assert.Equal(t, peerIdentity.ErrNilMessenger, err)
+}
+
+func TestNewHandshaker_EmptyPublicKeysMapShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hs, err := peerIdentity.NewHandshaker(
+		&mock.MessengerStub{},
+		&singlesig.BlsSingleSigner{},
+		keyGen,
+		&mock.PrivateKeyStub{},
+		&mock.MarshalizerFake{},
+		&mock.ShardCoordinatorMock{},
+		nil,
+		peerIdentity.NewIdentities(),
+		testTopic,
+	)
+
+	assert.Nil(t, hs)
+	assert.Equal(t, peerIdentity.ErrEmptyPublicKeysMap, err)
+}
+
+func TestNewHandshaker_NilIdentitiesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hs, err := peerIdentity.NewHandshaker(
+		&mock.MessengerStub{},
+		&singlesig.BlsSingleSigner{},
+		keyGen,
+		&mock.PrivateKeyStub{},
+		&mock.MarshalizerFake{},
+		&mock.ShardCoordinatorMock{},
+		map[uint32][]string{0: {"pk"}},
+		nil,
+		testTopic,
+	)
+
+	assert.Nil(t, hs)
+	assert.Equal(t, peerIdentity.ErrNilIdentities, err)
+}
+
+func TestNewHandshaker_EmptyTopicShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hs, err := peerIdentity.NewHandshaker(
+		&mock.MessengerStub{},
+		&singlesig.BlsSingleSigner{},
+		keyGen,
+		&mock.PrivateKeyStub{},
+		&mock.MarshalizerFake{},
+		&mock.ShardCoordinatorMock{},
+		map[uint32][]string{0: {"pk"}},
+		peerIdentity.NewIdentities(),
+		"",
+	)
+
+	assert.Nil(t, hs)
+	assert.Equal(t, peerIdentity.ErrEmptyTopic, err)
+}
+
+//------- handshake
+
+func TestHandshaker_HandshakeWithValidatorShouldBindIdentity(t *testing.T) {
+	t.Parallel()
+
+	first, second := createConnectedPeers(t, true)
+
+	first.handshaker.HandshakeUnverifiedPeers()
+
+	identity, found := first.identities.ByPeerID(second.pid)
+	assert.True(t, found)
+	assert.Equal(t, second.pubKey, identity.PubKey)
+	assert.Equal(t, uint32(1), identity.ShardID)
+	//the handshake is one-way, the second peer did not challenge the first one yet
+	assert.False(t, second.identities.IsVerified(first.pid))
+}
+
+func TestHandshaker_HandshakeWithNonValidatorShouldNotBindAndShouldNotRetry(t *testing.T) {
+	t.Parallel()
+
+	first, second := createConnectedPeers(t, false)
+
+	numSent := 0
+	sendHandler := first.messenger.SendToConnectedPeerCalled
+	first.messenger.SendToConnectedPeerCalled = func(topic string, buff []byte, peerID p2p.PeerID) error {
+		numSent++
+		return sendHandler(topic, buff, peerID)
+	}
+
+	first.handshaker.HandshakeUnverifiedPeers()
+	first.handshaker.HandshakeUnverifiedPeers()
+
+	assert.False(t, first.identities.IsVerified(second.pid))
+	assert.Equal(t, 1, numSent)
+}
+
+func TestHandshaker_HandshakeWithWrongShardShouldNotBind(t *testing.T) {
+	t.Parallel()
+
+	first := createTestPeer(t, "first")
+	second := createTestPeer(t, "second")
+	initialPubKeys := map[uint32][]string{0: {string(first.pubKey), string(second.pubKey)}}
+	first.createHandshaker(t, 0, initialPubKeys)
+	second.createHandshaker(t, 1, initialPubKeys)
+	connectPeers(first, second)
+
+	var errVerify error
+	second.messenger.SendToConnectedPeerCalled = func(topic string, buff []byte, peerID p2p.PeerID) error {
+		errVerify = first.handshaker.ProcessReceivedMessage(&mock.P2PMessageStub{DataField: buff, PeerField: second.pid}, nil)
+		return nil
+	}
+
+	first.handshaker.HandshakeUnverifiedPeers()
+
+	assert.Equal(t, peerIdentity.ErrShardIDMismatch, errVerify)
+	assert.False(t, first.identities.IsVerified(second.pid))
+}
+
+func TestHandshaker_ResponseRelayedByAnotherPeerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	first, second := createConnectedPeers(t, true)
+
+	var errVerify error
+	second.messenger.SendToConnectedPeerCalled = func(topic string, buff []byte, peerID p2p.PeerID) error {
+		errVerify = first.handshaker.ProcessReceivedMessage(&mock.P2PMessageStub{DataField: buff, PeerField: "third"}, nil)
+		return nil
+	}
+
+	first.handshaker.HandshakeUnverifiedPeers()
+
+	assert.Equal(t, peerIdentity.ErrUnexpectedChallenge, errVerify)
+	assert.False(t, first.identities.IsVerified(second.pid))
+}
+
+func TestHandshaker_ResponseWithTamperedSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	first, second := createConnectedPeers(t, true)
+
+	marshalizer := &mock.MarshalizerFake{}
+	var errVerify error
+	second.messenger.SendToConnectedPeerCalled = func(topic string, buff []byte, peerID p2p.PeerID) error {
+		hs := &peerIdentity.Handshake{}
+		_ = marshalizer.Unmarshal(hs, buff)
+		hs.Signature[0]++
+		buff, _ = marshalizer.Marshal(hs)
+
+		errVerify = first.handshaker.ProcessReceivedMessage(&mock.P2PMessageStub{DataField: buff, PeerField: second.pid}, nil)
+		return nil
+	}
+
+	first.handshaker.HandshakeUnverifiedPeers()
+
+	assert.NotNil(t, errVerify)
+	assert.False(t, first.identities.IsVerified(second.pid))
+}
+
+func TestHandshaker_BroadcastMessageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	first, _ := createConnectedPeers(t, true)
+
+	err := first.handshaker.ProcessReceivedMessage(
+		&mock.P2PMessageStub{DataField: []byte("data"), PeerField: "second"},
+		func(buffToSend []byte) {},
+	)
+
+	assert.Equal(t, peerIdentity.ErrHandshakeNotDirect, err)
+}
+
+func TestHandshaker_InvalidChallengeShouldNotRespond(t *testing.T) {
+	t.Parallel()
+
+	first, second := createConnectedPeers(t, true)
+	buff, _ := (&mock.MarshalizerFake{}).Marshal(&peerIdentity.Handshake{Challenge: []byte("short")})
+
+	err := first.handshaker.ProcessReceivedMessage(&mock.P2PMessageStub{DataField: buff, PeerField: second.pid}, nil)
+
+	assert.Equal(t, peerIdentity.ErrInvalidChallenge, err)
+}
+
+func TestHandshaker_PeerDisconnectedShouldRemoveIdentity(t *testing.T) {
+	t.Parallel()
+
+	first, second := createConnectedPeers(t, true)
+	first.handshaker.HandshakeUnverifiedPeers()
+	first.messenger.IsConnectedCalled = func(peerID p2p.PeerID) bool {
+		return false
+	}
+
+	first.handshaker.PeerDisconnected(second.pid)
+
+	assert.False(t, first.identities.IsVerified(second.pid))
+}
+
+func TestHandshaker_LateResponseToAnEarlierChallengeShouldBindIdentity(t *testing.T) {
+	t.Parallel()
+
+	first, second := createConnectedPeers(t, true)
+
+	responses := make([][]byte, 0)
+	second.messenger.SendToConnectedPeerCalled = func(topic string, buff []byte, peerID p2p.PeerID) error {
+		responses = append(responses, buff)
+		return nil
+	}
+
+	first.handshaker.HandshakeUnverifiedPeers()
+	first.handshaker.HandshakeUnverifiedPeers()
+	assert.Equal(t, 2, len(responses))
+
+	err := first.handshaker.ProcessReceivedMessage(&mock.P2PMessageStub{DataField: responses[0], PeerField: second.pid}, nil)
+	assert.Nil(t, err)
+	assert.True(t, first.identities.IsVerified(second.pid))
+}
+
+func TestHandshaker_ResponseToAnExpiredChallengeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	first, second := createConnectedPeers(t, true)
+
+	var response []byte
+	second.messenger.SendToConnectedPeerCalled = func(topic string, buff []byte, peerID p2p.PeerID) error {
+		response = buff
+		return nil
+	}
+
+	first.handshaker.HandshakeUnverifiedPeers()
+	first.handshaker.SetTimeHandler(func() time.Time {
+		return time.Now().Add(time.Hour)
+	})
+
+	err := first.handshaker.ProcessReceivedMessage(&mock.P2PMessageStub{DataField: response, PeerField: second.pid}, nil)
+	assert.Equal(t, peerIdentity.ErrUnexpectedChallenge, err)
+	assert.False(t, first.identities.IsVerified(second.pid))
+}
+
+func TestHandshaker_StartRetryingShouldStopOnClose(t *testing.T) {
+	t.Parallel()
+
+	first, _ := createConnectedPeers(t, false)
+
+	numSent := int32(0)
+	first.messenger.SendToConnectedPeerCalled = func(topic string, buff []byte, peerID p2p.PeerID) error {
+		atomic.AddInt32(&numSent, 1)
+		return nil
+	}
+
+	first.handshaker.StartRetrying(time.Millisecond * 10)
+	time.Sleep(time.Millisecond * 100)
+	_ = first.handshaker.Close()
+	time.Sleep(time.Millisecond * 20)
+	numSentAtClose := atomic.LoadInt32(&numSent)
+	time.Sleep(time.Millisecond * 100)
+
+	assert.True(t, numSentAtClose > 0)
+	assert.Equal(t, numSentAtClose, atomic.LoadInt32(&numSent))
+	assert.Nil(t, first.handshaker.Close())
+}
@@ -0,0 +1,120 @@
+package peerIdentity
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// Identities is the concurrent safe pid <-> public key <-> shard mapping of the verified peers
+type Identities struct {
+	mutIdentities sync.RWMutex
+	byPeerID      map[p2p.PeerID]*PeerIdentity
+	byPubKey      map[string]*PeerIdentity
+}
+
+// NewIdentities creates an empty identities holder
+func NewIdentities() *Identities {
+	return &Identities{
+		byPeerID: make(map[p2p.PeerID]*PeerIdentity),
+		byPubKey: make(map[string]*PeerIdentity),
+	}
+}
+
+// Add binds the peer ID to the public key and shard. Any older binding of the peer ID or of the public key is dropped
+func (i *Identities) Add(pid p2p.PeerID, pubKey []byte, shardId uint32) {
+	identity := &PeerIdentity{
+		PeerID:  pid,
+		PubKey:  pubKey,
+		ShardID: shardId,
+	}
+
+	i.mutIdentities.Lock()
+	defer i.mutIdentities.Unlock()
+
+	i.removeUnprotected(pid)
+	oldIdentity, found := i.byPubKey[string(pubKey)]
+	if found {
+		delete(i.byPeerID, oldIdentity.PeerID)
+	}
+
+	i.byPeerID[pid] = identity
+	i.byPubKey[string(pubKey)] = identity
+}
+
+// Remove drops the binding of the provided peer ID
+func (i *Identities) Remove(pid p2p.PeerID) {
+	i.mutIdentities.Lock()
+	i.removeUnprotected(pid)
+	i.mutIdentities.Unlock()
+}
+
+func (i *Identities) removeUnprotected(pid p2p.PeerID) {
+	identity, found := i.byPeerID[pid]
+	if !found {
+		return
+	}
+
+	delete(i.byPeerID, pid)
+	delete(i.byPubKey, string(identity.PubKey))
+}
+
+// ByPeerID returns a copy of the identity bound to the provided peer ID
+func (i *Identities) ByPeerID(pid p2p.PeerID) (*PeerIdentity, bool) {
+	i.mutIdentities.RLock()
+	identity, found := i.byPeerID[pid]
+	i.mutIdentities.RUnlock()
+
+	if !found {
+		return nil, false
+	}
+
+	identityCopy := *identity
+	return &identityCopy, true
+}
+
+// ByPublicKey returns a copy of the identity bound to the provided public key
+func (i *Identities) ByPublicKey(pubKey []byte) (*PeerIdentity, bool) {
+	i.mutIdentities.RLock()
+	identity, found := i.byPubKey[string(pubKey)]
+	i.mutIdentities.RUnlock()
+
+	if !found {
+		return nil, false
+	}
+
+	identityCopy := *identity
+	return &identityCopy, true
+}
+
+// IsVerified returns true if the peer has proven the ownership of a validator key
+func (i *Identities) IsVerified(pid p2p.PeerID) bool {
+	i.mutIdentities.RLock()
+	_, found := i.byPeerID[pid]
+	i.mutIdentities.RUnlock()
+
+	return found
+}
+
+// PeersInShard returns the verified peers that belong to the provided shard
+func (i *Identities) PeersInShard(shardId uint32) []p2p.PeerID {
+	i.mutIdentities.RLock()
+	defer i.mutIdentities.RUnlock()
+
+	peers := make([]p2p.PeerID, 0)
+	for pid, identity := range i.byPeerID {
+		if identity.ShardID == shardId {
+			peers = append(peers, pid)
+		}
+	}
+
+	return peers
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (i *Identities) IsInterfaceNil() bool {
+	if i == nil {
+		return true
+	}
+	return false
+}
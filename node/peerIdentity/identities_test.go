@@ -0,0 +1,66 @@
+package peerIdentity_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/node/peerIdentity"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdentities_AddShouldBindBothWays(t *testing.T) {
+	t.Parallel()
+
+	ids := peerIdentity.NewIdentities()
+	ids.Add("pid", []byte("pk"), 2)
+
+	identity, found := ids.ByPeerID("pid")
+	assert.True(t, found)
+	assert.Equal(t, []byte("pk"), identity.PubKey)
+	assert.Equal(t, uint32(2), identity.ShardID)
+
+	identity, found = ids.ByPublicKey([]byte("pk"))
+	assert.True(t, found)
+	assert.Equal(t, p2p.PeerID("pid"), identity.PeerID)
+	assert.True(t, ids.IsVerified("pid"))
+}
+
+func TestIdentities_AddSamePublicKeyFromAnotherPeerShouldReplaceOldBinding(t *testing.T) {
+	t.Parallel()
+
+	ids := peerIdentity.NewIdentities()
+	ids.Add("pid1", []byte("pk"), 0)
+	ids.Add("pid2", []byte("pk"), 0)
+
+	assert.False(t, ids.IsVerified("pid1"))
+	assert.True(t, ids.IsVerified("pid2"))
+
+	identity, _ := ids.ByPublicKey([]byte("pk"))
+	assert.Equal(t, p2p.PeerID("pid2"), identity.PeerID)
+}
+
+func TestIdentities_RemoveShouldDropBothWays(t *testing.T) {
+	t.Parallel()
+
+	ids := peerIdentity.NewIdentities()
+	ids.Add("pid", []byte("pk"), 0)
+	ids.Remove("pid")
+
+	_, found := ids.ByPeerID("pid")
+	assert.False(t, found)
+	_, found = ids.ByPublicKey([]byte("pk"))
+	assert.False(t, found)
+}
+
+func TestIdentities_PeersInShard(t *testing.T) {
+	t.Parallel()
+
+	ids := peerIdentity.NewIdentities()
+	ids.Add("pid1", []byte("pk1"), 0)
+	ids.Add("pid2", []byte("pk2"), 1)
+	ids.Add("pid3", []byte("pk3"), 1)
+
+	assert.Equal(t, []p2p.PeerID{"pid1"}, ids.PeersInShard(0))
+	assert.Equal(t, 2, len(ids.PeersInShard(1)))
+	assert.Equal(t, 0, len(ids.PeersInShard(2)))
+}
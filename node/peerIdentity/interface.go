@@ -0,0 +1,23 @@
+package peerIdentity
+
+import (
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// PeerMessenger defines a subset of the p2p.Messenger interface
+type PeerMessenger interface {
+	ID() p2p.PeerID
+	IsConnected(peerID p2p.PeerID) bool
+	ConnectedPeers() []p2p.PeerID
+	SendToConnectedPeer(topic string, buff []byte, peerID p2p.PeerID) error
+	IsInterfaceNil() bool
+}
+
+// IdentityProvider defines what a component that can be queried for verified peer identities should do
+type IdentityProvider interface {
+	ByPeerID(pid p2p.PeerID) (*PeerIdentity, bool)
+	ByPublicKey(pubKey []byte) (*PeerIdentity, bool)
+	IsVerified(pid p2p.PeerID) bool
+	PeersInShard(shardId uint32) []p2p.PeerID
+	IsInterfaceNil() bool
+}
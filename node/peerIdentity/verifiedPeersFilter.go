@@ -0,0 +1,62 @@
+package peerIdentity
+
+import (
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// VerifiedPeersFilter wraps a message processor and rejects, and thus stops relaying, the messages originated by
+// directly connected peers that did not prove their validator identity. Messages originated by peers that are not
+// directly connected have already passed this check on the first peer that received them
+type VerifiedPeersFilter struct {
+	processor  p2p.MessageProcessor
+	identities IdentityProvider
+	messenger  PeerMessenger
+}
+
+// NewVerifiedPeersFilter creates a new filter in front of the provided message processor
+func NewVerifiedPeersFilter(
+	processor p2p.MessageProcessor,
+	identities IdentityProvider,
+	messenger PeerMessenger,
+) (*VerifiedPeersFilter, error) {
+
+	if processor == nil || processor.IsInterfaceNil() {
+		return nil, ErrNilMessageProcessor
+	}
+	if identities == nil || identities.IsInterfaceNil() {
+		return nil, ErrNilIdentities
+	}
+	if messenger == nil || messenger.IsInterfaceNil() {
+		return nil, ErrNilMessenger
+	}
+
+	return &VerifiedPeersFilter{
+		processor:  processor,
+		identities: identities,
+		messenger:  messenger,
+	}, nil
+}
+
+// ProcessReceivedMessage checks the originator of the message and forwards it to the wrapped processor
+func (vpf *VerifiedPeersFilter) ProcessReceivedMessage(message p2p.MessageP2P, broadcastHandler func(buffToSend []byte)) error {
+	if message == nil || message.IsInterfaceNil() {
+		return ErrNilMessage
+	}
+
+	pid := message.Peer()
+	isSelf := pid == vpf.messenger.ID()
+	isUnverifiedConnectedPeer := vpf.messenger.IsConnected(pid) && !vpf.identities.IsVerified(pid)
+	if !isSelf && isUnverifiedConnectedPeer {
+		return ErrUnverifiedPeer
+	}
+
+	return vpf.processor.ProcessReceivedMessage(message, broadcastHandler)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (vpf *VerifiedPeersFilter) IsInterfaceNil() bool {
+	if vpf == nil {
+		return true
+	}
+	return false
+}
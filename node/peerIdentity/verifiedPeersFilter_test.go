@@ -0,0 +1,90 @@
+package peerIdentity_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/node/peerIdentity"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/stretchr/testify/assert"
+)
+
+type messageProcessorStub struct {
+	processCalled bool
+}
+
+func (mps *messageProcessorStub) ProcessReceivedMessage(message p2p.MessageP2P, broadcastHandler func(buffToSend []byte)) error {
+	mps.processCalled = true
+	return nil
+}
+
+func (mps *messageProcessorStub) IsInterfaceNil() bool {
+	return mps == nil
+}
+
+func createFilterMessenger(connectedPeer p2p.PeerID) *mock.MessengerStub {
+	return &mock.MessengerStub{
+		IDCalled: func() p2p.PeerID {
+			return "self"
+		},
+		IsConnectedCalled: func(peerID p2p.PeerID) bool {
+			return peerID == connectedPeer
+		},
+	}
+}
+
+func TestNewVerifiedPeersFilter_NilProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	vpf, err := peerIdentity.NewVerifiedPeersFilter(nil, peerIdentity.NewIdentities(), &mock.MessengerStub{})
+
+	assert.Nil(t, vpf)
+	assert.Equal(t, peerIdentity.ErrNilMessageProcessor, err)
+}
+
+func TestNewVerifiedPeersFilter_NilIdentitiesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	vpf, err := peerIdentity.NewVerifiedPeersFilter(&messageProcessorStub{}, nil, &mock.MessengerStub{})
+
+	assert.Nil(t, vpf)
+	assert.Equal(t, peerIdentity.ErrNilIdentities, err)
+}
+
+func TestVerifiedPeersFilter_UnverifiedConnectedPeerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mps := &messageProcessorStub{}
+	vpf, _ := peerIdentity.NewVerifiedPeersFilter(mps, peerIdentity.NewIdentities(), createFilterMessenger("pid"))
+
+	err := vpf.ProcessReceivedMessage(&mock.P2PMessageStub{PeerField: "pid"}, nil)
+
+	assert.Equal(t, peerIdentity.ErrUnverifiedPeer, err)
+	assert.False(t, mps.processCalled)
+}
+
+func TestVerifiedPeersFilter_VerifiedConnectedPeerShouldForward(t *testing.T) {
+	t.Parallel()
+
+	mps := &messageProcessorStub{}
+	ids := peerIdentity.NewIdentities()
+	ids.Add("pid", []byte("pk"), 0)
+	vpf, _ := peerIdentity.NewVerifiedPeersFilter(mps, ids, createFilterMessenger("pid"))
+
+	err := vpf.ProcessReceivedMessage(&mock.P2PMessageStub{PeerField: "pid"}, nil)
+
+	assert.Nil(t, err)
+	assert.True(t, mps.processCalled)
+}
+
+func TestVerifiedPeersFilter_NotConnectedPeerShouldForward(t *testing.T) {
+	t.Parallel()
+
+	mps := &messageProcessorStub{}
+	vpf, _ := peerIdentity.NewVerifiedPeersFilter(mps, peerIdentity.NewIdentities(), createFilterMessenger("other pid"))
+
+	err := vpf.ProcessReceivedMessage(&mock.P2PMessageStub{PeerField: "pid"}, nil)
+
+	assert.Nil(t, err)
+	assert.True(t, mps.processCalled)
+}
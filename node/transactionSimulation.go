@@ -0,0 +1,117 @@
+package node
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	processTransaction "github.com/ElrondNetwork/elrond-go/process/transaction"
+)
+
+// SimulateTransaction runs, without broadcasting the transaction, the checks a node does before accepting it: the
+// validity checks of the transaction interceptors and, if the sender belongs to the node's shard, the nonce and the
+// balance of the sender account. It returns the hash the transaction would have if sent
+func (n *Node) SimulateTransaction(
+	nonce uint64,
+	senderHex string,
+	receiverHex string,
+	value *big.Int,
+	gasPrice uint64,
+	gasLimit uint64,
+	transactionData string,
+	signature []byte,
+) (string, error) {
+	if n.addrConverter == nil || n.addrConverter.IsInterfaceNil() {
+		return "", ErrNilAddressConverter
+	}
+	if n.accounts == nil || n.accounts.IsInterfaceNil() {
+		return "", ErrNilAccountsAdapter
+	}
+
+	sender, err := n.addrConverter.CreateAddressFromHex(senderHex)
+	if err != nil {
+		return "", err
+	}
+
+	receiver, err := n.addrConverter.CreateAddressFromHex(receiverHex)
+	if err != nil {
+		return "", err
+	}
+
+	tx := transaction.Transaction{
+		Nonce:     nonce,
+		Value:     value,
+		RcvAddr:   receiver.Bytes(),
+		SndAddr:   sender.Bytes(),
+		GasPrice:  gasPrice,
+		GasLimit:  gasLimit,
+		Data:      transactionData,
+		Signature: signature,
+	}
+
+	txBuff, err := n.marshalizer.Marshal(&tx)
+	if err != nil {
+		return "", err
+	}
+
+	signerSetProvider, err := processTransaction.NewSignerSetProvider(n.accounts, n.marshalizer)
+	if err != nil {
+		return "", err
+	}
+
+	interceptedTx, err := processTransaction.NewInterceptedTransaction(
+		txBuff,
+		n.marshalizer,
+		n.hasher,
+		n.txSignKeyGen,
+		n.txSingleSigner,
+		n.addrConverter,
+		n.shardCoordinator,
+		n.feeHandler,
+		signerSetProvider,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	err = interceptedTx.CheckValidity()
+	if err != nil {
+		return "", err
+	}
+
+	txHexHash := hex.EncodeToString(interceptedTx.Hash())
+	if interceptedTx.SenderShardId() != n.shardCoordinator.SelfId() {
+		return txHexHash, nil
+	}
+
+	err = n.checkSenderAccount(interceptedTx)
+	if err != nil {
+		return "", err
+	}
+
+	return txHexHash, nil
+}
+
+// checkSenderAccount makes the checks of the transaction validator, returning the reason of the rejection
+func (n *Node) checkSenderAccount(interceptedTx *processTransaction.InterceptedTransaction) error {
+	accountHandler, err := n.accounts.GetExistingAccount(interceptedTx.SenderAddress())
+	if err != nil {
+		return err
+	}
+
+	account, ok := accountHandler.(*state.Account)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	if interceptedTx.Nonce() < account.Nonce {
+		return process.ErrLowerNonceInTransaction
+	}
+	if account.Balance.Cmp(interceptedTx.TotalValue()) < 0 {
+		return process.ErrInsufficientFunds
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package node_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+)
+
+var simulationSignature = []byte("signature")
+
+const minSimulationGasPrice = 10
+
+func createSimulationNode(senderAccount *state.Account, shardCoordinator sharding.Coordinator) *node.Node {
+	marshalizer := &mock.MarshalizerFake{}
+	n, _ := node.NewNode(
+		node.WithMarshalizer(marshalizer),
+		node.WithHasher(&mock.HasherFake{}),
+		node.WithAddressConverter(mock.NewAddressConverterFake(32, "")),
+		node.WithShardCoordinator(shardCoordinator),
+		node.WithAccountsAdapter(&mock.AccountsStub{
+			GetExistingAccountCalled: func(addressContainer state.AddressContainer) (state.AccountHandler, error) {
+				if senderAccount == nil {
+					return nil, state.ErrAccNotFound
+				}
+				return senderAccount, nil
+			},
+		}),
+		node.WithTxSignKeyGen(&mock.KeyGenMock{
+			PublicKeyFromByteArrayMock: func(b []byte) (crypto.PublicKey, error) {
+				return &mock.PublicKeyMock{}, nil
+			},
+		}),
+		node.WithFeeHandler(&mock.FeeHandlerStub{
+			CheckValidityTxValuesCalled: func(tx process.TransactionWithFeeHandler) error {
+				if tx.GetGasPrice() < minSimulationGasPrice {
+					return process.ErrInsufficientGasPriceInTx
+				}
+				return nil
+			},
+		}),
+		node.WithTxSingleSigner(&mock.SinglesignStub{
+			VerifyCalled: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+				tx := &transaction.Transaction{}
+				_ = marshalizer.Unmarshal(tx, msg)
+				if tx.Signature != nil || !bytes.Equal(sig, simulationSignature) {
+					return crypto.ErrSigNotValid
+				}
+				return nil
+			},
+		}),
+	)
+
+	return n
+}
+
+func createSenderAccount(nonce uint64, balance int64) *state.Account {
+	address, _ := mock.NewAddressConverterFake(32, "").CreateAddressFromPublicKeyBytes(make([]byte, 32))
+	account, _ := state.NewAccount(address, &mock.AccountTrackerStub{})
+	account.Nonce = nonce
+	account.Balance = big.NewInt(balance)
+
+	return account
+}
+
+func TestNode_SimulateTransactionShouldReturnTheHashWithoutBroadcasting(t *testing.T) {
+	t.Parallel()
+
+	n := createSimulationNode(createSenderAccount(5, 1000), mock.NewMultipleShardsCoordinatorMock())
+	sender := createDummyHexAddress(64)
+	receiver := createDummyHexAddress(64)
+
+	txHash, err := n.SimulateTransaction(5, sender, receiver, big.NewInt(100), 10, 90, "data", simulationSignature)
+	assert.Nil(t, err)
+
+	senderBytes, _ := hex.DecodeString(sender)
+	receiverBytes, _ := hex.DecodeString(receiver)
+	txBuff, _ := (&mock.MarshalizerFake{}).Marshal(&transaction.Transaction{
+		Nonce:     5,
+		Value:     big.NewInt(100),
+		RcvAddr:   receiverBytes,
+		SndAddr:   senderBytes,
+		GasPrice:  10,
+		GasLimit:  90,
+		Data:      "data",
+		Signature: simulationSignature,
+	})
+	assert.Equal(t, hex.EncodeToString((&mock.HasherFake{}).Compute(string(txBuff))), txHash)
+}
+
+func TestNode_SimulateTransactionInvalidTransactionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createSimulationNode(createSenderAccount(5, 1000), mock.NewMultipleShardsCoordinatorMock())
+	sender := createDummyHexAddress(64)
+	receiver := createDummyHexAddress(64)
+
+	_, err := n.SimulateTransaction(5, sender, receiver, nil, 10, 90, "", simulationSignature)
+	assert.Equal(t, process.ErrNilValue, err)
+
+	_, err = n.SimulateTransaction(5, sender, receiver, big.NewInt(-1), 10, 90, "", simulationSignature)
+	assert.Equal(t, process.ErrNegativeValue, err)
+
+	_, err = n.SimulateTransaction(5, sender, receiver, big.NewInt(1), 10, 90, "", []byte("forged"))
+	assert.Equal(t, crypto.ErrSigNotValid, err)
+
+	_, err = n.SimulateTransaction(5, sender, receiver, big.NewInt(1), minSimulationGasPrice-1, 90, "", simulationSignature)
+	assert.Equal(t, process.ErrInsufficientGasPriceInTx, err)
+
+	_, err = n.SimulateTransaction(4, sender, receiver, big.NewInt(1), 10, 90, "", simulationSignature)
+	assert.Equal(t, process.ErrLowerNonceInTransaction, err)
+
+	// the fee of 10 * 91 and the value go over the balance
+	_, err = n.SimulateTransaction(5, sender, receiver, big.NewInt(100), 10, 91, "", simulationSignature)
+	assert.Equal(t, process.ErrInsufficientFunds, err)
+}
+
+func TestNode_SimulateTransactionFromMultisigAccountShouldRequireTheSigners(t *testing.T) {
+	t.Parallel()
+
+	senderAccount := createSenderAccount(5, 1000)
+	senderAccount.SetDataTrie(&mock.TrieStub{})
+	signerSetBuff, _ := (&mock.MarshalizerFake{}).Marshal(&state.SignerSet{Threshold: 1, PubKeys: [][]byte{[]byte("signer")}})
+	senderAccount.DataTrieTracker().SaveKeyValue(state.SignerSetKey, signerSetBuff)
+	n := createSimulationNode(senderAccount, mock.NewMultipleShardsCoordinatorMock())
+
+	_, err := n.SimulateTransaction(5, createDummyHexAddress(64), createDummyHexAddress(64), big.NewInt(1), 10, 90, "", simulationSignature)
+	assert.Equal(t, process.ErrMultiSignatureRequired, err)
+}
+
+func TestNode_SimulateTransactionUnknownSenderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createSimulationNode(nil, mock.NewMultipleShardsCoordinatorMock())
+
+	_, err := n.SimulateTransaction(0, createDummyHexAddress(64), createDummyHexAddress(64), big.NewInt(1), 10, 0, "", simulationSignature)
+	assert.Equal(t, state.ErrAccNotFound, err)
+}
+
+func TestNode_SimulateTransactionSenderInOtherShardShouldNotCheckTheAccount(t *testing.T) {
+	t.Parallel()
+
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address state.AddressContainer) uint32 {
+		return 1
+	}
+	n := createSimulationNode(nil, shardCoordinator)
+
+	txHash, err := n.SimulateTransaction(0, createDummyHexAddress(64), createDummyHexAddress(64), big.NewInt(1), 10, 0, "", simulationSignature)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, txHash)
+}
@@ -84,3 +84,42 @@ var ErrInvalidDurationProvided = errors.New("invalid time.Duration provided")
 
 // ErrTooManyGoroutines is raised when the number of goroutines has exceeded a threshold
 var ErrTooManyGoroutines = errors.New(" number of goroutines exceeded")
+
+// ErrNilConnectionNotifiee signals that a nil connection notifiee has been provided
+var ErrNilConnectionNotifiee = errors.New("nil connection notifiee")
+
+// ErrInvalidTopicFlags signals that invalid topic flags have been provided
+var ErrInvalidTopicFlags = errors.New("invalid topic flags")
+
+// ErrInvalidWireFrame signals that a received wire frame could not be decoded
+var ErrInvalidWireFrame = errors.New("invalid wire frame")
+
+// ErrUnsupportedWireVersion signals that a received wire frame was encoded with an unknown version of the frame format
+var ErrUnsupportedWireVersion = errors.New("unsupported wire version")
+
+// ErrTooManyPendingChunkedMessages signals that the limit of partially received chunked messages has been reached
+var ErrTooManyPendingChunkedMessages = errors.New("too many pending chunked messages")
+
+// ErrPendingChunksSizeExceeded signals that the total size of the partially received chunked messages reached the limit
+var ErrPendingChunksSizeExceeded = errors.New("pending chunks size exceeded")
+
+// ErrEmptyNetworkID signals that an empty network ID has been provided
+var ErrEmptyNetworkID = errors.New("empty network ID")
+
+// ErrNetworkIDAlreadySet signals that the network ID has already been set
+var ErrNetworkIDAlreadySet = errors.New("network ID already set")
+
+// ErrEmptyServiceTag signals that an empty mDNS service tag has been provided
+var ErrEmptyServiceTag = errors.New("empty service tag")
+
+// ErrEmptyPeersList signals that an empty peers list has been provided
+var ErrEmptyPeersList = errors.New("empty peers list")
+
+// ErrInvalidBackoffDuration signals that an invalid backoff duration has been provided
+var ErrInvalidBackoffDuration = errors.New("invalid backoff duration")
+
+// ErrInvalidPeerDiscoveryType signals that an unknown peer discovery type has been configured
+var ErrInvalidPeerDiscoveryType = errors.New("invalid peer discovery type")
+
+// ErrMoreThanOnePeerDiscoveryEnabled signals that the configuration enables more than one peer discovery mechanism
+var ErrMoreThanOnePeerDiscoveryEnabled = errors.New("more than one peer discovery mechanism enabled")
@@ -95,6 +95,12 @@ func (ds *directSender) directStreamHandler(s network.Stream) {
 				return
 			}
 
+			//the sender can only speak for itself as the connection has already authenticated the remote peer
+			if peer.ID(msg.GetFrom()) != s.Conn().RemotePeer() {
+				log.Debug(fmt.Sprintf("direct message from %s claims to be sent by another peer", s.Conn().RemotePeer()))
+				continue
+			}
+
 			err = ds.processReceivedDirectMessage(msg)
 			if err != nil {
 				log.Debug(err.Error())
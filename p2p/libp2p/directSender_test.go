@@ -528,6 +528,12 @@ func TestDirectSender_ReceivedSentMessageShouldCallMessageHandlerTestFullCycle(t
 
 	stream := mock.NewStreamMock()
 	stream.SetProtocol(libp2p.DirectSendID)
+	//the stream is a loopback one so the remote peer as seen by the receiver is the sender itself
+	stream.SetConn(&mock.ConnStub{
+		RemotePeerCalled: func() peer.ID {
+			return id
+		},
+	})
 
 	streamHandler(stream)
 
@@ -552,3 +558,55 @@ func TestDirectSender_ReceivedSentMessageShouldCallMessageHandlerTestFullCycle(t
 	assert.Equal(t, data, receivedMsg.Data())
 	assert.Equal(t, []string{topic}, receivedMsg.TopicIDs())
 }
+
+func TestDirectSender_ReceivedMessageFromImpersonatingPeerShouldNotCallMessageHandler(t *testing.T) {
+	var streamHandler network.StreamHandler
+	netw := &mock.NetworkStub{}
+
+	hs := &mock.ConnectableHostStub{
+		SetStreamHandlerCalled: func(pid protocol.ID, handler network.StreamHandler) {
+			streamHandler = handler
+		},
+		NetworkCalled: func() network.Network {
+			return netw
+		},
+	}
+
+	chanDone := make(chan bool, 1)
+
+	ds, _ := libp2p.NewDirectSender(
+		context.Background(),
+		hs,
+		func(msg p2p.MessageP2P) error {
+			chanDone <- true
+			return nil
+		},
+	)
+
+	id, sk := createLibP2PCredentialsDirectSender()
+	remotePeer := peer.ID("remote peer")
+
+	stream := mock.NewStreamMock()
+	stream.SetProtocol(libp2p.DirectSendID)
+	stream.SetConn(&mock.ConnStub{
+		RemotePeerCalled: func() peer.ID {
+			return peer.ID("another peer")
+		},
+	})
+
+	streamHandler(stream)
+
+	cs := createConnStub(stream, id, sk, remotePeer)
+
+	netw.ConnsToPeerCalled = func(p peer.ID) []network.Conn {
+		return []network.Conn{cs}
+	}
+
+	_ = ds.Send("topic", []byte("data"), p2p.PeerID(cs.RemotePeer()))
+
+	select {
+	case <-chanDone:
+		assert.Fail(t, "message handler should have not been called")
+	case <-time.After(time.Millisecond * 500):
+	}
+}
@@ -28,3 +28,7 @@ func (kdd *KadDhtDiscoverer) ConnectToOnePeerFromInitialPeersList(
 
 	return kdd.connectToOnePeerFromInitialPeersList(durationBetweenAttempts, initialPeersList)
 }
+
+func (sld *StaticListDiscoverer) Backoff(numFailedDials int) time.Duration {
+	return sld.backoff(numFailedDials)
+}
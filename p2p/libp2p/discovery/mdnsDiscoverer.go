@@ -0,0 +1,117 @@
+package discovery
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/libp2p/go-libp2p-core/peer"
+	libp2pDiscovery "github.com/libp2p/go-libp2p/p2p/discovery"
+)
+
+const mdnsName = "mdns discovery"
+
+var mdnsConnectTimeout = 10 * time.Second
+
+// MdnsDiscoverer is the mDNS discovery type implementation. It finds the peers running in the same local network
+// that advertise the same service tag and connects to them. Useful for local clusters
+type MdnsDiscoverer struct {
+	mutService sync.Mutex
+	service    libp2pDiscovery.Service
+
+	contextProvider *libp2p.Libp2pContext
+
+	refreshInterval time.Duration
+	serviceTag      string
+}
+
+// NewMdnsPeerDiscoverer creates a new mDNS discovery type implementation
+func NewMdnsPeerDiscoverer(refreshInterval time.Duration, serviceTag string) (*MdnsDiscoverer, error) {
+	if refreshInterval <= 0 {
+		return nil, p2p.ErrNegativeOrZeroPeersRefreshInterval
+	}
+	if len(serviceTag) == 0 {
+		return nil, p2p.ErrEmptyServiceTag
+	}
+
+	return &MdnsDiscoverer{
+		refreshInterval: refreshInterval,
+		serviceTag:      serviceTag,
+	}, nil
+}
+
+// Bootstrap will start advertising this peer and querying for the other peers in the local network
+func (md *MdnsDiscoverer) Bootstrap() error {
+	md.mutService.Lock()
+	defer md.mutService.Unlock()
+
+	if md.service != nil {
+		return p2p.ErrPeerDiscoveryProcessAlreadyStarted
+	}
+
+	if md.contextProvider == nil {
+		return p2p.ErrNilContextProvider
+	}
+
+	service, err := libp2pDiscovery.NewMdnsService(
+		md.contextProvider.Context(),
+		md.contextProvider.Host(),
+		md.refreshInterval,
+		md.serviceTag,
+	)
+	if err != nil {
+		return err
+	}
+
+	service.RegisterNotifee(md)
+	md.service = service
+
+	return nil
+}
+
+// HandlePeerFound is called by the mDNS service each time a peer is found in the local network
+func (md *MdnsDiscoverer) HandlePeerFound(pInfo peer.AddrInfo) {
+	h := md.contextProvider.Host()
+	if pInfo.ID == h.ID() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(md.contextProvider.Context(), mdnsConnectTimeout)
+	defer cancel()
+
+	err := h.Connect(ctx, pInfo)
+	if err != nil {
+		log.Debug("mdns connect to " + pInfo.ID.Pretty() + ": " + err.Error())
+	}
+}
+
+// Name returns the name of the mDNS peer discovery implementation
+func (md *MdnsDiscoverer) Name() string {
+	return mdnsName
+}
+
+// ApplyContext sets the context in which this discoverer is to be run
+func (md *MdnsDiscoverer) ApplyContext(ctxProvider p2p.ContextProvider) error {
+	if ctxProvider == nil || ctxProvider.IsInterfaceNil() {
+		return p2p.ErrNilContextProvider
+	}
+
+	ctx, ok := ctxProvider.(*libp2p.Libp2pContext)
+
+	if !ok {
+		return p2p.ErrWrongContextApplier
+	}
+
+	md.contextProvider = ctx
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (md *MdnsDiscoverer) IsInterfaceNil() bool {
+	if md == nil {
+		return true
+	}
+	return false
+}
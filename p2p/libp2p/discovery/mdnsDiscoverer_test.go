@@ -0,0 +1,64 @@
+package discovery_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/discovery"
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/libp2p/go-libp2p-core/peer"
+	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMdnsPeerDiscoverer_InvalidIntervalShouldErr(t *testing.T) {
+	md, err := discovery.NewMdnsPeerDiscoverer(0, "tag")
+
+	assert.Nil(t, md)
+	assert.Equal(t, p2p.ErrNegativeOrZeroPeersRefreshInterval, err)
+}
+
+func TestNewMdnsPeerDiscoverer_EmptyServiceTagShouldErr(t *testing.T) {
+	md, err := discovery.NewMdnsPeerDiscoverer(time.Second, "")
+
+	assert.Nil(t, md)
+	assert.Equal(t, p2p.ErrEmptyServiceTag, err)
+}
+
+func TestMdnsDiscoverer_BootstrapWithoutContextShouldErr(t *testing.T) {
+	md, _ := discovery.NewMdnsPeerDiscoverer(time.Second, "tag")
+
+	err := md.Bootstrap()
+
+	assert.Equal(t, p2p.ErrNilContextProvider, err)
+}
+
+func TestMdnsDiscoverer_ApplyContextWrongTypeShouldErr(t *testing.T) {
+	md, _ := discovery.NewMdnsPeerDiscoverer(time.Second, "tag")
+
+	err := md.ApplyContext(&mock.ContextProviderMock{})
+
+	assert.Equal(t, p2p.ErrWrongContextApplier, err)
+}
+
+func TestMdnsDiscoverer_HandlePeerFoundShouldConnect(t *testing.T) {
+	netw := mocknet.New(context.Background())
+	h1, _ := netw.GenPeer()
+	h2, _ := netw.GenPeer()
+	_ = netw.LinkAll()
+	defer func() {
+		_ = h1.Close()
+		_ = h2.Close()
+	}()
+
+	ctx, _ := libp2p.NewLibp2pContext(context.Background(), libp2p.NewConnectableHost(h1))
+	md, _ := discovery.NewMdnsPeerDiscoverer(time.Second, "tag")
+	_ = md.ApplyContext(ctx)
+
+	md.HandlePeerFound(peer.AddrInfo{ID: h2.ID(), Addrs: h2.Addrs()})
+
+	assert.Equal(t, 1, len(h1.Network().Peers()))
+}
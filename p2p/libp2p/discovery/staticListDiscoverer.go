@@ -0,0 +1,274 @@
+package discovery
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+)
+
+const staticListName = "static list discovery"
+
+var staticDialTimeout = 10 * time.Second
+
+type staticPeer struct {
+	address        string
+	pid            peer.ID
+	isReachable    bool
+	numFailedDials int
+	lastError      string
+	nextDial       time.Time
+}
+
+// StaticListDiscoverer keeps the connections to a fixed list of peers. It periodically checks them and re-dials
+// the ones that disappeared, backing off exponentially for the peers that can not be reached
+type StaticListDiscoverer struct {
+	mutPeers sync.RWMutex
+	peers    []*staticPeer
+	started  bool
+
+	contextProvider *libp2p.Libp2pContext
+
+	checkInterval time.Duration
+	maxBackoff    time.Duration
+}
+
+// NewStaticListPeerDiscoverer creates a new static list discovery type implementation
+// All addresses should contain the peer ID (e.g. /ip4/127.0.0.1/tcp/10000/p2p/16Uiu2HAm...)
+func NewStaticListPeerDiscoverer(
+	peersList []string,
+	checkInterval time.Duration,
+	maxBackoff time.Duration,
+) (*StaticListDiscoverer, error) {
+
+	if len(peersList) == 0 {
+		return nil, p2p.ErrEmptyPeersList
+	}
+	if checkInterval <= 0 {
+		return nil, p2p.ErrNegativeOrZeroPeersRefreshInterval
+	}
+	if maxBackoff < checkInterval {
+		return nil, p2p.ErrInvalidBackoffDuration
+	}
+
+	peers := make([]*staticPeer, 0, len(peersList))
+	for _, address := range peersList {
+		multiAddr, err := multiaddr.NewMultiaddr(address)
+		if err != nil {
+			return nil, err
+		}
+
+		pInfo, err := peer.AddrInfoFromP2pAddr(multiAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		peers = append(peers, &staticPeer{
+			address: address,
+			pid:     pInfo.ID,
+		})
+	}
+
+	return &StaticListDiscoverer{
+		peers:         peers,
+		checkInterval: checkInterval,
+		maxBackoff:    maxBackoff,
+	}, nil
+}
+
+// Bootstrap will dial all the peers from the list and start the periodic checking
+func (sld *StaticListDiscoverer) Bootstrap() error {
+	sld.mutPeers.Lock()
+	defer sld.mutPeers.Unlock()
+
+	if sld.started {
+		return p2p.ErrPeerDiscoveryProcessAlreadyStarted
+	}
+
+	if sld.contextProvider == nil {
+		return p2p.ErrNilContextProvider
+	}
+
+	sld.started = true
+	go sld.checkPeersContinuously()
+
+	return nil
+}
+
+func (sld *StaticListDiscoverer) checkPeersContinuously() {
+	ctx := sld.contextProvider.Context()
+	for {
+		sld.CheckPeers()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(sld.checkInterval):
+		}
+	}
+}
+
+// CheckPeers updates the reachability of all the peers from the list and dials the ones that are not connected
+// and whose backoff period expired. It returns the number of connected peers
+func (sld *StaticListDiscoverer) CheckPeers() int {
+	sld.mutPeers.RLock()
+	peers := make([]*staticPeer, len(sld.peers))
+	copy(peers, sld.peers)
+	sld.mutPeers.RUnlock()
+
+	numConnected := 0
+	for _, sp := range peers {
+		if sld.checkPeer(sp) {
+			numConnected++
+		}
+	}
+
+	return numConnected
+}
+
+func (sld *StaticListDiscoverer) checkPeer(sp *staticPeer) bool {
+	h := sld.contextProvider.Host()
+	if h.Network().Connectedness(sp.pid) == network.Connected {
+		sld.setReachable(sp)
+		return true
+	}
+
+	sld.mutPeers.RLock()
+	shouldDial := !time.Now().Before(sp.nextDial)
+	sld.mutPeers.RUnlock()
+	if !shouldDial {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(sld.contextProvider.Context(), staticDialTimeout)
+	defer cancel()
+
+	err := h.ConnectToPeer(ctx, sp.address)
+	if err != nil {
+		sld.setUnreachable(sp, err)
+		return false
+	}
+
+	sld.setReachable(sp)
+	return true
+}
+
+func (sld *StaticListDiscoverer) setReachable(sp *staticPeer) {
+	sld.mutPeers.Lock()
+	defer sld.mutPeers.Unlock()
+
+	if !sp.isReachable {
+		log.Info("static peer " + sp.address + " is reachable")
+	}
+
+	sp.isReachable = true
+	sp.numFailedDials = 0
+	sp.lastError = ""
+	sp.nextDial = time.Time{}
+}
+
+func (sld *StaticListDiscoverer) setUnreachable(sp *staticPeer, err error) {
+	sld.mutPeers.Lock()
+	defer sld.mutPeers.Unlock()
+
+	if sp.isReachable || sp.numFailedDials == 0 {
+		log.Warn("static peer " + sp.address + " is not reachable: " + err.Error())
+	}
+
+	sp.isReachable = false
+	sp.numFailedDials++
+	sp.lastError = err.Error()
+	sp.nextDial = time.Now().Add(sld.backoff(sp.numFailedDials))
+}
+
+// backoff doubles the waiting time with each failed dial, up to the maximum backoff
+func (sld *StaticListDiscoverer) backoff(numFailedDials int) time.Duration {
+	backoff := sld.checkInterval
+	for i := 1; i < numFailedDials; i++ {
+		backoff *= 2
+		if backoff >= sld.maxBackoff {
+			return sld.maxBackoff
+		}
+	}
+
+	return backoff
+}
+
+// Reachability returns the current status of the peers from the list
+func (sld *StaticListDiscoverer) Reachability() []p2p.PeerReachability {
+	sld.mutPeers.RLock()
+	defer sld.mutPeers.RUnlock()
+
+	reachability := make([]p2p.PeerReachability, 0, len(sld.peers))
+	for _, sp := range sld.peers {
+		reachability = append(reachability, p2p.PeerReachability{
+			Address:        sp.address,
+			PeerID:         p2p.PeerID(sp.pid),
+			IsReachable:    sp.isReachable,
+			NumFailedDials: sp.numFailedDials,
+			LastError:      sp.lastError,
+			NextDial:       sp.nextDial,
+		})
+	}
+
+	return reachability
+}
+
+// Name returns the name of the static list peer discovery implementation
+func (sld *StaticListDiscoverer) Name() string {
+	return staticListName
+}
+
+// ApplyContext sets the context in which this discoverer is to be run
+func (sld *StaticListDiscoverer) ApplyContext(ctxProvider p2p.ContextProvider) error {
+	if ctxProvider == nil || ctxProvider.IsInterfaceNil() {
+		return p2p.ErrNilContextProvider
+	}
+
+	ctx, ok := ctxProvider.(*libp2p.Libp2pContext)
+
+	if !ok {
+		return p2p.ErrWrongContextApplier
+	}
+
+	sld.contextProvider = ctx
+	return nil
+}
+
+// ReconnectToNetwork will try to connect to the peers from the list, ignoring the backoff periods. The returned
+// channel is written once at least one peer is connected
+func (sld *StaticListDiscoverer) ReconnectToNetwork() <-chan struct{} {
+	chanDone := make(chan struct{}, 1)
+
+	go func() {
+		for {
+			sld.mutPeers.Lock()
+			for _, sp := range sld.peers {
+				sp.nextDial = time.Time{}
+			}
+			sld.mutPeers.Unlock()
+
+			if sld.CheckPeers() > 0 {
+				chanDone <- struct{}{}
+				return
+			}
+
+			time.Sleep(sld.checkInterval)
+		}
+	}()
+
+	return chanDone
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sld *StaticListDiscoverer) IsInterfaceNil() bool {
+	if sld == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,180 @@
+package discovery_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/discovery"
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+const staticPeerAddress = "/ip4/127.0.0.1/tcp/10000/p2p/16Uiu2HAmAzokH1ozUF52Vy3RKqRfCMr9ZdNDkUQFEkXRs9DqvmKf"
+
+func createStaticListContext(connected *bool, numDials *int, errDial error) *libp2p.Libp2pContext {
+	h := &mock.ConnectableHostStub{
+		NetworkCalled: func() network.Network {
+			return &mock.NetworkStub{
+				ConnectednessCalled: func(id peer.ID) network.Connectedness {
+					if *connected {
+						return network.Connected
+					}
+					return network.NotConnected
+				},
+			}
+		},
+		ConnectToPeerCalled: func(ctx context.Context, address string) error {
+			*numDials++
+			if errDial == nil {
+				*connected = true
+			}
+			return errDial
+		},
+	}
+
+	ctx, _ := libp2p.NewLibp2pContext(context.Background(), h)
+	return ctx
+}
+
+//------- NewStaticListPeerDiscoverer
+
+func TestNewStaticListPeerDiscoverer_EmptyListShouldErr(t *testing.T) {
+	sld, err := discovery.NewStaticListPeerDiscoverer(nil, time.Second, time.Minute)
+
+	assert.Nil(t, sld)
+	assert.Equal(t, p2p.ErrEmptyPeersList, err)
+}
+
+func TestNewStaticListPeerDiscoverer_InvalidIntervalShouldErr(t *testing.T) {
+	sld, err := discovery.NewStaticListPeerDiscoverer([]string{staticPeerAddress}, 0, time.Minute)
+
+	assert.Nil(t, sld)
+	assert.Equal(t, p2p.ErrNegativeOrZeroPeersRefreshInterval, err)
+}
+
+func TestNewStaticListPeerDiscoverer_BackoffLowerThanIntervalShouldErr(t *testing.T) {
+	sld, err := discovery.NewStaticListPeerDiscoverer([]string{staticPeerAddress}, time.Minute, time.Second)
+
+	assert.Nil(t, sld)
+	assert.Equal(t, p2p.ErrInvalidBackoffDuration, err)
+}
+
+func TestNewStaticListPeerDiscoverer_AddressWithoutPeerIDShouldErr(t *testing.T) {
+	sld, err := discovery.NewStaticListPeerDiscoverer([]string{"/ip4/127.0.0.1/tcp/10000"}, time.Second, time.Minute)
+
+	assert.Nil(t, sld)
+	assert.NotNil(t, err)
+}
+
+func TestNewStaticListPeerDiscoverer_OkValsShouldWork(t *testing.T) {
+	sld, err := discovery.NewStaticListPeerDiscoverer([]string{staticPeerAddress}, time.Second, time.Minute)
+
+	assert.NotNil(t, sld)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(sld.Reachability()))
+	assert.False(t, sld.Reachability()[0].IsReachable)
+}
+
+//------- Bootstrap
+
+func TestStaticListDiscoverer_BootstrapWithoutContextShouldErr(t *testing.T) {
+	sld, _ := discovery.NewStaticListPeerDiscoverer([]string{staticPeerAddress}, time.Second, time.Minute)
+
+	err := sld.Bootstrap()
+
+	assert.Equal(t, p2p.ErrNilContextProvider, err)
+}
+
+func TestStaticListDiscoverer_BootstrapTwiceShouldErr(t *testing.T) {
+	connected, numDials := false, 0
+	sld, _ := discovery.NewStaticListPeerDiscoverer([]string{staticPeerAddress}, time.Hour, time.Hour)
+	_ = sld.ApplyContext(createStaticListContext(&connected, &numDials, nil))
+
+	err := sld.Bootstrap()
+	assert.Nil(t, err)
+
+	err = sld.Bootstrap()
+	assert.Equal(t, p2p.ErrPeerDiscoveryProcessAlreadyStarted, err)
+}
+
+//------- CheckPeers
+
+func TestStaticListDiscoverer_CheckPeersConnectedPeerShouldNotDial(t *testing.T) {
+	connected, numDials := true, 0
+	sld, _ := discovery.NewStaticListPeerDiscoverer([]string{staticPeerAddress}, time.Second, time.Minute)
+	_ = sld.ApplyContext(createStaticListContext(&connected, &numDials, nil))
+
+	numConnected := sld.CheckPeers()
+
+	assert.Equal(t, 1, numConnected)
+	assert.Equal(t, 0, numDials)
+	assert.True(t, sld.Reachability()[0].IsReachable)
+}
+
+func TestStaticListDiscoverer_CheckPeersDisappearedPeerShouldRedial(t *testing.T) {
+	connected, numDials := true, 0
+	sld, _ := discovery.NewStaticListPeerDiscoverer([]string{staticPeerAddress}, time.Second, time.Minute)
+	_ = sld.ApplyContext(createStaticListContext(&connected, &numDials, nil))
+	_ = sld.CheckPeers()
+
+	connected = false
+	numConnected := sld.CheckPeers()
+
+	assert.Equal(t, 1, numConnected)
+	assert.Equal(t, 1, numDials)
+	assert.True(t, sld.Reachability()[0].IsReachable)
+}
+
+func TestStaticListDiscoverer_CheckPeersUnreachablePeerShouldBackoff(t *testing.T) {
+	connected, numDials := false, 0
+	errDial := errors.New("unreachable")
+	sld, _ := discovery.NewStaticListPeerDiscoverer([]string{staticPeerAddress}, time.Second, time.Minute)
+	_ = sld.ApplyContext(createStaticListContext(&connected, &numDials, errDial))
+
+	numConnected := sld.CheckPeers()
+	_ = sld.CheckPeers()
+
+	assert.Equal(t, 0, numConnected)
+	assert.Equal(t, 1, numDials)
+	reachability := sld.Reachability()[0]
+	assert.False(t, reachability.IsReachable)
+	assert.Equal(t, 1, reachability.NumFailedDials)
+	assert.Equal(t, errDial.Error(), reachability.LastError)
+	assert.True(t, reachability.NextDial.After(time.Now()))
+}
+
+func TestStaticListDiscoverer_BackoffShouldDoubleUpToMax(t *testing.T) {
+	sld, _ := discovery.NewStaticListPeerDiscoverer([]string{staticPeerAddress}, time.Second, 5*time.Second)
+
+	assert.Equal(t, time.Second, sld.Backoff(1))
+	assert.Equal(t, 2*time.Second, sld.Backoff(2))
+	assert.Equal(t, 4*time.Second, sld.Backoff(3))
+	assert.Equal(t, 5*time.Second, sld.Backoff(4))
+	assert.Equal(t, 5*time.Second, sld.Backoff(100))
+}
+
+func TestStaticListDiscoverer_ReconnectToNetworkShouldIgnoreBackoff(t *testing.T) {
+	connected, numDials := false, 0
+	sld, _ := discovery.NewStaticListPeerDiscoverer([]string{staticPeerAddress}, time.Second, time.Minute)
+	ctx := createStaticListContext(&connected, &numDials, errors.New("unreachable"))
+	_ = sld.ApplyContext(ctx)
+	_ = sld.CheckPeers()
+
+	successCtx := createStaticListContext(&connected, &numDials, nil)
+	_ = sld.ApplyContext(successCtx)
+
+	select {
+	case <-sld.ReconnectToNetwork():
+	case <-time.After(timeoutWaitResponses):
+		assert.Fail(t, "timeout reached")
+	}
+
+	assert.Equal(t, 2, numDials)
+	assert.True(t, sld.Reachability()[0].IsReachable)
+}
@@ -1,6 +1,10 @@
 package libp2p
 
 import (
+	"context"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
 	"github.com/libp2p/go-libp2p-core/connmgr"
 	"github.com/libp2p/go-libp2p-pubsub/pb"
@@ -9,6 +13,10 @@ import (
 
 var MaxSendBuffSize = maxSendBuffSize
 var BroadcastGoRoutines = broadcastGoRoutines
+var MaxPendingChunkedMessagesPerPeer = maxPendingChunkedMessagesPerPeer
+
+const FrameHeaderSize = frameHeaderSize
+const WireVersion = wireVersion
 
 func (netMes *networkMessenger) ConnManager() connmgr.ConnManager {
 	return netMes.ctxProvider.connHost.ConnManager()
@@ -30,6 +38,18 @@ func (ds *directSender) Counter() uint64 {
 	return ds.counter
 }
 
+func (wc *wireCodec) DecodeChunk(originator p2p.PeerID, frame []byte) ([]byte, *chunkedMessage, bool, error) {
+	return wc.decodeChunk(originator, frame)
+}
+
+func (cm *chunkedMessage) SetValidated(isValid bool) {
+	cm.setValidated(isValid)
+}
+
+func (cm *chunkedMessage) WaitValidated(timeout time.Duration) bool {
+	return cm.waitValidated(context.Background(), timeout)
+}
+
 func (mh *MutexHolder) Mutexes() *lrucache.LRUCache {
 	return mh.mutexes
 }
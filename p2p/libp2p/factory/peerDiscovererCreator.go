@@ -8,6 +8,17 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/discovery"
 )
 
+const (
+	// KadDhtDiscoveryType selects the kad-dht peer discovery
+	KadDhtDiscoveryType = "kad-dht"
+	// MdnsDiscoveryType selects the mDNS peer discovery, used in local networks
+	MdnsDiscoveryType = "mdns"
+	// StaticListDiscoveryType selects the static peers list discovery
+	StaticListDiscoveryType = "static"
+	// NullDiscoveryType disables the peer discovery
+	NullDiscoveryType = "none"
+)
+
 type peerDiscovererCreator struct {
 	p2pConfig config.P2PConfig
 }
@@ -21,12 +32,33 @@ func NewPeerDiscovererCreator(pConfig config.P2PConfig) *peerDiscovererCreator {
 
 // CreatePeerDiscoverer generates an implementation of PeerDiscoverer by parsing the p2pConfig struct
 // Errors if config is badly formatted
+// If no discovery type is set, the kad-dht discovery is used when enabled, for backward compatibility
 func (pdc *peerDiscovererCreator) CreatePeerDiscoverer() (p2p.PeerDiscoverer, error) {
-	if pdc.p2pConfig.KadDhtPeerDiscovery.Enabled {
+	discoveryType := pdc.p2pConfig.PeerDiscovery.Type
+	if len(discoveryType) == 0 {
+		if pdc.p2pConfig.KadDhtPeerDiscovery.Enabled {
+			return pdc.createKadDhtPeerDiscoverer()
+		}
+
+		return discovery.NewNullDiscoverer(), nil
+	}
+
+	if discoveryType != KadDhtDiscoveryType && pdc.p2pConfig.KadDhtPeerDiscovery.Enabled {
+		return nil, p2p.ErrMoreThanOnePeerDiscoveryEnabled
+	}
+
+	switch discoveryType {
+	case KadDhtDiscoveryType:
 		return pdc.createKadDhtPeerDiscoverer()
+	case MdnsDiscoveryType:
+		return pdc.createMdnsPeerDiscoverer()
+	case StaticListDiscoveryType:
+		return pdc.createStaticListPeerDiscoverer()
+	case NullDiscoveryType:
+		return discovery.NewNullDiscoverer(), nil
 	}
 
-	return discovery.NewNullDiscoverer(), nil
+	return nil, p2p.ErrInvalidPeerDiscoveryType
 }
 
 func (pdc *peerDiscovererCreator) createKadDhtPeerDiscoverer() (p2p.PeerDiscoverer, error) {
@@ -41,6 +73,31 @@ func (pdc *peerDiscovererCreator) createKadDhtPeerDiscoverer() (p2p.PeerDiscover
 	), nil
 }
 
+func (pdc *peerDiscovererCreator) createMdnsPeerDiscoverer() (p2p.PeerDiscoverer, error) {
+	mdnsDiscoverer, err := discovery.NewMdnsPeerDiscoverer(
+		time.Second*time.Duration(pdc.p2pConfig.MdnsPeerDiscovery.RefreshIntervalInSec),
+		pdc.p2pConfig.MdnsPeerDiscovery.ServiceTag,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return mdnsDiscoverer, nil
+}
+
+func (pdc *peerDiscovererCreator) createStaticListPeerDiscoverer() (p2p.PeerDiscoverer, error) {
+	staticListDiscoverer, err := discovery.NewStaticListPeerDiscoverer(
+		pdc.p2pConfig.StaticPeerDiscovery.PeerList,
+		time.Second*time.Duration(pdc.p2pConfig.StaticPeerDiscovery.CheckIntervalInSec),
+		time.Second*time.Duration(pdc.p2pConfig.StaticPeerDiscovery.MaxBackoffInSec),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return staticListDiscoverer, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (pdc *peerDiscovererCreator) IsInterfaceNil() bool {
 	if pdc == nil {
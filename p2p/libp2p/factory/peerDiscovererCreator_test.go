@@ -58,3 +58,125 @@ func TestPeerDiscovererCreator_CreatePeerDiscovererKadOkValsShouldWork(t *testin
 	assert.True(t, ok)
 	assert.Nil(t, err)
 }
+
+func TestPeerDiscovererCreator_CreatePeerDiscovererUnknownTypeShouldErr(t *testing.T) {
+	p2pConfig := config.P2PConfig{
+		PeerDiscovery: config.PeerDiscoveryConfig{
+			Type: "unknown",
+		},
+	}
+
+	f := factory.NewPeerDiscovererCreator(p2pConfig)
+	pDiscoverer, err := f.CreatePeerDiscoverer()
+
+	assert.Nil(t, pDiscoverer)
+	assert.Equal(t, p2p.ErrInvalidPeerDiscoveryType, err)
+}
+
+func TestPeerDiscovererCreator_CreatePeerDiscovererOtherTypeWithKadEnabledShouldErr(t *testing.T) {
+	p2pConfig := config.P2PConfig{
+		PeerDiscovery: config.PeerDiscoveryConfig{
+			Type: factory.MdnsDiscoveryType,
+		},
+		KadDhtPeerDiscovery: config.KadDhtPeerDiscoveryConfig{
+			Enabled:              true,
+			RefreshIntervalInSec: 1,
+		},
+	}
+
+	f := factory.NewPeerDiscovererCreator(p2pConfig)
+	pDiscoverer, err := f.CreatePeerDiscoverer()
+
+	assert.Nil(t, pDiscoverer)
+	assert.Equal(t, p2p.ErrMoreThanOnePeerDiscoveryEnabled, err)
+}
+
+func TestPeerDiscovererCreator_CreatePeerDiscovererKadTypeShouldWork(t *testing.T) {
+	p2pConfig := config.P2PConfig{
+		PeerDiscovery: config.PeerDiscoveryConfig{
+			Type: factory.KadDhtDiscoveryType,
+		},
+		KadDhtPeerDiscovery: config.KadDhtPeerDiscoveryConfig{
+			RefreshIntervalInSec: 1,
+		},
+	}
+
+	f := factory.NewPeerDiscovererCreator(p2pConfig)
+	pDiscoverer, err := f.CreatePeerDiscoverer()
+
+	_, ok := pDiscoverer.(*discovery.KadDhtDiscoverer)
+
+	assert.True(t, ok)
+	assert.Nil(t, err)
+}
+
+func TestPeerDiscovererCreator_CreatePeerDiscovererMdnsShouldWork(t *testing.T) {
+	p2pConfig := config.P2PConfig{
+		PeerDiscovery: config.PeerDiscoveryConfig{
+			Type: factory.MdnsDiscoveryType,
+		},
+		MdnsPeerDiscovery: config.MdnsPeerDiscoveryConfig{
+			RefreshIntervalInSec: 1,
+			ServiceTag:           "elrond",
+		},
+	}
+
+	f := factory.NewPeerDiscovererCreator(p2pConfig)
+	pDiscoverer, err := f.CreatePeerDiscoverer()
+
+	_, ok := pDiscoverer.(*discovery.MdnsDiscoverer)
+
+	assert.True(t, ok)
+	assert.Nil(t, err)
+}
+
+func TestPeerDiscovererCreator_CreatePeerDiscovererMdnsInvalidConfigShouldErr(t *testing.T) {
+	p2pConfig := config.P2PConfig{
+		PeerDiscovery: config.PeerDiscoveryConfig{
+			Type: factory.MdnsDiscoveryType,
+		},
+	}
+
+	f := factory.NewPeerDiscovererCreator(p2pConfig)
+	pDiscoverer, err := f.CreatePeerDiscoverer()
+
+	assert.Nil(t, pDiscoverer)
+	assert.Equal(t, p2p.ErrNegativeOrZeroPeersRefreshInterval, err)
+}
+
+func TestPeerDiscovererCreator_CreatePeerDiscovererStaticShouldWork(t *testing.T) {
+	p2pConfig := config.P2PConfig{
+		PeerDiscovery: config.PeerDiscoveryConfig{
+			Type: factory.StaticListDiscoveryType,
+		},
+		StaticPeerDiscovery: config.StaticPeerDiscoveryConfig{
+			PeerList:           []string{"/ip4/127.0.0.1/tcp/10000/p2p/16Uiu2HAmAzokH1ozUF52Vy3RKqRfCMr9ZdNDkUQFEkXRs9DqvmKf"},
+			CheckIntervalInSec: 1,
+			MaxBackoffInSec:    10,
+		},
+	}
+
+	f := factory.NewPeerDiscovererCreator(p2pConfig)
+	pDiscoverer, err := f.CreatePeerDiscoverer()
+
+	_, ok := pDiscoverer.(*discovery.StaticListDiscoverer)
+
+	assert.True(t, ok)
+	assert.Nil(t, err)
+}
+
+func TestPeerDiscovererCreator_CreatePeerDiscovererNoneTypeShouldRetNullDiscoverer(t *testing.T) {
+	p2pConfig := config.P2PConfig{
+		PeerDiscovery: config.PeerDiscoveryConfig{
+			Type: factory.NullDiscoveryType,
+		},
+	}
+
+	f := factory.NewPeerDiscovererCreator(p2pConfig)
+	pDiscoverer, err := f.CreatePeerDiscoverer()
+
+	_, ok := pDiscoverer.(*discovery.NullDiscoverer)
+
+	assert.True(t, ok)
+	assert.Nil(t, err)
+}
@@ -1,6 +1,7 @@
 package libp2p
 
 import (
+	"sync"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/p2p"
@@ -19,12 +20,15 @@ var DurationBetweenReconnectAttempts = time.Second * 5
 type libp2pConnectionMonitor struct {
 	chDoReconnect chan struct{}
 	reconnecter   p2p.Reconnecter
+	mutNotifiees  sync.RWMutex
+	notifiees     []p2p.ConnectionNotifiee
 }
 
 func newLibp2pConnectionMonitor(reconnecter p2p.Reconnecter) *libp2pConnectionMonitor {
 	cm := &libp2pConnectionMonitor{
 		reconnecter:   reconnecter,
 		chDoReconnect: make(chan struct{}, 0),
+		notifiees:     make([]p2p.ConnectionNotifiee, 0),
 	}
 
 	if reconnecter != nil {
@@ -41,10 +45,26 @@ func (lcm *libp2pConnectionMonitor) Listen(network.Network, multiaddr.Multiaddr)
 func (lcm *libp2pConnectionMonitor) ListenClose(network.Network, multiaddr.Multiaddr) {}
 
 // Connected is called when a connection opened
-func (lcm *libp2pConnectionMonitor) Connected(network.Network, network.Conn) {}
+func (lcm *libp2pConnectionMonitor) Connected(_ network.Network, conn network.Conn) {
+	if conn == nil {
+		return
+	}
+
+	pid := p2p.PeerID(conn.RemotePeer())
+	for _, notifiee := range lcm.getNotifiees() {
+		notifiee.PeerConnected(pid)
+	}
+}
 
 // Disconnected is called when a connection closed
 func (lcm *libp2pConnectionMonitor) Disconnected(netw network.Network, conn network.Conn) {
+	if conn != nil {
+		pid := p2p.PeerID(conn.RemotePeer())
+		for _, notifiee := range lcm.getNotifiees() {
+			notifiee.PeerDisconnected(pid)
+		}
+	}
+
 	if len(netw.Conns()) < ThresholdMinimumConnectedPeers {
 		select {
 		case lcm.chDoReconnect <- struct{}{}:
@@ -59,6 +79,30 @@ func (lcm *libp2pConnectionMonitor) OpenedStream(network.Network, network.Stream
 // ClosedStream is called when a stream closed
 func (lcm *libp2pConnectionMonitor) ClosedStream(network.Network, network.Stream) {}
 
+// addNotifiee registers a new component that will be notified when peers connect or disconnect.
+// The notifiee's handlers are called on the libp2p swarm go routine so they should not block
+func (lcm *libp2pConnectionMonitor) addNotifiee(notifiee p2p.ConnectionNotifiee) error {
+	if notifiee == nil || notifiee.IsInterfaceNil() {
+		return p2p.ErrNilConnectionNotifiee
+	}
+
+	lcm.mutNotifiees.Lock()
+	lcm.notifiees = append(lcm.notifiees, notifiee)
+	lcm.mutNotifiees.Unlock()
+
+	return nil
+}
+
+func (lcm *libp2pConnectionMonitor) getNotifiees() []p2p.ConnectionNotifiee {
+	lcm.mutNotifiees.RLock()
+	defer lcm.mutNotifiees.RUnlock()
+
+	notifiees := make([]p2p.ConnectionNotifiee, len(lcm.notifiees))
+	copy(notifiees, lcm.notifiees)
+
+	return notifiees
+}
+
 func (lcm *libp2pConnectionMonitor) doReconnection() {
 	for {
 		select {
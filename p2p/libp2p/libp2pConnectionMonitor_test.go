@@ -4,8 +4,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/mock"
 	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -60,3 +62,65 @@ func TestNewLibp2pConnectionMonitor_OnDisconnectedUnderThresholdShouldCallReconn
 		assert.Fail(t, "timeout waiting to call reconnect")
 	}
 }
+
+func TestLibp2pConnectionMonitor_AddNilNotifieeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cm := newLibp2pConnectionMonitor(nil)
+	err := cm.addNotifiee(nil)
+
+	assert.Equal(t, p2p.ErrNilConnectionNotifiee, err)
+}
+
+func TestLibp2pConnectionMonitor_ConnectedShouldNotifyAllNotifiees(t *testing.T) {
+	t.Parallel()
+
+	remotePid := peer.ID("remote peer")
+	numCalled := 0
+	cns := &mock.ConnectionNotifieeStub{
+		PeerConnectedCalled: func(pid p2p.PeerID) {
+			if pid == p2p.PeerID(remotePid) {
+				numCalled++
+			}
+		},
+	}
+
+	cm := newLibp2pConnectionMonitor(nil)
+	_ = cm.addNotifiee(cns)
+	_ = cm.addNotifiee(cns)
+	cm.Connected(nil, &mock.ConnStub{
+		RemotePeerCalled: func() peer.ID {
+			return remotePid
+		},
+	})
+
+	assert.Equal(t, 2, numCalled)
+}
+
+func TestLibp2pConnectionMonitor_DisconnectedShouldNotifyNotifiees(t *testing.T) {
+	t.Parallel()
+
+	remotePid := peer.ID("remote peer")
+	disconnectedCalled := false
+	cns := &mock.ConnectionNotifieeStub{
+		PeerDisconnectedCalled: func(pid p2p.PeerID) {
+			disconnectedCalled = pid == p2p.PeerID(remotePid)
+		},
+	}
+
+	ns := mock.NetworkStub{
+		ConnsCalled: func() []network.Conn {
+			return make([]network.Conn, ThresholdMinimumConnectedPeers)
+		},
+	}
+
+	cm := newLibp2pConnectionMonitor(nil)
+	_ = cm.addNotifiee(cns)
+	cm.Disconnected(&ns, &mock.ConnStub{
+		RemotePeerCalled: func() peer.ID {
+			return remotePid
+		},
+	})
+
+	assert.True(t, disconnectedCalled)
+}
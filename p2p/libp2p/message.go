@@ -80,3 +80,16 @@ func (m *Message) IsInterfaceNil() bool {
 	}
 	return false
 }
+
+// newMessageWithData returns a copy of the provided message that carries a different payload
+func newMessageWithData(message p2p.MessageP2P, data []byte) *Message {
+	return &Message{
+		from:      message.From(),
+		data:      data,
+		seqNo:     message.SeqNo(),
+		topicIds:  message.TopicIDs(),
+		signature: message.Signature(),
+		key:       message.Key(),
+		peer:      message.Peer(),
+	}
+}
@@ -3,6 +3,7 @@ package libp2p
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -29,6 +30,10 @@ const ListenLocalhostAddrWithIp4AndTcp = "/ip4/127.0.0.1/tcp/"
 // DirectSendID represents the protocol ID for sending and receiving direct P2P messages
 const DirectSendID = protocol.ID("/directsend/1.0.0")
 
+// NetworkIDProtocolPrefix is the prefix of the protocol advertised by a peer to let the others know the network
+// it belongs to. The network ID follows the prefix
+const NetworkIDProtocolPrefix = "/elrond/network/"
+
 const refreshPeersOnTopic = time.Second * 60
 const ttlPeersOnTopic = time.Second * 120
 
@@ -36,6 +41,18 @@ const pubsubTimeCacheDuration = 10 * time.Minute
 
 const broadcastGoRoutines = 1000
 
+// chunkValidationTimeout is the time a received chunk waits for the reassembled message to be validated before
+// being dropped instead of relayed
+const chunkValidationTimeout = time.Second * 10
+
+// maxWaitingChunks bounds the validators waiting for reassembled messages, so that the incomplete chunked messages
+// can not take all the validation slots of pubsub. The chunks over the limit are not relayed
+const maxWaitingChunks = 2048
+
+// topicValidatorConcurrency is the number of validations allowed to run at once on a topic, including the chunks
+// waiting for their reassembled message
+const topicValidatorConcurrency = 1024 + maxWaitingChunks
+
 //TODO remove the header size of the message when commit d3c5ecd3a3e884206129d9f2a9a4ddfd5e7c8951 from
 // https://github.com/libp2p/go-libp2p-pubsub/pull/189/commits will be part of a new release
 var messageHeader = 64 * 1024 //64kB
@@ -54,6 +71,12 @@ type networkMessenger struct {
 	outgoingPLB         p2p.ChannelLoadBalancer
 	poc                 *peersOnChannel
 	goRoutinesThrottler *throttler.NumGoRoutineThrottler
+	mutTopicFlags       sync.RWMutex
+	topicFlags          map[string]p2p.TopicFlags
+	codec               *wireCodec
+	chanWaitingChunks   chan struct{}
+	mutNetworkID        sync.RWMutex
+	networkID           string
 }
 
 // NewNetworkMessenger creates a libP2P messenger by opening a port on the current machine
@@ -145,12 +168,15 @@ func createMessenger(
 	reconnecter, _ := peerDiscoverer.(p2p.Reconnecter)
 
 	netMes := networkMessenger{
-		ctxProvider:    lctx,
-		pb:             pb,
-		topics:         make(map[string]p2p.MessageProcessor),
-		outgoingPLB:    outgoingPLB,
-		peerDiscoverer: peerDiscoverer,
-		connMonitor:    newLibp2pConnectionMonitor(reconnecter),
+		ctxProvider:       lctx,
+		pb:                pb,
+		topics:            make(map[string]p2p.MessageProcessor),
+		outgoingPLB:       outgoingPLB,
+		peerDiscoverer:    peerDiscoverer,
+		connMonitor:       newLibp2pConnectionMonitor(reconnecter),
+		topicFlags:        make(map[string]p2p.TopicFlags),
+		codec:             NewWireCodec(),
+		chanWaitingChunks: make(chan struct{}, maxWaitingChunks),
 	}
 	lctx.connHost.Network().Notify(netMes.connMonitor)
 
@@ -389,8 +415,9 @@ func (netMes *networkMessenger) OutgoingChannelLoadBalancer() p2p.ChannelLoadBal
 // BroadcastOnChannelBlocking tries to send a byte buffer onto a topic using provided channel
 // It is a blocking method. It needs to be launched on a go routine
 func (netMes *networkMessenger) BroadcastOnChannelBlocking(channel string, topic string, buff []byte) error {
-	if len(buff) > maxSendBuffSize {
-		return p2p.ErrMessageTooLarge
+	frames, err := netMes.encode(topic, buff)
+	if err != nil {
+		return err
 	}
 
 	if !netMes.goRoutinesThrottler.CanProcess() {
@@ -399,11 +426,13 @@ func (netMes *networkMessenger) BroadcastOnChannelBlocking(channel string, topic
 
 	netMes.goRoutinesThrottler.StartProcessing()
 
-	sendable := &p2p.SendableData{
-		Buff:  buff,
-		Topic: topic,
+	for _, frame := range frames {
+		sendable := &p2p.SendableData{
+			Buff:  frame,
+			Topic: topic,
+		}
+		netMes.outgoingPLB.GetChannelOrDefault(channel) <- sendable
 	}
-	netMes.outgoingPLB.GetChannelOrDefault(channel) <- sendable
 	netMes.goRoutinesThrottler.EndProcessing()
 	return nil
 }
@@ -443,14 +472,28 @@ func (netMes *networkMessenger) RegisterMessageProcessor(topic string, handler p
 		netMes.Broadcast(topic, buffToSend)
 	}
 
-	err := netMes.pb.RegisterTopicValidator(topic, func(ctx context.Context, pid peer.ID, message *pubsub.Message) bool {
-		err := handler.ProcessReceivedMessage(NewMessage(message), broadcastHandler)
+	topicValidator := func(ctx context.Context, pid peer.ID, message *pubsub.Message) bool {
+		rawMessage := NewMessage(message)
+		isSelfPublished := p2p.PeerID(pid) == netMes.ID()
+		if isChunkFrame(rawMessage.Data()) {
+			return netMes.processChunk(ctx, rawMessage, isSelfPublished, handler, broadcastHandler)
+		}
+
+		msg, _, err := netMes.decode(rawMessage)
+		if err != nil {
+			log.Debug(err.Error())
+			return false
+		}
+
+		err = handler.ProcessReceivedMessage(msg, broadcastHandler)
 		if err != nil {
 			log.Debug(err.Error())
 		}
 
 		return err == nil
-	})
+	}
+
+	err := netMes.pb.RegisterTopicValidator(topic, topicValidator, pubsub.WithValidatorConcurrency(topicValidatorConcurrency))
 	if err != nil {
 		return err
 	}
@@ -484,20 +527,162 @@ func (netMes *networkMessenger) UnregisterMessageProcessor(topic string) error {
 
 // SendToConnectedPeer sends a direct message to a connected peer
 func (netMes *networkMessenger) SendToConnectedPeer(topic string, buff []byte, peerID p2p.PeerID) error {
-	return netMes.ds.Send(topic, buff, peerID)
+	frames, err := netMes.encode(topic, buff)
+	if err != nil {
+		return err
+	}
+
+	for _, frame := range frames {
+		err = netMes.ds.Send(topic, frame, peerID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetTopicFlags sets the wire-level flags for all the topics whose names start with the provided prefix.
+// When more prefixes match a topic, the longest one is used
+func (netMes *networkMessenger) SetTopicFlags(topicPrefix string, flags p2p.TopicFlags) error {
+	if flags.CompressionThreshold < 0 || flags.ChunkSize < 0 {
+		return p2p.ErrInvalidTopicFlags
+	}
+	if flags.ChunkSize != 0 && (flags.ChunkSize <= chunkHeaderSize || flags.ChunkSize >= maxSendBuffSize) {
+		return p2p.ErrInvalidTopicFlags
+	}
+
+	netMes.mutTopicFlags.Lock()
+	netMes.topicFlags[topicPrefix] = flags
+	netMes.mutTopicFlags.Unlock()
+
+	return nil
+}
+
+func (netMes *networkMessenger) flagsForTopic(topic string) (p2p.TopicFlags, bool) {
+	netMes.mutTopicFlags.RLock()
+	defer netMes.mutTopicFlags.RUnlock()
+
+	bestPrefix := ""
+	found := false
+	for prefix := range netMes.topicFlags {
+		if strings.HasPrefix(topic, prefix) && (!found || len(prefix) > len(bestPrefix)) {
+			bestPrefix = prefix
+			found = true
+		}
+	}
+
+	return netMes.topicFlags[bestPrefix], found
+}
+
+func (netMes *networkMessenger) encode(topic string, buff []byte) ([][]byte, error) {
+	flags, _ := netMes.flagsForTopic(topic)
+	frames, err := netMes.codec.Encode(buff, flags)
+	if err != nil {
+		return nil, err
+	}
+	for _, frame := range frames {
+		//the frame header fits in the room left for the pubsub message header
+		if len(frame)-frameHeaderSize > maxSendBuffSize {
+			return nil, p2p.ErrMessageTooLarge
+		}
+	}
+
+	return frames, nil
+}
+
+// processChunk lets pubsub relay a chunk received from another peer only after the reassembled message has been
+// validated, so the chunks are relayed unchanged and the message keeps its originator and signature. The chunks
+// arrived before the last one wait for the validation done when the last chunk arrives. The chunks published by
+// this messenger are relayed right away
+func (netMes *networkMessenger) processChunk(
+	ctx context.Context,
+	rawMessage p2p.MessageP2P,
+	isSelfPublished bool,
+	handler p2p.MessageProcessor,
+	broadcastHandler func(buffToSend []byte),
+) bool {
+	payload, chunked, complete, err := netMes.codec.decodeChunk(rawMessage.Peer(), rawMessage.Data())
+	if err != nil {
+		log.Debug(err.Error())
+		return false
+	}
+	if !complete {
+		if isSelfPublished {
+			return true
+		}
+
+		return netMes.waitChunkedMessage(ctx, chunked)
+	}
+
+	err = handler.ProcessReceivedMessage(newMessageWithData(rawMessage, payload), broadcastHandler)
+	if err != nil {
+		log.Debug(err.Error())
+	}
+	chunked.setValidated(err == nil)
+
+	return err == nil || isSelfPublished
+}
+
+func (netMes *networkMessenger) waitChunkedMessage(ctx context.Context, chunked *chunkedMessage) bool {
+	select {
+	case netMes.chanWaitingChunks <- struct{}{}:
+	default:
+		log.Debug("too many chunks waiting for their reassembled message, chunk will not be relayed")
+		return false
+	}
+	defer func() {
+		<-netMes.chanWaitingChunks
+	}()
+
+	return chunked.waitValidated(ctx, chunkValidationTimeout)
+}
+
+func (netMes *networkMessenger) decode(message p2p.MessageP2P) (p2p.MessageP2P, bool, error) {
+	payload, complete, err := netMes.codec.Decode(message.Peer(), message.Data())
+	if err != nil || !complete {
+		return nil, complete, err
+	}
+
+	return newMessageWithData(message, payload), true, nil
+}
+
+// AddConnectionNotifiee registers a component that will be notified when peers connect or disconnect
+func (netMes *networkMessenger) AddConnectionNotifiee(notifiee p2p.ConnectionNotifiee) error {
+	return netMes.connMonitor.addNotifiee(notifiee)
+}
+
+// Reachability returns the status of the peers maintained by the peer discovery mechanism. If the mechanism is not
+// able to report it, an empty slice is returned
+func (netMes *networkMessenger) Reachability() []p2p.PeerReachability {
+	reporter, ok := netMes.peerDiscoverer.(p2p.ReachabilityReporter)
+	if !ok || reporter.IsInterfaceNil() {
+		return make([]p2p.PeerReachability, 0)
+	}
+
+	return reporter.Reachability()
 }
 
 func (netMes *networkMessenger) directMessageHandler(message p2p.MessageP2P) error {
 	var processor p2p.MessageProcessor
 
+	topic := message.TopicIDs()[0]
 	netMes.mutTopics.RLock()
-	processor = netMes.topics[message.TopicIDs()[0]]
+	processor = netMes.topics[topic]
 	netMes.mutTopics.RUnlock()
 
 	if processor == nil {
 		return p2p.ErrNilValidator
 	}
 
+	message, complete, err := netMes.decode(message)
+	if err != nil {
+		return err
+	}
+	if !complete {
+		return nil
+	}
+
 	go func(msg p2p.MessageP2P) {
 		err := processor.ProcessReceivedMessage(msg, nil)
 
@@ -509,6 +694,82 @@ func (netMes *networkMessenger) directMessageHandler(message p2p.MessageP2P) err
 	return nil
 }
 
+// SetNetworkID advertises the network this peer belongs to, as a protocol exposed to the other peers
+// through the identify service. It can be set only once
+func (netMes *networkMessenger) SetNetworkID(networkID string) error {
+	if len(networkID) == 0 {
+		return p2p.ErrEmptyNetworkID
+	}
+
+	netMes.mutNetworkID.Lock()
+	defer netMes.mutNetworkID.Unlock()
+
+	if len(netMes.networkID) > 0 {
+		return p2p.ErrNetworkIDAlreadySet
+	}
+
+	netMes.networkID = networkID
+	netMes.ctxProvider.Host().SetStreamHandler(
+		protocol.ID(NetworkIDProtocolPrefix+networkID),
+		func(s network.Stream) {
+			//the protocol is only advertised, no data is exchanged on it
+			_ = s.Reset()
+		},
+	)
+
+	return nil
+}
+
+// PeerNetworkID returns the network ID advertised by the provided peer or empty string if it is not known
+func (netMes *networkMessenger) PeerNetworkID(pid p2p.PeerID) string {
+	if pid == netMes.ID() {
+		netMes.mutNetworkID.RLock()
+		defer netMes.mutNetworkID.RUnlock()
+
+		return netMes.networkID
+	}
+
+	for _, p := range netMes.PeerProtocols(pid) {
+		if strings.HasPrefix(p, NetworkIDProtocolPrefix) {
+			return strings.TrimPrefix(p, NetworkIDProtocolPrefix)
+		}
+	}
+
+	return ""
+}
+
+// PeerAddresses returns all the dialable addresses known for the provided peer
+func (netMes *networkMessenger) PeerAddresses(pid p2p.PeerID) []string {
+	h := netMes.ctxProvider.Host()
+	addrs := make([]string, 0)
+
+	for _, address := range h.Peerstore().Addrs(peer.ID(pid)) {
+		addrs = append(addrs, address.String()+"/p2p/"+pid.Pretty())
+	}
+
+	return addrs
+}
+
+// PeerProtocols returns the protocols the provided peer announced it supports
+func (netMes *networkMessenger) PeerProtocols(pid p2p.PeerID) []string {
+	h := netMes.ctxProvider.Host()
+
+	protocols, err := h.Peerstore().GetProtocols(peer.ID(pid))
+	if err != nil {
+		log.Debug(err.Error())
+		return make([]string, 0)
+	}
+
+	return protocols
+}
+
+// ClosePeer closes all the connections with the provided peer
+func (netMes *networkMessenger) ClosePeer(pid p2p.PeerID) error {
+	h := netMes.ctxProvider.Host()
+
+	return h.Network().ClosePeer(peer.ID(pid))
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (netMes *networkMessenger) IsInterfaceNil() bool {
 	if netMes == nil {
@@ -1474,3 +1474,307 @@ func TestNetworkMessenger_BootstrapPeerDiscoveryShouldCallPeerBootstrapper(t *te
 
 	_ = mes.Close()
 }
+
+//------- SetTopicFlags
+
+func TestLibp2pMessenger_SetTopicFlagsInvalidValuesShouldErr(t *testing.T) {
+	mes := createMockMessenger()
+	flagsHandler := mes.(p2p.TopicFlagsHandler)
+
+	err := flagsHandler.SetTopicFlags("test", p2p.TopicFlags{CompressionThreshold: -1})
+	assert.Equal(t, p2p.ErrInvalidTopicFlags, err)
+
+	err = flagsHandler.SetTopicFlags("test", p2p.TopicFlags{ChunkSize: libp2p.MaxSendBuffSize})
+	assert.Equal(t, p2p.ErrInvalidTopicFlags, err)
+
+	_ = mes.Close()
+}
+
+func TestLibp2pMessenger_BroadcastLargeMessageOnChunkedTopicShouldWork(t *testing.T) {
+	msg := make([]byte, libp2p.MaxSendBuffSize*3)
+	_, _ = rand.Read(msg)
+
+	_, mes1, mes2 := createMockNetworkOf2()
+	flags := p2p.TopicFlags{CompressionThreshold: 1024, ChunkSize: 256 * 1024}
+	_ = mes1.(p2p.TopicFlagsHandler).SetTopicFlags("te", flags)
+	_ = mes2.(p2p.TopicFlagsHandler).SetTopicFlags("te", flags)
+
+	_ = mes1.ConnectToPeer(mes2.Addresses()[0])
+
+	wg := &sync.WaitGroup{}
+	chanDone := make(chan bool)
+	wg.Add(2)
+
+	go func() {
+		wg.Wait()
+		chanDone <- true
+	}()
+
+	prepareMessengerForMatchDataReceive(mes1, msg, wg)
+	prepareMessengerForMatchDataReceive(mes2, msg, wg)
+
+	fmt.Println("Delaying as to allow peers to announce themselves on the opened topic...")
+	time.Sleep(time.Second)
+
+	err := mes1.BroadcastOnChannelBlocking("test", "test", msg)
+	assert.Nil(t, err)
+
+	waitDoneWithTimeout(t, chanDone, timeoutWaitResponses)
+
+	_ = mes1.Close()
+	_ = mes2.Close()
+}
+
+func createChainOf3OnChunkedTopic() []p2p.Messenger {
+	_, peers := createMockNetwork(3)
+	flags := p2p.TopicFlags{CompressionThreshold: 1024, ChunkSize: 256 * 1024}
+	for _, mes := range peers {
+		_ = mes.(p2p.TopicFlagsHandler).SetTopicFlags("test", flags)
+	}
+
+	//the peers are connected in a chain, so the last one can only get the message relayed by the middle one
+	_ = peers[0].ConnectToPeer(getConnectableAddress(peers[1]))
+	_ = peers[1].ConnectToPeer(getConnectableAddress(peers[2]))
+
+	return peers
+}
+
+func TestLibp2pMessenger_BroadcastLargeMessageOnChunkedTopicShouldBeRelayedAfterReassembly(t *testing.T) {
+	msg := make([]byte, libp2p.MaxSendBuffSize*3)
+	_, _ = rand.Read(msg)
+
+	peers := createChainOf3OnChunkedTopic()
+
+	wg := &sync.WaitGroup{}
+	chanDone := make(chan bool)
+	wg.Add(2)
+
+	go func() {
+		wg.Wait()
+		chanDone <- true
+	}()
+
+	prepareMessengerForMatchDataReceive(peers[0], msg, wg)
+	prepareMessengerForMatchDataReceive(peers[1], msg, wg)
+
+	chanOriginator := make(chan p2p.PeerID, 1)
+	_ = peers[2].CreateTopic("test", false)
+	_ = peers[2].RegisterMessageProcessor("test",
+		&mock.MessageProcessorStub{
+			ProcessMessageCalled: func(message p2p.MessageP2P, _ func(buffToSend []byte)) error {
+				if bytes.Equal(msg, message.Data()) {
+					chanOriginator <- message.Peer()
+				}
+
+				return nil
+			},
+		})
+
+	fmt.Println("Delaying as to allow peers to announce themselves on the opened topic...")
+	time.Sleep(time.Second)
+
+	err := peers[0].BroadcastOnChannelBlocking("test", "test", msg)
+	assert.Nil(t, err)
+
+	waitDoneWithTimeout(t, chanDone, timeoutWaitResponses)
+	select {
+	case originator := <-chanOriginator:
+		//the chunks are relayed as they were published, so the message keeps its originator
+		assert.Equal(t, peers[0].ID(), originator)
+	case <-time.After(timeoutWaitResponses):
+		assert.Fail(t, "timeout while waiting for the relayed message")
+	}
+
+	for _, mes := range peers {
+		_ = mes.Close()
+	}
+}
+
+func TestLibp2pMessenger_BroadcastInvalidLargeMessageOnChunkedTopicShouldNotBeRelayed(t *testing.T) {
+	msg := make([]byte, libp2p.MaxSendBuffSize*3)
+	_, _ = rand.Read(msg)
+
+	peers := createChainOf3OnChunkedTopic()
+
+	chanValidated := make(chan struct{}, 1)
+	_ = peers[1].CreateTopic("test", false)
+	_ = peers[1].RegisterMessageProcessor("test",
+		&mock.MessageProcessorStub{
+			ProcessMessageCalled: func(message p2p.MessageP2P, _ func(buffToSend []byte)) error {
+				chanValidated <- struct{}{}
+				return errors.New("invalid message")
+			},
+		})
+
+	chanReceived := make(chan struct{}, 1)
+	_ = peers[2].CreateTopic("test", false)
+	_ = peers[2].RegisterMessageProcessor("test",
+		&mock.MessageProcessorStub{
+			ProcessMessageCalled: func(message p2p.MessageP2P, _ func(buffToSend []byte)) error {
+				chanReceived <- struct{}{}
+				return nil
+			},
+		})
+
+	_ = peers[0].CreateTopic("test", false)
+
+	fmt.Println("Delaying as to allow peers to announce themselves on the opened topic...")
+	time.Sleep(time.Second)
+
+	err := peers[0].BroadcastOnChannelBlocking("test", "test", msg)
+	assert.Nil(t, err)
+
+	select {
+	case <-chanValidated:
+	case <-time.After(timeoutWaitResponses):
+		assert.Fail(t, "timeout while waiting for the message to be validated")
+	}
+	select {
+	case <-chanReceived:
+		assert.Fail(t, "the chunks of an invalid message should not have been relayed")
+	case <-time.After(time.Second * 2):
+	}
+
+	for _, mes := range peers {
+		_ = mes.Close()
+	}
+}
+
+func TestLibp2pMessenger_BroadcastBetweenPeersWithDifferentTopicFlagsShouldWork(t *testing.T) {
+	msg := bytes.Repeat([]byte("compressible message "), libp2p.MaxSendBuffSize/32)
+
+	_, mes1, mes2 := createMockNetworkOf2()
+	flags := p2p.TopicFlags{CompressionThreshold: 1024, ChunkSize: 64 * 1024}
+	_ = mes1.(p2p.TopicFlagsHandler).SetTopicFlags("test", flags)
+
+	_ = mes1.ConnectToPeer(mes2.Addresses()[0])
+
+	wg := &sync.WaitGroup{}
+	chanDone := make(chan bool)
+	//both messages reach both peers
+	wg.Add(4)
+
+	go func() {
+		wg.Wait()
+		chanDone <- true
+	}()
+
+	prepareMessengerForMatchDataReceive(mes1, msg, wg)
+	prepareMessengerForMatchDataReceive(mes2, msg, wg)
+
+	fmt.Println("Delaying as to allow peers to announce themselves on the opened topic...")
+	time.Sleep(time.Second)
+
+	//the frames describe their own encoding, so each peer decodes the messages of the other one
+	err := mes1.BroadcastOnChannelBlocking("test", "test", msg)
+	assert.Nil(t, err)
+	err = mes2.BroadcastOnChannelBlocking("test", "test", msg)
+	assert.Nil(t, err)
+
+	waitDoneWithTimeout(t, chanDone, timeoutWaitResponses)
+
+	_ = mes1.Close()
+	_ = mes2.Close()
+}
+
+func TestLibp2pMessenger_SendDirectLargeMessageOnChunkedTopicShouldWork(t *testing.T) {
+	msg := bytes.Repeat([]byte("compressible message "), libp2p.MaxSendBuffSize/4)
+
+	_, mes1, mes2 := createMockNetworkOf2()
+	flags := p2p.TopicFlags{CompressionThreshold: 1024, ChunkSize: 64 * 1024}
+	_ = mes1.(p2p.TopicFlagsHandler).SetTopicFlags("test", flags)
+	_ = mes2.(p2p.TopicFlagsHandler).SetTopicFlags("test", flags)
+
+	_ = mes1.ConnectToPeer(mes2.Addresses()[0])
+
+	wg := &sync.WaitGroup{}
+	chanDone := make(chan bool)
+	wg.Add(1)
+
+	go func() {
+		wg.Wait()
+		chanDone <- true
+	}()
+
+	prepareMessengerForMatchDataReceive(mes2, msg, wg)
+
+	fmt.Println("Delaying as to allow peers to announce themselves on the opened topic...")
+	time.Sleep(time.Second)
+
+	err := mes1.SendToConnectedPeer("test", msg, mes2.ID())
+	assert.Nil(t, err)
+
+	waitDoneWithTimeout(t, chanDone, timeoutWaitResponses)
+
+	_ = mes1.Close()
+	_ = mes2.Close()
+}
+
+//------- network info
+
+func TestLibp2pMessenger_SetNetworkIDEmptyShouldErr(t *testing.T) {
+	mes := createMockMessenger()
+
+	err := mes.(p2p.NetworkInfoHandler).SetNetworkID("")
+
+	assert.Equal(t, p2p.ErrEmptyNetworkID, err)
+	_ = mes.Close()
+}
+
+func TestLibp2pMessenger_SetNetworkIDTwiceShouldErr(t *testing.T) {
+	mes := createMockMessenger()
+	infoHandler := mes.(p2p.NetworkInfoHandler)
+
+	err := infoHandler.SetNetworkID("testnet")
+	assert.Nil(t, err)
+	assert.Equal(t, "testnet", infoHandler.PeerNetworkID(mes.ID()))
+
+	err = infoHandler.SetNetworkID("mainnet")
+	assert.Equal(t, p2p.ErrNetworkIDAlreadySet, err)
+	_ = mes.Close()
+}
+
+func TestLibp2pMessenger_PeerNetworkIDShouldBeLearnedOnConnect(t *testing.T) {
+	_, mes1, mes2 := createMockNetworkOf2()
+	_ = mes2.(p2p.NetworkInfoHandler).SetNetworkID("testnet")
+
+	_ = mes1.ConnectToPeer(mes2.Addresses()[0])
+	time.Sleep(time.Second)
+
+	infoHandler := mes1.(p2p.NetworkInfoHandler)
+	assert.Equal(t, "testnet", infoHandler.PeerNetworkID(mes2.ID()))
+	assert.Equal(t, "", mes2.(p2p.NetworkInfoHandler).PeerNetworkID(mes1.ID()))
+	assert.True(t, len(infoHandler.PeerAddresses(mes2.ID())) > 0)
+
+	err := infoHandler.ClosePeer(mes2.ID())
+	assert.Nil(t, err)
+	assert.False(t, mes1.IsConnected(mes2.ID()))
+
+	_ = mes1.Close()
+	_ = mes2.Close()
+}
+
+//------- Reachability
+
+func TestLibp2pMessenger_ReachabilityNotReportedByDiscovererShouldReturnEmpty(t *testing.T) {
+	mes := createMockMessenger()
+
+	assert.Equal(t, 0, len(mes.Reachability()))
+
+	_ = mes.Close()
+}
+
+func TestLibp2pMessenger_ReachabilityShouldReturnTheDiscovererStatus(t *testing.T) {
+	netw := mocknet.New(context.Background())
+	address := "/ip4/127.0.0.1/tcp/10000/p2p/16Uiu2HAmAzokH1ozUF52Vy3RKqRfCMr9ZdNDkUQFEkXRs9DqvmKf"
+	sld, _ := discovery.NewStaticListPeerDiscoverer([]string{address}, time.Second, time.Minute)
+
+	mes, _ := libp2p.NewMemoryMessenger(context.Background(), netw, sld)
+
+	reachability := mes.Reachability()
+	assert.Equal(t, 1, len(reachability))
+	assert.Equal(t, address, reachability[0].Address)
+	assert.False(t, reachability[0].IsReachable)
+
+	_ = mes.Close()
+}
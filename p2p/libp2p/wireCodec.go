@@ -0,0 +1,355 @@
+package libp2p
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// wireVersion is the version of the frame format, written in front of every frame
+const wireVersion byte = 1
+
+const (
+	frameFlagCompressed byte = 1 << 0
+	frameFlagChunk      byte = 1 << 1
+	frameKnownFlags          = frameFlagCompressed | frameFlagChunk
+)
+
+// frameHeaderSize holds version | flags
+const frameHeaderSize = 2
+const chunkIdSize = 8
+
+// chunkHeaderSize holds version | flags | message id | chunk index | number of chunks
+const chunkHeaderSize = frameHeaderSize + chunkIdSize + 4 + 4
+
+// maxChunksPerMessage limits the memory a peer can make us hold for one reassembled message
+const maxChunksPerMessage = 64
+
+// maxDecompressedSize protects against payloads that expand to unreasonable sizes
+const maxDecompressedSize = maxChunksPerMessage * (1 << 20)
+
+const maxPendingChunkedMessages = 1000
+const maxPendingChunkedMessagesPerPeer = 16
+const maxPendingChunksBytes = 128 * (1 << 20)
+const pendingChunksTTL = time.Second * 30
+
+type pendingKey struct {
+	pid p2p.PeerID
+	id  string
+}
+
+type pendingMessage struct {
+	chunks    [][]byte
+	received  int
+	size      int
+	timestamp time.Time
+	validated *chunkedMessage
+}
+
+// chunkedMessage lets the chunks of a message wait for the validation of the reassembled message
+type chunkedMessage struct {
+	chanValidated chan struct{}
+	isValid       bool
+}
+
+func newChunkedMessage() *chunkedMessage {
+	return &chunkedMessage{
+		chanValidated: make(chan struct{}),
+	}
+}
+
+// setValidated records the validation result of the reassembled message and releases the waiting chunks.
+// It must be called only once
+func (cm *chunkedMessage) setValidated(isValid bool) {
+	cm.isValid = isValid
+	close(cm.chanValidated)
+}
+
+// waitValidated returns the validation result of the reassembled message or false if it was not validated
+// in the given time
+func (cm *chunkedMessage) waitValidated(ctx context.Context, timeout time.Duration) bool {
+	select {
+	case <-cm.chanValidated:
+		return cm.isValid
+	case <-ctx.Done():
+		return false
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+// wireCodec applies the topic flags on the payloads that leave the messenger and reverts them on the received
+// frames. Each frame starts with the wire version and a flags byte describing how the frame was encoded, so the
+// receivers decode it regardless of the flags they set locally for the topic; chunk frames carry a random message
+// id, the chunk index and the total number of chunks, so they can be reassembled regardless of the arrival order.
+// The partially received messages are bounded per originator and by their total size
+type wireCodec struct {
+	mutPending     sync.Mutex
+	pending        map[pendingKey]*pendingMessage
+	pendingPerPeer map[p2p.PeerID]int
+	pendingBytes   int
+	ttl            time.Duration
+}
+
+// NewWireCodec creates a new wire codec
+func NewWireCodec() *wireCodec {
+	return &wireCodec{
+		pending:        make(map[pendingKey]*pendingMessage),
+		pendingPerPeer: make(map[p2p.PeerID]int),
+		ttl:            pendingChunksTTL,
+	}
+}
+
+// Encode transforms the payload in one or more frames, as required by the provided flags. Without flags, the
+// payload is sent in a single frame, only prefixed by the frame header
+func (wc *wireCodec) Encode(buff []byte, flags p2p.TopicFlags) ([][]byte, error) {
+	if flags.ChunkSize != 0 && flags.ChunkSize <= chunkHeaderSize {
+		return nil, p2p.ErrInvalidTopicFlags
+	}
+
+	frameFlags := byte(0)
+	payload := buff
+	if flags.CompressionThreshold > 0 && len(buff) > flags.CompressionThreshold {
+		compressed, err := compress(buff)
+		if err != nil {
+			return nil, err
+		}
+		if len(compressed) < len(buff) {
+			payload = compressed
+			frameFlags |= frameFlagCompressed
+		}
+	}
+
+	if flags.ChunkSize == 0 || len(payload)+frameHeaderSize <= flags.ChunkSize {
+		frame := make([]byte, 0, len(payload)+frameHeaderSize)
+		frame = append(frame, wireVersion, frameFlags)
+		frame = append(frame, payload...)
+
+		return [][]byte{frame}, nil
+	}
+
+	return createChunks(payload, frameFlags|frameFlagChunk, flags.ChunkSize-chunkHeaderSize)
+}
+
+func createChunks(payload []byte, frameFlags byte, maxChunkPayload int) ([][]byte, error) {
+	numChunks := (len(payload) + maxChunkPayload - 1) / maxChunkPayload
+	if numChunks > maxChunksPerMessage {
+		return nil, p2p.ErrMessageTooLarge
+	}
+
+	id := make([]byte, chunkIdSize)
+	_, err := rand.Read(id)
+	if err != nil {
+		return nil, err
+	}
+
+	frames := make([][]byte, 0, numChunks)
+	for i := 0; i < numChunks; i++ {
+		start := i * maxChunkPayload
+		end := start + maxChunkPayload
+		if end > len(payload) {
+			end = len(payload)
+		}
+
+		frame := make([]byte, chunkHeaderSize, chunkHeaderSize+end-start)
+		frame[0] = wireVersion
+		frame[1] = frameFlags
+		copy(frame[frameHeaderSize:], id)
+		binary.BigEndian.PutUint32(frame[frameHeaderSize+chunkIdSize:], uint32(i))
+		binary.BigEndian.PutUint32(frame[frameHeaderSize+chunkIdSize+4:], uint32(numChunks))
+		frame = append(frame, payload[start:end]...)
+
+		frames = append(frames, frame)
+	}
+
+	return frames, nil
+}
+
+// Decode reverts the encoding of a received frame. For chunk frames, the payload is returned only after all the
+// chunks of the message, sent by the same originator, have arrived. Until then, complete will be false
+func (wc *wireCodec) Decode(originator p2p.PeerID, frame []byte) (payload []byte, complete bool, err error) {
+	payload, _, complete, err = wc.decodeFrame(originator, frame)
+	return payload, complete, err
+}
+
+// decodeChunk works as Decode but it also returns the chunked message the frame belongs to. The validation of
+// the reassembled message has to be set on it by the caller which received the last chunk
+func (wc *wireCodec) decodeChunk(
+	originator p2p.PeerID,
+	frame []byte,
+) (payload []byte, message *chunkedMessage, complete bool, err error) {
+
+	if !isChunkFrame(frame) {
+		return nil, nil, false, p2p.ErrInvalidWireFrame
+	}
+
+	return wc.decodeFrame(originator, frame)
+}
+
+func (wc *wireCodec) decodeFrame(
+	originator p2p.PeerID,
+	frame []byte,
+) (payload []byte, message *chunkedMessage, complete bool, err error) {
+
+	if len(frame) < frameHeaderSize {
+		return nil, nil, false, p2p.ErrInvalidWireFrame
+	}
+	if frame[0] != wireVersion {
+		return nil, nil, false, p2p.ErrUnsupportedWireVersion
+	}
+
+	frameFlags := frame[1]
+	if frameFlags&^frameKnownFlags != 0 {
+		return nil, nil, false, p2p.ErrInvalidWireFrame
+	}
+
+	payload = frame[frameHeaderSize:]
+	if frameFlags&frameFlagChunk != 0 {
+		payload, message, err = wc.addChunk(originator, frame)
+		if err != nil || payload == nil {
+			return nil, message, false, err
+		}
+	}
+
+	if frameFlags&frameFlagCompressed != 0 {
+		payload, err = decompress(payload)
+		if err != nil {
+			return nil, message, false, err
+		}
+	}
+
+	return payload, message, true, nil
+}
+
+func isChunkFrame(frame []byte) bool {
+	return len(frame) >= frameHeaderSize && frame[0] == wireVersion && frame[1]&frameFlagChunk != 0
+}
+
+func (wc *wireCodec) addChunk(originator p2p.PeerID, frame []byte) ([]byte, *chunkedMessage, error) {
+	if len(frame) <= chunkHeaderSize {
+		return nil, nil, p2p.ErrInvalidWireFrame
+	}
+
+	index := binary.BigEndian.Uint32(frame[frameHeaderSize+chunkIdSize:])
+	numChunks := binary.BigEndian.Uint32(frame[frameHeaderSize+chunkIdSize+4:])
+	if numChunks < 2 || numChunks > maxChunksPerMessage || index >= numChunks {
+		return nil, nil, p2p.ErrInvalidWireFrame
+	}
+
+	key := pendingKey{
+		pid: originator,
+		id:  string(frame[frameHeaderSize : frameHeaderSize+chunkIdSize]),
+	}
+
+	wc.mutPending.Lock()
+	defer wc.mutPending.Unlock()
+
+	pm, found := wc.pending[key]
+	if !found {
+		wc.removeExpired()
+		if len(wc.pending) >= maxPendingChunkedMessages {
+			return nil, nil, p2p.ErrTooManyPendingChunkedMessages
+		}
+		if wc.pendingPerPeer[originator] >= maxPendingChunkedMessagesPerPeer {
+			return nil, nil, p2p.ErrTooManyPendingChunkedMessages
+		}
+
+		pm = &pendingMessage{
+			chunks:    make([][]byte, numChunks),
+			timestamp: time.Now(),
+			validated: newChunkedMessage(),
+		}
+		wc.pending[key] = pm
+		wc.pendingPerPeer[originator]++
+	}
+	if int(numChunks) != len(pm.chunks) {
+		return nil, nil, p2p.ErrInvalidWireFrame
+	}
+	if pm.chunks[index] != nil {
+		return nil, pm.validated, nil
+	}
+
+	chunk := frame[chunkHeaderSize:]
+	if wc.pendingBytes+len(chunk) > maxPendingChunksBytes {
+		return nil, nil, p2p.ErrPendingChunksSizeExceeded
+	}
+
+	pm.chunks[index] = chunk
+	pm.received++
+	pm.size += len(chunk)
+	wc.pendingBytes += len(chunk)
+	if pm.received < len(pm.chunks) {
+		return nil, pm.validated, nil
+	}
+
+	wc.removePending(key)
+
+	return bytes.Join(pm.chunks, nil), pm.validated, nil
+}
+
+func (wc *wireCodec) removePending(key pendingKey) {
+	pm, found := wc.pending[key]
+	if !found {
+		return
+	}
+
+	delete(wc.pending, key)
+	wc.pendingBytes -= pm.size
+	wc.pendingPerPeer[key.pid]--
+	if wc.pendingPerPeer[key.pid] <= 0 {
+		delete(wc.pendingPerPeer, key.pid)
+	}
+}
+
+func (wc *wireCodec) removeExpired() {
+	for key, pm := range wc.pending {
+		if time.Since(pm.timestamp) > wc.ttl {
+			wc.removePending(key)
+		}
+	}
+}
+
+func compress(buff []byte) ([]byte, error) {
+	b := &bytes.Buffer{}
+	w, err := flate.NewWriter(b, flate.BestSpeed)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = w.Write(buff)
+	if err != nil {
+		return nil, err
+	}
+
+	err = w.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+func decompress(buff []byte) ([]byte, error) {
+	r := flate.NewReader(bytes.NewReader(buff))
+	defer func() {
+		_ = r.Close()
+	}()
+
+	decompressed, err := ioutil.ReadAll(io.LimitReader(r, maxDecompressedSize+1))
+	if err != nil {
+		return nil, p2p.ErrInvalidWireFrame
+	}
+	if len(decompressed) > maxDecompressedSize {
+		return nil, p2p.ErrMessageTooLarge
+	}
+
+	return decompressed, nil
+}
@@ -0,0 +1,445 @@
+package libp2p_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/stretchr/testify/assert"
+)
+
+var originator = p2p.PeerID("originator")
+
+// createCompressiblePayload builds a payload resembling a batch of serialized transactions: repetitive field
+// names and addresses, random signatures
+func createCompressiblePayload(numTxs int) []byte {
+	buff := &bytes.Buffer{}
+	for i := 0; i < numTxs; i++ {
+		signature := make([]byte, 32)
+		_, _ = rand.Read(signature)
+
+		buff.WriteString(fmt.Sprintf(
+			`{"nonce":%d,"value":"1000000000","rcvAddr":"c3ZnZiBzZ2ZkZiBnZGZnZGZnZGZnZGY=","sndAddr":"ZGZnZGZnZGZnZGZnIGRmZ2RmZ2RmZ2c=","gasPrice":10,"gasLimit":1000,"signature":"%x"}`,
+			i,
+			signature,
+		))
+	}
+
+	return buff.Bytes()
+}
+
+func decodeFrames(t *testing.T, frames [][]byte) []byte {
+	codec := libp2p.NewWireCodec()
+
+	var payload []byte
+	for i, frame := range frames {
+		decoded, complete, err := codec.Decode(originator, frame)
+		assert.Nil(t, err)
+		assert.Equal(t, i == len(frames)-1, complete)
+
+		payload = decoded
+	}
+
+	return payload
+}
+
+//------- Encode
+
+func TestWireCodec_EncodeInvalidChunkSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	codec := libp2p.NewWireCodec()
+
+	frames, err := codec.Encode([]byte("data"), p2p.TopicFlags{ChunkSize: 2})
+
+	assert.Nil(t, frames)
+	assert.Equal(t, p2p.ErrInvalidTopicFlags, err)
+}
+
+func TestWireCodec_EncodeNoFlagsShouldOnlyAddHeader(t *testing.T) {
+	t.Parallel()
+
+	codec := libp2p.NewWireCodec()
+	data := []byte("data")
+
+	frames, err := codec.Encode(data, p2p.TopicFlags{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(frames))
+	assert.Equal(t, len(data)+libp2p.FrameHeaderSize, len(frames[0]))
+	assert.Equal(t, data, decodeFrames(t, frames))
+}
+
+func TestWireCodec_EncodeBelowThresholdShouldNotCompress(t *testing.T) {
+	t.Parallel()
+
+	codec := libp2p.NewWireCodec()
+	data := createCompressiblePayload(2)
+
+	frames, err := codec.Encode(data, p2p.TopicFlags{CompressionThreshold: len(data)})
+
+	assert.Nil(t, err)
+	assert.Equal(t, len(data)+libp2p.FrameHeaderSize, len(frames[0]))
+}
+
+func TestWireCodec_EncodeAboveThresholdShouldCompress(t *testing.T) {
+	t.Parallel()
+
+	codec := libp2p.NewWireCodec()
+	data := createCompressiblePayload(100)
+
+	frames, err := codec.Encode(data, p2p.TopicFlags{CompressionThreshold: 1024})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(frames))
+	assert.True(t, len(frames[0]) < len(data)/2)
+	assert.Equal(t, data, decodeFrames(t, frames))
+}
+
+func TestWireCodec_EncodeIncompressibleDataShouldSendItRaw(t *testing.T) {
+	t.Parallel()
+
+	codec := libp2p.NewWireCodec()
+	data := make([]byte, 4096)
+	_, _ = rand.Read(data)
+
+	frames, err := codec.Encode(data, p2p.TopicFlags{CompressionThreshold: 1024})
+
+	assert.Nil(t, err)
+	assert.Equal(t, len(data)+libp2p.FrameHeaderSize, len(frames[0]))
+	assert.Equal(t, data, decodeFrames(t, frames))
+}
+
+func TestWireCodec_EncodeLargePayloadShouldChunk(t *testing.T) {
+	t.Parallel()
+
+	codec := libp2p.NewWireCodec()
+	data := make([]byte, 10000)
+	_, _ = rand.Read(data)
+	chunkSize := 1000
+
+	frames, err := codec.Encode(data, p2p.TopicFlags{ChunkSize: chunkSize})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 11, len(frames))
+	for _, frame := range frames {
+		assert.True(t, len(frame) <= chunkSize)
+	}
+	assert.Equal(t, data, decodeFrames(t, frames))
+}
+
+func TestWireCodec_EncodeTooManyChunksShouldErr(t *testing.T) {
+	t.Parallel()
+
+	codec := libp2p.NewWireCodec()
+
+	frames, err := codec.Encode(make([]byte, 100000), p2p.TopicFlags{ChunkSize: 100})
+
+	assert.Nil(t, frames)
+	assert.Equal(t, p2p.ErrMessageTooLarge, err)
+}
+
+func TestWireCodec_EncodeCompressedAndChunkedShouldWork(t *testing.T) {
+	t.Parallel()
+
+	codec := libp2p.NewWireCodec()
+	data := createCompressiblePayload(1000)
+
+	frames, err := codec.Encode(data, p2p.TopicFlags{CompressionThreshold: 1024, ChunkSize: 4096})
+
+	assert.Nil(t, err)
+	assert.True(t, len(frames) > 1)
+	assert.Equal(t, data, decodeFrames(t, frames))
+}
+
+//------- Decode
+
+func TestWireCodec_DecodeEmptyFrameShouldErr(t *testing.T) {
+	t.Parallel()
+
+	codec := libp2p.NewWireCodec()
+
+	payload, complete, err := codec.Decode(originator, make([]byte, 0))
+
+	assert.Nil(t, payload)
+	assert.False(t, complete)
+	assert.Equal(t, p2p.ErrInvalidWireFrame, err)
+}
+
+func TestWireCodec_DecodeUnknownFlagsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	codec := libp2p.NewWireCodec()
+
+	_, _, err := codec.Decode(originator, []byte{libp2p.WireVersion, 0x80, 1, 2, 3})
+
+	assert.Equal(t, p2p.ErrInvalidWireFrame, err)
+}
+
+func TestWireCodec_DecodeUnknownVersionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	codec := libp2p.NewWireCodec()
+	frames, _ := codec.Encode([]byte("data"), p2p.TopicFlags{})
+	frames[0][0] = libp2p.WireVersion + 1
+
+	payload, complete, err := codec.Decode(originator, frames[0])
+
+	assert.Nil(t, payload)
+	assert.False(t, complete)
+	assert.Equal(t, p2p.ErrUnsupportedWireVersion, err)
+}
+
+func TestWireCodec_DecodeShouldNotDependOnTheReceiverFlags(t *testing.T) {
+	t.Parallel()
+
+	data := createCompressiblePayload(1000)
+	allFlags := []p2p.TopicFlags{
+		{},
+		{CompressionThreshold: 1024},
+		{ChunkSize: 4096},
+		{CompressionThreshold: 1024, ChunkSize: 4096},
+	}
+
+	//the codec does not receive the flags on decoding, the frames describe how they were encoded
+	for _, flags := range allFlags {
+		frames, err := libp2p.NewWireCodec().Encode(data, flags)
+
+		assert.Nil(t, err)
+		assert.Equal(t, data, decodeFrames(t, frames))
+	}
+}
+
+func TestWireCodec_DecodeCorruptedCompressedDataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	codec := libp2p.NewWireCodec()
+	frames, _ := codec.Encode(createCompressiblePayload(100), p2p.TopicFlags{CompressionThreshold: 1})
+	corrupted := frames[0][:len(frames[0])/2]
+
+	_, complete, err := codec.Decode(originator, corrupted)
+
+	assert.False(t, complete)
+	assert.Equal(t, p2p.ErrInvalidWireFrame, err)
+}
+
+func TestWireCodec_DecodeChunksOutOfOrderShouldWork(t *testing.T) {
+	t.Parallel()
+
+	codec := libp2p.NewWireCodec()
+	data := make([]byte, 5000)
+	_, _ = rand.Read(data)
+	frames, _ := codec.Encode(data, p2p.TopicFlags{ChunkSize: 1000})
+
+	receiver := libp2p.NewWireCodec()
+	for i := len(frames) - 1; i > 0; i-- {
+		payload, complete, err := receiver.Decode(originator, frames[i])
+		assert.Nil(t, payload)
+		assert.False(t, complete)
+		assert.Nil(t, err)
+	}
+
+	payload, complete, err := receiver.Decode(originator, frames[0])
+	assert.Nil(t, err)
+	assert.True(t, complete)
+	assert.Equal(t, data, payload)
+}
+
+func TestWireCodec_DecodeDuplicatedChunkShouldBeIgnored(t *testing.T) {
+	t.Parallel()
+
+	codec := libp2p.NewWireCodec()
+	data := make([]byte, 2500)
+	_, _ = rand.Read(data)
+	frames, _ := codec.Encode(data, p2p.TopicFlags{ChunkSize: 1000})
+
+	receiver := libp2p.NewWireCodec()
+	_, _, _ = receiver.Decode(originator, frames[0])
+	_, _, _ = receiver.Decode(originator, frames[0])
+	_, complete, _ := receiver.Decode(originator, frames[1])
+	assert.False(t, complete)
+
+	payload, complete, err := receiver.Decode(originator, frames[2])
+	assert.Nil(t, err)
+	assert.True(t, complete)
+	assert.Equal(t, data, payload)
+}
+
+func TestWireCodec_DecodeChunksFromDifferentOriginatorsShouldNotMix(t *testing.T) {
+	t.Parallel()
+
+	codec := libp2p.NewWireCodec()
+	frames, _ := codec.Encode(make([]byte, 2000), p2p.TopicFlags{ChunkSize: 1100})
+
+	receiver := libp2p.NewWireCodec()
+	_, _, _ = receiver.Decode(originator, frames[0])
+	_, complete, err := receiver.Decode("another originator", frames[1])
+
+	assert.Nil(t, err)
+	assert.False(t, complete)
+}
+
+func TestWireCodec_DecodeInvalidChunkIndexShouldErr(t *testing.T) {
+	t.Parallel()
+
+	codec := libp2p.NewWireCodec()
+	frames, _ := codec.Encode(make([]byte, 2000), p2p.TopicFlags{ChunkSize: 1100})
+	//index is stored after the version and flags bytes and the 8 bytes message id
+	frames[1][13] = 5
+
+	_, complete, err := codec.Decode(originator, frames[1])
+
+	assert.False(t, complete)
+	assert.Equal(t, p2p.ErrInvalidWireFrame, err)
+}
+
+func TestWireCodec_DecodeTooManyPendingMessagesFromOnePeerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sender := libp2p.NewWireCodec()
+	receiver := libp2p.NewWireCodec()
+	for i := 0; i < libp2p.MaxPendingChunkedMessagesPerPeer; i++ {
+		frames, _ := sender.Encode(make([]byte, 2000), p2p.TopicFlags{ChunkSize: 1100})
+		_, _, err := receiver.Decode(originator, frames[0])
+		assert.Nil(t, err)
+	}
+
+	frames, _ := sender.Encode(make([]byte, 2000), p2p.TopicFlags{ChunkSize: 1100})
+	_, _, err := receiver.Decode(originator, frames[0])
+	assert.Equal(t, p2p.ErrTooManyPendingChunkedMessages, err)
+
+	_, _, err = receiver.Decode("another originator", frames[0])
+	assert.Nil(t, err)
+
+	//completing a message frees a slot for its originator
+	_, complete, err := receiver.Decode("another originator", frames[1])
+	assert.Nil(t, err)
+	assert.True(t, complete)
+}
+
+//------- DecodeChunk
+
+func TestWireCodec_DecodeChunkNotAChunkShouldErr(t *testing.T) {
+	t.Parallel()
+
+	codec := libp2p.NewWireCodec()
+	frames, _ := codec.Encode([]byte("data"), p2p.TopicFlags{})
+
+	payload, message, complete, err := codec.DecodeChunk(originator, frames[0])
+
+	assert.Nil(t, payload)
+	assert.Nil(t, message)
+	assert.False(t, complete)
+	assert.Equal(t, p2p.ErrInvalidWireFrame, err)
+}
+
+func TestWireCodec_DecodeChunkShouldReleaseTheChunksWhenTheMessageIsValidated(t *testing.T) {
+	t.Parallel()
+
+	data := make([]byte, 2500)
+	_, _ = rand.Read(data)
+	frames, _ := libp2p.NewWireCodec().Encode(data, p2p.TopicFlags{ChunkSize: 1000})
+
+	receiver := libp2p.NewWireCodec()
+	_, firstMessage, complete, err := receiver.DecodeChunk(originator, frames[0])
+	assert.Nil(t, err)
+	assert.False(t, complete)
+	_, secondMessage, _, _ := receiver.DecodeChunk(originator, frames[1])
+	assert.True(t, firstMessage == secondMessage)
+
+	chanValid := make(chan bool)
+	go func() {
+		chanValid <- firstMessage.WaitValidated(time.Second * 5)
+	}()
+
+	payload, lastMessage, complete, err := receiver.DecodeChunk(originator, frames[2])
+	assert.Nil(t, err)
+	assert.True(t, complete)
+	assert.Equal(t, data, payload)
+	assert.True(t, firstMessage == lastMessage)
+
+	lastMessage.SetValidated(true)
+	assert.True(t, <-chanValid)
+	assert.True(t, secondMessage.WaitValidated(time.Second))
+}
+
+func TestWireCodec_DecodeChunkInvalidMessageShouldNotReleaseTheChunks(t *testing.T) {
+	t.Parallel()
+
+	frames, _ := libp2p.NewWireCodec().Encode(make([]byte, 2000), p2p.TopicFlags{ChunkSize: 1100})
+
+	receiver := libp2p.NewWireCodec()
+	_, firstMessage, _, _ := receiver.DecodeChunk(originator, frames[0])
+	_, lastMessage, complete, _ := receiver.DecodeChunk(originator, frames[1])
+	assert.True(t, complete)
+
+	lastMessage.SetValidated(false)
+
+	assert.False(t, firstMessage.WaitValidated(time.Second))
+}
+
+func TestWireCodec_DecodeChunkIncompleteMessageShouldTimeout(t *testing.T) {
+	t.Parallel()
+
+	frames, _ := libp2p.NewWireCodec().Encode(make([]byte, 2000), p2p.TopicFlags{ChunkSize: 1100})
+
+	receiver := libp2p.NewWireCodec()
+	_, message, complete, _ := receiver.DecodeChunk(originator, frames[0])
+	assert.False(t, complete)
+
+	assert.False(t, message.WaitValidated(time.Millisecond*100))
+}
+
+//------- benchmarks
+
+func benchmarkWireCodec(b *testing.B, numTxs int, flags p2p.TopicFlags) {
+	payload := createCompressiblePayload(numTxs)
+	sender := libp2p.NewWireCodec()
+	receiver := libp2p.NewWireCodec()
+
+	wireBytes := 0
+	b.SetBytes(int64(len(payload)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		frames, err := sender.Encode(payload, flags)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		wireBytes = 0
+		for _, frame := range frames {
+			wireBytes += len(frame)
+			_, _, err = receiver.Decode(originator, frame)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+	b.StopTimer()
+
+	b.Logf("payload: %d bytes, on the wire: %d bytes (%.1f%%)",
+		len(payload),
+		wireBytes,
+		float64(wireBytes)*100/float64(len(payload)),
+	)
+}
+
+func BenchmarkWireCodec_TxBatchRaw(b *testing.B) {
+	benchmarkWireCodec(b, 1000, p2p.TopicFlags{})
+}
+
+func BenchmarkWireCodec_TxBatchCompressed(b *testing.B) {
+	benchmarkWireCodec(b, 1000, p2p.TopicFlags{CompressionThreshold: 1024})
+}
+
+func BenchmarkWireCodec_MiniBlockRawChunked(b *testing.B) {
+	benchmarkWireCodec(b, 20000, p2p.TopicFlags{ChunkSize: 512 * 1024})
+}
+
+func BenchmarkWireCodec_MiniBlockCompressedChunked(b *testing.B) {
+	benchmarkWireCodec(b, 20000, p2p.TopicFlags{CompressionThreshold: 1024, ChunkSize: 512 * 1024})
+}
@@ -328,6 +328,25 @@ func (messenger *Messenger) SendToConnectedPeer(topic string, buff []byte, peerI
 	return ErrNotConnectedToNetwork
 }
 
+// AddConnectionNotifiee registers the provided notifiee. As the in-memory network is fully connected and has
+// no connection events, the notifiee will be immediately informed about all the peers that are currently connected.
+func (messenger *Messenger) AddConnectionNotifiee(notifiee p2p.ConnectionNotifiee) error {
+	if notifiee == nil || notifiee.IsInterfaceNil() {
+		return p2p.ErrNilConnectionNotifiee
+	}
+
+	for _, pid := range messenger.ConnectedPeers() {
+		notifiee.PeerConnected(pid)
+	}
+
+	return nil
+}
+
+// Reachability returns an empty slice, as the in-memory network has no peer discovery mechanism
+func (messenger *Messenger) Reachability() []p2p.PeerReachability {
+	return make([]p2p.PeerReachability, 0)
+}
+
 // ReceiveMessage handles the received message by passing it to the message
 // processor of the corresponding topic, given that this Messenger has
 // previously registered a message processor for that topic. The Network will
@@ -0,0 +1,26 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+type ConnectionNotifieeStub struct {
+	PeerConnectedCalled    func(pid p2p.PeerID)
+	PeerDisconnectedCalled func(pid p2p.PeerID)
+}
+
+func (cns *ConnectionNotifieeStub) PeerConnected(pid p2p.PeerID) {
+	cns.PeerConnectedCalled(pid)
+}
+
+func (cns *ConnectionNotifieeStub) PeerDisconnected(pid p2p.PeerID) {
+	cns.PeerDisconnectedCalled(pid)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cns *ConnectionNotifieeStub) IsInterfaceNil() bool {
+	if cns == nil {
+		return true
+	}
+	return false
+}
@@ -16,6 +16,7 @@ type streamMock struct {
 	pid          protocol.ID
 	streamClosed bool
 	canRead      bool
+	conn         network.Conn
 }
 
 func NewStreamMock() *streamMock {
@@ -103,6 +104,14 @@ func (sm *streamMock) Stat() network.Stat {
 	}
 }
 
+func (sm *streamMock) SetConn(conn network.Conn) {
+	sm.conn = conn
+}
+
 func (sm *streamMock) Conn() network.Conn {
-	panic("implement me")
+	if sm.conn == nil {
+		panic("implement me")
+	}
+
+	return sm.conn
 }
@@ -3,6 +3,7 @@ package p2p
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/mr-tron/base58/base58"
 )
@@ -56,6 +57,22 @@ type PeerDiscoverer interface {
 	IsInterfaceNil() bool
 }
 
+// PeerReachability holds the reachability status of a peer the node was configured to keep connections with
+type PeerReachability struct {
+	Address        string
+	PeerID         PeerID
+	IsReachable    bool
+	NumFailedDials int
+	LastError      string
+	NextDial       time.Time
+}
+
+// ReachabilityReporter defines a peer discovery mechanism able to report the status of the peers it maintains
+type ReachabilityReporter interface {
+	Reachability() []PeerReachability
+	IsInterfaceNil() bool
+}
+
 // Reconnecter defines the behaviour of a network reconnection mechanism
 type Reconnecter interface {
 	ReconnectToNetwork() <-chan struct{}
@@ -155,10 +172,26 @@ type Messenger interface {
 	// peer, but reuses a connection and a stream if possible.
 	SendToConnectedPeer(topic string, buff []byte, peerID PeerID) error
 
+	// AddConnectionNotifiee registers a component that will be notified each time a peer connects or
+	// disconnects from the Messenger.
+	AddConnectionNotifiee(notifiee ConnectionNotifiee) error
+
+	// Reachability returns the status of the peers maintained by the peer
+	// discovery mechanism, if it is able to report it (e.g. the static list
+	// discovery). Otherwise, it returns an empty slice.
+	Reachability() []PeerReachability
+
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 }
 
+// ConnectionNotifiee defines a component that needs to be notified when peers connect or disconnect
+type ConnectionNotifiee interface {
+	PeerConnected(pid PeerID)
+	PeerDisconnected(pid PeerID)
+	IsInterfaceNil() bool
+}
+
 // MessageP2P defines what a p2p message can do (should return)
 type MessageP2P interface {
 	From() []byte
@@ -192,3 +225,30 @@ type PeerDiscoveryFactory interface {
 	CreatePeerDiscoverer() (PeerDiscoverer, error)
 	IsInterfaceNil() bool
 }
+
+// TopicFlags defines the wire-level processing applied to the messages sent on a topic. Each frame records how it
+// was encoded, so the peers using a topic do not need to have the same flags set for it
+type TopicFlags struct {
+	// CompressionThreshold is the payload size in bytes above which the payload is compressed. 0 disables compression
+	CompressionThreshold int
+	// ChunkSize is the maximum size in bytes of one wire message. Larger payloads are split in chunks that are
+	// reassembled on the receiving side. 0 disables chunking
+	ChunkSize int
+}
+
+// TopicFlagsHandler defines a messenger that is able to apply wire-level flags on topics
+type TopicFlagsHandler interface {
+	SetTopicFlags(topicPrefix string, flags TopicFlags) error
+	IsInterfaceNil() bool
+}
+
+// NetworkInfoHandler defines a messenger able to provide low-level information about the known peers, to advertise
+// the network it belongs to and to drop connections
+type NetworkInfoHandler interface {
+	SetNetworkID(networkID string) error
+	PeerNetworkID(pid PeerID) string
+	PeerAddresses(pid PeerID) []string
+	PeerProtocols(pid PeerID) []string
+	ClosePeer(pid PeerID) error
+	IsInterfaceNil() bool
+}
@@ -27,6 +27,8 @@ type ArgBaseProcessor struct {
 	StartHeaders          map[uint32]data.HeaderHandler
 	RequestHandler        process.RequestHandler
 	Core                  serviceContainer.Core
+	BlockSizeThrottler    process.BlockSizeThrottler
+	HeaderSigVerifier     process.InterceptedHeaderSigVerifier
 }
 
 // ArgShardProcessor holds all dependencies required by the process data factory in order to create
@@ -60,6 +60,7 @@ type baseProcessor struct {
 	store                 dataRetriever.StorageService
 	uint64Converter       typeConverters.Uint64ByteSliceConverter
 	blockSizeThrottler    process.BlockSizeThrottler
+	headerSigVerifier     process.InterceptedHeaderSigVerifier
 
 	hdrsForCurrBlock hdrForBlock
 
@@ -211,6 +212,17 @@ func (bp *baseProcessor) getRootHash() []byte {
 	return rootHash
 }
 
+// checkHeaderSignatures verifies that the given header, notarized from another shard, was proposed and signed
+// by the consensus group elected for its round and shard
+func (bp *baseProcessor) checkHeaderSignatures(hdr data.HeaderHandler) error {
+	err := bp.headerSigVerifier.VerifyRandSeed(hdr)
+	if err != nil {
+		return err
+	}
+
+	return bp.headerSigVerifier.VerifySignature(hdr)
+}
+
 func (bp *baseProcessor) isHdrConstructionValid(currHdr, prevHdr data.HeaderHandler) error {
 	if prevHdr == nil || prevHdr.IsInterfaceNil() {
 		return process.ErrNilBlockHeader
@@ -564,6 +576,12 @@ func checkProcessorNilParameters(arguments ArgBaseProcessor) error {
 	if arguments.RequestHandler == nil || arguments.RequestHandler.IsInterfaceNil() {
 		return process.ErrNilRequestHandler
 	}
+	if arguments.BlockSizeThrottler == nil || arguments.BlockSizeThrottler.IsInterfaceNil() {
+		return process.ErrNilBlockSizeThrottler
+	}
+	if arguments.HeaderSigVerifier == nil || arguments.HeaderSigVerifier.IsInterfaceNil() {
+		return process.ErrNilHeaderSigVerifier
+	}
 
 	return nil
 }
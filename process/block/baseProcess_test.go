@@ -365,6 +365,8 @@ func CreateMockArguments() blproc.ArgShardProcessor {
 			StartHeaders:          createGenesisBlocks(mock.NewOneShardCoordinatorMock()),
 			RequestHandler:        &mock.RequestHandlerMock{},
 			Core:                  &mock.ServiceContainerMock{},
+			BlockSizeThrottler:    &mock.BlockSizeThrottlerStub{},
+			HeaderSigVerifier:     &mock.HeaderSigVerifierStub{},
 		},
 		DataPool:        initDataPool([]byte("")),
 		TxCoordinator:   &mock.TransactionCoordinatorMock{},
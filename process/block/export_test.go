@@ -74,6 +74,8 @@ func NewShardProcessorEmptyWith3shards(tdp dataRetriever.PoolsHolder, genesisBlo
 			StartHeaders:          genesisBlocks,
 			RequestHandler:        &mock.RequestHandlerMock{},
 			Core:                  &mock.ServiceContainerMock{},
+			BlockSizeThrottler:    &mock.BlockSizeThrottlerStub{},
+			HeaderSigVerifier:     &mock.HeaderSigVerifierStub{},
 		},
 		DataPool:        tdp,
 		TxCoordinator:   &mock.TransactionCoordinatorMock{},
@@ -11,12 +11,11 @@ import (
 
 func createDefaultBlockHeaderArgument() *ArgInterceptedBlockHeader {
 	arg := &ArgInterceptedBlockHeader{
-		ShardCoordinator: mock.NewOneShardCoordinatorMock(),
-		MultiSigVerifier: mock.NewMultiSigner(),
-		Hasher:           mock.HasherMock{},
-		Marshalizer:      &mock.MarshalizerMock{},
-		NodesCoordinator: mock.NewNodesCoordinatorMock(),
-		HdrBuff:          []byte("test buffer"),
+		ShardCoordinator:  mock.NewOneShardCoordinatorMock(),
+		Hasher:            mock.HasherMock{},
+		Marshalizer:       &mock.MarshalizerMock{},
+		HeaderSigVerifier: &mock.HeaderSigVerifierStub{},
+		HdrBuff:           []byte("test buffer"),
 	}
 
 	return arg
@@ -99,26 +98,15 @@ func TestCheckBlockHeaderArgument_NilHasherShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilHasher, err)
 }
 
-func TestCheckBlockHeaderArgument_NilMultiSigVerifierShouldErr(t *testing.T) {
-	t.Parallel()
-
-	arg := createDefaultBlockHeaderArgument()
-	arg.MultiSigVerifier = nil
-
-	err := checkBlockHeaderArgument(arg)
-
-	assert.Equal(t, process.ErrNilMultiSigVerifier, err)
-}
-
-func TestCheckBlockHeaderArgument_NilChronologyValidatorShouldErr(t *testing.T) {
+func TestCheckBlockHeaderArgument_NilHeaderSigVerifierShouldErr(t *testing.T) {
 	t.Parallel()
 
 	arg := createDefaultBlockHeaderArgument()
-	arg.NodesCoordinator = nil
+	arg.HeaderSigVerifier = nil
 
 	err := checkBlockHeaderArgument(arg)
 
-	assert.Equal(t, process.ErrNilNodesCoordinator, err)
+	assert.Equal(t, process.ErrNilHeaderSigVerifier, err)
 }
 
 func TestCheckBlockHeaderArgument_NilShardCoordinatorShouldErr(t *testing.T) {
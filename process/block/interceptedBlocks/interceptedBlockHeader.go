@@ -5,6 +5,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
@@ -12,7 +13,7 @@ import (
 // It implements Newer and Hashed interfaces
 type InterceptedHeader struct {
 	hdr               *block.Header
-	sigVerifier       *headerMultiSigVerifier
+	sigVerifier       process.InterceptedHeaderSigVerifier
 	hasher            hashing.Hasher
 	shardCoordinator  sharding.Coordinator
 	hash              []byte
@@ -31,21 +32,12 @@ func NewInterceptedHeader(arg *ArgInterceptedBlockHeader) (*InterceptedHeader, e
 		return nil, err
 	}
 
-	sigVerifier := &headerMultiSigVerifier{
-		marshalizer:      arg.Marshalizer,
-		hasher:           arg.Hasher,
-		nodesCoordinator: arg.NodesCoordinator,
-		multiSigVerifier: arg.MultiSigVerifier,
-	}
-
 	inHdr := &InterceptedHeader{
 		hdr:              hdr,
 		hasher:           arg.Hasher,
-		sigVerifier:      sigVerifier,
+		sigVerifier:      arg.HeaderSigVerifier,
 		shardCoordinator: arg.ShardCoordinator,
 	}
-	//wire-up the "virtual" function
-	inHdr.sigVerifier.copyHeaderWithoutSig = inHdr.copyHeaderWithoutSig
 	inHdr.processFields(arg.HdrBuff)
 
 	return inHdr, nil
@@ -64,17 +56,6 @@ func createShardHdr(marshalizer marshal.Marshalizer, hdrBuff []byte) (*block.Hea
 	return hdr, nil
 }
 
-func (inHdr *InterceptedHeader) copyHeaderWithoutSig(header data.HeaderHandler) data.HeaderHandler {
-	//it is virtually impossible here to have a wrong type assertion case
-	hdr := header.(*block.Header)
-
-	headerCopy := *hdr
-	headerCopy.Signature = nil
-	headerCopy.PubKeysBitmap = nil
-
-	return &headerCopy
-}
-
 func (inHdr *InterceptedHeader) processFields(txBuff []byte) {
 	inHdr.hash = inHdr.hasher.Compute(string(txBuff))
 
@@ -90,7 +71,12 @@ func (inHdr *InterceptedHeader) CheckValidity() error {
 		return err
 	}
 
-	return inHdr.sigVerifier.verifySig(inHdr.hdr)
+	err = inHdr.sigVerifier.VerifyRandSeed(inHdr.hdr)
+	if err != nil {
+		return err
+	}
+
+	return inHdr.sigVerifier.VerifySignature(inHdr.hdr)
 }
 
 // integrity checks the integrity of the header block wrapper
@@ -1,9 +1,11 @@
 package interceptedBlocks_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
 	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/block/interceptedBlocks"
@@ -21,11 +23,10 @@ var hdrEpoch = uint32(78)
 
 func createDefaultShardArgument() *interceptedBlocks.ArgInterceptedBlockHeader {
 	arg := &interceptedBlocks.ArgInterceptedBlockHeader{
-		ShardCoordinator: mock.NewOneShardCoordinatorMock(),
-		MultiSigVerifier: mock.NewMultiSigner(),
-		Hasher:           testHasher,
-		Marshalizer:      testMarshalizer,
-		NodesCoordinator: &mock.NodesCoordinatorMock{},
+		ShardCoordinator:  mock.NewOneShardCoordinatorMock(),
+		Hasher:            testHasher,
+		Marshalizer:       testMarshalizer,
+		HeaderSigVerifier: &mock.HeaderSigVerifierStub{},
 	}
 
 	hdr := createMockShardHeader()
@@ -182,6 +183,40 @@ func TestInterceptedHeader_ErrorInMiniBlockShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrInvalidShardId, err)
 }
 
+func TestInterceptedHeader_CheckValidityWrongRandSeedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errWrongRandSeed := errors.New("wrong rand seed")
+	arg := createDefaultShardArgument()
+	arg.HeaderSigVerifier = &mock.HeaderSigVerifierStub{
+		VerifyRandSeedCalled: func(header data.HeaderHandler) error {
+			return errWrongRandSeed
+		},
+	}
+	inHdr, _ := interceptedBlocks.NewInterceptedHeader(arg)
+
+	err := inHdr.CheckValidity()
+
+	assert.Equal(t, errWrongRandSeed, err)
+}
+
+func TestInterceptedHeader_CheckValidityWrongSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errWrongSignature := errors.New("wrong signature")
+	arg := createDefaultShardArgument()
+	arg.HeaderSigVerifier = &mock.HeaderSigVerifierStub{
+		VerifySignatureCalled: func(header data.HeaderHandler) error {
+			return errWrongSignature
+		},
+	}
+	inHdr, _ := interceptedBlocks.NewInterceptedHeader(arg)
+
+	err := inHdr.CheckValidity()
+
+	assert.Equal(t, errWrongSignature, err)
+}
+
 func TestInterceptedHeader_CheckValidityShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -5,13 +5,14 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
 // InterceptedMetaHeader represents the wrapper over the meta block header struct
 type InterceptedMetaHeader struct {
 	hdr              *block.MetaBlock
-	sigVerifier      *headerMultiSigVerifier
+	sigVerifier      process.InterceptedHeaderSigVerifier
 	hasher           hashing.Hasher
 	shardCoordinator sharding.Coordinator
 	hash             []byte
@@ -29,21 +30,12 @@ func NewInterceptedMetaHeader(arg *ArgInterceptedBlockHeader) (*InterceptedMetaH
 		return nil, err
 	}
 
-	sigVerifier := &headerMultiSigVerifier{
-		marshalizer:      arg.Marshalizer,
-		hasher:           arg.Hasher,
-		nodesCoordinator: arg.NodesCoordinator,
-		multiSigVerifier: arg.MultiSigVerifier,
-	}
-
 	inHdr := &InterceptedMetaHeader{
 		hdr:              hdr,
 		hasher:           arg.Hasher,
-		sigVerifier:      sigVerifier,
+		sigVerifier:      arg.HeaderSigVerifier,
 		shardCoordinator: arg.ShardCoordinator,
 	}
-	//wire-up the "virtual" function
-	inHdr.sigVerifier.copyHeaderWithoutSig = inHdr.copyHeaderWithoutSig
 	inHdr.processFields(arg.HdrBuff)
 
 	return inHdr, nil
@@ -62,17 +54,6 @@ func createMetaHdr(marshalizer marshal.Marshalizer, hdrBuff []byte) (*block.Meta
 	return hdr, nil
 }
 
-func (imh *InterceptedMetaHeader) copyHeaderWithoutSig(header data.HeaderHandler) data.HeaderHandler {
-	//it is virtually impossible here to have a wrong type assertion case
-	hdr := header.(*block.MetaBlock)
-
-	headerCopy := *hdr
-	headerCopy.Signature = nil
-	headerCopy.PubKeysBitmap = nil
-
-	return &headerCopy
-}
-
 func (imh *InterceptedMetaHeader) processFields(txBuff []byte) {
 	imh.hash = imh.hasher.Compute(string(txBuff))
 }
@@ -94,7 +75,12 @@ func (imh *InterceptedMetaHeader) CheckValidity() error {
 		return err
 	}
 
-	return imh.sigVerifier.verifySig(imh.hdr)
+	err = imh.sigVerifier.VerifyRandSeed(imh.hdr)
+	if err != nil {
+		return err
+	}
+
+	return imh.sigVerifier.VerifySignature(imh.hdr)
 }
 
 // integrity checks the integrity of the meta header block wrapper
@@ -1,9 +1,11 @@
 package interceptedBlocks_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
 	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/block/interceptedBlocks"
@@ -13,11 +15,10 @@ import (
 
 func createDefaultMetaArgument() *interceptedBlocks.ArgInterceptedBlockHeader {
 	arg := &interceptedBlocks.ArgInterceptedBlockHeader{
-		ShardCoordinator: mock.NewOneShardCoordinatorMock(),
-		MultiSigVerifier: mock.NewMultiSigner(),
-		Hasher:           testHasher,
-		Marshalizer:      testMarshalizer,
-		NodesCoordinator: &mock.NodesCoordinatorMock{},
+		ShardCoordinator:  mock.NewOneShardCoordinatorMock(),
+		Hasher:            testHasher,
+		Marshalizer:       testMarshalizer,
+		HeaderSigVerifier: &mock.HeaderSigVerifierStub{},
 	}
 
 	hdr := createMockMetaHeader()
@@ -121,6 +122,40 @@ func TestInterceptedMetaHeader_ErrorInMiniBlockShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrInvalidShardId, err)
 }
 
+func TestInterceptedMetaHeader_CheckValidityWrongRandSeedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errWrongRandSeed := errors.New("wrong rand seed")
+	arg := createDefaultMetaArgument()
+	arg.HeaderSigVerifier = &mock.HeaderSigVerifierStub{
+		VerifyRandSeedCalled: func(header data.HeaderHandler) error {
+			return errWrongRandSeed
+		},
+	}
+	inHdr, _ := interceptedBlocks.NewInterceptedMetaHeader(arg)
+
+	err := inHdr.CheckValidity()
+
+	assert.Equal(t, errWrongRandSeed, err)
+}
+
+func TestInterceptedMetaHeader_CheckValidityWrongSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errWrongSignature := errors.New("wrong signature")
+	arg := createDefaultMetaArgument()
+	arg.HeaderSigVerifier = &mock.HeaderSigVerifierStub{
+		VerifySignatureCalled: func(header data.HeaderHandler) error {
+			return errWrongSignature
+		},
+	}
+	inHdr, _ := interceptedBlocks.NewInterceptedMetaHeader(arg)
+
+	err := inHdr.CheckValidity()
+
+	assert.Equal(t, errWrongSignature, err)
+}
+
 func TestInterceptedMetaHeader_CheckValidityShouldWork(t *testing.T) {
 	t.Parallel()
 
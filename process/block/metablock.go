@@ -13,7 +13,6 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/dataPool"
 	"github.com/ElrondNetwork/elrond-go/process"
-	"github.com/ElrondNetwork/elrond-go/process/throttle"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 )
@@ -45,14 +44,10 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 		return nil, process.ErrNilHeadersDataPool
 	}
 
-	blockSizeThrottler, err := throttle.NewBlockSizeThrottle()
-	if err != nil {
-		return nil, err
-	}
-
 	base := &baseProcessor{
 		accounts:                      arguments.Accounts,
-		blockSizeThrottler:            blockSizeThrottler,
+		blockSizeThrottler:            arguments.BlockSizeThrottler,
+		headerSigVerifier:             arguments.HeaderSigVerifier,
 		forkDetector:                  arguments.ForkDetector,
 		hasher:                        arguments.Hasher,
 		marshalizer:                   arguments.Marshalizer,
@@ -257,6 +252,19 @@ func (mp *metaProcessor) indexBlock(
 	saveRoundInfoInElastic(mp.core.Indexer(), mp.nodesCoordinator, sharding.MetachainShardId, metaBlock, lastMetaBlock, signersIndexes)
 }
 
+func (mp *metaProcessor) notifyCommittedBlockIfNeeded(header data.HeaderHandler, headerHash []byte) {
+	if mp.core == nil {
+		return
+	}
+
+	notifier := mp.core.SubscriptionNotifier()
+	if notifier == nil || notifier.IsInterfaceNil() {
+		return
+	}
+
+	notifier.NotifyCommittedBlock(header, headerHash, nil)
+}
+
 // removeBlockInfoFromPool removes the block info from associated pools
 func (mp *metaProcessor) removeBlockInfoFromPool(header *block.MetaBlock) error {
 	if header == nil || header.IsInterfaceNil() {
@@ -552,6 +560,7 @@ func (mp *metaProcessor) CommitBlock(
 	}
 
 	mp.indexBlock(header, lastMetaBlock)
+	mp.notifyCommittedBlockIfNeeded(header, headerHash)
 
 	saveMetachainCommitBlockMetrics(mp.appStatusHandler, header, headerHash, mp.nodesCoordinator)
 
@@ -681,6 +690,11 @@ func (mp *metaProcessor) checkShardHeadersValidity() (map[uint32]data.HeaderHand
 				return nil, err
 			}
 
+			err = mp.checkHeaderSignatures(shardHdr)
+			if err != nil {
+				return nil, err
+			}
+
 			tmpLastNotarized[shardId] = shardHdr
 			highestNonceHdrs[shardId] = shardHdr
 		}
@@ -990,6 +1004,13 @@ func (mp *metaProcessor) createShardInfo(
 			continue
 		}
 
+		err = mp.checkHeaderSignatures(orderedHdrs[index])
+		if err != nil {
+			log.Debug(fmt.Sprintf("shard header with nonce %d from shard %d is not correctly signed: %s\n",
+				orderedHdrs[index].Nonce, shId, err.Error()))
+			continue
+		}
+
 		lastPushedHdr[shId] = orderedHdrs[index]
 
 		shardData := block.ShardData{}
@@ -37,6 +37,8 @@ func createMockMetaArguments() blproc.ArgMetaProcessor {
 			StartHeaders:          createGenesisBlocks(shardCoordinator),
 			RequestHandler:        &mock.RequestHandlerMock{},
 			Core:                  &mock.ServiceContainerMock{},
+			BlockSizeThrottler:    &mock.BlockSizeThrottlerStub{},
+			HeaderSigVerifier:     &mock.HeaderSigVerifierStub{},
 		},
 		DataPool: mdp,
 	}
@@ -232,6 +234,28 @@ func TestNewMetaProcessor_NilRequestHeaderHandlerShouldErr(t *testing.T) {
 	assert.Nil(t, be)
 }
 
+func TestNewMetaProcessor_NilBlockSizeThrottlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments()
+	arguments.BlockSizeThrottler = nil
+
+	be, err := blproc.NewMetaProcessor(arguments)
+	assert.Equal(t, process.ErrNilBlockSizeThrottler, err)
+	assert.Nil(t, be)
+}
+
+func TestNewMetaProcessor_NilHeaderSigVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments()
+	arguments.HeaderSigVerifier = nil
+
+	be, err := blproc.NewMetaProcessor(arguments)
+	assert.Equal(t, process.ErrNilHeaderSigVerifier, err)
+	assert.Nil(t, be)
+}
+
 func TestNewMetaProcessor_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -1625,6 +1649,48 @@ func TestMetaProcessor_CheckShardHeadersValidity(t *testing.T) {
 	assert.Equal(t, currHdr.Nonce, highestNonceHdrs[currHdr.ShardId].GetNonce())
 }
 
+func TestMetaProcessor_CheckShardHeadersValidityWrongSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errWrongSignature := errors.New("wrong signature")
+	pool := mock.NewMetaPoolsHolderFake()
+	noOfShards := uint32(5)
+	arguments := createMockMetaArguments()
+	arguments.Hasher = &mock.HasherMock{}
+	arguments.DataPool = pool
+	arguments.Store = initStore()
+	arguments.ShardCoordinator = mock.NewMultiShardsCoordinatorMock(noOfShards)
+	arguments.StartHeaders = createGenesisBlocks(mock.NewMultiShardsCoordinatorMock(noOfShards))
+	arguments.HeaderSigVerifier = &mock.HeaderSigVerifierStub{
+		VerifySignatureCalled: func(header data.HeaderHandler) error {
+			return errWrongSignature
+		},
+	}
+	mp, _ := blproc.NewMetaProcessor(arguments)
+
+	prevRandSeed := []byte("prevrand")
+	notarizedHdrs := mp.NotarizedHdrs()
+	setLastNotarizedHdr(noOfShards, 9, 44, prevRandSeed, notarizedHdrs)
+
+	prevHash, _ := mp.ComputeHeaderHash(mp.LastNotarizedHdrForShard(0).(*block.Header))
+	currHdr := &block.Header{
+		Round:        10,
+		Nonce:        45,
+		ShardId:      0,
+		PrevRandSeed: prevRandSeed,
+		RandSeed:     []byte("currrand"),
+		PrevHash:     prevHash,
+		RootHash:     []byte("currRootHash")}
+	currHash, _ := mp.ComputeHeaderHash(currHdr)
+	pool.ShardHeaders().Put(currHash, currHdr)
+
+	mp.SetHdrForCurrentBlock(currHash, currHdr, true)
+
+	highestNonceHdrs, err := mp.CheckShardHeadersValidity()
+	assert.Nil(t, highestNonceHdrs)
+	assert.Equal(t, errWrongSignature, err)
+}
+
 func TestMetaProcessor_CheckShardHeadersValidityWrongNonceFromLastNoted(t *testing.T) {
 	t.Parallel()
 
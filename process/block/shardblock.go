@@ -13,7 +13,6 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/dataPool"
 	"github.com/ElrondNetwork/elrond-go/process"
-	"github.com/ElrondNetwork/elrond-go/process/throttle"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 )
@@ -48,14 +47,10 @@ func NewShardProcessor(arguments ArgShardProcessor) (*shardProcessor, error) {
 		return nil, process.ErrNilTransactionCoordinator
 	}
 
-	blockSizeThrottler, err := throttle.NewBlockSizeThrottle()
-	if err != nil {
-		return nil, err
-	}
-
 	base := &baseProcessor{
 		accounts:                      arguments.Accounts,
-		blockSizeThrottler:            blockSizeThrottler,
+		blockSizeThrottler:            arguments.BlockSizeThrottler,
+		headerSigVerifier:             arguments.HeaderSigVerifier,
 		forkDetector:                  arguments.ForkDetector,
 		hasher:                        arguments.Hasher,
 		marshalizer:                   arguments.Marshalizer,
@@ -301,6 +296,11 @@ func (sp *shardProcessor) checkMetaHeadersValidityAndFinality() error {
 			return err
 		}
 
+		err = sp.checkHeaderSignatures(metaHdr)
+		if err != nil {
+			return err
+		}
+
 		tmpNotedHdr = metaHdr
 	}
 
@@ -437,16 +437,7 @@ func (sp *shardProcessor) indexBlockIfNeeded(
 		return
 	}
 
-	txPool := sp.txCoordinator.GetAllCurrentUsedTxs(block.TxBlock)
-	scPool := sp.txCoordinator.GetAllCurrentUsedTxs(block.SmartContractResultBlock)
-	rewardPool := sp.txCoordinator.GetAllCurrentUsedTxs(block.RewardsBlock)
-
-	for hash, tx := range scPool {
-		txPool[hash] = tx
-	}
-	for hash, tx := range rewardPool {
-		txPool[hash] = tx
-	}
+	txPool := sp.getAllCurrentUsedTxs()
 
 	shardId := sp.shardCoordinator.SelfId()
 	pubKeys, err := sp.nodesCoordinator.GetValidatorsPublicKeys(header.GetPrevRandSeed(), header.GetRound(), shardId)
@@ -460,6 +451,74 @@ func (sp *shardProcessor) indexBlockIfNeeded(
 	saveRoundInfoInElastic(sp.core.Indexer(), sp.nodesCoordinator, shardId, header, lastBlockHeader, signersIndexes)
 }
 
+func (sp *shardProcessor) notifyCommittedBlockIfNeeded(header data.HeaderHandler, headerHash []byte) {
+	if sp.core == nil {
+		return
+	}
+
+	notifier := sp.core.SubscriptionNotifier()
+	if notifier == nil || notifier.IsInterfaceNil() {
+		return
+	}
+
+	notifier.NotifyCommittedBlock(header, headerHash, sp.getAllCurrentUsedTxs())
+}
+
+func (sp *shardProcessor) saveTransactionHistoryIfNeeded(header data.HeaderHandler, headerHash []byte) {
+	if sp.core == nil {
+		return
+	}
+
+	txHistory := sp.core.TransactionHistory()
+	if txHistory == nil || txHistory.IsInterfaceNil() {
+		return
+	}
+
+	err := txHistory.SaveBlock(header, headerHash, sp.getAllCurrentUsedTxs())
+	if err != nil {
+		log.Error(fmt.Sprintf("could not save the transaction history of block with nonce %d: %s",
+			header.GetNonce(),
+			err.Error()))
+	}
+}
+
+// removeTransactionHistoryIfNeeded removes the transaction history records of a reverted block
+func (sp *shardProcessor) removeTransactionHistoryIfNeeded(header data.HeaderHandler) {
+	if sp.core == nil {
+		return
+	}
+
+	txHistory := sp.core.TransactionHistory()
+	if txHistory == nil || txHistory.IsInterfaceNil() {
+		return
+	}
+
+	headerHash, err := core.CalculateHash(sp.marshalizer, sp.hasher, header)
+	if err == nil {
+		err = txHistory.RemoveBlock(headerHash)
+	}
+	if err != nil {
+		log.Error(fmt.Sprintf("could not remove the transaction history of block with nonce %d: %s",
+			header.GetNonce(),
+			err.Error()))
+	}
+}
+
+func (sp *shardProcessor) getAllCurrentUsedTxs() map[string]data.TransactionHandler {
+	txPool := sp.txCoordinator.GetAllCurrentUsedTxs(block.TxBlock)
+	scPool := sp.txCoordinator.GetAllCurrentUsedTxs(block.SmartContractResultBlock)
+	rewardPool := sp.txCoordinator.GetAllCurrentUsedTxs(block.RewardsBlock)
+
+	for hash, tx := range scPool {
+		txPool[hash] = tx
+	}
+	for hash, tx := range rewardPool {
+		txPool[hash] = tx
+	}
+
+	return txPool
+}
+
 // RestoreBlockIntoPools restores the TxBlock and MetaBlock into associated pools
 func (sp *shardProcessor) RestoreBlockIntoPools(headerHandler data.HeaderHandler, bodyHandler data.BodyHandler) error {
 	sp.removeLastNotarized()
@@ -481,6 +540,8 @@ func (sp *shardProcessor) RestoreBlockIntoPools(headerHandler data.HeaderHandler
 		return process.ErrWrongTypeAssertion
 	}
 
+	sp.removeTransactionHistoryIfNeeded(header)
+
 	restoredTxNr, err := sp.txCoordinator.RestoreBlockDataFromStorage(body)
 	go sp.txCounter.subtractRestoredTxs(restoredTxNr)
 	if err != nil {
@@ -716,6 +777,8 @@ func (sp *shardProcessor) CommitBlock(
 
 	chainHandler.SetCurrentBlockHeaderHash(headerHash)
 	sp.indexBlockIfNeeded(bodyHandler, headerHandler, lastBlockHeader)
+	sp.notifyCommittedBlockIfNeeded(headerHandler, headerHash)
+	sp.saveTransactionHistoryIfNeeded(headerHandler, headerHash)
 
 	headerMeta, err := sp.getLastNotarizedHdr(sharding.MetachainShardId)
 	if err != nil {
@@ -1367,6 +1430,12 @@ func (sp *shardProcessor) createAndProcessCrossMiniBlocksDstMe(
 			continue
 		}
 
+		err = sp.checkHeaderSignatures(hdr)
+		if err != nil {
+			log.Debug(fmt.Sprintf("meta header with nonce %d is not correctly signed: %s\n", hdr.Nonce, err.Error()))
+			continue
+		}
+
 		if len(hdr.GetMiniBlockHeadersWithDst(sp.shardCoordinator.SelfId())) == 0 {
 			sp.hdrsForCurrBlock.hdrHashAndInfo[string(orderedMetaBlocks[i].hash)] = &hdrInfo{hdr: hdr, usedInBlock: true}
 			hdrsAdded++
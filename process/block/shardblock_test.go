@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/history"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
@@ -224,6 +225,28 @@ func TestNewShardProcessor_NilRequestTransactionHandlerShouldErr(t *testing.T) {
 	assert.Nil(t, sp)
 }
 
+func TestNewShardProcessor_NilBlockSizeThrottlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.BlockSizeThrottler = nil
+	sp, err := blproc.NewShardProcessor(arguments)
+
+	assert.Equal(t, process.ErrNilBlockSizeThrottler, err)
+	assert.Nil(t, sp)
+}
+
+func TestNewShardProcessor_NilHeaderSigVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.HeaderSigVerifier = nil
+	sp, err := blproc.NewShardProcessor(arguments)
+
+	assert.Equal(t, process.ErrNilHeaderSigVerifier, err)
+	assert.Nil(t, sp)
+}
+
 func TestNewShardProcessor_NilTransactionPoolShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -3061,6 +3084,34 @@ func TestBlockProcessor_RestoreBlockIntoPoolsShouldErrNilTxBlockBody(t *testing.
 	assert.Equal(t, err, process.ErrNilTxBlockBody)
 }
 
+func TestShardProcessor_RestoreBlockIntoPoolsShouldRemoveTheTransactionHistory(t *testing.T) {
+	t.Parallel()
+
+	var removedHash []byte
+	arguments := CreateMockArgumentsMultiShard()
+	arguments.Hasher = &mock.HasherStub{
+		ComputeCalled: func(s string) []byte {
+			return []byte("header hash")
+		},
+	}
+	arguments.Core = &mock.ServiceContainerMock{
+		TransactionHistoryCalled: func() history.Handler {
+			return &mock.TransactionHistoryStub{
+				RemoveBlockCalled: func(headerHash []byte) error {
+					removedHash = headerHash
+					return nil
+				},
+			}
+		},
+	}
+	sp, _ := blproc.NewShardProcessor(arguments)
+
+	err := sp.RestoreBlockIntoPools(&block.Header{Nonce: 1}, make(block.Body, 0))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("header hash"), removedHash)
+}
+
 func TestShardProcessor_RestoreBlockIntoPoolsShouldWork(t *testing.T) {
 	t.Parallel()
 
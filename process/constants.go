@@ -26,10 +26,16 @@ const (
 	SCInvoking
 	// RewardTx defines ID of a reward transaction
 	RewardTx
+	// SignerSetChange defines ID of a transaction that registers, changes or removes the signer set of its sender
+	SignerSetChange
 	// InvalidTransaction defines unknown transaction type
 	InvalidTransaction
 )
 
+// SignerSetChangeFunction is the function name used in the data field of a signer set change transaction.
+// The data field has the form setSignerSet@<threshold>@<public key 1>@...@<public key N>, all arguments hex encoded
+const SignerSetChangeFunction = "setSignerSet"
+
 const ShardBlockFinality = 1
 const MetaBlockFinality = 1
 const MaxHeaderRequestsAllowed = 10
@@ -2,6 +2,7 @@ package coordinator
 
 import (
 	"bytes"
+	"strings"
 
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
@@ -53,6 +54,10 @@ func (tc *txTypeHandler) ComputeTransactionType(tx data.TransactionHandler) (pro
 		return process.RewardTx, nil
 	}
 
+	if tc.isSignerSetChange(tx) {
+		return process.SignerSetChange, nil
+	}
+
 	isEmptyAddress := tc.isDestAddressEmpty(tx)
 	if isEmptyAddress {
 		if len(tx.GetData()) > 0 {
@@ -82,6 +87,13 @@ func (tc *txTypeHandler) isDestAddressEmpty(tx data.TransactionHandler) bool {
 	return isEmptyAddress
 }
 
+func (tc *txTypeHandler) isSignerSetChange(tx data.TransactionHandler) bool {
+	isSelfTransfer := bytes.Equal(tx.GetSndAddress(), tx.GetRecvAddress())
+	hasSignerSetChangeData := strings.HasPrefix(tx.GetData(), process.SignerSetChangeFunction+"@")
+
+	return isSelfTransfer && hasSignerSetChangeData
+}
+
 func (tc *txTypeHandler) getAccountFromAddress(address []byte) (state.AccountHandler, error) {
 	adrSrc, err := tc.adrConv.CreateAddressFromPublicKeyBytes(address)
 	if err != nil {
@@ -243,6 +243,31 @@ func TestTxTypeHandler_ComputeTransactionTypeMoveBalance(t *testing.T) {
 	assert.Equal(t, process.MoveBalance, txType)
 }
 
+func TestTxTypeHandler_ComputeTransactionTypeSignerSetChange(t *testing.T) {
+	t.Parallel()
+
+	addrConverter := &mock.AddressConverterMock{}
+	tx := &transaction.Transaction{}
+	tx.Nonce = 0
+	tx.SndAddr = generateRandomByteSlice(addrConverter.AddressLen())
+	tx.RcvAddr = tx.SndAddr
+	tx.Data = process.SignerSetChangeFunction + "@01@aa"
+	tx.Value = big.NewInt(0)
+
+	tth, err := NewTxTypeHandler(
+		addrConverter,
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.AccountsStub{},
+	)
+
+	assert.NotNil(t, tth)
+	assert.Nil(t, err)
+
+	txType, err := tth.ComputeTransactionType(tx)
+	assert.Nil(t, err)
+	assert.Equal(t, process.SignerSetChange, txType)
+}
+
 func TestTxTypeHandler_ComputeTransactionTypeRewardTx(t *testing.T) {
 	t.Parallel()
 
@@ -502,3 +502,69 @@ var ErrInvalidRewardsPercentages = errors.New("invalid rewards percentages")
 
 // ErrNilMiniBlocksCompacter signals that a nil mini blocks compacter has been provided
 var ErrNilMiniBlocksCompacter = errors.New("nil mini blocks compacter")
+
+// ErrNilBlockSizeThrottler signals that a nil block size throttler has been provided
+var ErrNilBlockSizeThrottler = errors.New("nil block size throttler")
+
+// ErrNilSyncTimer signals that a nil sync timer has been provided
+var ErrNilSyncTimer = errors.New("nil sync timer")
+
+// ErrInvalidCommitDeadline signals that an invalid commit deadline has been provided
+var ErrInvalidCommitDeadline = errors.New("invalid commit deadline")
+
+// ErrInvalidCloseToDeadlinePercent signals that an invalid percent of the commit deadline has been provided
+var ErrInvalidCloseToDeadlinePercent = errors.New("invalid close to deadline percent")
+
+// ErrInvalidNumRecentRounds signals that an invalid number of recent rounds has been provided
+var ErrInvalidNumRecentRounds = errors.New("invalid number of recent rounds")
+
+// ErrNilHeaderSigVerifier signals that a nil header sig verifier has been provided
+var ErrNilHeaderSigVerifier = errors.New("nil header sig verifier")
+
+// ErrNotEnoughSignaturesInBitmap signals that the public keys bitmap does not select enough consensus group members
+var ErrNotEnoughSignaturesInBitmap = errors.New("not enough signatures in public keys bitmap")
+
+// ErrWrongSizeBitmap signals that the size of the public keys bitmap does not match the consensus group size
+var ErrWrongSizeBitmap = errors.New("wrong size bitmap")
+
+// ErrEmptyConsensusGroup signals that an empty consensus group has been computed
+var ErrEmptyConsensusGroup = errors.New("empty consensus group")
+
+// ErrNilInterceptedDataVerifier signals that a nil intercepted data verifier has been provided
+var ErrNilInterceptedDataVerifier = errors.New("nil intercepted data verifier")
+
+// ErrInvalidNumWorkers signals that an invalid number of workers has been provided
+var ErrInvalidNumWorkers = errors.New("invalid number of workers")
+
+// ErrInvalidSignature signals that the signature of the intercepted data is not valid
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// ErrNilSignerSetProvider signals that a nil signer set provider has been provided
+var ErrNilSignerSetProvider = errors.New("nil signer set provider")
+
+// ErrSignersSignaturesMismatch signals that the number of signer public keys differs from the number of signatures
+var ErrSignersSignaturesMismatch = errors.New("number of signer public keys does not match the number of signatures")
+
+// ErrSignatureAndMultiSignature signals that a transaction carries both a single signature and multiple signatures
+var ErrSignatureAndMultiSignature = errors.New("transaction carries both a single signature and multiple signatures")
+
+// ErrSignerSetNotRegistered signals that multiple signatures were provided for an account without a registered signer set
+var ErrSignerSetNotRegistered = errors.New("signer set not registered for sender account")
+
+// ErrMultiSignatureRequired signals that an account with a registered signer set sent a single signed transaction
+var ErrMultiSignatureRequired = errors.New("sender account requires signatures from its signer set")
+
+// ErrSignerNotInSignerSet signals that a transaction was signed by a key outside of the sender's signer set
+var ErrSignerNotInSignerSet = errors.New("signer does not belong to the sender's signer set")
+
+// ErrDuplicatedSigner signals that the same signer signed a transaction more than once
+var ErrDuplicatedSigner = errors.New("duplicated signer")
+
+// ErrNotEnoughSignatures signals that a transaction does not carry enough signatures to reach the signer set threshold
+var ErrNotEnoughSignatures = errors.New("not enough signatures to reach the signer set threshold")
+
+// ErrInvalidSignerSetChange signals that the data of a signer set change transaction could not be parsed
+var ErrInvalidSignerSetChange = errors.New("invalid signer set change data")
+
+// ErrNilLogsHandler signals that a nil handler of the smart contract logs has been provided
+var ErrNilLogsHandler = errors.New("nil smart contract logs handler")
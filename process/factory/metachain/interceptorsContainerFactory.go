@@ -17,30 +17,31 @@ import (
 	processInterceptors "github.com/ElrondNetwork/elrond-go/process/interceptors"
 	interceptorFactory "github.com/ElrondNetwork/elrond-go/process/interceptors/factory"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors/processor"
+	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
 const numGoRoutines = 2000
 
 type interceptorsContainerFactory struct {
-	accounts               state.AccountsAdapter
-	addrConverter          state.AddressConverter
-	singleSigner           crypto.SingleSigner
-	keyGen                 crypto.KeyGenerator
-	maxTxNonceDeltaAllowed int
-	txFeeHandler           process.FeeHandler
-	txInterceptorThrottler process.InterceptorThrottler
-	marshalizer            marshal.Marshalizer
-	hasher                 hashing.Hasher
-	store                  dataRetriever.StorageService
-	dataPool               dataRetriever.MetaPoolsHolder
-	shardCoordinator       sharding.Coordinator
-	messenger              process.TopicHandler
-	multiSigner            crypto.MultiSigner
-	nodesCoordinator       sharding.NodesCoordinator
-	tpsBenchmark           *statistics.TpsBenchmark
-	argInterceptorFactory  *interceptorFactory.ArgInterceptedDataFactory
-	globalThrottler        process.InterceptorThrottler
+	accounts                state.AccountsAdapter
+	addrConverter           state.AddressConverter
+	singleSigner            crypto.SingleSigner
+	keyGen                  crypto.KeyGenerator
+	maxTxNonceDeltaAllowed  int
+	txFeeHandler            process.FeeHandler
+	txInterceptorThrottler  process.InterceptorThrottler
+	marshalizer             marshal.Marshalizer
+	hasher                  hashing.Hasher
+	store                   dataRetriever.StorageService
+	dataPool                dataRetriever.MetaPoolsHolder
+	shardCoordinator        sharding.Coordinator
+	messenger               process.TopicHandler
+	nodesCoordinator        sharding.NodesCoordinator
+	tpsBenchmark            *statistics.TpsBenchmark
+	argInterceptorFactory   *interceptorFactory.ArgInterceptedDataFactory
+	globalThrottler         process.InterceptorThrottler
+	interceptedDataVerifier process.InterceptedDataVerifier
 }
 
 // NewInterceptorsContainerFactory is responsible for creating a new interceptors factory object
@@ -51,7 +52,7 @@ func NewInterceptorsContainerFactory(
 	store dataRetriever.StorageService,
 	marshalizer marshal.Marshalizer,
 	hasher hashing.Hasher,
-	multiSigner crypto.MultiSigner,
+	headerSigVerifier process.InterceptedHeaderSigVerifier,
 	dataPool dataRetriever.MetaPoolsHolder,
 	accounts state.AccountsAdapter,
 	addrConverter state.AddressConverter,
@@ -59,6 +60,7 @@ func NewInterceptorsContainerFactory(
 	keyGen crypto.KeyGenerator,
 	maxTxNonceDeltaAllowed int,
 	txFeeHandler process.FeeHandler,
+	interceptedDataVerifier process.InterceptedDataVerifier,
 ) (*interceptorsContainerFactory, error) {
 
 	if check.IfNil(shardCoordinator) {
@@ -76,8 +78,8 @@ func NewInterceptorsContainerFactory(
 	if check.IfNil(hasher) {
 		return nil, process.ErrNilHasher
 	}
-	if check.IfNil(multiSigner) {
-		return nil, process.ErrNilMultiSigVerifier
+	if check.IfNil(headerSigVerifier) {
+		return nil, process.ErrNilHeaderSigVerifier
 	}
 	if check.IfNil(dataPool) {
 		return nil, process.ErrNilDataPoolHolder
@@ -100,34 +102,41 @@ func NewInterceptorsContainerFactory(
 	if check.IfNil(txFeeHandler) {
 		return nil, process.ErrNilEconomicsFeeHandler
 	}
+	if check.IfNil(interceptedDataVerifier) {
+		return nil, process.ErrNilInterceptedDataVerifier
+	}
+
+	signerSetProvider, err := transaction.NewSignerSetProvider(accounts, marshalizer)
+	if err != nil {
+		return nil, err
+	}
 
 	argInterceptorFactory := &interceptorFactory.ArgInterceptedDataFactory{
-		Marshalizer:      marshalizer,
-		Hasher:           hasher,
-		ShardCoordinator: shardCoordinator,
-		NodesCoordinator: nodesCoordinator,
-		MultiSigVerifier: multiSigner,
-		KeyGen:           keyGen,
-		Signer:           singleSigner,
-		AddrConv:         addrConverter,
-		FeeHandler:       txFeeHandler,
+		Marshalizer:       marshalizer,
+		Hasher:            hasher,
+		ShardCoordinator:  shardCoordinator,
+		HeaderSigVerifier: headerSigVerifier,
+		KeyGen:            keyGen,
+		Signer:            singleSigner,
+		AddrConv:          addrConverter,
+		FeeHandler:        txFeeHandler,
+		SignerSetProvider: signerSetProvider,
 	}
 
 	icf := &interceptorsContainerFactory{
-		shardCoordinator:       shardCoordinator,
-		messenger:              messenger,
-		store:                  store,
-		marshalizer:            marshalizer,
-		hasher:                 hasher,
-		multiSigner:            multiSigner,
-		dataPool:               dataPool,
-		nodesCoordinator:       nodesCoordinator,
-		argInterceptorFactory:  argInterceptorFactory,
-		maxTxNonceDeltaAllowed: maxTxNonceDeltaAllowed,
-		accounts:               accounts,
-	}
-
-	var err error
+		shardCoordinator:        shardCoordinator,
+		messenger:               messenger,
+		store:                   store,
+		marshalizer:             marshalizer,
+		hasher:                  hasher,
+		dataPool:                dataPool,
+		nodesCoordinator:        nodesCoordinator,
+		argInterceptorFactory:   argInterceptorFactory,
+		maxTxNonceDeltaAllowed:  maxTxNonceDeltaAllowed,
+		accounts:                accounts,
+		interceptedDataVerifier: interceptedDataVerifier,
+	}
+
 	icf.globalThrottler, err = throttler.NewNumGoRoutineThrottler(numGoRoutines)
 	if err != nil {
 		return nil, err
@@ -365,6 +374,7 @@ func (icf *interceptorsContainerFactory) createOneTxInterceptor(topic string) (p
 		txFactory,
 		txProcessor,
 		icf.globalThrottler,
+		icf.interceptedDataVerifier,
 	)
 	if err != nil {
 		return nil, err
@@ -91,7 +91,7 @@ func TestNewInterceptorsContainerFactory_NilShardCoordinatorShouldErr(t *testing
 		createStore(),
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -99,6 +99,7 @@ func TestNewInterceptorsContainerFactory_NilShardCoordinatorShouldErr(t *testing
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	assert.Nil(t, icf)
@@ -115,7 +116,7 @@ func TestNewInterceptorsContainerFactory_NilNodesCoordinatorShouldErr(t *testing
 		createStore(),
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -123,6 +124,7 @@ func TestNewInterceptorsContainerFactory_NilNodesCoordinatorShouldErr(t *testing
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	assert.Nil(t, icf)
@@ -139,7 +141,7 @@ func TestNewInterceptorsContainerFactory_NilTopicHandlerShouldErr(t *testing.T)
 		createStore(),
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -147,6 +149,7 @@ func TestNewInterceptorsContainerFactory_NilTopicHandlerShouldErr(t *testing.T)
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	assert.Nil(t, icf)
@@ -163,7 +166,7 @@ func TestNewInterceptorsContainerFactory_NilBlockchainShouldErr(t *testing.T) {
 		nil,
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -171,6 +174,7 @@ func TestNewInterceptorsContainerFactory_NilBlockchainShouldErr(t *testing.T) {
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	assert.Nil(t, icf)
@@ -187,7 +191,7 @@ func TestNewInterceptorsContainerFactory_NilMarshalizerShouldErr(t *testing.T) {
 		createStore(),
 		nil,
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -195,6 +199,7 @@ func TestNewInterceptorsContainerFactory_NilMarshalizerShouldErr(t *testing.T) {
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	assert.Nil(t, icf)
@@ -211,7 +216,7 @@ func TestNewInterceptorsContainerFactory_NilHasherShouldErr(t *testing.T) {
 		createStore(),
 		&mock.MarshalizerMock{},
 		nil,
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -219,13 +224,14 @@ func TestNewInterceptorsContainerFactory_NilHasherShouldErr(t *testing.T) {
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	assert.Nil(t, icf)
 	assert.Equal(t, process.ErrNilHasher, err)
 }
 
-func TestNewInterceptorsContainerFactory_NilMultiSignerShouldErr(t *testing.T) {
+func TestNewInterceptorsContainerFactory_NilHeaderSigVerifierShouldErr(t *testing.T) {
 	t.Parallel()
 
 	icf, err := metachain.NewInterceptorsContainerFactory(
@@ -243,10 +249,11 @@ func TestNewInterceptorsContainerFactory_NilMultiSignerShouldErr(t *testing.T) {
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	assert.Nil(t, icf)
-	assert.Equal(t, process.ErrNilMultiSigVerifier, err)
+	assert.Equal(t, process.ErrNilHeaderSigVerifier, err)
 }
 
 func TestNewInterceptorsContainerFactory_NilDataPoolShouldErr(t *testing.T) {
@@ -259,7 +266,7 @@ func TestNewInterceptorsContainerFactory_NilDataPoolShouldErr(t *testing.T) {
 		createStore(),
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		nil,
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -267,6 +274,7 @@ func TestNewInterceptorsContainerFactory_NilDataPoolShouldErr(t *testing.T) {
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	assert.Nil(t, icf)
@@ -283,7 +291,7 @@ func TestNewInterceptorsContainerFactory_NilAccountsShouldErr(t *testing.T) {
 		createStore(),
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		nil,
 		&mock.AddressConverterMock{},
@@ -291,6 +299,7 @@ func TestNewInterceptorsContainerFactory_NilAccountsShouldErr(t *testing.T) {
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	assert.Nil(t, icf)
@@ -307,7 +316,7 @@ func TestNewInterceptorsContainerFactory_NilAddrConvShouldErr(t *testing.T) {
 		createStore(),
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		nil,
@@ -315,6 +324,7 @@ func TestNewInterceptorsContainerFactory_NilAddrConvShouldErr(t *testing.T) {
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	assert.Nil(t, icf)
@@ -331,7 +341,7 @@ func TestNewInterceptorsContainerFactory_NilSingleSignerShouldErr(t *testing.T)
 		createStore(),
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -339,6 +349,7 @@ func TestNewInterceptorsContainerFactory_NilSingleSignerShouldErr(t *testing.T)
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	assert.Nil(t, icf)
@@ -355,7 +366,7 @@ func TestNewInterceptorsContainerFactory_NilKeyGenShouldErr(t *testing.T) {
 		createStore(),
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -363,6 +374,7 @@ func TestNewInterceptorsContainerFactory_NilKeyGenShouldErr(t *testing.T) {
 		nil,
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	assert.Nil(t, icf)
@@ -379,7 +391,7 @@ func TestNewInterceptorsContainerFactory_NilFeeHandlerShouldErr(t *testing.T) {
 		createStore(),
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -387,12 +399,38 @@ func TestNewInterceptorsContainerFactory_NilFeeHandlerShouldErr(t *testing.T) {
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		nil,
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	assert.Nil(t, icf)
 	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
 }
 
+func TestNewInterceptorsContainerFactory_NilInterceptedDataVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	icf, err := metachain.NewInterceptorsContainerFactory(
+		mock.NewOneShardCoordinatorMock(),
+		mock.NewNodesCoordinatorMock(),
+		&mock.TopicHandlerStub{},
+		createStore(),
+		&mock.MarshalizerMock{},
+		&mock.HasherMock{},
+		&mock.HeaderSigVerifierStub{},
+		createDataPools(),
+		&mock.AccountsStub{},
+		&mock.AddressConverterMock{},
+		&mock.SignerMock{},
+		&mock.SingleSignKeyGenMock{},
+		maxTxNonceDeltaAllowed,
+		&mock.FeeHandlerStub{},
+		nil,
+	)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrNilInterceptedDataVerifier, err)
+}
+
 func TestNewInterceptorsContainerFactory_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -403,7 +441,7 @@ func TestNewInterceptorsContainerFactory_ShouldWork(t *testing.T) {
 		createStore(),
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -411,6 +449,7 @@ func TestNewInterceptorsContainerFactory_ShouldWork(t *testing.T) {
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	assert.NotNil(t, icf)
@@ -429,7 +468,7 @@ func TestInterceptorsContainerFactory_CreateTopicMetablocksFailsShouldErr(t *tes
 		createStore(),
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -437,6 +476,7 @@ func TestInterceptorsContainerFactory_CreateTopicMetablocksFailsShouldErr(t *tes
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	container, err := icf.Create()
@@ -455,7 +495,7 @@ func TestInterceptorsContainerFactory_CreateTopicShardHeadersForMetachainFailsSh
 		createStore(),
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -463,6 +503,7 @@ func TestInterceptorsContainerFactory_CreateTopicShardHeadersForMetachainFailsSh
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	container, err := icf.Create()
@@ -481,7 +522,7 @@ func TestInterceptorsContainerFactory_CreateRegisterForMetablocksFailsShouldErr(
 		createStore(),
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -489,6 +530,7 @@ func TestInterceptorsContainerFactory_CreateRegisterForMetablocksFailsShouldErr(
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	container, err := icf.Create()
@@ -507,7 +549,7 @@ func TestInterceptorsContainerFactory_CreateRegisterShardHeadersForMetachainFail
 		createStore(),
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -515,6 +557,7 @@ func TestInterceptorsContainerFactory_CreateRegisterShardHeadersForMetachainFail
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	container, err := icf.Create()
@@ -540,7 +583,7 @@ func TestInterceptorsContainerFactory_CreateShouldWork(t *testing.T) {
 		createStore(),
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -548,6 +591,7 @@ func TestInterceptorsContainerFactory_CreateShouldWork(t *testing.T) {
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	container, err := icf.Create()
@@ -586,7 +630,7 @@ func TestInterceptorsContainerFactory_With4ShardsShouldWork(t *testing.T) {
 		createStore(),
 		&mock.MarshalizerMock{},
 		&mock.HasherMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AccountsStub{},
 		&mock.AddressConverterMock{},
@@ -594,6 +638,7 @@ func TestInterceptorsContainerFactory_With4ShardsShouldWork(t *testing.T) {
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	container, err := icf.Create()
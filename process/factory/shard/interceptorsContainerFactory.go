@@ -16,27 +16,28 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/interceptors/processor"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/process/rewardTransaction"
+	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
 const numGoRoutines = 2000
 
 type interceptorsContainerFactory struct {
-	accounts               state.AccountsAdapter
-	shardCoordinator       sharding.Coordinator
-	messenger              process.TopicHandler
-	store                  dataRetriever.StorageService
-	marshalizer            marshal.Marshalizer
-	hasher                 hashing.Hasher
-	keyGen                 crypto.KeyGenerator
-	singleSigner           crypto.SingleSigner
-	multiSigner            crypto.MultiSigner
-	dataPool               dataRetriever.PoolsHolder
-	addrConverter          state.AddressConverter
-	nodesCoordinator       sharding.NodesCoordinator
-	argInterceptorFactory  *interceptorFactory.ArgInterceptedDataFactory
-	globalTxThrottler      process.InterceptorThrottler
-	maxTxNonceDeltaAllowed int
+	accounts                state.AccountsAdapter
+	shardCoordinator        sharding.Coordinator
+	messenger               process.TopicHandler
+	store                   dataRetriever.StorageService
+	marshalizer             marshal.Marshalizer
+	hasher                  hashing.Hasher
+	keyGen                  crypto.KeyGenerator
+	singleSigner            crypto.SingleSigner
+	dataPool                dataRetriever.PoolsHolder
+	addrConverter           state.AddressConverter
+	nodesCoordinator        sharding.NodesCoordinator
+	argInterceptorFactory   *interceptorFactory.ArgInterceptedDataFactory
+	globalTxThrottler       process.InterceptorThrottler
+	maxTxNonceDeltaAllowed  int
+	interceptedDataVerifier process.InterceptedDataVerifier
 }
 
 // NewInterceptorsContainerFactory is responsible for creating a new interceptors factory object
@@ -50,11 +51,12 @@ func NewInterceptorsContainerFactory(
 	hasher hashing.Hasher,
 	keyGen crypto.KeyGenerator,
 	singleSigner crypto.SingleSigner,
-	multiSigner crypto.MultiSigner,
+	headerSigVerifier process.InterceptedHeaderSigVerifier,
 	dataPool dataRetriever.PoolsHolder,
 	addrConverter state.AddressConverter,
 	maxTxNonceDeltaAllowed int,
 	txFeeHandler process.FeeHandler,
+	interceptedDataVerifier process.InterceptedDataVerifier,
 ) (*interceptorsContainerFactory, error) {
 	if accounts == nil || accounts.IsInterfaceNil() {
 		return nil, process.ErrNilAccountsAdapter
@@ -80,8 +82,8 @@ func NewInterceptorsContainerFactory(
 	if singleSigner == nil || singleSigner.IsInterfaceNil() {
 		return nil, process.ErrNilSingleSigner
 	}
-	if multiSigner == nil || multiSigner.IsInterfaceNil() {
-		return nil, process.ErrNilMultiSigVerifier
+	if headerSigVerifier == nil || headerSigVerifier.IsInterfaceNil() {
+		return nil, process.ErrNilHeaderSigVerifier
 	}
 	if dataPool == nil || dataPool.IsInterfaceNil() {
 		return nil, process.ErrNilDataPoolHolder
@@ -95,37 +97,44 @@ func NewInterceptorsContainerFactory(
 	if txFeeHandler == nil || txFeeHandler.IsInterfaceNil() {
 		return nil, process.ErrNilEconomicsFeeHandler
 	}
+	if interceptedDataVerifier == nil || interceptedDataVerifier.IsInterfaceNil() {
+		return nil, process.ErrNilInterceptedDataVerifier
+	}
+
+	signerSetProvider, err := transaction.NewSignerSetProvider(accounts, marshalizer)
+	if err != nil {
+		return nil, err
+	}
 
 	argInterceptorFactory := &interceptorFactory.ArgInterceptedDataFactory{
-		Marshalizer:      marshalizer,
-		Hasher:           hasher,
-		ShardCoordinator: shardCoordinator,
-		MultiSigVerifier: multiSigner,
-		NodesCoordinator: nodesCoordinator,
-		KeyGen:           keyGen,
-		Signer:           singleSigner,
-		AddrConv:         addrConverter,
-		FeeHandler:       txFeeHandler,
+		Marshalizer:       marshalizer,
+		Hasher:            hasher,
+		ShardCoordinator:  shardCoordinator,
+		HeaderSigVerifier: headerSigVerifier,
+		KeyGen:            keyGen,
+		Signer:            singleSigner,
+		AddrConv:          addrConverter,
+		FeeHandler:        txFeeHandler,
+		SignerSetProvider: signerSetProvider,
 	}
 
 	icf := &interceptorsContainerFactory{
-		accounts:               accounts,
-		shardCoordinator:       shardCoordinator,
-		messenger:              messenger,
-		store:                  store,
-		marshalizer:            marshalizer,
-		hasher:                 hasher,
-		keyGen:                 keyGen,
-		singleSigner:           singleSigner,
-		multiSigner:            multiSigner,
-		dataPool:               dataPool,
-		addrConverter:          addrConverter,
-		nodesCoordinator:       nodesCoordinator,
-		argInterceptorFactory:  argInterceptorFactory,
-		maxTxNonceDeltaAllowed: maxTxNonceDeltaAllowed,
-	}
-
-	var err error
+		accounts:                accounts,
+		shardCoordinator:        shardCoordinator,
+		messenger:               messenger,
+		store:                   store,
+		marshalizer:             marshalizer,
+		hasher:                  hasher,
+		keyGen:                  keyGen,
+		singleSigner:            singleSigner,
+		dataPool:                dataPool,
+		addrConverter:           addrConverter,
+		nodesCoordinator:        nodesCoordinator,
+		argInterceptorFactory:   argInterceptorFactory,
+		maxTxNonceDeltaAllowed:  maxTxNonceDeltaAllowed,
+		interceptedDataVerifier: interceptedDataVerifier,
+	}
+
 	icf.globalTxThrottler, err = throttler.NewNumGoRoutineThrottler(numGoRoutines)
 	if err != nil {
 		return nil, err
@@ -278,6 +287,7 @@ func (icf *interceptorsContainerFactory) createOneTxInterceptor(topic string) (p
 		txFactory,
 		txProcessor,
 		icf.globalTxThrottler,
+		icf.interceptedDataVerifier,
 	)
 	if err != nil {
 		return nil, err
@@ -349,6 +359,7 @@ func (icf *interceptorsContainerFactory) createOneUnsignedTxInterceptor(topic st
 		txFactory,
 		txProcessor,
 		icf.globalTxThrottler,
+		icf.interceptedDataVerifier,
 	)
 	if err != nil {
 		return nil, err
@@ -95,11 +95,12 @@ func TestNewInterceptorsContainerFactory_NilAccountsAdapter(t *testing.T) {
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	assert.Nil(t, icf)
@@ -119,11 +120,12 @@ func TestNewInterceptorsContainerFactory_NilShardCoordinatorShouldErr(t *testing
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	assert.Nil(t, icf)
@@ -143,11 +145,12 @@ func TestNewInterceptorsContainerFactory_NilNodesCoordinatorShouldErr(t *testing
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	assert.Nil(t, icf)
@@ -167,11 +170,12 @@ func TestNewInterceptorsContainerFactory_NilTopicHandlerShouldErr(t *testing.T)
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	assert.Nil(t, icf)
@@ -191,11 +195,12 @@ func TestNewInterceptorsContainerFactory_NilBlockchainShouldErr(t *testing.T) {
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	assert.Nil(t, icf)
@@ -215,11 +220,12 @@ func TestNewInterceptorsContainerFactory_NilMarshalizerShouldErr(t *testing.T) {
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	assert.Nil(t, icf)
@@ -239,11 +245,12 @@ func TestNewInterceptorsContainerFactory_NilHasherShouldErr(t *testing.T) {
 		nil,
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	assert.Nil(t, icf)
@@ -263,11 +270,12 @@ func TestNewInterceptorsContainerFactory_NilKeyGenShouldErr(t *testing.T) {
 		&mock.HasherMock{},
 		nil,
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	assert.Nil(t, icf)
@@ -287,18 +295,19 @@ func TestNewInterceptorsContainerFactory_NilSingleSignerShouldErr(t *testing.T)
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		nil,
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	assert.Nil(t, icf)
 	assert.Equal(t, process.ErrNilSingleSigner, err)
 }
 
-func TestNewInterceptorsContainerFactory_NilMultiSignerShouldErr(t *testing.T) {
+func TestNewInterceptorsContainerFactory_NilHeaderSigVerifierShouldErr(t *testing.T) {
 	t.Parallel()
 
 	icf, err := shard.NewInterceptorsContainerFactory(
@@ -316,10 +325,11 @@ func TestNewInterceptorsContainerFactory_NilMultiSignerShouldErr(t *testing.T) {
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	assert.Nil(t, icf)
-	assert.Equal(t, process.ErrNilMultiSigVerifier, err)
+	assert.Equal(t, process.ErrNilHeaderSigVerifier, err)
 }
 
 func TestNewInterceptorsContainerFactory_NilDataPoolShouldErr(t *testing.T) {
@@ -335,11 +345,12 @@ func TestNewInterceptorsContainerFactory_NilDataPoolShouldErr(t *testing.T) {
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		nil,
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	assert.Nil(t, icf)
@@ -359,11 +370,12 @@ func TestNewInterceptorsContainerFactory_NilAddrConverterShouldErr(t *testing.T)
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		nil,
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	assert.Nil(t, icf)
@@ -383,17 +395,43 @@ func TestNewInterceptorsContainerFactory_NilTxFeeHandlerShouldErr(t *testing.T)
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		nil,
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	assert.Nil(t, icf)
 	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
 }
 
+func TestNewInterceptorsContainerFactory_NilInterceptedDataVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	icf, err := shard.NewInterceptorsContainerFactory(
+		&mock.AccountsStub{},
+		mock.NewOneShardCoordinatorMock(),
+		mock.NewNodesCoordinatorMock(),
+		&mock.TopicHandlerStub{},
+		createStore(),
+		&mock.MarshalizerMock{},
+		&mock.HasherMock{},
+		&mock.SingleSignKeyGenMock{},
+		&mock.SignerMock{},
+		&mock.HeaderSigVerifierStub{},
+		createDataPools(),
+		&mock.AddressConverterMock{},
+		maxTxNonceDeltaAllowed,
+		&mock.FeeHandlerStub{},
+		nil,
+	)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrNilInterceptedDataVerifier, err)
+}
+
 func TestNewInterceptorsContainerFactory_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -407,11 +445,12 @@ func TestNewInterceptorsContainerFactory_ShouldWork(t *testing.T) {
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	assert.NotNil(t, icf)
@@ -433,11 +472,12 @@ func TestInterceptorsContainerFactory_CreateTopicCreationTxFailsShouldErr(t *tes
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	container, err := icf.Create()
@@ -459,11 +499,12 @@ func TestInterceptorsContainerFactory_CreateTopicCreationHdrFailsShouldErr(t *te
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	container, err := icf.Create()
@@ -485,11 +526,12 @@ func TestInterceptorsContainerFactory_CreateTopicCreationMiniBlocksFailsShouldEr
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	container, err := icf.Create()
@@ -511,11 +553,12 @@ func TestInterceptorsContainerFactory_CreateTopicCreationMetachainHeadersFailsSh
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	container, err := icf.Create()
@@ -537,11 +580,12 @@ func TestInterceptorsContainerFactory_CreateRegisterTxFailsShouldErr(t *testing.
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	container, err := icf.Create()
@@ -563,11 +607,12 @@ func TestInterceptorsContainerFactory_CreateRegisterHdrFailsShouldErr(t *testing
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	container, err := icf.Create()
@@ -589,11 +634,12 @@ func TestInterceptorsContainerFactory_CreateRegisterMiniBlocksFailsShouldErr(t *
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	container, err := icf.Create()
@@ -615,11 +661,12 @@ func TestInterceptorsContainerFactory_CreateRegisterMetachainHeadersShouldErr(t
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	container, err := icf.Create()
@@ -648,11 +695,12 @@ func TestInterceptorsContainerFactory_CreateShouldWork(t *testing.T) {
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	container, err := icf.Create()
@@ -694,11 +742,12 @@ func TestInterceptorsContainerFactory_With4ShardsShouldWork(t *testing.T) {
 		&mock.HasherMock{},
 		&mock.SingleSignKeyGenMock{},
 		&mock.SignerMock{},
-		mock.NewMultiSigner(),
+		&mock.HeaderSigVerifierStub{},
 		createDataPools(),
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	container, err := icf.Create()
@@ -0,0 +1,208 @@
+package headerCheck
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// ArgsHeaderSigVerifier is used to store all components that are needed to create a new header sig verifier
+type ArgsHeaderSigVerifier struct {
+	Marshalizer       marshal.Marshalizer
+	Hasher            hashing.Hasher
+	NodesCoordinator  sharding.NodesCoordinator
+	MultiSigVerifier  crypto.MultiSigVerifier
+	SingleSigVerifier crypto.SingleSigner
+	KeyGen            crypto.KeyGenerator
+}
+
+// headerSigVerifier is able to check that a header was signed by the consensus group elected for its round and shard
+type headerSigVerifier struct {
+	marshalizer       marshal.Marshalizer
+	hasher            hashing.Hasher
+	nodesCoordinator  sharding.NodesCoordinator
+	multiSigVerifier  crypto.MultiSigVerifier
+	singleSigVerifier crypto.SingleSigner
+	keyGen            crypto.KeyGenerator
+}
+
+// NewHeaderSigVerifier creates a new instance of headerSigVerifier
+func NewHeaderSigVerifier(arguments *ArgsHeaderSigVerifier) (*headerSigVerifier, error) {
+	err := checkArgsHeaderSigVerifier(arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	return &headerSigVerifier{
+		marshalizer:       arguments.Marshalizer,
+		hasher:            arguments.Hasher,
+		nodesCoordinator:  arguments.NodesCoordinator,
+		multiSigVerifier:  arguments.MultiSigVerifier,
+		singleSigVerifier: arguments.SingleSigVerifier,
+		keyGen:            arguments.KeyGen,
+	}, nil
+}
+
+func checkArgsHeaderSigVerifier(arguments *ArgsHeaderSigVerifier) error {
+	if arguments == nil {
+		return process.ErrNilArguments
+	}
+	if check.IfNil(arguments.Marshalizer) {
+		return process.ErrNilMarshalizer
+	}
+	if check.IfNil(arguments.Hasher) {
+		return process.ErrNilHasher
+	}
+	if check.IfNil(arguments.NodesCoordinator) {
+		return process.ErrNilNodesCoordinator
+	}
+	if check.IfNil(arguments.MultiSigVerifier) {
+		return process.ErrNilMultiSigVerifier
+	}
+	if check.IfNil(arguments.SingleSigVerifier) {
+		return process.ErrNilSingleSigner
+	}
+	if check.IfNil(arguments.KeyGen) {
+		return process.ErrNilKeyGen
+	}
+
+	return nil
+}
+
+// VerifyRandSeed verifies that the rand seed of the header is the signature of the block proposer
+// over the previous rand seed
+func (hsv *headerSigVerifier) VerifyRandSeed(header data.HeaderHandler) error {
+	if check.IfNil(header) {
+		return process.ErrNilBlockHeader
+	}
+
+	consensusPubKeys, err := hsv.getConsensusPubKeys(header)
+	if err != nil {
+		return err
+	}
+
+	leaderPubKey, err := hsv.keyGen.PublicKeyFromByteArray([]byte(consensusPubKeys[0]))
+	if err != nil {
+		return err
+	}
+
+	return hsv.singleSigVerifier.Verify(leaderPubKey, header.GetPrevRandSeed(), header.GetRandSeed())
+}
+
+// VerifySignature verifies that the public keys bitmap of the header selects the block proposer and at least
+// the consensus threshold of the group members and that the aggregated signature is valid for this bitmap
+func (hsv *headerSigVerifier) VerifySignature(header data.HeaderHandler) error {
+	if check.IfNil(header) {
+		return process.ErrNilBlockHeader
+	}
+
+	bitmap := header.GetPubKeysBitmap()
+	if len(bitmap) == 0 {
+		return process.ErrNilPubKeysBitmap
+	}
+	if bitmap[0]&1 == 0 {
+		return process.ErrBlockProposerSignatureMissing
+	}
+
+	consensusPubKeys, err := hsv.getConsensusPubKeys(header)
+	if err != nil {
+		return err
+	}
+
+	err = checkBitmap(bitmap, len(consensusPubKeys))
+	if err != nil {
+		return err
+	}
+
+	verifier, err := hsv.multiSigVerifier.Create(consensusPubKeys, 0)
+	if err != nil {
+		return err
+	}
+
+	err = verifier.SetAggregatedSig(header.GetSignature())
+	if err != nil {
+		return err
+	}
+
+	// get marshalled block header without signature and bitmap
+	// as this is the message that was signed
+	headerCopy, err := copyHeaderWithoutSig(header)
+	if err != nil {
+		return err
+	}
+
+	hash, err := core.CalculateHash(hsv.marshalizer, hsv.hasher, headerCopy)
+	if err != nil {
+		return err
+	}
+
+	return verifier.Verify(hash, bitmap)
+}
+
+func (hsv *headerSigVerifier) getConsensusPubKeys(header data.HeaderHandler) ([]string, error) {
+	consensusPubKeys, err := hsv.nodesCoordinator.GetValidatorsPublicKeys(
+		header.GetPrevRandSeed(),
+		header.GetRound(),
+		header.GetShardID(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(consensusPubKeys) == 0 {
+		return nil, process.ErrEmptyConsensusGroup
+	}
+
+	return consensusPubKeys, nil
+}
+
+func checkBitmap(bitmap []byte, consensusSize int) error {
+	expectedBitmapSize := (consensusSize + 7) / 8
+	if len(bitmap) != expectedBitmapSize {
+		return process.ErrWrongSizeBitmap
+	}
+
+	numSigners := 0
+	for i := 0; i < consensusSize; i++ {
+		if bitmap[i/8]&(1<<uint8(i%8)) != 0 {
+			numSigners++
+		}
+	}
+
+	threshold := consensusSize*2/3 + 1
+	if numSigners < threshold {
+		return process.ErrNotEnoughSignaturesInBitmap
+	}
+
+	return nil
+}
+
+func copyHeaderWithoutSig(header data.HeaderHandler) (data.HeaderHandler, error) {
+	switch hdr := header.(type) {
+	case *block.Header:
+		headerCopy := *hdr
+		headerCopy.Signature = nil
+		headerCopy.PubKeysBitmap = nil
+		return &headerCopy, nil
+	case *block.MetaBlock:
+		headerCopy := *hdr
+		headerCopy.Signature = nil
+		headerCopy.PubKeysBitmap = nil
+		return &headerCopy, nil
+	}
+
+	return nil, process.ErrWrongTypeAssertion
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hsv *headerSigVerifier) IsInterfaceNil() bool {
+	if hsv == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,368 @@
+package headerCheck_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber"
+	llsig "github.com/ElrondNetwork/elrond-go/crypto/signing/kyber/multisig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber/singlesig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/multisig"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/headerCheck"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+const consensusSize = 4
+
+var testMarshalizer = &mock.MarshalizerMock{}
+var testHasher = mock.HasherMock{}
+var multiSigHasher = blake2b.Blake2b{HashSize: 16}
+
+type consensusKeys struct {
+	privKeys []crypto.PrivateKey
+	pubKeys  []string
+	keyGen   crypto.KeyGenerator
+}
+
+func createConsensusKeys() *consensusKeys {
+	keys := &consensusKeys{
+		privKeys: make([]crypto.PrivateKey, consensusSize),
+		pubKeys:  make([]string, consensusSize),
+		keyGen:   signing.NewKeyGenerator(kyber.NewSuitePairingBn256()),
+	}
+
+	for i := 0; i < consensusSize; i++ {
+		privKey, pubKey := keys.keyGen.GeneratePair()
+		pubKeyBytes, _ := pubKey.ToByteArray()
+
+		keys.privKeys[i] = privKey
+		keys.pubKeys[i] = string(pubKeyBytes)
+	}
+
+	return keys
+}
+
+func createMultiSigner(keys *consensusKeys, index uint16) crypto.MultiSigner {
+	multiSigner, _ := multisig.NewBLSMultisig(
+		&llsig.KyberMultiSignerBLS{},
+		multiSigHasher,
+		keys.pubKeys,
+		keys.privKeys[index],
+		keys.keyGen,
+		index,
+	)
+
+	return multiSigner
+}
+
+func createArguments(keys *consensusKeys) *headerCheck.ArgsHeaderSigVerifier {
+	return &headerCheck.ArgsHeaderSigVerifier{
+		Marshalizer: testMarshalizer,
+		Hasher:      testHasher,
+		NodesCoordinator: &mock.NodesCoordinatorMock{
+			GetValidatorsPublicKeysCalled: func(randomness []byte, round uint64, shardId uint32) ([]string, error) {
+				return keys.pubKeys, nil
+			},
+		},
+		MultiSigVerifier:  createMultiSigner(keys, 0),
+		SingleSigVerifier: &singlesig.BlsSingleSigner{},
+		KeyGen:            keys.keyGen,
+	}
+}
+
+func createHeader() *block.Header {
+	return &block.Header{
+		Nonce:        5,
+		Round:        7,
+		ShardId:      1,
+		PrevHash:     []byte("prev hash"),
+		PrevRandSeed: []byte("prev rand seed"),
+		RootHash:     []byte("root hash"),
+	}
+}
+
+// signHeader fills the rand seed, as the proposer does, and the aggregated signature of the given signers
+func signHeader(keys *consensusKeys, header data.HeaderHandler, signers ...uint16) {
+	randSeed, _ := (&singlesig.BlsSingleSigner{}).Sign(keys.privKeys[0], header.GetPrevRandSeed())
+	header.SetRandSeed(randSeed)
+
+	hash, _ := core.CalculateHash(testMarshalizer, testHasher, header)
+
+	bitmap := make([]byte, (consensusSize+7)/8)
+	aggregator := createMultiSigner(keys, 0)
+	for _, index := range signers {
+		bitmap[index/8] |= 1 << (index % 8)
+
+		sigShare, _ := createMultiSigner(keys, index).CreateSignatureShare(hash, nil)
+		_ = aggregator.StoreSignatureShare(index, sigShare)
+	}
+
+	signature, _ := aggregator.AggregateSigs(bitmap)
+	header.SetSignature(signature)
+	header.SetPubKeysBitmap(bitmap)
+}
+
+//------- NewHeaderSigVerifier
+
+func TestNewHeaderSigVerifier_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hsv, err := headerCheck.NewHeaderSigVerifier(nil)
+
+	assert.Nil(t, hsv)
+	assert.Equal(t, process.ErrNilArguments, err)
+}
+
+func TestNewHeaderSigVerifier_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArguments(createConsensusKeys())
+	args.Marshalizer = nil
+	hsv, err := headerCheck.NewHeaderSigVerifier(args)
+
+	assert.Nil(t, hsv)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewHeaderSigVerifier_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArguments(createConsensusKeys())
+	args.Hasher = nil
+	hsv, err := headerCheck.NewHeaderSigVerifier(args)
+
+	assert.Nil(t, hsv)
+	assert.Equal(t, process.ErrNilHasher, err)
+}
+
+func TestNewHeaderSigVerifier_NilNodesCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArguments(createConsensusKeys())
+	args.NodesCoordinator = nil
+	hsv, err := headerCheck.NewHeaderSigVerifier(args)
+
+	assert.Nil(t, hsv)
+	assert.Equal(t, process.ErrNilNodesCoordinator, err)
+}
+
+func TestNewHeaderSigVerifier_NilMultiSigVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArguments(createConsensusKeys())
+	args.MultiSigVerifier = nil
+	hsv, err := headerCheck.NewHeaderSigVerifier(args)
+
+	assert.Nil(t, hsv)
+	assert.Equal(t, process.ErrNilMultiSigVerifier, err)
+}
+
+func TestNewHeaderSigVerifier_NilSingleSigVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArguments(createConsensusKeys())
+	args.SingleSigVerifier = nil
+	hsv, err := headerCheck.NewHeaderSigVerifier(args)
+
+	assert.Nil(t, hsv)
+	assert.Equal(t, process.ErrNilSingleSigner, err)
+}
+
+func TestNewHeaderSigVerifier_NilKeyGenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArguments(createConsensusKeys())
+	args.KeyGen = nil
+	hsv, err := headerCheck.NewHeaderSigVerifier(args)
+
+	assert.Nil(t, hsv)
+	assert.Equal(t, process.ErrNilKeyGen, err)
+}
+
+func TestNewHeaderSigVerifier_OkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	hsv, err := headerCheck.NewHeaderSigVerifier(createArguments(createConsensusKeys()))
+
+	assert.Nil(t, err)
+	assert.False(t, hsv.IsInterfaceNil())
+}
+
+//------- VerifyRandSeed
+
+func TestHeaderSigVerifier_VerifyRandSeedNodesCoordinatorErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("expected error")
+	args := createArguments(createConsensusKeys())
+	args.NodesCoordinator = &mock.NodesCoordinatorMock{
+		GetValidatorsPublicKeysCalled: func(randomness []byte, round uint64, shardId uint32) ([]string, error) {
+			return nil, errExpected
+		},
+	}
+	hsv, _ := headerCheck.NewHeaderSigVerifier(args)
+
+	err := hsv.VerifyRandSeed(createHeader())
+
+	assert.Equal(t, errExpected, err)
+}
+
+func TestHeaderSigVerifier_VerifyRandSeedNotSignedByProposerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	keys := createConsensusKeys()
+	hsv, _ := headerCheck.NewHeaderSigVerifier(createArguments(keys))
+
+	header := createHeader()
+	header.RandSeed, _ = (&singlesig.BlsSingleSigner{}).Sign(keys.privKeys[1], header.PrevRandSeed)
+
+	err := hsv.VerifyRandSeed(header)
+
+	assert.NotNil(t, err)
+}
+
+func TestHeaderSigVerifier_VerifyRandSeedShouldWork(t *testing.T) {
+	t.Parallel()
+
+	keys := createConsensusKeys()
+	hsv, _ := headerCheck.NewHeaderSigVerifier(createArguments(keys))
+
+	header := createHeader()
+	signHeader(keys, header, 0, 1, 2)
+
+	err := hsv.VerifyRandSeed(header)
+
+	assert.Nil(t, err)
+}
+
+//------- VerifySignature
+
+func TestHeaderSigVerifier_VerifySignatureNilBitmapShouldErr(t *testing.T) {
+	t.Parallel()
+
+	keys := createConsensusKeys()
+	hsv, _ := headerCheck.NewHeaderSigVerifier(createArguments(keys))
+
+	header := createHeader()
+	signHeader(keys, header, 0, 1, 2)
+	header.PubKeysBitmap = nil
+
+	err := hsv.VerifySignature(header)
+
+	assert.Equal(t, process.ErrNilPubKeysBitmap, err)
+}
+
+func TestHeaderSigVerifier_VerifySignatureWithoutProposerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	keys := createConsensusKeys()
+	hsv, _ := headerCheck.NewHeaderSigVerifier(createArguments(keys))
+
+	header := createHeader()
+	signHeader(keys, header, 1, 2, 3)
+
+	err := hsv.VerifySignature(header)
+
+	assert.Equal(t, process.ErrBlockProposerSignatureMissing, err)
+}
+
+func TestHeaderSigVerifier_VerifySignatureWrongSizeBitmapShouldErr(t *testing.T) {
+	t.Parallel()
+
+	keys := createConsensusKeys()
+	hsv, _ := headerCheck.NewHeaderSigVerifier(createArguments(keys))
+
+	header := createHeader()
+	signHeader(keys, header, 0, 1, 2)
+	header.PubKeysBitmap = append(header.PubKeysBitmap, 0)
+
+	err := hsv.VerifySignature(header)
+
+	assert.Equal(t, process.ErrWrongSizeBitmap, err)
+}
+
+func TestHeaderSigVerifier_VerifySignatureBelowThresholdShouldErr(t *testing.T) {
+	t.Parallel()
+
+	keys := createConsensusKeys()
+	hsv, _ := headerCheck.NewHeaderSigVerifier(createArguments(keys))
+
+	header := createHeader()
+	signHeader(keys, header, 0, 1)
+
+	err := hsv.VerifySignature(header)
+
+	assert.Equal(t, process.ErrNotEnoughSignaturesInBitmap, err)
+}
+
+func TestHeaderSigVerifier_VerifySignatureOnAlteredHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	keys := createConsensusKeys()
+	hsv, _ := headerCheck.NewHeaderSigVerifier(createArguments(keys))
+
+	header := createHeader()
+	signHeader(keys, header, 0, 1, 2)
+	header.RootHash = []byte("altered root hash")
+
+	err := hsv.VerifySignature(header)
+
+	assert.NotNil(t, err)
+}
+
+func TestHeaderSigVerifier_VerifySignatureBitmapNotMatchingTheSignersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	keys := createConsensusKeys()
+	hsv, _ := headerCheck.NewHeaderSigVerifier(createArguments(keys))
+
+	header := createHeader()
+	signHeader(keys, header, 0, 1, 2)
+	header.PubKeysBitmap[0] |= 1 << 3
+
+	err := hsv.VerifySignature(header)
+
+	assert.NotNil(t, err)
+}
+
+func TestHeaderSigVerifier_VerifySignatureShouldWork(t *testing.T) {
+	t.Parallel()
+
+	keys := createConsensusKeys()
+	hsv, _ := headerCheck.NewHeaderSigVerifier(createArguments(keys))
+
+	header := createHeader()
+	signHeader(keys, header, 0, 1, 2)
+
+	err := hsv.VerifySignature(header)
+
+	assert.Nil(t, err)
+}
+
+func TestHeaderSigVerifier_VerifySignatureMetaBlockShouldWork(t *testing.T) {
+	t.Parallel()
+
+	keys := createConsensusKeys()
+	hsv, _ := headerCheck.NewHeaderSigVerifier(createArguments(keys))
+
+	header := &block.MetaBlock{
+		Nonce:        5,
+		Round:        7,
+		PrevHash:     []byte("prev hash"),
+		PrevRandSeed: []byte("prev rand seed"),
+		RootHash:     []byte("root hash"),
+	}
+	signHeader(keys, header, 0, 1, 2, 3)
+
+	err := hsv.VerifySignature(header)
+
+	assert.Nil(t, err)
+}
@@ -12,13 +12,13 @@ import (
 // ArgInterceptedDataFactory holds all dependencies required by the shard and meta intercepted data factory in order to create
 // new instances
 type ArgInterceptedDataFactory struct {
-	Marshalizer      marshal.Marshalizer
-	Hasher           hashing.Hasher
-	ShardCoordinator sharding.Coordinator
-	MultiSigVerifier crypto.MultiSigVerifier
-	NodesCoordinator sharding.NodesCoordinator
-	KeyGen           crypto.KeyGenerator
-	Signer           crypto.SingleSigner
-	AddrConv         state.AddressConverter
-	FeeHandler       process.FeeHandler
+	Marshalizer       marshal.Marshalizer
+	Hasher            hashing.Hasher
+	ShardCoordinator  sharding.Coordinator
+	HeaderSigVerifier process.InterceptedHeaderSigVerifier
+	KeyGen            crypto.KeyGenerator
+	Signer            crypto.SingleSigner
+	AddrConv          state.AddressConverter
+	FeeHandler        process.FeeHandler
+	SignerSetProvider process.SignerSetProvider
 }
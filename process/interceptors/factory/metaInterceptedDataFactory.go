@@ -20,9 +20,9 @@ type metaInterceptedDataFactory struct {
 	addrConverter       state.AddressConverter
 	shardCoordinator    sharding.Coordinator
 	interceptedDataType InterceptedDataType
-	multiSigVerifier    crypto.MultiSigVerifier
-	nodesCoordinator    sharding.NodesCoordinator
+	headerSigVerifier   process.InterceptedHeaderSigVerifier
 	feeHandler          process.FeeHandler
+	signerSetProvider   process.SignerSetProvider
 }
 
 // NewMetaInterceptedDataFactory creates an instance of interceptedDataFactory that can create
@@ -44,15 +44,15 @@ func NewMetaInterceptedDataFactory(
 	if check.IfNil(argument.ShardCoordinator) {
 		return nil, process.ErrNilShardCoordinator
 	}
-	if check.IfNil(argument.MultiSigVerifier) {
-		return nil, process.ErrNilMultiSigVerifier
-	}
-	if check.IfNil(argument.NodesCoordinator) {
-		return nil, process.ErrNilNodesCoordinator
+	if check.IfNil(argument.HeaderSigVerifier) {
+		return nil, process.ErrNilHeaderSigVerifier
 	}
 	if check.IfNil(argument.FeeHandler) {
 		return nil, process.ErrNilEconomicsFeeHandler
 	}
+	if check.IfNil(argument.SignerSetProvider) {
+		return nil, process.ErrNilSignerSetProvider
+	}
 	if check.IfNil(argument.KeyGen) {
 		return nil, process.ErrNilKeyGen
 	}
@@ -68,12 +68,12 @@ func NewMetaInterceptedDataFactory(
 		hasher:              argument.Hasher,
 		shardCoordinator:    argument.ShardCoordinator,
 		interceptedDataType: dataType,
-		multiSigVerifier:    argument.MultiSigVerifier,
-		nodesCoordinator:    argument.NodesCoordinator,
+		headerSigVerifier:   argument.HeaderSigVerifier,
 		feeHandler:          argument.FeeHandler,
 		keyGen:              argument.KeyGen,
 		singleSigner:        argument.Signer,
 		addrConverter:       argument.AddrConv,
+		signerSetProvider:   argument.SignerSetProvider,
 	}, nil
 }
 
@@ -94,12 +94,11 @@ func (midf *metaInterceptedDataFactory) Create(buff []byte) (process.Intercepted
 
 func (midf *metaInterceptedDataFactory) createInterceptedShardHeader(buff []byte) (process.InterceptedData, error) {
 	arg := &interceptedBlocks.ArgInterceptedBlockHeader{
-		HdrBuff:          buff,
-		Marshalizer:      midf.marshalizer,
-		Hasher:           midf.hasher,
-		MultiSigVerifier: midf.multiSigVerifier,
-		NodesCoordinator: midf.nodesCoordinator,
-		ShardCoordinator: midf.shardCoordinator,
+		HdrBuff:           buff,
+		Marshalizer:       midf.marshalizer,
+		Hasher:            midf.hasher,
+		HeaderSigVerifier: midf.headerSigVerifier,
+		ShardCoordinator:  midf.shardCoordinator,
 	}
 
 	return interceptedBlocks.NewInterceptedHeader(arg)
@@ -107,12 +106,11 @@ func (midf *metaInterceptedDataFactory) createInterceptedShardHeader(buff []byte
 
 func (midf *metaInterceptedDataFactory) createInterceptedMetaHeader(buff []byte) (process.InterceptedData, error) {
 	arg := &interceptedBlocks.ArgInterceptedBlockHeader{
-		HdrBuff:          buff,
-		Marshalizer:      midf.marshalizer,
-		Hasher:           midf.hasher,
-		MultiSigVerifier: midf.multiSigVerifier,
-		NodesCoordinator: midf.nodesCoordinator,
-		ShardCoordinator: midf.shardCoordinator,
+		HdrBuff:           buff,
+		Marshalizer:       midf.marshalizer,
+		Hasher:            midf.hasher,
+		HeaderSigVerifier: midf.headerSigVerifier,
+		ShardCoordinator:  midf.shardCoordinator,
 	}
 
 	return interceptedBlocks.NewInterceptedMetaHeader(arg)
@@ -128,6 +126,7 @@ func (midf *metaInterceptedDataFactory) createInterceptedTx(buff []byte) (proces
 		midf.addrConverter,
 		midf.shardCoordinator,
 		midf.feeHandler,
+		midf.signerSetProvider,
 	)
 }
 
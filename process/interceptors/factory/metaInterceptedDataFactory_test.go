@@ -59,40 +59,40 @@ func TestNewMetaInterceptedDataFactory_NilShardCoordinatorShouldErr(t *testing.T
 	assert.Equal(t, process.ErrNilShardCoordinator, err)
 }
 
-func TestNewMetaInterceptedDataFactory_NilMultiSigVerifierShouldErr(t *testing.T) {
+func TestNewMetaInterceptedDataFactory_NilHeaderSigVerifierShouldErr(t *testing.T) {
 	t.Parallel()
 
 	arg := createMockArgument()
-	arg.MultiSigVerifier = nil
+	arg.HeaderSigVerifier = nil
 
 	midf, err := factory.NewMetaInterceptedDataFactory(arg, factory.InterceptedShardHeader)
 
 	assert.Nil(t, midf)
-	assert.Equal(t, process.ErrNilMultiSigVerifier, err)
+	assert.Equal(t, process.ErrNilHeaderSigVerifier, err)
 }
 
-func TestNewMetaInterceptedDataFactory_NilNodesCoordinatorShouldErr(t *testing.T) {
+func TestNewMetaInterceptedDataFactory_NilFeeHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
 	arg := createMockArgument()
-	arg.NodesCoordinator = nil
+	arg.FeeHandler = nil
 
 	midf, err := factory.NewMetaInterceptedDataFactory(arg, factory.InterceptedShardHeader)
 
 	assert.Nil(t, midf)
-	assert.Equal(t, process.ErrNilNodesCoordinator, err)
+	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
 }
 
-func TestNewMetaInterceptedDataFactory_NilFeeHandlerShouldErr(t *testing.T) {
+func TestNewMetaInterceptedDataFactory_NilSignerSetProviderShouldErr(t *testing.T) {
 	t.Parallel()
 
 	arg := createMockArgument()
-	arg.FeeHandler = nil
+	arg.SignerSetProvider = nil
 
 	midf, err := factory.NewMetaInterceptedDataFactory(arg, factory.InterceptedShardHeader)
 
 	assert.Nil(t, midf)
-	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
+	assert.Equal(t, process.ErrNilSignerSetProvider, err)
 }
 
 func TestNewMetaInterceptedDataFactory_NilKeyGenShouldErr(t *testing.T) {
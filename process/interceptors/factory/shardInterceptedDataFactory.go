@@ -21,9 +21,9 @@ type shardInterceptedDataFactory struct {
 	addrConverter       state.AddressConverter
 	shardCoordinator    sharding.Coordinator
 	interceptedDataType InterceptedDataType
-	multiSigVerifier    crypto.MultiSigVerifier
-	nodesCoordinator    sharding.NodesCoordinator
+	headerSigVerifier   process.InterceptedHeaderSigVerifier
 	feeHandler          process.FeeHandler
+	signerSetProvider   process.SignerSetProvider
 }
 
 // NewShardInterceptedDataFactory creates an instance of interceptedDataFactory that can create
@@ -54,15 +54,15 @@ func NewShardInterceptedDataFactory(
 	if check.IfNil(argument.ShardCoordinator) {
 		return nil, process.ErrNilShardCoordinator
 	}
-	if check.IfNil(argument.MultiSigVerifier) {
-		return nil, process.ErrNilMultiSigVerifier
-	}
-	if check.IfNil(argument.NodesCoordinator) {
-		return nil, process.ErrNilNodesCoordinator
+	if check.IfNil(argument.HeaderSigVerifier) {
+		return nil, process.ErrNilHeaderSigVerifier
 	}
 	if check.IfNil(argument.FeeHandler) {
 		return nil, process.ErrNilEconomicsFeeHandler
 	}
+	if check.IfNil(argument.SignerSetProvider) {
+		return nil, process.ErrNilSignerSetProvider
+	}
 
 	return &shardInterceptedDataFactory{
 		marshalizer:         argument.Marshalizer,
@@ -72,9 +72,9 @@ func NewShardInterceptedDataFactory(
 		addrConverter:       argument.AddrConv,
 		shardCoordinator:    argument.ShardCoordinator,
 		interceptedDataType: dataType,
-		multiSigVerifier:    argument.MultiSigVerifier,
-		nodesCoordinator:    argument.NodesCoordinator,
+		headerSigVerifier:   argument.HeaderSigVerifier,
 		feeHandler:          argument.FeeHandler,
+		signerSetProvider:   argument.SignerSetProvider,
 	}, nil
 }
 
@@ -107,6 +107,7 @@ func (sidf *shardInterceptedDataFactory) createInterceptedTx(buff []byte) (proce
 		sidf.addrConverter,
 		sidf.shardCoordinator,
 		sidf.feeHandler,
+		sidf.signerSetProvider,
 	)
 }
 
@@ -122,12 +123,11 @@ func (sidf *shardInterceptedDataFactory) createInterceptedUnsignedTx(buff []byte
 
 func (sidf *shardInterceptedDataFactory) createInterceptedShardHeader(buff []byte) (process.InterceptedData, error) {
 	arg := &interceptedBlocks.ArgInterceptedBlockHeader{
-		HdrBuff:          buff,
-		Marshalizer:      sidf.marshalizer,
-		Hasher:           sidf.hasher,
-		MultiSigVerifier: sidf.multiSigVerifier,
-		NodesCoordinator: sidf.nodesCoordinator,
-		ShardCoordinator: sidf.shardCoordinator,
+		HdrBuff:           buff,
+		Marshalizer:       sidf.marshalizer,
+		Hasher:            sidf.hasher,
+		HeaderSigVerifier: sidf.headerSigVerifier,
+		ShardCoordinator:  sidf.shardCoordinator,
 	}
 
 	return interceptedBlocks.NewInterceptedHeader(arg)
@@ -135,12 +135,11 @@ func (sidf *shardInterceptedDataFactory) createInterceptedShardHeader(buff []byt
 
 func (sidf *shardInterceptedDataFactory) createInterceptedMetaHeader(buff []byte) (process.InterceptedData, error) {
 	arg := &interceptedBlocks.ArgInterceptedBlockHeader{
-		HdrBuff:          buff,
-		Marshalizer:      sidf.marshalizer,
-		Hasher:           sidf.hasher,
-		MultiSigVerifier: sidf.multiSigVerifier,
-		NodesCoordinator: sidf.nodesCoordinator,
-		ShardCoordinator: sidf.shardCoordinator,
+		HdrBuff:           buff,
+		Marshalizer:       sidf.marshalizer,
+		Hasher:            sidf.hasher,
+		HeaderSigVerifier: sidf.headerSigVerifier,
+		ShardCoordinator:  sidf.shardCoordinator,
 	}
 
 	return interceptedBlocks.NewInterceptedMetaHeader(arg)
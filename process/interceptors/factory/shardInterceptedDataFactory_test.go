@@ -61,15 +61,15 @@ func createMockFeeHandler() process.FeeHandler {
 
 func createMockArgument() *factory.ArgInterceptedDataFactory {
 	return &factory.ArgInterceptedDataFactory{
-		Marshalizer:      &mock.MarshalizerMock{},
-		Hasher:           mock.HasherMock{},
-		ShardCoordinator: mock.NewOneShardCoordinatorMock(),
-		MultiSigVerifier: mock.NewMultiSigner(),
-		NodesCoordinator: mock.NewNodesCoordinatorMock(),
-		KeyGen:           createMockKeyGen(),
-		Signer:           createMockSigner(),
-		AddrConv:         createMockAddressConverter(),
-		FeeHandler:       createMockFeeHandler(),
+		Marshalizer:       &mock.MarshalizerMock{},
+		Hasher:            mock.HasherMock{},
+		ShardCoordinator:  mock.NewOneShardCoordinatorMock(),
+		HeaderSigVerifier: &mock.HeaderSigVerifierStub{},
+		KeyGen:            createMockKeyGen(),
+		Signer:            createMockSigner(),
+		AddrConv:          createMockAddressConverter(),
+		FeeHandler:        createMockFeeHandler(),
+		SignerSetProvider: &mock.SignerSetProviderStub{},
 	}
 }
 
@@ -154,40 +154,40 @@ func TestNewShardInterceptedDataFactory_NilShardCoordinatorShouldErr(t *testing.
 	assert.Equal(t, process.ErrNilShardCoordinator, err)
 }
 
-func TestNewShardInterceptedDataFactory_NilMultiSigVerifierShouldErr(t *testing.T) {
+func TestNewShardInterceptedDataFactory_NilHeaderSigVerifierShouldErr(t *testing.T) {
 	t.Parallel()
 
 	arg := createMockArgument()
-	arg.MultiSigVerifier = nil
+	arg.HeaderSigVerifier = nil
 
 	sidf, err := factory.NewShardInterceptedDataFactory(arg, factory.InterceptedTx)
 
 	assert.Nil(t, sidf)
-	assert.Equal(t, process.ErrNilMultiSigVerifier, err)
+	assert.Equal(t, process.ErrNilHeaderSigVerifier, err)
 }
 
-func TestNewShardInterceptedDataFactory_NilNodesCoordinatorShouldErr(t *testing.T) {
+func TestNewShardInterceptedDataFactory_NilFeeHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
 	arg := createMockArgument()
-	arg.NodesCoordinator = nil
+	arg.FeeHandler = nil
 
 	sidf, err := factory.NewShardInterceptedDataFactory(arg, factory.InterceptedTx)
 
 	assert.Nil(t, sidf)
-	assert.Equal(t, process.ErrNilNodesCoordinator, err)
+	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
 }
 
-func TestNewShardInterceptedDataFactory_NilFeeHandlerShouldErr(t *testing.T) {
+func TestNewShardInterceptedDataFactory_NilSignerSetProviderShouldErr(t *testing.T) {
 	t.Parallel()
 
 	arg := createMockArgument()
-	arg.FeeHandler = nil
+	arg.SignerSetProvider = nil
 
 	sidf, err := factory.NewShardInterceptedDataFactory(arg, factory.InterceptedTx)
 
 	assert.Nil(t, sidf)
-	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
+	assert.Equal(t, process.ErrNilSignerSetProvider, err)
 }
 
 func TestNewShardInterceptedDataFactory_ShouldWork(t *testing.T) {
@@ -18,6 +18,7 @@ type MultiDataInterceptor struct {
 	factory     process.InterceptedDataFactory
 	processor   process.InterceptorProcessor
 	throttler   process.InterceptorThrottler
+	verifier    process.InterceptedDataVerifier
 }
 
 // NewMultiDataInterceptor hooks a new interceptor for packed multi data
@@ -26,6 +27,7 @@ func NewMultiDataInterceptor(
 	factory process.InterceptedDataFactory,
 	processor process.InterceptorProcessor,
 	throttler process.InterceptorThrottler,
+	verifier process.InterceptedDataVerifier,
 ) (*MultiDataInterceptor, error) {
 
 	if check.IfNil(marshalizer) {
@@ -40,12 +42,16 @@ func NewMultiDataInterceptor(
 	if check.IfNil(throttler) {
 		return nil, process.ErrNilInterceptorThrottler
 	}
+	if check.IfNil(verifier) {
+		return nil, process.ErrNilInterceptedDataVerifier
+	}
 
 	multiDataIntercept := &MultiDataInterceptor{
 		marshalizer: marshalizer,
 		factory:     factory,
 		processor:   processor,
 		throttler:   throttler,
+		verifier:    verifier,
 	}
 
 	return multiDataIntercept, nil
@@ -79,6 +85,8 @@ func (mdi *MultiDataInterceptor) ProcessReceivedMessage(message p2p.MessageP2P,
 		mdi.throttler.EndProcessing()
 	}()
 
+	interceptedMultiData := make([]process.InterceptedData, 0, len(multiDataBuff))
+	createdDataBuff := make([][]byte, 0, len(multiDataBuff))
 	for _, dataBuff := range multiDataBuff {
 		interceptedData, err := mdi.factory.Create(dataBuff)
 		if err != nil {
@@ -87,15 +95,21 @@ func (mdi *MultiDataInterceptor) ProcessReceivedMessage(message p2p.MessageP2P,
 			continue
 		}
 
-		err = interceptedData.CheckValidity()
-		if err != nil {
-			lastErrEncountered = err
+		interceptedMultiData = append(interceptedMultiData, interceptedData)
+		createdDataBuff = append(createdDataBuff, dataBuff)
+	}
+
+	//the validity checks, signature verifications included, are done in parallel for the whole batch
+	validityErrs := mdi.verifier.Verify(interceptedMultiData)
+	for idx, interceptedData := range interceptedMultiData {
+		if validityErrs[idx] != nil {
+			lastErrEncountered = validityErrs[idx]
 			wgProcess.Done()
 			continue
 		}
 
 		//data is validated, add it to filtered out buff
-		filteredMultiDataBuff = append(filteredMultiDataBuff, dataBuff)
+		filteredMultiDataBuff = append(filteredMultiDataBuff, createdDataBuff[idx])
 		if !interceptedData.IsForCurrentShard() {
 			log.Debug("intercepted data is for other shards")
 			wgProcess.Done()
@@ -22,6 +22,7 @@ func TestNewMultiDataInterceptor_NilMarshalizerShouldErr(t *testing.T) {
 		&mock.InterceptedDataFactoryStub{},
 		&mock.InterceptorProcessorStub{},
 		&mock.InterceptorThrottlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	assert.Nil(t, mdi)
@@ -36,6 +37,7 @@ func TestNewMultiDataInterceptor_NilInterceptedDataFactoryShouldErr(t *testing.T
 		nil,
 		&mock.InterceptorProcessorStub{},
 		&mock.InterceptorThrottlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	assert.Nil(t, mdi)
@@ -50,6 +52,7 @@ func TestNewMultiDataInterceptor_NilInterceptedDataProcessorShouldErr(t *testing
 		&mock.InterceptedDataFactoryStub{},
 		nil,
 		&mock.InterceptorThrottlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	assert.Nil(t, mdi)
@@ -64,12 +67,28 @@ func TestNewMultiDataInterceptor_NilInterceptorThrottlerShouldErr(t *testing.T)
 		&mock.InterceptedDataFactoryStub{},
 		&mock.InterceptorProcessorStub{},
 		nil,
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	assert.Nil(t, mdi)
 	assert.Equal(t, process.ErrNilInterceptorThrottler, err)
 }
 
+func TestNewMultiDataInterceptor_NilInterceptedDataVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mdi, err := interceptors.NewMultiDataInterceptor(
+		&mock.MarshalizerMock{},
+		&mock.InterceptedDataFactoryStub{},
+		&mock.InterceptorProcessorStub{},
+		&mock.InterceptorThrottlerStub{},
+		nil,
+	)
+
+	assert.Nil(t, mdi)
+	assert.Equal(t, process.ErrNilInterceptedDataVerifier, err)
+}
+
 func TestNewMultiDataInterceptor(t *testing.T) {
 	t.Parallel()
 
@@ -78,6 +97,7 @@ func TestNewMultiDataInterceptor(t *testing.T) {
 		&mock.InterceptedDataFactoryStub{},
 		&mock.InterceptorProcessorStub{},
 		&mock.InterceptorThrottlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	assert.False(t, check.IfNil(mdi))
@@ -94,6 +114,7 @@ func TestMultiDataInterceptor_ProcessReceivedMessageNilMessageShouldErr(t *testi
 		&mock.InterceptedDataFactoryStub{},
 		&mock.InterceptorProcessorStub{},
 		&mock.InterceptorThrottlerStub{},
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	err := mdi.ProcessReceivedMessage(nil, nil)
@@ -114,6 +135,7 @@ func TestMultiDataInterceptor_ProcessReceivedMessageUnmarshalFailsShouldErr(t *t
 		&mock.InterceptedDataFactoryStub{},
 		&mock.InterceptorProcessorStub{},
 		createMockThrottler(),
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	msg := &mock.P2PMessageMock{
@@ -136,6 +158,7 @@ func TestMultiDataInterceptor_ProcessReceivedMessageUnmarshalReturnsEmptySliceSh
 		&mock.InterceptedDataFactoryStub{},
 		&mock.InterceptorProcessorStub{},
 		createMockThrottler(),
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	msg := &mock.P2PMessageMock{
@@ -166,6 +189,7 @@ func TestMultiDataInterceptor_ProcessReceivedCreateFailsShouldNotResend(t *testi
 		},
 		createMockInterceptorStub(&checkCalledNum, &processCalledNum),
 		throttler,
+		&mock.InterceptedDataVerifierMock{},
 	)
 	bradcastCallback := func(buffToSend []byte) {
 		atomic.AddInt32(&broadcastNum, 1)
@@ -221,6 +245,7 @@ func TestMultiDataInterceptor_ProcessReceivedPartiallyCorrectDataShouldSendOnlyC
 		},
 		createMockInterceptorStub(&checkCalledNum, &processCalledNum),
 		throttler,
+		&mock.InterceptedDataVerifierMock{},
 	)
 	bradcastCallback := func(buffToSend []byte) {
 		unmarshalledBuffs := make([][]byte, 0)
@@ -281,6 +306,7 @@ func TestMultiDataInterceptor_ProcessReceivedMessageNotValidShouldErrAndNotProce
 		},
 		createMockInterceptorStub(&checkCalledNum, &processCalledNum),
 		throttler,
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	dataField, _ := marshalizer.Marshal(buffData)
@@ -324,6 +350,7 @@ func TestMultiDataInterceptor_ProcessReceivedMessageIsAddressedToOtherShardShoul
 		},
 		createMockInterceptorStub(&checkCalledNum, &processCalledNum),
 		throttler,
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	dataField, _ := marshalizer.Marshal(buffData)
@@ -367,6 +394,7 @@ func TestMultiDataInterceptor_ProcessReceivedMessageOkMessageShouldRetNil(t *tes
 		},
 		createMockInterceptorStub(&checkCalledNum, &processCalledNum),
 		throttler,
+		&mock.InterceptedDataVerifierMock{},
 	)
 
 	dataField, _ := marshalizer.Marshal(buffData)
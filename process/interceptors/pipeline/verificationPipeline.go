@@ -0,0 +1,155 @@
+package pipeline
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// ArgVerificationPipeline holds all dependencies required by the verification pipeline in order to create
+// new instances
+type ArgVerificationPipeline struct {
+	NumWorkers    uint32
+	Cacher        storage.Cacher
+	StatusHandler core.AppStatusHandler
+}
+
+// verificationPipeline checks the validity of batches of intercepted data on a pool of workers. The signatures of the
+// intercepted signed data are verified apart and their results are cached by the data hash, so that the same data
+// received from several peers is verified only once
+type verificationPipeline struct {
+	workers       chan struct{}
+	cacher        storage.Cacher
+	statusHandler core.AppStatusHandler
+}
+
+// NewVerificationPipeline creates a new verificationPipeline instance
+func NewVerificationPipeline(arg *ArgVerificationPipeline) (*verificationPipeline, error) {
+	if arg == nil {
+		return nil, process.ErrNilArguments
+	}
+	if arg.NumWorkers == 0 {
+		return nil, process.ErrInvalidNumWorkers
+	}
+	if check.IfNil(arg.Cacher) {
+		return nil, process.ErrNilCacher
+	}
+	if check.IfNil(arg.StatusHandler) {
+		return nil, process.ErrNilAppStatusHandler
+	}
+
+	return &verificationPipeline{
+		workers:       make(chan struct{}, arg.NumWorkers),
+		cacher:        arg.Cacher,
+		statusHandler: arg.StatusHandler,
+	}, nil
+}
+
+// Verify checks the validity of the provided intercepted data and returns, for each one, the error encountered
+// or nil if the data is valid. The call blocks until all the data from the batch has been checked
+func (vp *verificationPipeline) Verify(interceptedData []process.InterceptedData) []error {
+	errs := make([]error, len(interceptedData))
+	numVerified := uint64(0)
+	numCacheHits := uint64(0)
+	startTime := time.Now()
+
+	wg := &sync.WaitGroup{}
+	for i := range interceptedData {
+		idx := i
+		data := interceptedData[i]
+
+		signedData, ok := data.(process.InterceptedSignedData)
+		if !ok {
+			vp.runOnWorker(wg, func() {
+				errs[idx] = data.CheckValidity()
+			})
+			continue
+		}
+
+		err := signedData.CheckIntegrity()
+		if err != nil {
+			errs[idx] = err
+			continue
+		}
+
+		isSigValid, isCached := vp.cachedVerification(signedData.Hash())
+		if isCached {
+			numCacheHits++
+			if !isSigValid {
+				errs[idx] = process.ErrInvalidSignature
+			}
+			continue
+		}
+
+		numVerified++
+		vp.runOnWorker(wg, func() {
+			errs[idx] = vp.verifySig(signedData)
+		})
+	}
+	wg.Wait()
+
+	vp.updateMetrics(numVerified, numCacheHits, time.Since(startTime))
+
+	return errs
+}
+
+// runOnWorker waits for a free worker and runs the provided job on it
+func (vp *verificationPipeline) runOnWorker(wg *sync.WaitGroup, job func()) {
+	wg.Add(1)
+	vp.workers <- struct{}{}
+
+	go func() {
+		job()
+
+		<-vp.workers
+		wg.Done()
+	}()
+}
+
+func (vp *verificationPipeline) verifySig(signedData process.InterceptedSignedData) error {
+	err := signedData.VerifySig()
+	vp.cacher.Put(signedData.Hash(), err == nil)
+
+	return err
+}
+
+func (vp *verificationPipeline) cachedVerification(hash []byte) (isSigValid bool, isCached bool) {
+	value, ok := vp.cacher.Get(hash)
+	if !ok {
+		return false, false
+	}
+
+	isSigValid, ok = value.(bool)
+	if !ok {
+		return false, false
+	}
+
+	return isSigValid, true
+}
+
+func (vp *verificationPipeline) updateMetrics(numVerified uint64, numCacheHits uint64, elapsedTime time.Duration) {
+	if numCacheHits > 0 {
+		vp.statusHandler.AddUint64(core.MetricNumSigVerificationCacheHits, numCacheHits)
+	}
+	if numVerified == 0 {
+		return
+	}
+
+	vp.statusHandler.AddUint64(core.MetricNumSigVerifications, numVerified)
+	if elapsedTime > 0 {
+		verificationsPerSecond := uint64(float64(numVerified) / elapsedTime.Seconds())
+		vp.statusHandler.SetUInt64Value(core.MetricSigVerificationsPerSecond, verificationsPerSecond)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (vp *verificationPipeline) IsInterfaceNil() bool {
+	if vp == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,233 @@
+package pipeline_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/interceptors/pipeline"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/stretchr/testify/assert"
+)
+
+type metricsCounter struct {
+	mut    sync.Mutex
+	values map[string]uint64
+}
+
+func (mc *metricsCounter) value(key string) uint64 {
+	mc.mut.Lock()
+	defer mc.mut.Unlock()
+
+	return mc.values[key]
+}
+
+func createStatusHandler(counter *metricsCounter) *mock.AppStatusHandlerStub {
+	counter.values = make(map[string]uint64)
+
+	return &mock.AppStatusHandlerStub{
+		AddUint64Handler: func(key string, value uint64) {
+			counter.mut.Lock()
+			counter.values[key] += value
+			counter.mut.Unlock()
+		},
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			counter.mut.Lock()
+			counter.values[key] = value
+			counter.mut.Unlock()
+		},
+	}
+}
+
+func createArgument(numWorkers uint32) *pipeline.ArgVerificationPipeline {
+	cacher, _ := lrucache.NewCache(100)
+
+	return &pipeline.ArgVerificationPipeline{
+		NumWorkers:    numWorkers,
+		Cacher:        cacher,
+		StatusHandler: createStatusHandler(&metricsCounter{}),
+	}
+}
+
+func createSignedData(hash string, errVerify error, numVerifyCalls *int32) *mock.InterceptedSignedDataStub {
+	return &mock.InterceptedSignedDataStub{
+		InterceptedDataStub: mock.InterceptedDataStub{
+			HashCalled: func() []byte {
+				return []byte(hash)
+			},
+		},
+		CheckIntegrityCalled: func() error {
+			return nil
+		},
+		VerifySigCalled: func() error {
+			atomic.AddInt32(numVerifyCalls, 1)
+			return errVerify
+		},
+	}
+}
+
+//------- NewVerificationPipeline
+
+func TestNewVerificationPipeline_NilArgumentShouldErr(t *testing.T) {
+	t.Parallel()
+
+	vp, err := pipeline.NewVerificationPipeline(nil)
+
+	assert.Nil(t, vp)
+	assert.Equal(t, process.ErrNilArguments, err)
+}
+
+func TestNewVerificationPipeline_ZeroWorkersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	vp, err := pipeline.NewVerificationPipeline(createArgument(0))
+
+	assert.Nil(t, vp)
+	assert.Equal(t, process.ErrInvalidNumWorkers, err)
+}
+
+func TestNewVerificationPipeline_NilCacherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createArgument(1)
+	arg.Cacher = nil
+	vp, err := pipeline.NewVerificationPipeline(arg)
+
+	assert.Nil(t, vp)
+	assert.Equal(t, process.ErrNilCacher, err)
+}
+
+func TestNewVerificationPipeline_NilStatusHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createArgument(1)
+	arg.StatusHandler = nil
+	vp, err := pipeline.NewVerificationPipeline(arg)
+
+	assert.Nil(t, vp)
+	assert.Equal(t, process.ErrNilAppStatusHandler, err)
+}
+
+func TestNewVerificationPipeline_OkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	vp, err := pipeline.NewVerificationPipeline(createArgument(1))
+
+	assert.False(t, check.IfNil(vp))
+	assert.Nil(t, err)
+}
+
+//------- Verify
+
+func TestVerificationPipeline_VerifyNotSignedDataShouldCheckValidity(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("expected error")
+	validData := &mock.InterceptedDataStub{
+		CheckValidityCalled: func() error {
+			return nil
+		},
+	}
+	invalidData := &mock.InterceptedDataStub{
+		CheckValidityCalled: func() error {
+			return errExpected
+		},
+	}
+	vp, _ := pipeline.NewVerificationPipeline(createArgument(2))
+
+	errs := vp.Verify([]process.InterceptedData{validData, invalidData, validData})
+
+	assert.Equal(t, []error{nil, errExpected, nil}, errs)
+}
+
+func TestVerificationPipeline_VerifyIntegrityFailsShouldNotVerifySig(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("expected error")
+	numVerifyCalls := int32(0)
+	signedData := createSignedData("hash", nil, &numVerifyCalls)
+	signedData.CheckIntegrityCalled = func() error {
+		return errExpected
+	}
+	vp, _ := pipeline.NewVerificationPipeline(createArgument(2))
+
+	errs := vp.Verify([]process.InterceptedData{signedData})
+
+	assert.Equal(t, []error{errExpected}, errs)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&numVerifyCalls))
+}
+
+func TestVerificationPipeline_VerifySameDataShouldVerifySigOnce(t *testing.T) {
+	t.Parallel()
+
+	numVerifyCalls := int32(0)
+	signedData := createSignedData("hash", nil, &numVerifyCalls)
+	counter := &metricsCounter{}
+	arg := createArgument(2)
+	arg.StatusHandler = createStatusHandler(counter)
+	vp, _ := pipeline.NewVerificationPipeline(arg)
+
+	errs := vp.Verify([]process.InterceptedData{signedData})
+	assert.Equal(t, []error{nil}, errs)
+
+	errs = vp.Verify([]process.InterceptedData{signedData, signedData})
+	assert.Equal(t, []error{nil, nil}, errs)
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&numVerifyCalls))
+	assert.Equal(t, uint64(1), counter.value(core.MetricNumSigVerifications))
+	assert.Equal(t, uint64(2), counter.value(core.MetricNumSigVerificationCacheHits))
+}
+
+func TestVerificationPipeline_VerifyCachedInvalidSigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("expected error")
+	numVerifyCalls := int32(0)
+	signedData := createSignedData("hash", errExpected, &numVerifyCalls)
+	vp, _ := pipeline.NewVerificationPipeline(createArgument(2))
+
+	errs := vp.Verify([]process.InterceptedData{signedData})
+	assert.Equal(t, []error{errExpected}, errs)
+
+	errs = vp.Verify([]process.InterceptedData{signedData})
+	assert.Equal(t, []error{process.ErrInvalidSignature}, errs)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&numVerifyCalls))
+}
+
+func TestVerificationPipeline_VerifyShouldRunOnAllWorkersInParallel(t *testing.T) {
+	t.Parallel()
+
+	numWorkers := 4
+	numRunning := int32(0)
+	maxRunning := int32(0)
+	batch := make([]process.InterceptedData, 0, 3*numWorkers)
+	for i := 0; i < 3*numWorkers; i++ {
+		signedData := createSignedData(string(rune('a'+i)), nil, new(int32))
+		signedData.VerifySigCalled = func() error {
+			running := atomic.AddInt32(&numRunning, 1)
+			for {
+				max := atomic.LoadInt32(&maxRunning)
+				if running <= max || atomic.CompareAndSwapInt32(&maxRunning, max, running) {
+					break
+				}
+			}
+
+			time.Sleep(time.Millisecond * 20)
+			atomic.AddInt32(&numRunning, -1)
+			return nil
+		}
+		batch = append(batch, signedData)
+	}
+	vp, _ := pipeline.NewVerificationPipeline(createArgument(uint32(numWorkers)))
+
+	errs := vp.Verify(batch)
+
+	assert.Equal(t, make([]error, len(batch)), errs)
+	assert.Equal(t, int32(numWorkers), atomic.LoadInt32(&maxRunning))
+}
@@ -48,6 +48,12 @@ type TxTypeHandler interface {
 	IsInterfaceNil() bool
 }
 
+// SignerSetProvider is able to return the signer set registered by a multisig account
+type SignerSetProvider interface {
+	SignerSet(address state.AddressContainer) (*state.SignerSet, error)
+	IsInterfaceNil() bool
+}
+
 // TxValidator can determine if a provided transaction handler is valid or not from the process point of view
 type TxValidator interface {
 	IsTxValidForProcessing(txHandler TxValidatorHandler) bool
@@ -96,6 +102,28 @@ type InterceptorProcessor interface {
 	IsInterfaceNil() bool
 }
 
+// InterceptedSignedData defines the intercepted data whose signature can be verified apart from the other validity
+// checks, so that the signature verifications can be run in parallel and cached. VerifySig must depend only on the
+// data itself, any check depending on the state being done by CheckIntegrity, which is not cached
+type InterceptedSignedData interface {
+	InterceptedData
+	CheckIntegrity() error
+	VerifySig() error
+}
+
+// InterceptedDataVerifier defines the component able to check the validity of a batch of intercepted data
+type InterceptedDataVerifier interface {
+	Verify(interceptedData []InterceptedData) []error
+	IsInterfaceNil() bool
+}
+
+// InterceptedHeaderSigVerifier is the interface needed to check that a header is correctly signed by its consensus group
+type InterceptedHeaderSigVerifier interface {
+	VerifyRandSeed(header data.HeaderHandler) error
+	VerifySignature(header data.HeaderHandler) error
+	IsInterfaceNil() bool
+}
+
 // InterceptorThrottler can
 type InterceptorThrottler interface {
 	CanProcess() bool
@@ -334,6 +362,12 @@ type IntermediateProcessorsContainerFactory interface {
 	IsInterfaceNil() bool
 }
 
+// SmartContractLogsHandler defines what a handler of the logs generated by the smart contract executions should do
+type SmartContractLogsHandler interface {
+	SaveLogs(txHash []byte, logs []*vmcommon.LogEntry)
+	IsInterfaceNil() bool
+}
+
 // VirtualMachinesContainer defines a virtual machine holder data type with basic functionality
 type VirtualMachinesContainer interface {
 	Get(key []byte) (vmcommon.VMExecutionHandler, error)
@@ -21,6 +21,7 @@ type AccountsStub struct {
 	SaveDataTrieCalled          func(acountWrapper state.AccountHandler) error
 	RootHashCalled              func() ([]byte, error)
 	RecreateTrieCalled          func(rootHash []byte) error
+	GetProofCalled              func(addressContainer state.AddressContainer, rootHash []byte) ([][]byte, error)
 }
 
 var errNotImplemented = errors.New("not implemented")
@@ -139,6 +140,14 @@ func (aam *AccountsStub) RecreateTrie(rootHash []byte) error {
 	return errNotImplemented
 }
 
+func (aam *AccountsStub) GetProof(addressContainer state.AddressContainer, rootHash []byte) ([][]byte, error) {
+	if aam.GetProofCalled != nil {
+		return aam.GetProofCalled(addressContainer, rootHash)
+	}
+
+	return nil, errNotImplemented
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (aam *AccountsStub) IsInterfaceNil() bool {
 	if aam == nil {
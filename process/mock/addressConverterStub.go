@@ -7,6 +7,7 @@ import (
 type AddressConverterStub struct {
 	CreateAddressFromPublicKeyBytesCalled func(pubKey []byte) (state.AddressContainer, error)
 	ConvertToHexCalled                    func(addressContainer state.AddressContainer) (string, error)
+	ConvertToStringCalled                 func(addressContainer state.AddressContainer) (string, error)
 	CreateAddressFromHexCalled            func(hexAddress string) (state.AddressContainer, error)
 	PrepareAddressBytesCalled             func(addressBytes []byte) ([]byte, error)
 	AddressLenHandler                     func() int
@@ -20,6 +21,10 @@ func (acs *AddressConverterStub) ConvertToHex(addressContainer state.AddressCont
 	return acs.ConvertToHexCalled(addressContainer)
 }
 
+func (acs *AddressConverterStub) ConvertToString(addressContainer state.AddressContainer) (string, error) {
+	return acs.ConvertToStringCalled(addressContainer)
+}
+
 func (acs *AddressConverterStub) CreateAddressFromHex(hexAddress string) (state.AddressContainer, error) {
 	return acs.CreateAddressFromHexCalled(hexAddress)
 }
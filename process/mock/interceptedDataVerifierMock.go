@@ -0,0 +1,25 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+type InterceptedDataVerifierMock struct {
+}
+
+func (idvm *InterceptedDataVerifierMock) Verify(interceptedData []process.InterceptedData) []error {
+	errs := make([]error, len(interceptedData))
+	for idx, data := range interceptedData {
+		errs[idx] = data.CheckValidity()
+	}
+
+	return errs
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (idvm *InterceptedDataVerifierMock) IsInterfaceNil() bool {
+	if idvm == nil {
+		return true
+	}
+	return false
+}
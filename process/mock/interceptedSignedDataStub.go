@@ -0,0 +1,23 @@
+package mock
+
+type InterceptedSignedDataStub struct {
+	InterceptedDataStub
+	CheckIntegrityCalled func() error
+	VerifySigCalled      func() error
+}
+
+func (isds *InterceptedSignedDataStub) CheckIntegrity() error {
+	return isds.CheckIntegrityCalled()
+}
+
+func (isds *InterceptedSignedDataStub) VerifySig() error {
+	return isds.VerifySigCalled()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (isds *InterceptedSignedDataStub) IsInterfaceNil() bool {
+	if isds == nil {
+		return true
+	}
+	return false
+}
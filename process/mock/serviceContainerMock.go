@@ -1,14 +1,18 @@
 package mock
 
 import (
+	"github.com/ElrondNetwork/elrond-go/core/history"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
 )
 
 // ServiceContainerMock is a mock implementation of the Core interface
 type ServiceContainerMock struct {
-	IndexerCalled      func() indexer.Indexer
-	TPSBenchmarkCalled func() statistics.TPSBenchmark
+	IndexerCalled              func() indexer.Indexer
+	TPSBenchmarkCalled         func() statistics.TPSBenchmark
+	SubscriptionNotifierCalled func() subscription.Notifier
+	TransactionHistoryCalled   func() history.Handler
 }
 
 // Indexer returns a mock implementation for core.Indexer
@@ -27,6 +31,22 @@ func (scm *ServiceContainerMock) TPSBenchmark() statistics.TPSBenchmark {
 	return nil
 }
 
+// SubscriptionNotifier returns a mock implementation for subscription.Notifier
+func (scm *ServiceContainerMock) SubscriptionNotifier() subscription.Notifier {
+	if scm.SubscriptionNotifierCalled != nil {
+		return scm.SubscriptionNotifierCalled()
+	}
+	return nil
+}
+
+// TransactionHistory returns a mock implementation for history.Handler
+func (scm *ServiceContainerMock) TransactionHistory() history.Handler {
+	if scm.TransactionHistoryCalled != nil {
+		return scm.TransactionHistoryCalled()
+	}
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (scm *ServiceContainerMock) IsInterfaceNil() bool {
 	if scm == nil {
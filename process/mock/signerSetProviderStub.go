@@ -0,0 +1,25 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+type SignerSetProviderStub struct {
+	SignerSetCalled func(address state.AddressContainer) (*state.SignerSet, error)
+}
+
+func (ssps *SignerSetProviderStub) SignerSet(address state.AddressContainer) (*state.SignerSet, error) {
+	if ssps.SignerSetCalled != nil {
+		return ssps.SignerSetCalled(address)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ssps *SignerSetProviderStub) IsInterfaceNil() bool {
+	if ssps == nil {
+		return true
+	}
+	return false
+}
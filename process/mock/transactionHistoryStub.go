@@ -0,0 +1,36 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+type TransactionHistoryStub struct {
+	SaveBlockCalled   func(header data.HeaderHandler, headerHash []byte, txs map[string]data.TransactionHandler) error
+	RemoveBlockCalled func(headerHash []byte) error
+}
+
+func (ths *TransactionHistoryStub) SaveBlock(
+	header data.HeaderHandler,
+	headerHash []byte,
+	txs map[string]data.TransactionHandler,
+) error {
+	if ths.SaveBlockCalled != nil {
+		return ths.SaveBlockCalled(header, headerHash, txs)
+	}
+	return nil
+}
+
+func (ths *TransactionHistoryStub) RemoveBlock(headerHash []byte) error {
+	if ths.RemoveBlockCalled != nil {
+		return ths.RemoveBlockCalled(headerHash)
+	}
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ths *TransactionHistoryStub) IsInterfaceNil() bool {
+	if ths == nil {
+		return true
+	}
+	return false
+}
@@ -42,6 +42,7 @@ type scProcessor struct {
 
 	scrForwarder process.IntermediateTransactionHandler
 	txFeeHandler process.TransactionFeeHandler
+	logsHandler  process.SmartContractLogsHandler
 }
 
 var log = logger.DefaultLogger()
@@ -783,6 +784,22 @@ func (sc *scProcessor) saveReturnCode(returnCode vmcommon.ReturnCode, round uint
 // save vm output logs into accounts
 func (sc *scProcessor) saveLogsIntoState(logs []*vmcommon.LogEntry, round uint64, txHash []byte) error {
 	sc.mapExecState[round].allLogs[string(txHash)] = logs
+	if sc.logsHandler != nil {
+		sc.logsHandler.SaveLogs(txHash, logs)
+	}
+	return nil
+}
+
+// SetLogsHandler sets the handler which receives the logs generated by the smart contract executions
+func (sc *scProcessor) SetLogsHandler(handler process.SmartContractLogsHandler) error {
+	if handler == nil || handler.IsInterfaceNil() {
+		return process.ErrNilLogsHandler
+	}
+
+	sc.mutSCState.Lock()
+	sc.logsHandler = handler
+	sc.mutSCState.Unlock()
+
 	return nil
 }
 
@@ -0,0 +1,153 @@
+package throttle
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/ntp"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// minAdaptiveItemsPercent specifies the lower limit, as a percentage of the max items allowed by the wrapped
+// throttler, until which the max items in one block could be shrunk
+const minAdaptiveItemsPercent = 10
+
+// adaptiveBlockSizeThrottle wraps a BlockSizeThrottler and shrinks the max items which could be added in one block
+// proportionally with the number of blocks which, in the recent rounds, were committed close to the end round deadline.
+// Only the blocks proposed by the node itself are taken into account, as these are the only ones committed by the
+// consensus: the other blocks are committed by the bootstrapper when synced, so their commit time does not reflect the
+// consensus timing
+type adaptiveBlockSizeThrottle struct {
+	throttler       process.BlockSizeThrottler
+	rounder         consensus.Rounder
+	syncTimer       ntp.SyncTimer
+	closeToDeadline time.Duration
+	numRecentRounds int
+
+	mutCommits        sync.RWMutex
+	recentCommits     []bool
+	lastProposedRound int64
+}
+
+// NewAdaptiveBlockSizeThrottle creates a new adaptiveBlockSizeThrottle object. A block is considered committed
+// close to the deadline if this happens after closeToDeadlinePercent of the commit deadline, counted from the start
+// of the round
+func NewAdaptiveBlockSizeThrottle(
+	throttler process.BlockSizeThrottler,
+	rounder consensus.Rounder,
+	syncTimer ntp.SyncTimer,
+	commitDeadline time.Duration,
+	closeToDeadlinePercent uint32,
+	numRecentRounds uint32,
+) (*adaptiveBlockSizeThrottle, error) {
+
+	if throttler == nil || throttler.IsInterfaceNil() {
+		return nil, process.ErrNilBlockSizeThrottler
+	}
+	if rounder == nil || rounder.IsInterfaceNil() {
+		return nil, process.ErrNilRounder
+	}
+	if syncTimer == nil || syncTimer.IsInterfaceNil() {
+		return nil, process.ErrNilSyncTimer
+	}
+	if commitDeadline <= 0 {
+		return nil, process.ErrInvalidCommitDeadline
+	}
+	if closeToDeadlinePercent == 0 || closeToDeadlinePercent > 100 {
+		return nil, process.ErrInvalidCloseToDeadlinePercent
+	}
+	if numRecentRounds == 0 {
+		return nil, process.ErrInvalidNumRecentRounds
+	}
+
+	return &adaptiveBlockSizeThrottle{
+		throttler:         throttler,
+		rounder:           rounder,
+		syncTimer:         syncTimer,
+		closeToDeadline:   commitDeadline * time.Duration(closeToDeadlinePercent) / 100,
+		numRecentRounds:   int(numRecentRounds),
+		recentCommits:     make([]bool, 0, numRecentRounds),
+		lastProposedRound: -1,
+	}, nil
+}
+
+// MaxItemsToAdd gets the maximum number of items which could be added in one block, as computed by the wrapped
+// throttler and shrunk by the part of the recent rounds in which the block was committed close to the deadline
+func (abst *adaptiveBlockSizeThrottle) MaxItemsToAdd() uint32 {
+	maxItems := abst.throttler.MaxItemsToAdd()
+
+	abst.mutCommits.RLock()
+	numCloseToDeadline := 0
+	for _, isCloseToDeadline := range abst.recentCommits {
+		if isCloseToDeadline {
+			numCloseToDeadline++
+		}
+	}
+	abst.mutCommits.RUnlock()
+
+	if numCloseToDeadline == 0 {
+		return maxItems
+	}
+
+	minItems := core.MaxUint32(1, maxItems*minAdaptiveItemsPercent/100)
+	shrunkItems := uint32(uint64(maxItems) * uint64(abst.numRecentRounds-numCloseToDeadline) / uint64(abst.numRecentRounds))
+
+	return core.MaxUint32(minItems, shrunkItems)
+}
+
+// Add adds the new state for last block which has been sent. The round is kept as the last one in which the node
+// proposed a block
+func (abst *adaptiveBlockSizeThrottle) Add(round uint64, items uint32) {
+	abst.throttler.Add(round, items)
+
+	abst.mutCommits.Lock()
+	abst.lastProposedRound = int64(round)
+	abst.mutCommits.Unlock()
+}
+
+// Succeed sets the state of the last block which has been sent at the given round. When the block proposed by the
+// node is committed by the consensus, in its own round, the time elapsed from the start of the round is kept for the
+// next computations. The commits of the synced blocks are ignored
+func (abst *adaptiveBlockSizeThrottle) Succeed(round uint64) {
+	abst.throttler.Succeed(round)
+
+	abst.mutCommits.Lock()
+	isProposedBySelf := abst.lastProposedRound == int64(round)
+	isCommittedInItsRound := abst.rounder.Index() == int64(round)
+	if !isProposedBySelf || !isCommittedInItsRound {
+		abst.mutCommits.Unlock()
+		return
+	}
+	//a block is committed by the consensus at most once
+	abst.lastProposedRound = -1
+
+	elapsedTime := abst.syncTimer.CurrentTime().Sub(abst.rounder.TimeStamp())
+	isCloseToDeadline := elapsedTime > abst.closeToDeadline
+
+	abst.recentCommits = append(abst.recentCommits, isCloseToDeadline)
+	if len(abst.recentCommits) > abst.numRecentRounds {
+		abst.recentCommits = abst.recentCommits[1:]
+	}
+	abst.mutCommits.Unlock()
+
+	if isCloseToDeadline {
+		log.Info(fmt.Sprintf("block in round %d has been committed after %v, close to the deadline\n",
+			round, elapsedTime))
+	}
+}
+
+// ComputeMaxItems computes the max items which could be added in one block
+func (abst *adaptiveBlockSizeThrottle) ComputeMaxItems() {
+	abst.throttler.ComputeMaxItems()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (abst *adaptiveBlockSizeThrottle) IsInterfaceNil() bool {
+	if abst == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,295 @@
+package throttle_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/throttle"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	commitDeadline         = 3 * time.Second
+	closeToDeadlinePercent = 80
+	numRecentRounds        = 4
+)
+
+var roundTimeStamp = time.Unix(100, 0)
+
+func createThrottlerStub(maxItems uint32) *mock.BlockSizeThrottlerStub {
+	return &mock.BlockSizeThrottlerStub{
+		MaxItemsToAddCalled: func() uint32 {
+			return maxItems
+		},
+	}
+}
+
+func createSyncTimer(elapsedTime *time.Duration) *mock.SyncTimerMock {
+	return &mock.SyncTimerMock{
+		CurrentTimeCalled: func() time.Time {
+			return roundTimeStamp.Add(*elapsedTime)
+		},
+	}
+}
+
+//------- NewAdaptiveBlockSizeThrottle
+
+func TestNewAdaptiveBlockSizeThrottle_NilThrottlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	elapsedTime := time.Duration(0)
+	abst, err := throttle.NewAdaptiveBlockSizeThrottle(
+		nil,
+		&mock.RounderMock{},
+		createSyncTimer(&elapsedTime),
+		commitDeadline,
+		closeToDeadlinePercent,
+		numRecentRounds,
+	)
+
+	assert.Nil(t, abst)
+	assert.Equal(t, process.ErrNilBlockSizeThrottler, err)
+}
+
+func TestNewAdaptiveBlockSizeThrottle_NilRounderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	elapsedTime := time.Duration(0)
+	abst, err := throttle.NewAdaptiveBlockSizeThrottle(
+		createThrottlerStub(100),
+		nil,
+		createSyncTimer(&elapsedTime),
+		commitDeadline,
+		closeToDeadlinePercent,
+		numRecentRounds,
+	)
+
+	assert.Nil(t, abst)
+	assert.Equal(t, process.ErrNilRounder, err)
+}
+
+func TestNewAdaptiveBlockSizeThrottle_NilSyncTimerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	abst, err := throttle.NewAdaptiveBlockSizeThrottle(
+		createThrottlerStub(100),
+		&mock.RounderMock{},
+		nil,
+		commitDeadline,
+		closeToDeadlinePercent,
+		numRecentRounds,
+	)
+
+	assert.Nil(t, abst)
+	assert.Equal(t, process.ErrNilSyncTimer, err)
+}
+
+func TestNewAdaptiveBlockSizeThrottle_InvalidCommitDeadlineShouldErr(t *testing.T) {
+	t.Parallel()
+
+	elapsedTime := time.Duration(0)
+	abst, err := throttle.NewAdaptiveBlockSizeThrottle(
+		createThrottlerStub(100),
+		&mock.RounderMock{},
+		createSyncTimer(&elapsedTime),
+		0,
+		closeToDeadlinePercent,
+		numRecentRounds,
+	)
+
+	assert.Nil(t, abst)
+	assert.Equal(t, process.ErrInvalidCommitDeadline, err)
+}
+
+func TestNewAdaptiveBlockSizeThrottle_InvalidCloseToDeadlinePercentShouldErr(t *testing.T) {
+	t.Parallel()
+
+	elapsedTime := time.Duration(0)
+	abst, err := throttle.NewAdaptiveBlockSizeThrottle(
+		createThrottlerStub(100),
+		&mock.RounderMock{},
+		createSyncTimer(&elapsedTime),
+		commitDeadline,
+		101,
+		numRecentRounds,
+	)
+
+	assert.Nil(t, abst)
+	assert.Equal(t, process.ErrInvalidCloseToDeadlinePercent, err)
+}
+
+func TestNewAdaptiveBlockSizeThrottle_InvalidNumRecentRoundsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	elapsedTime := time.Duration(0)
+	abst, err := throttle.NewAdaptiveBlockSizeThrottle(
+		createThrottlerStub(100),
+		&mock.RounderMock{},
+		createSyncTimer(&elapsedTime),
+		commitDeadline,
+		closeToDeadlinePercent,
+		0,
+	)
+
+	assert.Nil(t, abst)
+	assert.Equal(t, process.ErrInvalidNumRecentRounds, err)
+}
+
+//------- MaxItemsToAdd
+
+func TestAdaptiveBlockSizeThrottle_MaxItemsToAddWithoutCommitsShouldReturnWrappedValue(t *testing.T) {
+	t.Parallel()
+
+	elapsedTime := time.Duration(0)
+	abst, _ := throttle.NewAdaptiveBlockSizeThrottle(
+		createThrottlerStub(100),
+		&mock.RounderMock{},
+		createSyncTimer(&elapsedTime),
+		commitDeadline,
+		closeToDeadlinePercent,
+		numRecentRounds,
+	)
+
+	assert.Equal(t, uint32(100), abst.MaxItemsToAdd())
+}
+
+func TestAdaptiveBlockSizeThrottle_MaxItemsToAddShouldShrinkWithTheCommitsCloseToDeadline(t *testing.T) {
+	t.Parallel()
+
+	rounder := &mock.RounderMock{RoundTimeStamp: roundTimeStamp}
+	elapsedTime := time.Duration(0)
+	abst, _ := throttle.NewAdaptiveBlockSizeThrottle(
+		createThrottlerStub(100),
+		rounder,
+		createSyncTimer(&elapsedTime),
+		commitDeadline,
+		closeToDeadlinePercent,
+		numRecentRounds,
+	)
+
+	commit := func(round int64, elapsed time.Duration) {
+		rounder.RoundIndex = round
+		elapsedTime = elapsed
+		abst.Add(uint64(round), 1)
+		abst.Succeed(uint64(round))
+	}
+
+	commit(1, time.Second)
+	assert.Equal(t, uint32(100), abst.MaxItemsToAdd())
+
+	//the threshold is 80% of 3 seconds
+	commit(2, 2500*time.Millisecond)
+	assert.Equal(t, uint32(75), abst.MaxItemsToAdd())
+
+	commit(3, 2900*time.Millisecond)
+	assert.Equal(t, uint32(50), abst.MaxItemsToAdd())
+
+	//the first commit close to the deadline gets out of the recent rounds
+	commit(4, time.Second)
+	commit(5, time.Second)
+	commit(6, time.Second)
+	assert.Equal(t, uint32(75), abst.MaxItemsToAdd())
+}
+
+func TestAdaptiveBlockSizeThrottle_MaxItemsToAddShouldNotShrinkBelowLimit(t *testing.T) {
+	t.Parallel()
+
+	rounder := &mock.RounderMock{RoundTimeStamp: roundTimeStamp}
+	elapsedTime := 2900 * time.Millisecond
+	abst, _ := throttle.NewAdaptiveBlockSizeThrottle(
+		createThrottlerStub(100),
+		rounder,
+		createSyncTimer(&elapsedTime),
+		commitDeadline,
+		closeToDeadlinePercent,
+		numRecentRounds,
+	)
+
+	for round := int64(1); round <= numRecentRounds; round++ {
+		rounder.RoundIndex = round
+		abst.Add(uint64(round), 1)
+		abst.Succeed(uint64(round))
+	}
+
+	assert.Equal(t, uint32(10), abst.MaxItemsToAdd())
+}
+
+//------- Succeed
+
+func TestAdaptiveBlockSizeThrottle_SucceedInOtherRoundShouldNotCount(t *testing.T) {
+	t.Parallel()
+
+	succeedRound := uint64(0)
+	throttler := createThrottlerStub(100)
+	throttler.SucceedCalled = func(round uint64) {
+		succeedRound = round
+	}
+
+	rounder := &mock.RounderMock{RoundIndex: 10, RoundTimeStamp: roundTimeStamp}
+	elapsedTime := 2900 * time.Millisecond
+	abst, _ := throttle.NewAdaptiveBlockSizeThrottle(
+		throttler,
+		rounder,
+		createSyncTimer(&elapsedTime),
+		commitDeadline,
+		closeToDeadlinePercent,
+		numRecentRounds,
+	)
+
+	abst.Add(7, 1)
+	abst.Succeed(7)
+
+	assert.Equal(t, uint64(7), succeedRound)
+	assert.Equal(t, uint32(100), abst.MaxItemsToAdd())
+}
+
+func TestAdaptiveBlockSizeThrottle_SucceedForSyncedBlockShouldNotCount(t *testing.T) {
+	t.Parallel()
+
+	succeedRound := uint64(0)
+	throttler := createThrottlerStub(100)
+	throttler.SucceedCalled = func(round uint64) {
+		succeedRound = round
+	}
+
+	rounder := &mock.RounderMock{RoundIndex: 10, RoundTimeStamp: roundTimeStamp}
+	elapsedTime := 2900 * time.Millisecond
+	abst, _ := throttle.NewAdaptiveBlockSizeThrottle(
+		throttler,
+		rounder,
+		createSyncTimer(&elapsedTime),
+		commitDeadline,
+		closeToDeadlinePercent,
+		numRecentRounds,
+	)
+
+	//the block of round 10 was proposed by another node and committed by the bootstrapper
+	abst.Add(9, 1)
+	abst.Succeed(10)
+
+	assert.Equal(t, uint64(10), succeedRound)
+	assert.Equal(t, uint32(100), abst.MaxItemsToAdd())
+}
+
+func TestAdaptiveBlockSizeThrottle_SucceedTwiceForTheSameRoundShouldCountOnce(t *testing.T) {
+	t.Parallel()
+
+	rounder := &mock.RounderMock{RoundIndex: 10, RoundTimeStamp: roundTimeStamp}
+	elapsedTime := 2900 * time.Millisecond
+	abst, _ := throttle.NewAdaptiveBlockSizeThrottle(
+		createThrottlerStub(100),
+		rounder,
+		createSyncTimer(&elapsedTime),
+		commitDeadline,
+		closeToDeadlinePercent,
+		numRecentRounds,
+	)
+
+	abst.Add(10, 1)
+	abst.Succeed(10)
+	abst.Succeed(10)
+
+	assert.Equal(t, uint32(75), abst.MaxItemsToAdd())
+}
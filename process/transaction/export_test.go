@@ -29,3 +29,7 @@ func (txProc *txProcessor) MoveBalances(acntSrc, acntDst *state.Account, value *
 func (txProc *txProcessor) IncreaseNonce(acntSrc *state.Account) error {
 	return txProc.increaseNonce(acntSrc)
 }
+
+func ParseSignerSetChange(data string) (*state.SignerSet, error) {
+	return parseSignerSetChange(data)
+}
@@ -30,6 +30,7 @@ type InterceptedTransaction struct {
 	isForCurrentShard bool
 	sndAddr           state.AddressContainer
 	feeHandler        process.FeeHandler
+	signerSetProvider process.SignerSetProvider
 }
 
 // NewInterceptedTransaction returns a new instance of InterceptedTransaction
@@ -42,6 +43,7 @@ func NewInterceptedTransaction(
 	addrConv state.AddressConverter,
 	coordinator sharding.Coordinator,
 	feeHandler process.FeeHandler,
+	signerSetProvider process.SignerSetProvider,
 ) (*InterceptedTransaction, error) {
 
 	if txBuff == nil {
@@ -68,6 +70,9 @@ func NewInterceptedTransaction(
 	if feeHandler == nil || coordinator.IsInterfaceNil() {
 		return nil, process.ErrNilEconomicsFeeHandler
 	}
+	if check.IfNil(signerSetProvider) {
+		return nil, process.ErrNilSignerSetProvider
+	}
 
 	tx, err := createTx(marshalizer, txBuff)
 	if err != nil {
@@ -75,14 +80,15 @@ func NewInterceptedTransaction(
 	}
 
 	inTx := &InterceptedTransaction{
-		tx:           tx,
-		marshalizer:  marshalizer,
-		hasher:       hasher,
-		singleSigner: signer,
-		addrConv:     addrConv,
-		keyGen:       keyGen,
-		coordinator:  coordinator,
-		feeHandler:   feeHandler,
+		tx:                tx,
+		marshalizer:       marshalizer,
+		hasher:            hasher,
+		singleSigner:      signer,
+		addrConv:          addrConv,
+		keyGen:            keyGen,
+		coordinator:       coordinator,
+		feeHandler:        feeHandler,
+		signerSetProvider: signerSetProvider,
 	}
 
 	err = inTx.processFields(txBuff)
@@ -105,12 +111,12 @@ func createTx(marshalizer marshal.Marshalizer, txBuff []byte) (*transaction.Tran
 
 // CheckValidity checks if the received transaction is valid (not nil fields, valid sig and so on)
 func (inTx *InterceptedTransaction) CheckValidity() error {
-	err := inTx.integrity()
+	err := inTx.CheckIntegrity()
 	if err != nil {
 		return err
 	}
 
-	err = inTx.verifySig()
+	err = inTx.VerifySig()
 	if err != nil {
 		return err
 	}
@@ -118,6 +124,18 @@ func (inTx *InterceptedTransaction) CheckValidity() error {
 	return nil
 }
 
+// CheckIntegrity checks the transaction fields and, if the sender is in the current shard, the signers against the
+// sender's signer set, without verifying the signatures. As the signer set depends on the sender's state, this
+// check is not part of VerifySig, whose result is cached by transaction hash
+func (inTx *InterceptedTransaction) CheckIntegrity() error {
+	err := inTx.integrity()
+	if err != nil {
+		return err
+	}
+
+	return inTx.checkSignerSet()
+}
+
 func (inTx *InterceptedTransaction) processFields(txBuff []byte) error {
 	inTx.hash = inTx.hasher.Compute(string(txBuff))
 
@@ -148,8 +166,9 @@ func (inTx *InterceptedTransaction) processFields(txBuff []byte) error {
 
 // integrity checks for not nil fields and negative value
 func (inTx *InterceptedTransaction) integrity() error {
-	if inTx.tx.Signature == nil {
-		return process.ErrNilSignature
+	err := inTx.checkSignaturesIntegrity()
+	if err != nil {
+		return err
 	}
 	if inTx.tx.RcvAddr == nil {
 		return process.ErrNilRcvAddr
@@ -167,26 +186,79 @@ func (inTx *InterceptedTransaction) integrity() error {
 	return inTx.feeHandler.CheckValidityTxValues(inTx.tx)
 }
 
-// verifySig checks if the tx is correctly signed
-func (inTx *InterceptedTransaction) verifySig() error {
+func (inTx *InterceptedTransaction) checkSignaturesIntegrity() error {
+	if !inTx.tx.IsMultiSigned() {
+		if inTx.tx.Signature == nil {
+			return process.ErrNilSignature
+		}
+		return nil
+	}
+
+	if len(inTx.tx.Signature) > 0 {
+		return process.ErrSignatureAndMultiSignature
+	}
+	if len(inTx.tx.SignerPubKeys) != len(inTx.tx.Signatures) {
+		return process.ErrSignersSignaturesMismatch
+	}
+
+	return nil
+}
+
+// VerifySig checks if the tx is correctly signed. A multi signed transaction must carry valid signatures
+// from its signers
+func (inTx *InterceptedTransaction) VerifySig() error {
 	copiedTx := *inTx.tx
 	copiedTx.Signature = nil
+	copiedTx.SignerPubKeys = nil
+	copiedTx.Signatures = nil
 	buffCopiedTx, err := inTx.marshalizer.Marshal(&copiedTx)
 	if err != nil {
 		return err
 	}
 
-	senderPubKey, err := inTx.keyGen.PublicKeyFromByteArray(inTx.tx.SndAddr)
+	if !inTx.tx.IsMultiSigned() {
+		return inTx.verifySignature(inTx.tx.SndAddr, buffCopiedTx, inTx.tx.Signature)
+	}
+
+	for i := range inTx.tx.Signatures {
+		err = inTx.verifySignature(inTx.tx.SignerPubKeys[i], buffCopiedTx, inTx.tx.Signatures[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (inTx *InterceptedTransaction) verifySignature(pubKeyBytes []byte, message []byte, signature []byte) error {
+	pubKey, err := inTx.keyGen.PublicKeyFromByteArray(pubKeyBytes)
 	if err != nil {
 		return err
 	}
 
-	err = inTx.singleSigner.Verify(senderPubKey, buffCopiedTx, inTx.tx.Signature)
+	return inTx.singleSigner.Verify(pubKey, message, signature)
+}
+
+// checkSignerSet verifies the signers against the signer set of the sender. The sender's state is only
+// available if the sender is in the current shard, otherwise the check is left to the sender's shard
+func (inTx *InterceptedTransaction) checkSignerSet() error {
+	if inTx.sndShard != inTx.coordinator.SelfId() {
+		return nil
+	}
+
+	signerSet, err := inTx.signerSetProvider.SignerSet(inTx.sndAddr)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	if signerSet == nil {
+		if inTx.tx.IsMultiSigned() {
+			return process.ErrSignerSetNotRegistered
+		}
+		return nil
+	}
+
+	return checkSignersAgainstSet(inTx.tx, signerSet)
 }
 
 // ReceiverShardId returns the receiver shard id
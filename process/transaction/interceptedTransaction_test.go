@@ -86,9 +86,56 @@ func createInterceptedTxFromPlainTx(tx *dataTransaction.Transaction, txFeeHandle
 		},
 		shardCoordinator,
 		txFeeHandler,
+		&mock.SignerSetProviderStub{},
 	)
 }
 
+func createInterceptedTxInSenderShard(
+	tx *dataTransaction.Transaction,
+	signerSetProvider process.SignerSetProvider,
+) (*transaction.InterceptedTransaction, error) {
+	marshalizer := &mock.MarshalizerMock{}
+	txBuff, _ := marshalizer.Marshal(tx)
+
+	return transaction.NewInterceptedTransaction(
+		txBuff,
+		marshalizer,
+		mock.HasherMock{},
+		createKeyGenMock(),
+		createDummySigner(),
+		&mock.AddressConverterStub{
+			CreateAddressFromPublicKeyBytesCalled: func(pubKey []byte) (container state.AddressContainer, e error) {
+				return mock.NewAddressMock(pubKey), nil
+			},
+		},
+		mock.NewOneShardCoordinatorMock(),
+		createFreeTxFeeHandler(),
+		signerSetProvider,
+	)
+}
+
+func createMultiSignedTx(signerPubKeys [][]byte, signatures [][]byte) *dataTransaction.Transaction {
+	return &dataTransaction.Transaction{
+		Nonce:         1,
+		Value:         big.NewInt(2),
+		Data:          "data",
+		GasLimit:      3,
+		GasPrice:      4,
+		RcvAddr:       recvAddress,
+		SndAddr:       senderAddress,
+		SignerPubKeys: signerPubKeys,
+		Signatures:    signatures,
+	}
+}
+
+func createSignerSetProvider(signerSet *state.SignerSet) process.SignerSetProvider {
+	return &mock.SignerSetProviderStub{
+		SignerSetCalled: func(address state.AddressContainer) (*state.SignerSet, error) {
+			return signerSet, nil
+		},
+	}
+}
+
 //------- NewInterceptedTransaction
 
 func TestNewInterceptedTransaction_NilBufferShouldErr(t *testing.T) {
@@ -103,6 +150,7 @@ func TestNewInterceptedTransaction_NilBufferShouldErr(t *testing.T) {
 		&mock.AddressConverterMock{},
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
+		&mock.SignerSetProviderStub{},
 	)
 
 	assert.Nil(t, txi)
@@ -121,6 +169,7 @@ func TestNewInterceptedTransaction_NilMarshalizerShouldErr(t *testing.T) {
 		&mock.AddressConverterMock{},
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
+		&mock.SignerSetProviderStub{},
 	)
 
 	assert.Nil(t, txi)
@@ -139,6 +188,7 @@ func TestNewInterceptedTransaction_NilHasherShouldErr(t *testing.T) {
 		&mock.AddressConverterMock{},
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
+		&mock.SignerSetProviderStub{},
 	)
 
 	assert.Nil(t, txi)
@@ -157,6 +207,7 @@ func TestNewInterceptedTransaction_NilKeyGenShouldErr(t *testing.T) {
 		&mock.AddressConverterMock{},
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
+		&mock.SignerSetProviderStub{},
 	)
 
 	assert.Nil(t, txi)
@@ -175,6 +226,7 @@ func TestNewInterceptedTransaction_NilSignerShouldErr(t *testing.T) {
 		&mock.AddressConverterMock{},
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
+		&mock.SignerSetProviderStub{},
 	)
 
 	assert.Nil(t, txi)
@@ -193,6 +245,7 @@ func TestNewInterceptedTransaction_NilAddressConverterShouldErr(t *testing.T) {
 		nil,
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
+		&mock.SignerSetProviderStub{},
 	)
 
 	assert.Nil(t, txi)
@@ -211,6 +264,7 @@ func TestNewInterceptedTransaction_NilCoordinatorShouldErr(t *testing.T) {
 		&mock.AddressConverterMock{},
 		nil,
 		&mock.FeeHandlerStub{},
+		&mock.SignerSetProviderStub{},
 	)
 
 	assert.Nil(t, txi)
@@ -229,12 +283,32 @@ func TestNewInterceptedTransaction_NilFeeHandlerShouldErr(t *testing.T) {
 		&mock.AddressConverterMock{},
 		mock.NewOneShardCoordinatorMock(),
 		nil,
+		&mock.SignerSetProviderStub{},
 	)
 
 	assert.Nil(t, txi)
 	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
 }
 
+func TestNewInterceptedTransaction_NilSignerSetProviderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	txi, err := transaction.NewInterceptedTransaction(
+		make([]byte, 0),
+		&mock.MarshalizerMock{},
+		mock.HasherMock{},
+		&mock.SingleSignKeyGenMock{},
+		&mock.SignerMock{},
+		&mock.AddressConverterMock{},
+		mock.NewOneShardCoordinatorMock(),
+		&mock.FeeHandlerStub{},
+		nil,
+	)
+
+	assert.Nil(t, txi)
+	assert.Equal(t, process.ErrNilSignerSetProvider, err)
+}
+
 func TestNewInterceptedTransaction_UnmarshalingTxFailsShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -253,6 +327,7 @@ func TestNewInterceptedTransaction_UnmarshalingTxFailsShouldErr(t *testing.T) {
 		&mock.AddressConverterMock{},
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
+		&mock.SignerSetProviderStub{},
 	)
 
 	assert.Nil(t, txi)
@@ -275,6 +350,7 @@ func TestNewInterceptedTransaction_AddrConvFailsShouldErr(t *testing.T) {
 		},
 		mock.NewOneShardCoordinatorMock(),
 		&mock.FeeHandlerStub{},
+		&mock.SignerSetProviderStub{},
 	)
 
 	assert.Nil(t, txi)
@@ -492,6 +568,46 @@ func TestInterceptedTransaction_CheckValidityOkValsShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+//------- CheckIntegrity and VerifySig
+
+func TestInterceptedTransaction_CheckIntegrityShouldNotVerifySignature(t *testing.T) {
+	t.Parallel()
+
+	tx := &dataTransaction.Transaction{
+		Nonce:     1,
+		Value:     big.NewInt(2),
+		Data:      "data",
+		GasLimit:  3,
+		GasPrice:  4,
+		RcvAddr:   recvAddress,
+		SndAddr:   senderAddress,
+		Signature: []byte("wrong sig"),
+	}
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	assert.Nil(t, txi.CheckIntegrity())
+	assert.Equal(t, errSignerMockVerifySigFails, txi.VerifySig())
+}
+
+func TestInterceptedTransaction_CheckIntegrityNilValueShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tx := &dataTransaction.Transaction{
+		Nonce:     1,
+		Value:     nil,
+		Data:      "data",
+		GasLimit:  3,
+		GasPrice:  4,
+		RcvAddr:   recvAddress,
+		SndAddr:   senderAddress,
+		Signature: sigOk,
+	}
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	assert.Equal(t, process.ErrNilValue, txi.CheckIntegrity())
+	assert.Nil(t, txi.VerifySig())
+}
+
 func TestInterceptedTransaction_OkValsGettersShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -561,6 +677,7 @@ func TestInterceptedTransaction_ScTxDeployRecvShardIdShouldBeSendersShardId(t *t
 		},
 		shardCoordinator,
 		createFreeTxFeeHandler(),
+		&mock.SignerSetProviderStub{},
 	)
 
 	assert.Nil(t, err)
@@ -658,6 +775,155 @@ func TestInterceptedTransaction_GetSenderAddress(t *testing.T) {
 	assert.NotNil(t, result)
 }
 
+//------- multi signed transactions
+
+func TestInterceptedTransaction_CheckIntegrityMultiSignedWithSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tx := createMultiSignedTx([][]byte{[]byte("pk1")}, [][]byte{sigOk})
+	tx.Signature = sigOk
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	err := txi.CheckIntegrity()
+
+	assert.Equal(t, process.ErrSignatureAndMultiSignature, err)
+}
+
+func TestInterceptedTransaction_CheckIntegrityMultiSignedSignersMismatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tx := createMultiSignedTx([][]byte{[]byte("pk1"), []byte("pk2")}, [][]byte{sigOk})
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	err := txi.CheckIntegrity()
+
+	assert.Equal(t, process.ErrSignersSignaturesMismatch, err)
+}
+
+func TestInterceptedTransaction_CheckValidityMultiSignedWrongSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tx := createMultiSignedTx([][]byte{[]byte("pk1"), []byte("pk2")}, [][]byte{sigOk, []byte("wrong sig")})
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, errSignerMockVerifySigFails, err)
+}
+
+func TestInterceptedTransaction_CheckValidityMultiSignedSenderInOtherShardShouldNotCheckSignerSet(t *testing.T) {
+	t.Parallel()
+
+	tx := createMultiSignedTx([][]byte{[]byte("pk1"), []byte("pk2")}, [][]byte{sigOk, sigOk})
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler())
+
+	err := txi.CheckValidity()
+
+	assert.Nil(t, err)
+}
+
+func TestInterceptedTransaction_CheckValidityMultiSignedWithoutSignerSetShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tx := createMultiSignedTx([][]byte{[]byte("pk1")}, [][]byte{sigOk})
+	txi, _ := createInterceptedTxInSenderShard(tx, createSignerSetProvider(nil))
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, process.ErrSignerSetNotRegistered, err)
+}
+
+func TestInterceptedTransaction_MultiSignedWithoutSignerSetShouldFailIntegrityAndPassVerifySig(t *testing.T) {
+	t.Parallel()
+
+	tx := createMultiSignedTx([][]byte{[]byte("pk1")}, [][]byte{sigOk})
+	txi, _ := createInterceptedTxInSenderShard(tx, createSignerSetProvider(nil))
+
+	//the signer set depends on the sender's state, so it is not checked along with the cached signatures
+	assert.Equal(t, process.ErrSignerSetNotRegistered, txi.CheckIntegrity())
+	assert.Nil(t, txi.VerifySig())
+}
+
+func TestInterceptedTransaction_CheckValiditySingleSignedFromMultiSigAccountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	signerSet, _ := state.NewSignerSet(1, [][]byte{[]byte("pk1"), []byte("pk2")})
+	tx := createMultiSignedTx(nil, nil)
+	tx.Signature = sigOk
+	txi, _ := createInterceptedTxInSenderShard(tx, createSignerSetProvider(signerSet))
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, process.ErrMultiSignatureRequired, err)
+}
+
+func TestInterceptedTransaction_CheckValidityMultiSignedSignerNotInSetShouldErr(t *testing.T) {
+	t.Parallel()
+
+	signerSet, _ := state.NewSignerSet(1, [][]byte{[]byte("pk1"), []byte("pk2")})
+	tx := createMultiSignedTx([][]byte{[]byte("pk3")}, [][]byte{sigOk})
+	txi, _ := createInterceptedTxInSenderShard(tx, createSignerSetProvider(signerSet))
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, process.ErrSignerNotInSignerSet, err)
+}
+
+func TestInterceptedTransaction_CheckValidityMultiSignedDuplicatedSignerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	signerSet, _ := state.NewSignerSet(2, [][]byte{[]byte("pk1"), []byte("pk2")})
+	tx := createMultiSignedTx([][]byte{[]byte("pk1"), []byte("pk1")}, [][]byte{sigOk, sigOk})
+	txi, _ := createInterceptedTxInSenderShard(tx, createSignerSetProvider(signerSet))
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, process.ErrDuplicatedSigner, err)
+}
+
+func TestInterceptedTransaction_CheckValidityMultiSignedBelowThresholdShouldErr(t *testing.T) {
+	t.Parallel()
+
+	signerSet, _ := state.NewSignerSet(2, [][]byte{[]byte("pk1"), []byte("pk2"), []byte("pk3")})
+	tx := createMultiSignedTx([][]byte{[]byte("pk1")}, [][]byte{sigOk})
+	txi, _ := createInterceptedTxInSenderShard(tx, createSignerSetProvider(signerSet))
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, process.ErrNotEnoughSignatures, err)
+}
+
+func TestInterceptedTransaction_CheckValidityMultiSignedProviderErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("expected error")
+	tx := createMultiSignedTx([][]byte{[]byte("pk1")}, [][]byte{sigOk})
+	txi, _ := createInterceptedTxInSenderShard(
+		tx,
+		&mock.SignerSetProviderStub{
+			SignerSetCalled: func(address state.AddressContainer) (*state.SignerSet, error) {
+				return nil, errExpected
+			},
+		},
+	)
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, errExpected, err)
+}
+
+func TestInterceptedTransaction_CheckValidityMultiSignedOkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	signerSet, _ := state.NewSignerSet(2, [][]byte{[]byte("pk1"), []byte("pk2"), []byte("pk3")})
+	tx := createMultiSignedTx([][]byte{[]byte("pk3"), []byte("pk1")}, [][]byte{sigOk, sigOk})
+	txi, _ := createInterceptedTxInSenderShard(tx, createSignerSetProvider(signerSet))
+
+	err := txi.CheckValidity()
+
+	assert.Nil(t, err)
+}
+
 //------- IsInterfaceNil
 
 func TestInterceptedTransaction_IsInterfaceNil(t *testing.T) {
@@ -102,6 +102,11 @@ func (txProc *txProcessor) ProcessTransaction(tx *transaction.Transaction, round
 		return err
 	}
 
+	err = txProc.checkSigners(tx, acntSnd)
+	if err != nil {
+		return err
+	}
+
 	txType, err := txProc.txTypeHandler.ComputeTransactionType(tx)
 	if err != nil {
 		return err
@@ -114,11 +119,36 @@ func (txProc *txProcessor) ProcessTransaction(tx *transaction.Transaction, round
 		return txProc.processSCDeployment(tx, adrSrc, roundIndex)
 	case process.SCInvoking:
 		return txProc.processSCInvoking(tx, adrSrc, adrDst, roundIndex)
+	case process.SignerSetChange:
+		return txProc.processSignerSetChange(tx, adrSrc)
 	}
 
 	return process.ErrWrongTransaction
 }
 
+// checkSigners verifies that a multisig account sent a transaction signed by enough members of its signer set
+// and that a transaction carrying multiple signatures comes from an account with a registered signer set
+func (txProc *txProcessor) checkSigners(tx *transaction.Transaction, acntSnd state.AccountHandler) error {
+	if acntSnd == nil || acntSnd.IsInterfaceNil() {
+		// transaction was already done at sender shard
+		return nil
+	}
+
+	signerSet, err := getSignerSet(acntSnd, txProc.marshalizer)
+	if err != nil {
+		return err
+	}
+
+	if signerSet == nil {
+		if tx.IsMultiSigned() {
+			return process.ErrSignerSetNotRegistered
+		}
+		return nil
+	}
+
+	return checkSignersAgainstSet(tx, signerSet)
+}
+
 func (txProc *txProcessor) processTxFee(tx *transaction.Transaction, acntSnd *state.Account) (*big.Int, error) {
 	if acntSnd == nil {
 		return nil, nil
@@ -212,6 +242,61 @@ func (txProc *txProcessor) processSCInvoking(
 	return err
 }
 
+func (txProc *txProcessor) processSignerSetChange(
+	tx *transaction.Transaction,
+	adrSrc state.AddressContainer,
+) error {
+
+	acntSrc, _, err := txProc.getAccounts(adrSrc, adrSrc)
+	if err != nil {
+		return err
+	}
+	if acntSrc == nil {
+		// signer set changes are self transfers so they are completely processed in the sender shard
+		return nil
+	}
+
+	signerSet, err := parseSignerSetChange(tx.Data)
+	if err != nil {
+		return err
+	}
+
+	// the current value has to be loaded in the data trie tracker, otherwise removing the signer set
+	// would not be seen as a change when saving the data trie
+	_, err = getSignerSet(acntSrc, txProc.marshalizer)
+	if err != nil {
+		return err
+	}
+
+	buff := make([]byte, 0)
+	if signerSet != nil {
+		buff, err = txProc.marshalizer.Marshal(signerSet)
+		if err != nil {
+			return err
+		}
+	}
+
+	txFee, err := txProc.processTxFee(tx, acntSrc)
+	if err != nil {
+		return err
+	}
+
+	acntSrc.DataTrieTracker().SaveKeyValue(state.SignerSetKey, buff)
+	err = txProc.accounts.SaveDataTrie(acntSrc)
+	if err != nil {
+		return err
+	}
+
+	err = txProc.increaseNonce(acntSrc)
+	if err != nil {
+		return err
+	}
+
+	txProc.txFeeHandler.ProcessTransactionFee(txFee)
+
+	return nil
+}
+
 func (txProc *txProcessor) moveBalances(acntSrc, acntDst *state.Account,
 	value *big.Int,
 ) error {
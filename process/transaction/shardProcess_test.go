@@ -9,11 +9,14 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/factory"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	txproc "github.com/ElrondNetwork/elrond-go/process/transaction"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -1315,3 +1318,238 @@ func TestTxProcessor_ProcessTransactionScTxShouldNotBeCalledWhenAdrDstIsNotInNod
 	assert.Equal(t, 3, journalizeCalled)
 	assert.Equal(t, 3, saveAccountCalled)
 }
+
+//------- signer set changes
+
+func createInMemoryAccountsDB() state.AccountsAdapter {
+	marshalizer := &mock.MarshalizerMock{}
+	memDB, _ := memorydb.New()
+	tr, _ := trie.NewTrie(memDB, marshalizer, mock.HasherMock{})
+	adb, _ := state.NewAccountsDB(tr, mock.HasherMock{}, marshalizer, factory.NewAccountCreator())
+
+	return adb
+}
+
+func createTxProcessorWithAccounts(accounts state.AccountsAdapter) process.TransactionProcessor {
+	addrConv := &mock.AddressConverterMock{}
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+	txTypeHandler, _ := coordinator.NewTxTypeHandler(addrConv, shardCoordinator, accounts)
+
+	txProc, _ := txproc.NewTxProcessor(
+		accounts,
+		mock.HasherMock{},
+		addrConv,
+		&mock.MarshalizerMock{},
+		shardCoordinator,
+		&mock.SCProcessorMock{},
+		&mock.UnsignedTxHandlerMock{},
+		txTypeHandler,
+		feeHandlerMock(),
+	)
+
+	return txProc
+}
+
+func createFundedAccount(accounts state.AccountsAdapter, address []byte) {
+	account, _ := accounts.GetAccountWithJournal(mock.NewAddressMock(address))
+	_ = account.(*state.Account).SetBalanceWithJournal(big.NewInt(1000))
+	_, _ = accounts.Commit()
+}
+
+func createSignerSetChangeTx(nonce uint64, address []byte, data string) *transaction.Transaction {
+	return &transaction.Transaction{
+		Nonce:   nonce,
+		Value:   big.NewInt(0),
+		SndAddr: address,
+		RcvAddr: address,
+		Data:    data,
+	}
+}
+
+func TestTxProcessor_ProcessSignerSetChangeShouldRegisterSignerSet(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("multisig_address_of_32_bytes_len")
+	accounts := createInMemoryAccountsDB()
+	createFundedAccount(accounts, address)
+	execTx := createTxProcessorWithAccounts(accounts)
+
+	tx := createSignerSetChangeTx(0, address, "setSignerSet@02@706b31@706b32@706b33")
+	err := execTx.ProcessTransaction(tx, 1)
+	assert.Nil(t, err)
+	_, _ = accounts.Commit()
+
+	signerSetProvider, _ := txproc.NewSignerSetProvider(accounts, &mock.MarshalizerMock{})
+	signerSet, err := signerSetProvider.SignerSet(mock.NewAddressMock(address))
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(2), signerSet.Threshold)
+	assert.Equal(t, [][]byte{[]byte("pk1"), []byte("pk2"), []byte("pk3")}, signerSet.PubKeys)
+}
+
+func TestTxProcessor_ProcessSignerSetChangeInvalidThresholdShouldErr(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("multisig_address_of_32_bytes_len")
+	accounts := createInMemoryAccountsDB()
+	createFundedAccount(accounts, address)
+	execTx := createTxProcessorWithAccounts(accounts)
+
+	tx := createSignerSetChangeTx(0, address, "setSignerSet@03@706b31@706b32")
+	err := execTx.ProcessTransaction(tx, 1)
+
+	assert.Equal(t, state.ErrInvalidSignerSetThreshold, err)
+}
+
+func TestTxProcessor_ProcessTransactionFromMultiSigAccount(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("multisig_address_of_32_bytes_len")
+	accounts := createInMemoryAccountsDB()
+	createFundedAccount(accounts, address)
+	execTx := createTxProcessorWithAccounts(accounts)
+
+	tx := createSignerSetChangeTx(0, address, "setSignerSet@02@706b31@706b32@706b33")
+	err := execTx.ProcessTransaction(tx, 1)
+	assert.Nil(t, err)
+	_, _ = accounts.Commit()
+
+	transfer := &transaction.Transaction{
+		Nonce:   1,
+		Value:   big.NewInt(10),
+		SndAddr: address,
+		RcvAddr: []byte("receiver_address_of_32_bytes_len"),
+	}
+
+	transfer.Signature = []byte("sig")
+	err = execTx.ProcessTransaction(transfer, 2)
+	assert.Equal(t, process.ErrMultiSignatureRequired, err)
+
+	transfer.Signature = nil
+	transfer.SignerPubKeys = [][]byte{[]byte("pk1")}
+	transfer.Signatures = [][]byte{[]byte("sig1")}
+	err = execTx.ProcessTransaction(transfer, 2)
+	assert.Equal(t, process.ErrNotEnoughSignatures, err)
+
+	transfer.SignerPubKeys = [][]byte{[]byte("pk1"), []byte("pk4")}
+	transfer.Signatures = [][]byte{[]byte("sig1"), []byte("sig4")}
+	err = execTx.ProcessTransaction(transfer, 2)
+	assert.Equal(t, process.ErrSignerNotInSignerSet, err)
+
+	transfer.SignerPubKeys = [][]byte{[]byte("pk1"), []byte("pk3")}
+	transfer.Signatures = [][]byte{[]byte("sig1"), []byte("sig3")}
+	err = execTx.ProcessTransaction(transfer, 2)
+	assert.Nil(t, err)
+	_, _ = accounts.Commit()
+
+	//the same signed transaction can not be replayed as its nonce was consumed
+	err = execTx.ProcessTransaction(transfer, 3)
+	assert.Equal(t, process.ErrLowerNonceInTransaction, err)
+}
+
+func TestTxProcessor_ProcessSignerSetChangeShouldRequireCurrentSignerSet(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("multisig_address_of_32_bytes_len")
+	accounts := createInMemoryAccountsDB()
+	createFundedAccount(accounts, address)
+	execTx := createTxProcessorWithAccounts(accounts)
+
+	tx := createSignerSetChangeTx(0, address, "setSignerSet@02@706b31@706b32")
+	err := execTx.ProcessTransaction(tx, 1)
+	assert.Nil(t, err)
+	_, _ = accounts.Commit()
+
+	//lowering the threshold must be approved by the current signer set
+	tx = createSignerSetChangeTx(1, address, "setSignerSet@01@706b31@706b32")
+	tx.SignerPubKeys = [][]byte{[]byte("pk1")}
+	tx.Signatures = [][]byte{[]byte("sig1")}
+	err = execTx.ProcessTransaction(tx, 2)
+	assert.Equal(t, process.ErrNotEnoughSignatures, err)
+
+	tx.SignerPubKeys = [][]byte{[]byte("pk1"), []byte("pk2")}
+	tx.Signatures = [][]byte{[]byte("sig1"), []byte("sig2")}
+	err = execTx.ProcessTransaction(tx, 2)
+	assert.Nil(t, err)
+	_, _ = accounts.Commit()
+
+	signerSetProvider, _ := txproc.NewSignerSetProvider(accounts, &mock.MarshalizerMock{})
+	signerSet, _ := signerSetProvider.SignerSet(mock.NewAddressMock(address))
+	assert.Equal(t, uint32(1), signerSet.Threshold)
+}
+
+func TestTxProcessor_ProcessSignerSetChangeShouldRemoveSignerSet(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("multisig_address_of_32_bytes_len")
+	accounts := createInMemoryAccountsDB()
+	createFundedAccount(accounts, address)
+	execTx := createTxProcessorWithAccounts(accounts)
+
+	tx := createSignerSetChangeTx(0, address, "setSignerSet@01@706b31")
+	err := execTx.ProcessTransaction(tx, 1)
+	assert.Nil(t, err)
+	_, _ = accounts.Commit()
+
+	tx = createSignerSetChangeTx(1, address, "setSignerSet@00")
+	tx.SignerPubKeys = [][]byte{[]byte("pk1")}
+	tx.Signatures = [][]byte{[]byte("sig1")}
+	err = execTx.ProcessTransaction(tx, 2)
+	assert.Nil(t, err)
+	_, _ = accounts.Commit()
+
+	signerSetProvider, _ := txproc.NewSignerSetProvider(accounts, &mock.MarshalizerMock{})
+	signerSet, err := signerSetProvider.SignerSet(mock.NewAddressMock(address))
+	assert.Nil(t, err)
+	assert.Nil(t, signerSet)
+
+	//the account is back to single signature transactions
+	transfer := &transaction.Transaction{
+		Nonce:     2,
+		Value:     big.NewInt(10),
+		SndAddr:   address,
+		RcvAddr:   []byte("receiver_address_of_32_bytes_len"),
+		Signature: []byte("sig"),
+	}
+	err = execTx.ProcessTransaction(transfer, 3)
+	assert.Nil(t, err)
+}
+
+//------- parseSignerSetChange
+
+func TestParseSignerSetChange_InvalidDataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	invalidData := []string{
+		"",
+		"setSignerSet",
+		"otherFunction@01@706b31",
+		"setSignerSet@zz@706b31",
+		"setSignerSet@01@zz",
+		"setSignerSet@0100000000@706b31",
+	}
+
+	for _, data := range invalidData {
+		signerSet, err := txproc.ParseSignerSetChange(data)
+
+		assert.Nil(t, signerSet)
+		assert.Equal(t, process.ErrInvalidSignerSetChange, err)
+	}
+}
+
+func TestParseSignerSetChange_DuplicatedKeysShouldErr(t *testing.T) {
+	t.Parallel()
+
+	signerSet, err := txproc.ParseSignerSetChange("setSignerSet@01@706b31@706b31")
+
+	assert.Nil(t, signerSet)
+	assert.Equal(t, state.ErrDuplicatedSignerPubKey, err)
+}
+
+func TestParseSignerSetChange_ZeroThresholdWithoutKeysShouldReturnNil(t *testing.T) {
+	t.Parallel()
+
+	signerSet, err := txproc.ParseSignerSetChange("setSignerSet@")
+
+	assert.Nil(t, signerSet)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,103 @@
+package transaction
+
+import (
+	"encoding/hex"
+	"math"
+	"math/big"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+const signerSetChangeSeparator = "@"
+
+// getSignerSet returns the signer set saved in the account's data trie or nil if the account has none
+func getSignerSet(account state.AccountHandler, marshalizer marshal.Marshalizer) (*state.SignerSet, error) {
+	if account == nil || account.IsInterfaceNil() {
+		return nil, nil
+	}
+	if account.DataTrie() == nil || account.DataTrie().IsInterfaceNil() {
+		return nil, nil
+	}
+
+	buff, err := account.DataTrieTracker().RetrieveValue(state.SignerSetKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(buff) == 0 {
+		return nil, nil
+	}
+
+	signerSet := &state.SignerSet{}
+	err = marshalizer.Unmarshal(signerSet, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return signerSet, nil
+}
+
+// checkSignersAgainstSet verifies that all the signers of the transaction belong to the signer set and
+// that there are enough distinct signers to reach the threshold. The signatures themselves are verified
+// by the intercepted transaction
+func checkSignersAgainstSet(tx *transaction.Transaction, signerSet *state.SignerSet) error {
+	if !tx.IsMultiSigned() {
+		return process.ErrMultiSignatureRequired
+	}
+
+	signers := make(map[string]struct{}, len(tx.SignerPubKeys))
+	for _, pubKey := range tx.SignerPubKeys {
+		if !signerSet.Contains(pubKey) {
+			return process.ErrSignerNotInSignerSet
+		}
+
+		_, exists := signers[string(pubKey)]
+		if exists {
+			return process.ErrDuplicatedSigner
+		}
+		signers[string(pubKey)] = struct{}{}
+	}
+
+	if len(signers) < int(signerSet.Threshold) {
+		return process.ErrNotEnoughSignatures
+	}
+
+	return nil
+}
+
+// parseSignerSetChange decodes the data field of a signer set change transaction. A nil signer set
+// is returned for a zero threshold without any public keys, meaning the signer set is removed
+func parseSignerSetChange(data string) (*state.SignerSet, error) {
+	tokens := strings.Split(data, signerSetChangeSeparator)
+	if len(tokens) < 2 || tokens[0] != process.SignerSetChangeFunction {
+		return nil, process.ErrInvalidSignerSetChange
+	}
+
+	thresholdBytes, err := hex.DecodeString(tokens[1])
+	if err != nil {
+		return nil, process.ErrInvalidSignerSetChange
+	}
+	threshold := big.NewInt(0).SetBytes(thresholdBytes)
+	if threshold.Cmp(big.NewInt(math.MaxUint32)) > 0 {
+		return nil, process.ErrInvalidSignerSetChange
+	}
+
+	pubKeys := make([][]byte, 0, len(tokens)-2)
+	for _, token := range tokens[2:] {
+		pubKey, err := hex.DecodeString(token)
+		if err != nil {
+			return nil, process.ErrInvalidSignerSetChange
+		}
+
+		pubKeys = append(pubKeys, pubKey)
+	}
+
+	if threshold.Uint64() == 0 && len(pubKeys) == 0 {
+		return nil, nil
+	}
+
+	return state.NewSignerSet(uint32(threshold.Uint64()), pubKeys)
+}
@@ -0,0 +1,55 @@
+package transaction
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// signerSetProvider reads the signer sets registered by multisig accounts from the accounts state
+type signerSetProvider struct {
+	accounts    state.AccountsAdapter
+	marshalizer marshal.Marshalizer
+}
+
+// NewSignerSetProvider creates a new signer set provider
+func NewSignerSetProvider(accounts state.AccountsAdapter, marshalizer marshal.Marshalizer) (*signerSetProvider, error) {
+	if check.IfNil(accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+
+	return &signerSetProvider{
+		accounts:    accounts,
+		marshalizer: marshalizer,
+	}, nil
+}
+
+// SignerSet returns the signer set registered by the account found at the provided address or nil if
+// the account does not exist or has no signer set
+func (ssp *signerSetProvider) SignerSet(address state.AddressContainer) (*state.SignerSet, error) {
+	if check.IfNil(address) {
+		return nil, process.ErrNilAddressContainer
+	}
+
+	account, err := ssp.accounts.GetExistingAccount(address)
+	if err == state.ErrAccNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return getSignerSet(account, ssp.marshalizer)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ssp *signerSetProvider) IsInterfaceNil() bool {
+	if ssp == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+package transaction_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	txproc "github.com/ElrondNetwork/elrond-go/process/transaction"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSignerSetProvider_NilAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ssp, err := txproc.NewSignerSetProvider(nil, &mock.MarshalizerMock{})
+
+	assert.True(t, check.IfNil(ssp))
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+}
+
+func TestNewSignerSetProvider_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ssp, err := txproc.NewSignerSetProvider(&mock.AccountsStub{}, nil)
+
+	assert.True(t, check.IfNil(ssp))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewSignerSetProvider_OkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ssp, err := txproc.NewSignerSetProvider(&mock.AccountsStub{}, &mock.MarshalizerMock{})
+
+	assert.False(t, check.IfNil(ssp))
+	assert.Nil(t, err)
+}
+
+func TestSignerSetProvider_SignerSetNilAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ssp, _ := txproc.NewSignerSetProvider(&mock.AccountsStub{}, &mock.MarshalizerMock{})
+
+	signerSet, err := ssp.SignerSet(nil)
+
+	assert.Nil(t, signerSet)
+	assert.Equal(t, process.ErrNilAddressContainer, err)
+}
+
+func TestSignerSetProvider_SignerSetAccountNotFoundShouldReturnNil(t *testing.T) {
+	t.Parallel()
+
+	ssp, _ := txproc.NewSignerSetProvider(
+		&mock.AccountsStub{
+			GetExistingAccountCalled: func(addressContainer state.AddressContainer) (state.AccountHandler, error) {
+				return nil, state.ErrAccNotFound
+			},
+		},
+		&mock.MarshalizerMock{},
+	)
+
+	signerSet, err := ssp.SignerSet(mock.NewAddressMock([]byte("address")))
+
+	assert.Nil(t, signerSet)
+	assert.Nil(t, err)
+}
+
+func TestSignerSetProvider_SignerSetAccountsErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("expected error")
+	ssp, _ := txproc.NewSignerSetProvider(
+		&mock.AccountsStub{
+			GetExistingAccountCalled: func(addressContainer state.AddressContainer) (state.AccountHandler, error) {
+				return nil, errExpected
+			},
+		},
+		&mock.MarshalizerMock{},
+	)
+
+	signerSet, err := ssp.SignerSet(mock.NewAddressMock([]byte("address")))
+
+	assert.Nil(t, signerSet)
+	assert.Equal(t, errExpected, err)
+}
+
+func TestSignerSetProvider_SignerSetAccountWithoutDataTrieShouldReturnNil(t *testing.T) {
+	t.Parallel()
+
+	address := mock.NewAddressMock([]byte("address"))
+	account, _ := state.NewAccount(address, &mock.AccountTrackerStub{})
+	ssp, _ := txproc.NewSignerSetProvider(
+		&mock.AccountsStub{
+			GetExistingAccountCalled: func(addressContainer state.AddressContainer) (state.AccountHandler, error) {
+				return account, nil
+			},
+		},
+		&mock.MarshalizerMock{},
+	)
+
+	signerSet, err := ssp.SignerSet(address)
+
+	assert.Nil(t, signerSet)
+	assert.Nil(t, err)
+}
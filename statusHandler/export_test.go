@@ -13,3 +13,11 @@ func (psh *PrometheusStatusHandler) GetPrometheusMetricByKey(key string) (promet
 	}
 	return nil, errors.New("metric does not exist")
 }
+
+func (psh *PrometheusStatusHandler) GetPrometheusHistogramByKey(key string) (prometheus.Histogram, error) {
+	value, ok := psh.prometheusHistogramMetrics.Load(key)
+	if ok {
+		return value.(prometheus.Histogram), nil
+	}
+	return nil, errors.New("metric does not exist")
+}
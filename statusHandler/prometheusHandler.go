@@ -7,14 +7,19 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 )
 
+// consensusTimeBuckets are the upper bounds, in milliseconds, of the consensus time histograms buckets
+var consensusTimeBuckets = prometheus.ExponentialBuckets(50, 2, 8)
+
 // PrometheusStatusHandler will define the handler which will update prometheus metrics
 type PrometheusStatusHandler struct {
-	prometheusGaugeMetrics sync.Map
+	prometheusGaugeMetrics     sync.Map
+	prometheusHistogramMetrics sync.Map
 }
 
 // InitMetricsMap will init the map of prometheus metrics
 func (psh *PrometheusStatusHandler) InitMetricsMap() {
 	psh.prometheusGaugeMetrics = sync.Map{}
+	psh.prometheusHistogramMetrics = sync.Map{}
 }
 
 // will create a prometheus gauge and add it to the sync map
@@ -26,6 +31,16 @@ func (psh *PrometheusStatusHandler) addMetric(name string, help string) {
 	psh.prometheusGaugeMetrics.Store(name, metric)
 }
 
+// will create a prometheus histogram and add it to the sync map. The values set for a histogram metric are observed
+func (psh *PrometheusStatusHandler) addHistogram(name string, help string, buckets []float64) {
+	metric := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    name,
+		Help:    help,
+		Buckets: buckets,
+	})
+	psh.prometheusHistogramMetrics.Store(name, metric)
+}
+
 // InitMetrics will declare and init all the metrics which should be used for Prometheus
 func (psh *PrometheusStatusHandler) InitMetrics() {
 	psh.InitMetricsMap()
@@ -34,14 +49,28 @@ func (psh *PrometheusStatusHandler) InitMetrics() {
 	psh.addMetric(core.MetricNonce, "The nonce for the node")
 	psh.addMetric(core.MetricCurrentRound, "The current round where the node is")
 	psh.addMetric(core.MetricNumConnectedPeers, "The current number of peers connected")
+	psh.addMetric(core.MetricNumStaticPeers, "The current number of peers from the static list")
+	psh.addMetric(core.MetricNumReachableStaticPeers, "The current number of reachable peers from the static list")
 	psh.addMetric(core.MetricIsSyncing, "The synchronization state. If it's in process of syncing will be 1"+
 		" and if it's synchronized will be 0")
 
+	psh.addHistogram(core.MetricBlockReceivedTime, "The time in milliseconds from the start of the round in which"+
+		" the proposed block was received", consensusTimeBuckets)
+	psh.addHistogram(core.MetricSignaturesGatheredTime, "The time in milliseconds from the start of the round in"+
+		" which the signatures threshold was reached", consensusTimeBuckets)
+	psh.addHistogram(core.MetricCommitTime, "The time in milliseconds from the start of the round in which the"+
+		" block was committed", consensusTimeBuckets)
+
 	psh.prometheusGaugeMetrics.Range(func(key, value interface{}) bool {
 		gauge := value.(prometheus.Gauge)
 		_ = prometheus.Register(gauge)
 		return true
 	})
+	psh.prometheusHistogramMetrics.Range(func(key, value interface{}) bool {
+		histogram := value.(prometheus.Histogram)
+		_ = prometheus.Register(histogram)
+		return true
+	})
 }
 
 // NewPrometheusStatusHandler will return an instance of a PrometheusStatusHandler
@@ -87,11 +116,14 @@ func (psh *PrometheusStatusHandler) SetInt64Value(key string, value int64) {
 	}
 }
 
-// SetUInt64Value method - will update the value for a key
+// SetUInt64Value method - will update the value for a key. For a histogram metric, the value is observed
 func (psh *PrometheusStatusHandler) SetUInt64Value(key string, value uint64) {
 	if metric, ok := psh.prometheusGaugeMetrics.Load(key); ok {
 		metric.(prometheus.Gauge).Set(float64(value))
 	}
+	if metric, ok := psh.prometheusHistogramMetrics.Load(key); ok {
+		metric.(prometheus.Histogram).Observe(float64(value))
+	}
 }
 
 // SetStringValue method - will update the value for a key
@@ -105,4 +137,9 @@ func (psh *PrometheusStatusHandler) Close() {
 		prometheus.Unregister(gauge)
 		return true
 	})
+	psh.prometheusHistogramMetrics.Range(func(key, value interface{}) bool {
+		histogram := value.(prometheus.Histogram)
+		prometheus.Unregister(histogram)
+		return true
+	})
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	prometheusUtils "github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -104,6 +105,28 @@ func TestPrometheusStatusHandler_TestSetUInt64Value(t *testing.T) {
 	assert.Equal(t, float64(20), result)
 }
 
+func TestPrometheusStatusHandler_TestSetUInt64ValueShouldObserveHistogram(t *testing.T) {
+	t.Parallel()
+
+	var metricKey = core.MetricCommitTime
+
+	promStatusHandler := statusHandler.NewPrometheusStatusHandler()
+
+	// observe two values
+	promStatusHandler.SetUInt64Value(metricKey, uint64(120))
+	promStatusHandler.SetUInt64Value(metricKey, uint64(3000))
+
+	histogram, err := promStatusHandler.GetPrometheusHistogramByKey(metricKey)
+	assert.Nil(t, err)
+
+	metric := &dto.Metric{}
+	err = histogram.Write(metric)
+	assert.Nil(t, err)
+	// test if both values were observed
+	assert.Equal(t, uint64(2), metric.GetHistogram().GetSampleCount())
+	assert.Equal(t, float64(3120), metric.GetHistogram().GetSampleSum())
+}
+
 func BenchmarkPrometheusStatusHandler_Increment(b *testing.B) {
 	var promStatusHandler core.AppStatusHandler
 	promStatusHandler = statusHandler.NewPrometheusStatusHandler()